@@ -42,6 +42,8 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(interceptors.RequestIDServerInterceptor()),
+		grpc.MaxRecvMsgSize(static.MaxMessageSize),
+		grpc.MaxSendMsgSize(static.MaxMessageSize),
 	)
 
 	staticGRPC := static.NewGRPC(staticService)
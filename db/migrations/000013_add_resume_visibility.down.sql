@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_resume_visibility;
+
+DROP TABLE IF EXISTS applicant_blocked_employer;
+
+ALTER TABLE resume DROP COLUMN IF EXISTS access_token;
+ALTER TABLE resume DROP COLUMN IF EXISTS visibility;
+
+DROP TYPE IF EXISTS resume_visibility_type;
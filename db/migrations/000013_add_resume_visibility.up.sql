@@ -0,0 +1,16 @@
+CREATE TYPE resume_visibility_type AS ENUM ('public', 'employers_only', 'link_only', 'hidden');
+
+-- Видимость резюме и секретный токен для доступа по ссылке
+ALTER TABLE resume ADD COLUMN visibility resume_visibility_type NOT NULL DEFAULT 'public';
+ALTER TABLE resume ADD COLUMN access_token TEXT UNIQUE;
+
+-- Работодатели, которым соискатель запретил просматривать свои резюме
+CREATE TABLE IF NOT EXISTS applicant_blocked_employer (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    applicant_id INT NOT NULL REFERENCES applicant(id) ON DELETE CASCADE,
+    employer_id INT NOT NULL REFERENCES employer(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(applicant_id, employer_id)
+);
+
+CREATE INDEX idx_resume_visibility ON resume(visibility);
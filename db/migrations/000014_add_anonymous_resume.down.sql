@@ -0,0 +1,14 @@
+DELETE FROM notification WHERE type = 'contact_request';
+
+ALTER TYPE notification_type RENAME TO notification_type_old;
+CREATE TYPE notification_type AS ENUM ('apply', 'download_resume');
+ALTER TABLE notification ALTER COLUMN type TYPE notification_type USING type::text::notification_type;
+DROP TYPE notification_type_old;
+
+DROP INDEX IF EXISTS idx_contact_request_applicant;
+
+DROP TABLE IF EXISTS contact_request;
+
+DROP TYPE IF EXISTS contact_request_status;
+
+ALTER TABLE resume DROP COLUMN IF EXISTS is_anonymous;
@@ -0,0 +1,19 @@
+-- Анонимное резюме: имя, фото и контакты скрыты от работодателя до согласия соискателя
+ALTER TABLE resume ADD COLUMN is_anonymous BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TYPE contact_request_status AS ENUM ('pending', 'accepted', 'declined');
+
+CREATE TABLE IF NOT EXISTS contact_request (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    employer_id INT NOT NULL REFERENCES employer(id) ON DELETE CASCADE,
+    applicant_id INT NOT NULL REFERENCES applicant(id) ON DELETE CASCADE,
+    resume_id INT NOT NULL REFERENCES resume(id) ON DELETE CASCADE,
+    status contact_request_status NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (employer_id, applicant_id)
+);
+
+CREATE INDEX idx_contact_request_applicant ON contact_request(applicant_id);
+
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'contact_request';
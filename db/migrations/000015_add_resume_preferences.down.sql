@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_resume_desired_salary;
+DROP INDEX IF EXISTS idx_resume_city_city;
+
+DROP TABLE IF EXISTS resume_city;
+
+ALTER TABLE resume DROP COLUMN IF EXISTS schedules;
+ALTER TABLE resume DROP COLUMN IF EXISTS employment_types;
+ALTER TABLE resume DROP COLUMN IF EXISTS work_formats;
+ALTER TABLE resume DROP COLUMN IF EXISTS ready_for_business_trips;
+ALTER TABLE resume DROP COLUMN IF EXISTS ready_to_relocate;
+ALTER TABLE resume DROP COLUMN IF EXISTS salary_currency;
+ALTER TABLE resume DROP COLUMN IF EXISTS desired_salary;
+
+DROP TYPE IF EXISTS currency_type;
@@ -0,0 +1,20 @@
+CREATE TYPE currency_type AS ENUM ('rub', 'usd', 'eur');
+
+-- Пожелания соискателя к будущей работе, 0 в desired_salary означает "по договоренности"
+ALTER TABLE resume ADD COLUMN desired_salary INTEGER NOT NULL DEFAULT 0 CHECK (desired_salary >= 0);
+ALTER TABLE resume ADD COLUMN salary_currency currency_type NOT NULL DEFAULT 'rub';
+ALTER TABLE resume ADD COLUMN ready_to_relocate BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE resume ADD COLUMN ready_for_business_trips BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE resume ADD COLUMN work_formats work_format_type[] NOT NULL DEFAULT '{}';
+ALTER TABLE resume ADD COLUMN employment_types employment_type[] NOT NULL DEFAULT '{}';
+ALTER TABLE resume ADD COLUMN schedules schedule_type[] NOT NULL DEFAULT '{}';
+
+-- Города, в которых соискатель готов работать
+CREATE TABLE IF NOT EXISTS resume_city (
+    resume_id INT NOT NULL REFERENCES resume(id) ON DELETE CASCADE,
+    city_id INT NOT NULL REFERENCES city(id) ON DELETE CASCADE,
+    PRIMARY KEY (resume_id, city_id)
+);
+
+CREATE INDEX idx_resume_city_city ON resume_city(city_id);
+CREATE INDEX idx_resume_desired_salary ON resume(desired_salary);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_applicant_status;
+DROP INDEX IF EXISTS idx_resume_updated_at;
+DROP INDEX IF EXISTS idx_resume_skill_skill;
+DROP INDEX IF EXISTS idx_work_experience_resume;
+DROP INDEX IF EXISTS idx_work_experience_fts;
+DROP INDEX IF EXISTS idx_resume_about_me_fts;
@@ -0,0 +1,11 @@
+-- Полнотекстовый поиск по резюме: о себе, обязанности и достижения
+CREATE INDEX idx_resume_about_me_fts
+    ON resume USING GIN (to_tsvector('russian', COALESCE(about_me, '')));
+
+CREATE INDEX idx_work_experience_fts
+    ON work_experience USING GIN (to_tsvector('russian', COALESCE(duties, '') || ' ' || COALESCE(achievements, '')));
+
+CREATE INDEX idx_work_experience_resume ON work_experience(resume_id);
+CREATE INDEX idx_resume_skill_skill ON resume_skill(skill_id);
+CREATE INDEX idx_resume_updated_at ON resume(updated_at);
+CREATE INDEX idx_applicant_status ON applicant(status);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_resume_language_language;
+DROP INDEX IF EXISTS idx_resume_course_resume;
+DROP INDEX IF EXISTS idx_resume_education_level;
+DROP INDEX IF EXISTS idx_resume_education_resume;
+
+DROP TABLE IF EXISTS resume_language;
+DROP TABLE IF EXISTS resume_course;
+DROP TABLE IF EXISTS resume_education;
+
+DROP TYPE IF EXISTS language_level_type;
+DROP TYPE IF EXISTS course_kind_type;
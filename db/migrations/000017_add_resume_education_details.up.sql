@@ -0,0 +1,52 @@
+CREATE TYPE course_kind_type AS ENUM ('course', 'certificate');
+
+-- Уровни владения языком по шкале CEFR, порядок значений используется при сравнении
+CREATE TYPE language_level_type AS ENUM ('A1', 'A2', 'B1', 'B2', 'C1', 'C2', 'native');
+
+CREATE TABLE IF NOT EXISTS resume_education (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    resume_id INT NOT NULL REFERENCES resume(id) ON DELETE CASCADE,
+    level education_type NOT NULL,
+    institution TEXT
+    CONSTRAINT resume_education_institution_length CHECK (LENGTH(institution) <= 255) NOT NULL,
+    faculty TEXT
+    CONSTRAINT resume_education_faculty_length CHECK (LENGTH(faculty) <= 255),
+    specialty TEXT
+    CONSTRAINT resume_education_specialty_length CHECK (LENGTH(specialty) <= 255),
+    graduation_year INT NOT NULL
+    CONSTRAINT resume_education_graduation_year_range CHECK (graduation_year BETWEEN 1950 AND 2100)
+);
+
+CREATE TABLE IF NOT EXISTS resume_course (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    resume_id INT NOT NULL REFERENCES resume(id) ON DELETE CASCADE,
+    kind course_kind_type NOT NULL DEFAULT 'course',
+    name TEXT
+    CONSTRAINT resume_course_name_length CHECK (LENGTH(name) <= 255) NOT NULL,
+    organization TEXT
+    CONSTRAINT resume_course_organization_length CHECK (LENGTH(organization) <= 255),
+    year INT
+    CONSTRAINT resume_course_year_range CHECK (year BETWEEN 1950 AND 2100),
+    file_id INT REFERENCES static(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS resume_language (
+    resume_id INT NOT NULL REFERENCES resume(id) ON DELETE CASCADE,
+    language TEXT
+    CONSTRAINT resume_language_length CHECK (LENGTH(language) <= 64) NOT NULL,
+    level language_level_type NOT NULL,
+    PRIMARY KEY (resume_id, language)
+);
+
+CREATE INDEX idx_resume_education_resume ON resume_education(resume_id);
+CREATE INDEX idx_resume_education_level ON resume_education(level);
+CREATE INDEX idx_resume_course_resume ON resume_course(resume_id);
+CREATE INDEX idx_resume_language_language ON resume_language(LOWER(language));
+
+-- Единственное образование из самого резюме переносится в список
+INSERT INTO resume_education (resume_id, level, institution, graduation_year)
+SELECT id, education, educational_institution, EXTRACT(YEAR FROM graduation_year)::INT
+FROM resume
+WHERE education IS NOT NULL
+  AND educational_institution IS NOT NULL
+  AND graduation_year IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_message_chat_id;
+
+ALTER TABLE chat DROP COLUMN IF EXISTS employer_last_read_message_id;
+ALTER TABLE chat DROP COLUMN IF EXISTS applicant_last_read_message_id;
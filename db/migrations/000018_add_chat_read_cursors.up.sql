@@ -0,0 +1,17 @@
+-- Курсоры прочтения: id последнего прочитанного сообщения для каждой стороны чата
+ALTER TABLE chat
+    ADD COLUMN applicant_last_read_message_id INT NOT NULL DEFAULT 0,
+    ADD COLUMN employer_last_read_message_id INT NOT NULL DEFAULT 0;
+
+-- Переписка, которая была до появления курсоров, считается прочитанной
+UPDATE chat
+SET applicant_last_read_message_id = last.id,
+    employer_last_read_message_id = last.id
+FROM (
+    SELECT chat_id, MAX(id) AS id
+    FROM message
+    GROUP BY chat_id
+) AS last
+WHERE last.chat_id = chat.id;
+
+CREATE INDEX idx_message_chat_id ON message(chat_id, id);
@@ -0,0 +1,3 @@
+-- Полнотекстовый поиск по сообщениям во всех чатах пользователя
+CREATE INDEX idx_message_payload_fts
+    ON message USING GIN (to_tsvector('russian', payload));
@@ -0,0 +1,9 @@
+-- Идентификатор, сгенерированный клиентом: повторная отправка того же сообщения после
+-- обрыва соединения возвращает уже сохраненную запись вместо создания дубликата
+ALTER TABLE message
+    ADD COLUMN client_message_id TEXT
+        CONSTRAINT message_client_message_id_length CHECK (LENGTH(client_message_id) <= 64);
+
+CREATE UNIQUE INDEX idx_message_client_message_id
+    ON message(chat_id, sender_id, from_applicant, client_message_id)
+    WHERE client_message_id IS NOT NULL;
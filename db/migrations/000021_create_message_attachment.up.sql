@@ -0,0 +1,19 @@
+-- Вложения загружаются до отправки сообщения, поэтому message_id заполняется только при отправке.
+-- Файлы лежат в закрытом бакете статики и отдаются участникам чата через приложение
+CREATE TABLE message_attachment (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    chat_id INTEGER NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    message_id INTEGER REFERENCES message(id) ON DELETE CASCADE,
+    uploader_id INTEGER NOT NULL,
+    from_applicant BOOLEAN NOT NULL,
+    static_id INTEGER NOT NULL REFERENCES static(id) ON DELETE CASCADE,
+    file_name TEXT
+        CONSTRAINT message_attachment_file_name_length CHECK (LENGTH(file_name) <= 255) NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER
+        CONSTRAINT message_attachment_size_positive CHECK (size > 0) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_message_attachment_message ON message_attachment(message_id);
+CREATE INDEX idx_message_attachment_chat ON message_attachment(chat_id);
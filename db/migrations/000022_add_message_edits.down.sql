@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS message_edit;
+
+ALTER TABLE message
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS edited_at;
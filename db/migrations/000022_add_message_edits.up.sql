@@ -0,0 +1,15 @@
+-- Исправленное сообщение хранит время последней правки, а прежние редакции - в message_edit.
+-- Удаленное сообщение остается в истории чата без текста, чтобы не сбивать курсоры страниц
+ALTER TABLE message
+    ADD COLUMN edited_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE message_edit (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    message_id INTEGER NOT NULL REFERENCES message(id) ON DELETE CASCADE,
+    payload TEXT
+        CONSTRAINT message_edit_payload_length CHECK (LENGTH(payload) <= 1024) NOT NULL,
+    edited_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_message_edit_message ON message_edit(message_id);
@@ -0,0 +1,12 @@
+DELETE FROM notification WHERE type = 'invitation';
+
+ALTER TYPE notification_type RENAME TO notification_type_old;
+CREATE TYPE notification_type AS ENUM ('apply', 'download_resume', 'contact_request');
+ALTER TABLE notification ALTER COLUMN type TYPE notification_type USING type::text::notification_type;
+DROP TYPE notification_type_old;
+
+DROP INDEX IF EXISTS idx_vacancy_invitation_applicant;
+
+DROP TABLE IF EXISTS vacancy_invitation;
+
+DROP TYPE IF EXISTS invitation_status;
@@ -0,0 +1,20 @@
+-- Приглашение работодателя на вакансию по найденному резюме. Вместе с приглашением создается чат,
+-- а принятое приглашение записывается как обычный отклик соискателя
+CREATE TYPE invitation_status AS ENUM ('pending', 'accepted', 'declined');
+
+CREATE TABLE IF NOT EXISTS vacancy_invitation (
+    id INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    vacancy_id INT NOT NULL REFERENCES vacancy(id) ON DELETE CASCADE,
+    resume_id INT NOT NULL REFERENCES resume(id) ON DELETE CASCADE,
+    employer_id INT NOT NULL REFERENCES employer(id) ON DELETE CASCADE,
+    applicant_id INT NOT NULL REFERENCES applicant(id) ON DELETE CASCADE,
+    chat_id INT REFERENCES chat(id) ON DELETE SET NULL,
+    status invitation_status NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (vacancy_id, applicant_id)
+);
+
+CREATE INDEX idx_vacancy_invitation_applicant ON vacancy_invitation(applicant_id);
+
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'invitation';
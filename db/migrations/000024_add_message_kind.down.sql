@@ -0,0 +1,10 @@
+DELETE FROM message WHERE kind = 'system';
+
+ALTER TABLE message
+    DROP CONSTRAINT IF EXISTS message_sender_required,
+    ALTER COLUMN sender_id SET NOT NULL,
+    ALTER COLUMN from_applicant SET NOT NULL,
+    DROP COLUMN IF EXISTS event,
+    DROP COLUMN IF EXISTS kind;
+
+DROP TYPE IF EXISTS message_kind;
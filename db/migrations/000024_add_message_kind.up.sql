@@ -0,0 +1,11 @@
+-- Системные сообщения сообщают об откликах, приглашениях и скачиваниях резюме прямо в чате.
+-- Отправителя у них нет, поэтому sender_id и from_applicant обязательны только для сообщений участников
+CREATE TYPE message_kind AS ENUM ('user', 'system');
+
+ALTER TABLE message
+    ADD COLUMN kind message_kind NOT NULL DEFAULT 'user',
+    ADD COLUMN event TEXT,
+    ALTER COLUMN sender_id DROP NOT NULL,
+    ALTER COLUMN from_applicant DROP NOT NULL,
+    ADD CONSTRAINT message_sender_required
+        CHECK (kind = 'system' OR (sender_id IS NOT NULL AND from_applicant IS NOT NULL));
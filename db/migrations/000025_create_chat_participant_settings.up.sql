@@ -0,0 +1,11 @@
+-- Настройки чата у каждого участника свои: соискатель и работодатель архивируют,
+-- отключают уведомления и блокируют собеседника независимо друг от друга
+CREATE TABLE chat_participant_settings (
+    chat_id INTEGER NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    is_applicant BOOLEAN NOT NULL,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    muted BOOLEAN NOT NULL DEFAULT FALSE,
+    blocked BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (chat_id, is_applicant)
+);
@@ -0,0 +1,11 @@
+-- Состояние писем-дайджестов с непрочитанными сообщениями: последнее сообщение, о котором
+-- пользователь уже получил письмо, и отказ от рассылки по ссылке из письма
+CREATE TABLE message_digest (
+    user_id INTEGER NOT NULL,
+    is_applicant BOOLEAN NOT NULL,
+    last_message_id INTEGER NOT NULL DEFAULT 0,
+    unsubscribed BOOLEAN NOT NULL DEFAULT FALSE,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, is_applicant)
+);
@@ -0,0 +1,23 @@
+-- Настройки уведомлений: хранятся только каналы, которые пользователь изменил,
+-- остальные включены или выключены по умолчанию. Тип хранится строкой, чтобы новые
+-- типы уведомлений не требовали миграции
+CREATE TABLE notification_preference (
+    user_id INTEGER NOT NULL,
+    is_applicant BOOLEAN NOT NULL,
+    type TEXT NOT NULL,
+    channel TEXT NOT NULL CHECK (channel IN ('in_app', 'websocket', 'email')),
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, is_applicant, type, channel)
+);
+
+-- Тихие часы пользователя. Нет строки - тихие часы выключены
+CREATE TABLE notification_quiet_hours (
+    user_id INTEGER NOT NULL,
+    is_applicant BOOLEAN NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    time_zone TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, is_applicant)
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_notification_receiver;
+
+DELETE FROM notification WHERE type NOT IN ('apply', 'download_resume', 'contact_request', 'invitation');
+
+ALTER TABLE notification DROP COLUMN IF EXISTS payload;
+
+CREATE TYPE notification_type AS ENUM ('apply', 'download_resume', 'contact_request', 'invitation');
+ALTER TABLE notification ALTER COLUMN type TYPE notification_type USING type::notification_type;
@@ -0,0 +1,32 @@
+-- Типы уведомлений описываются в коде, поэтому новый тип больше не требует миграции перечисления.
+-- Данные, нужные для превью, хранятся в payload на момент события
+ALTER TABLE notification ALTER COLUMN type TYPE TEXT USING type::text;
+DROP TYPE IF EXISTS notification_type;
+
+ALTER TABLE notification ADD COLUMN payload JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+UPDATE notification
+SET sender_role = CASE WHEN type = 'apply' THEN 'applicant' ELSE 'employer' END::user_type
+WHERE sender_role IS NULL;
+
+UPDATE notification
+SET receiver_role = CASE WHEN type = 'apply' THEN 'employer' ELSE 'applicant' END::user_type
+WHERE receiver_role IS NULL;
+
+UPDATE notification n
+SET payload = jsonb_build_object('vacancy_title', v.title)
+FROM vacancy v
+WHERE n.type = 'apply' AND v.id = n.object_id;
+
+UPDATE notification n
+SET payload = jsonb_build_object('profession', COALESCE(r.profession, ''))
+FROM resume r
+WHERE n.type IN ('download_resume', 'contact_request') AND r.id = n.resume_id;
+
+UPDATE notification n
+SET payload = jsonb_build_object('vacancy_title', v.title)
+FROM vacancy_invitation vi
+JOIN vacancy v ON v.id = vi.vacancy_id
+WHERE n.type = 'invitation' AND vi.id = n.object_id;
+
+CREATE INDEX IF NOT EXISTS idx_notification_receiver ON notification(receiver_id, receiver_role, created_at DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_notification_group_head;
+DROP INDEX IF EXISTS idx_notification_group;
+
+ALTER TABLE notification DROP COLUMN IF EXISTS group_id;
@@ -0,0 +1,5 @@
+-- Уведомление группы ссылается на первое уведомление группы, у первого и у одиночных group_id пустой
+ALTER TABLE notification ADD COLUMN group_id INT;
+
+CREATE INDEX IF NOT EXISTS idx_notification_group ON notification(group_id) WHERE group_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_notification_group_head ON notification(receiver_id, type, object_id, created_at DESC) WHERE group_id IS NULL;
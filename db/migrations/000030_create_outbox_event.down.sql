@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_message_outbox_event;
+DROP INDEX IF EXISTS idx_notification_outbox_event;
+
+ALTER TABLE message DROP COLUMN IF EXISTS outbox_event_id;
+ALTER TABLE notification DROP COLUMN IF EXISTS outbox_event_id;
+
+DROP TABLE IF EXISTS outbox_event;
@@ -0,0 +1,25 @@
+-- Доменные события пишутся в одной транзакции с изменением, которое их породило,
+-- и доставляются получателям диспетчером после фиксации. События без уведомления,
+-- например об изменении вакансии, несут только id затронутых объектов
+CREATE TABLE IF NOT EXISTS outbox_event (
+    id SERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    notification JSONB,
+    objects JSONB NOT NULL DEFAULT '{}',
+    delivered_sinks TEXT[] NOT NULL DEFAULT '{}',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_event_pending ON outbox_event(next_attempt_at) WHERE delivered_at IS NULL;
+
+-- Получатели outbox доставляют событие не меньше одного раза. Созданные по событию уведомление
+-- и системные сообщения помечаются его id, чтобы повторная доставка не создала их еще раз
+ALTER TABLE notification ADD COLUMN outbox_event_id INT;
+ALTER TABLE message ADD COLUMN outbox_event_id INT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_outbox_event ON notification(outbox_event_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_message_outbox_event ON message(chat_id, outbox_event_id);
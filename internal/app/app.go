@@ -4,14 +4,17 @@ import (
 	"ResuMatch/internal/config"
 	"ResuMatch/internal/metrics"
 	"ResuMatch/internal/repository/postgres"
+	redisRepo "ResuMatch/internal/repository/redis"
 	"ResuMatch/internal/server"
 	"ResuMatch/internal/transport/grpc/auth"
 	"ResuMatch/internal/transport/grpc/static"
 	handler "ResuMatch/internal/transport/http"
+	"ResuMatch/internal/transport/mail"
 	"ResuMatch/internal/transport/ws"
 	"ResuMatch/internal/usecase/service"
 	"ResuMatch/pkg/connector"
 	l "ResuMatch/pkg/logger"
+	"context"
 	"net/http"
 )
 
@@ -22,6 +25,9 @@ func Init(cfg *config.Config) *server.Server {
 		l.Log.Errorf("Не удалось установить соединение соединение с postgres: %v", err)
 	}
 
+	// Redis Connection
+	redisPool := connector.NewRedisPool(cfg.Redis)
+
 	// Repositories Init
 	resumeRepo, err := postgres.NewResumeRepository(postgresConn)
 	if err != nil {
@@ -73,6 +79,12 @@ func Init(cfg *config.Config) *server.Server {
 		l.Log.Errorf("Ошибка создания репозитория сообщений: %v", err)
 	}
 
+	presenceRepo := redisRepo.NewPresenceRepository(redisPool)
+
+	digestRepo := postgres.NewDigestRepository(postgresConn)
+	notificationSettingsRepo := postgres.NewNotificationSettingsRepository(postgresConn)
+	outboxRepo := postgres.NewOutboxRepository(postgresConn)
+
 	// Use Cases Init
 	staticService, err := static.NewGateway(cfg.Microservices.S3.Addr())
 	if err != nil {
@@ -84,27 +96,38 @@ func Init(cfg *config.Config) *server.Server {
 		l.Log.Errorf("Ошибка при подключении к сервису авторизации: %v", err)
 	}
 
-	applicantService := service.NewApplicantService(applicantRepo, cityRepo, staticService)
+	applicantService := service.NewApplicantService(applicantRepo, cityRepo, resumeRepo, staticService)
 	employerService := service.NewEmployerService(employerRepo, staticService)
 
 	specializationService := service.NewSpecializationService(specializationRepo)
 
-	resumeService := service.NewResumeService(resumeRepo, skillRepo, specializationRepo, applicantRepo, applicantService, cfg.Resume)
+	resumeService := service.NewResumeService(resumeRepo, skillRepo, specializationRepo, applicantRepo, applicantService, staticService, outboxRepo, cfg.Resume)
 	vacancyService := service.NewVacanciesService(vacancyRepo, applicantRepo, specializationRepo, employerService, resumeRepo, applicantService)
-	notificationService := service.NewNotificationService(notificationRepo)
-	chatService := service.NewChatService(applicantService, employerService, resumeService, vacancyService, chatRepo, messageRepo)
+	mailer := mail.NewMailer(cfg.Digest.SMTP)
+	notificationService := service.NewNotificationService(notificationRepo, notificationSettingsRepo, mailer)
+	presenceService := service.NewPresenceService(presenceRepo)
+	digestService := service.NewDigestService(digestRepo, notificationSettingsRepo, presenceService, mailer, cfg.Digest)
+	chatService := service.NewChatService(applicantService, employerService, resumeService, resumeRepo, vacancyService, chatRepo, messageRepo, presenceService, staticService, cfg.Chat, cfg.Resume)
 
 	// Transport Init
-	wsHub := ws.NewHub(chatService)
+	wsBroker := ws.NewRedisBroker(redisPool)
+	wsHub := ws.NewHub(chatService, notificationService, presenceService, wsBroker)
+	outboxService := service.NewOutboxService(outboxRepo, cfg.Outbox,
+		ws.NewNotificationSink(wsHub, notificationService),
+		ws.NewChatSink(wsHub, chatService),
+		service.NewNotificationMailSink(notificationService),
+	)
 	go wsHub.Run()
+	go digestService.Run(context.Background())
+	go outboxService.Run(context.Background())
 
 	authHandler := handler.NewAuthHandler(authService, cfg.CSRF)
 	applicantHandler := handler.NewApplicantHandler(authService, applicantService, cfg.CSRF)
 	employmentHandler := handler.NewEmployerHandler(authService, employerService, cfg.CSRF)
-	resumeHandler := handler.NewResumeHandler(authService, resumeService, cfg.CSRF, wsHub, notificationService)
-	vacancyHandler := handler.NewVacancyHandler(authService, vacancyService, cfg.CSRF, wsHub, notificationService)
+	resumeHandler := handler.NewResumeHandler(authService, resumeService, cfg.CSRF)
+	vacancyHandler := handler.NewVacancyHandler(authService, vacancyService, cfg.CSRF)
 	specializationHandler := handler.NewSpecializationHandler(specializationService)
-	notificationHandler := handler.NewNotificationHandler(notificationService, authService)
+	notificationHandler := handler.NewNotificationHandler(notificationService, authService, digestService)
 	chatHandler := handler.NewChatHandler(authService, chatService)
 	websocketHandler := ws.NewWebsocketHandler(authService, wsHub)
 
@@ -56,6 +56,7 @@ type MinioConfig struct {
 	UseSSL           bool   `yaml:"use_ssl"`
 	Scheme           string `yaml:"scheme"`
 	Bucket           string `yaml:"bucket"`
+	PrivateBucket    string `yaml:"private_bucket"`
 }
 
 type S3ClientConfig struct {
@@ -127,6 +128,44 @@ type ResumeConfig struct {
 	GenerateURL string `yaml:"generateURL"`
 }
 
+// ChatConfig - настройки чатов. Выгрузка переписки в PDF использует путь к шаблонам
+// и Gotenberg из ResumeConfig
+type ChatConfig struct {
+	ExportFile string `yaml:"exportFile"`
+}
+
+// DigestConfig - рассылка писем о непрочитанных сообщениях пользователям, которые не на сайте
+type DigestConfig struct {
+	Interval    time.Duration `yaml:"interval"`
+	UnreadAfter time.Duration `yaml:"unreadAfter"`
+	BaseURL     string        `yaml:"baseURL"`
+	Secret      string        `yaml:"-"`
+	SMTP        SMTPConfig    `yaml:"smtp"`
+}
+
+// OutboxConfig - доставка доменных событий из outbox
+type OutboxConfig struct {
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int           `yaml:"batchSize"`
+	// Lease - время, на которое событие скрывается от других диспетчеров, пока обрабатывается
+	Lease       time.Duration `yaml:"lease"`
+	MaxAttempts int           `yaml:"maxAttempts"`
+	// RetryDelay - пауза перед первым повтором, каждая следующая вдвое длиннее
+	RetryDelay time.Duration `yaml:"retryDelay"`
+}
+
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	From     string `yaml:"from"`
+	Username string `yaml:"-"`
+	Password string `yaml:"-"`
+}
+
+func (s *SMTPConfig) Addr() string {
+	return fmt.Sprintf("%s:%s", s.Host, s.Port)
+}
+
 type Config struct {
 	HTTP          HTTPConfig          `yaml:"http"`
 	Session       SessionConfig       `yaml:"session_id"`
@@ -134,6 +173,10 @@ type Config struct {
 	Postgres      PostgresConfig      `yaml:"postgres"`
 	Microservices MicroservicesConfig `yaml:"microservices"`
 	Resume        ResumeConfig        `yaml:"resume"`
+	Redis         RedisConfig         `yaml:"redis"`
+	Chat          ChatConfig          `yaml:"chat"`
+	Digest        DigestConfig        `yaml:"digest"`
+	Outbox        OutboxConfig        `yaml:"outbox"`
 }
 
 func LoadAppConfig(vaultClient *vault.VaultClient) (*Config, error) {
@@ -195,6 +238,15 @@ func LoadAppConfig(vaultClient *vault.VaultClient) (*Config, error) {
 
 	cfg.Postgres = loadPostgresConfig()
 
+	// Redis основного приложения используется для доставки websocket-сообщений между экземплярами
+	cfg.Redis.Host = os.Getenv("REDIS_HOST")
+	cfg.Redis.Port = os.Getenv("REDIS_CONTAINER_PORT")
+	cfg.Redis.Password = os.Getenv("REDIS_PASSWORD")
+
+	cfg.Digest.Secret = os.Getenv("DIGEST_SECRET")
+	cfg.Digest.SMTP.Username = os.Getenv("SMTP_USER")
+	cfg.Digest.SMTP.Password = os.Getenv("SMTP_PASSWORD")
+
 	return &cfg, nil
 }
 
@@ -5,11 +5,87 @@ import (
 )
 
 type Chat struct {
-	ID          int       `json:"id"`
-	VacancyID   int       `json:"vacancy_id"`
-	ResumeID    int       `json:"resume_id"`
-	ApplicantID int       `json:"applicant_id"`
-	EmployerID  int       `json:"employer_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                  int       `json:"id"`
+	VacancyID           int       `json:"vacancy_id"`
+	ResumeID            int       `json:"resume_id"`
+	ApplicantID         int       `json:"applicant_id"`
+	EmployerID          int       `json:"employer_id"`
+	ApplicantLastReadID int       `json:"applicant_last_read_id"`
+	EmployerLastReadID  int       `json:"employer_last_read_id"`
+	UnreadCount         int       `json:"unread_count"`
+	ApplicantBlocked    bool      `json:"applicant_blocked"`
+	EmployerBlocked     bool      `json:"employer_blocked"`
+	Archived            bool      `json:"archived"`
+	Muted               bool      `json:"muted"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// IsBlocked сообщает, заблокировал ли чат хотя бы один из участников
+func (c *Chat) IsBlocked() bool {
+	return c.ApplicantBlocked || c.EmployerBlocked
+}
+
+// BlockedBy сообщает, заблокировала ли чат указанная сторона
+func (c *Chat) BlockedBy(isApplicant bool) bool {
+	if isApplicant {
+		return c.ApplicantBlocked
+	}
+	return c.EmployerBlocked
+}
+
+// LastReadID возвращает курсор прочтения стороны чата
+func (c *Chat) LastReadID(isApplicant bool) int {
+	if isApplicant {
+		return c.ApplicantLastReadID
+	}
+	return c.EmployerLastReadID
+}
+
+// ChatSettings - настройки чата одного из участников. Архивный чат возвращается в общий список,
+// как только в нем появляется новое сообщение, поэтому хранится время архивации, а не флаг
+type ChatSettings struct {
+	ChatID      int        `json:"chat_id"`
+	IsApplicant bool       `json:"is_applicant"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+	Archived    bool       `json:"archived"`
+	Muted       bool       `json:"muted"`
+	Blocked     bool       `json:"blocked"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// UnreadCount - непрочитанные пользователем сообщения во всех его чатах
+type UnreadCount struct {
+	Messages int
+	Chats    int
+}
+
+// ExportFormat - формат выгрузки переписки
+type ExportFormat string
+
+const (
+	ExportFormatPDF  ExportFormat = "pdf"
+	ExportFormatHTML ExportFormat = "html"
+	ExportFormatJSON ExportFormat = "json"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationDeclined InvitationStatus = "declined"
+)
+
+// Invitation - приглашение работодателя на вакансию, отправленное соискателю по найденному резюме
+type Invitation struct {
+	ID          int              `json:"id"`
+	VacancyID   int              `json:"vacancy_id"`
+	ResumeID    int              `json:"resume_id"`
+	EmployerID  int              `json:"employer_id"`
+	ApplicantID int              `json:"applicant_id"`
+	ChatID      int              `json:"chat_id"`
+	Status      InvitationStatus `json:"status"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
 }
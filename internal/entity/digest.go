@@ -0,0 +1,45 @@
+package entity
+
+// DigestChat - непрочитанные сообщения одного чата в письме-дайджесте
+type DigestChat struct {
+	ChatID        int
+	VacancyTitle  string
+	Unread        int
+	LastMessageID int
+}
+
+// Digest - письмо пользователю, который давно не читал сообщения и сейчас не на сайте
+type Digest struct {
+	UserID int
+	Role   UserRole
+	Email  string
+	Chats  []*DigestChat
+}
+
+// LastMessageID возвращает последнее сообщение, попавшее в дайджест
+func (d *Digest) LastMessageID() int {
+	var last int
+	for _, chat := range d.Chats {
+		if chat.LastMessageID > last {
+			last = chat.LastMessageID
+		}
+	}
+	return last
+}
+
+// Unread возвращает число непрочитанных сообщений во всех чатах дайджеста
+func (d *Digest) Unread() int {
+	var unread int
+	for _, chat := range d.Chats {
+		unread += chat.Unread
+	}
+	return unread
+}
+
+// Mail - письмо для отправки через почтовый сервис
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+	Headers map[string]string
+}
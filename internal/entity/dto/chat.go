@@ -1,21 +1,34 @@
 package dto
 
-import "time"
+import (
+	"ResuMatch/internal/entity"
+	"time"
+)
 
 // easyjson:json
 type ChatResponse struct {
-	ID        int                  `json:"id"`
-	Vacancy   *VacancyChatResponse `json:"vacancy"`
-	Resume    *ResumeChatResponse  `json:"resume"`
-	CreatedAt time.Time            `json:"created_at"`
-	UpdatedAt time.Time            `json:"updated_at"`
+	ID                    int                  `json:"id"`
+	Vacancy               *VacancyChatResponse `json:"vacancy"`
+	Resume                *ResumeChatResponse  `json:"resume"`
+	LastReadMessageID     int                  `json:"last_read_message_id"`
+	PeerLastReadMessageID int                  `json:"peer_last_read_message_id"`
+	PeerPresence          *PresenceResponse    `json:"peer_presence"`
+	Blocked               bool                 `json:"blocked"`
+	BlockedByPeer         bool                 `json:"blocked_by_peer"`
+	CreatedAt             time.Time            `json:"created_at"`
+	UpdatedAt             time.Time            `json:"updated_at"`
 }
 
 // easyjson:json
 type ChatShortResponse struct {
-	ID           int             `json:"id"`
-	VacancyTitle string          `json:"vacancy_title"`
-	User         ChatUserPreview `json:"user"`
+	ID            int             `json:"id"`
+	VacancyTitle  string          `json:"vacancy_title"`
+	User          ChatUserPreview `json:"user"`
+	UnreadCount   int             `json:"unread_count"`
+	Archived      bool            `json:"archived"`
+	Muted         bool            `json:"muted"`
+	Blocked       bool            `json:"blocked"`
+	BlockedByPeer bool            `json:"blocked_by_peer"`
 }
 
 // easyjson:json
@@ -27,3 +40,84 @@ type ChatUserPreview struct {
 
 // easyjson:json
 type ChatResponseList []*ChatShortResponse
+
+// ChatPeer - собеседник пользователя. UserHidden сообщает, что собеседник - работодатель, которому
+// владелец анонимного резюме еще не раскрыт, поэтому id пользователя ему передавать нельзя
+type ChatPeer struct {
+	ID         int
+	UserHidden bool
+}
+
+// ChatSettingsRequest - изменение настроек чата. Незаполненные поля остаются прежними
+// easyjson:json
+type ChatSettingsRequest struct {
+	Archived *bool `json:"archived,omitempty"`
+	Muted    *bool `json:"muted,omitempty"`
+	Blocked  *bool `json:"blocked,omitempty"`
+}
+
+// easyjson:json
+type ChatSettingsResponse struct {
+	ChatID   int  `json:"chat_id"`
+	Archived bool `json:"archived"`
+	Muted    bool `json:"muted"`
+	Blocked  bool `json:"blocked"`
+}
+
+// easyjson:json
+type UnreadCountResponse struct {
+	Messages int `json:"messages"`
+	Chats    int `json:"chats"`
+}
+
+// InvitationRequest - работодатель приглашает владельца резюме на одну из своих активных вакансий
+// easyjson:json
+type InvitationRequest struct {
+	VacancyID int `json:"vacancy_id"`
+	ResumeID  int `json:"resume_id"`
+}
+
+// easyjson:json
+type InvitationResponse struct {
+	ID          int                     `json:"id"`
+	VacancyID   int                     `json:"vacancy_id"`
+	ResumeID    int                     `json:"resume_id"`
+	EmployerID  int                     `json:"employer_id"`
+	ApplicantID int                     `json:"applicant_id"`
+	ChatID      int                     `json:"chat_id"`
+	Status      entity.InvitationStatus `json:"status"`
+	Message     *MessageResponse        `json:"message,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+// ChatExport - вся переписка чата для выгрузки в архив. По этой же структуре строится HTML-шаблон
+// easyjson:json
+type ChatExport struct {
+	ChatID        int                  `json:"chat_id"`
+	VacancyID     int                  `json:"vacancy_id"`
+	VacancyTitle  string               `json:"vacancy_title"`
+	ResumeID      int                  `json:"resume_id"`
+	Profession    string               `json:"profession"`
+	ApplicantName string               `json:"applicant_name"`
+	EmployerName  string               `json:"employer_name"`
+	CreatedAt     time.Time            `json:"created_at"`
+	ExportedAt    time.Time            `json:"exported_at"`
+	Messages      []*ChatExportMessage `json:"messages"`
+}
+
+// ChatExportMessage - сообщение в выгрузке. Вместо вложений указываются только имена файлов,
+// у системных сообщений нет автора
+// easyjson:json
+type ChatExportMessage struct {
+	ID            int                `json:"id"`
+	Author        string             `json:"author,omitempty"`
+	FromApplicant bool               `json:"from_applicant"`
+	Kind          entity.MessageKind `json:"kind"`
+	Event         entity.SystemEvent `json:"event,omitempty"`
+	Payload       string             `json:"payload"`
+	Attachments   []string           `json:"attachments,omitempty"`
+	SentAt        time.Time          `json:"sent_at"`
+	EditedAt      *time.Time         `json:"edited_at,omitempty"`
+	Deleted       bool               `json:"deleted"`
+}
@@ -3,10 +3,12 @@
 package dto
 
 import (
+	entity "ResuMatch/internal/entity"
 	json "encoding/json"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +19,294 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson9b8f5552DecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *ChatUserPreview) {
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *UnreadCountResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			out.Messages = int(in.Int())
+		case "chats":
+			out.Chats = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto(out *jwriter.Writer, in UnreadCountResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Messages))
+	}
+	{
+		const prefix string = ",\"chats\":"
+		out.RawString(prefix)
+		out.Int(int(in.Chats))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnreadCountResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnreadCountResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnreadCountResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnreadCountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *InvitationResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "vacancy_id":
+			out.VacancyID = int(in.Int())
+		case "resume_id":
+			out.ResumeID = int(in.Int())
+		case "employer_id":
+			out.EmployerID = int(in.Int())
+		case "applicant_id":
+			out.ApplicantID = int(in.Int())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "status":
+			out.Status = entity.InvitationStatus(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(MessageResponse)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in InvitationResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"vacancy_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VacancyID))
+	}
+	{
+		const prefix string = ",\"resume_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ResumeID))
+	}
+	{
+		const prefix string = ",\"employer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.EmployerID))
+	}
+	{
+		const prefix string = ",\"applicant_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ApplicantID))
+	}
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		(*in.Message).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InvitationResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InvitationResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InvitationResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InvitationResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto1(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto2(in *jlexer.Lexer, out *InvitationRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "vacancy_id":
+			out.VacancyID = int(in.Int())
+		case "resume_id":
+			out.ResumeID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto2(out *jwriter.Writer, in InvitationRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vacancy_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.VacancyID))
+	}
+	{
+		const prefix string = ",\"resume_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ResumeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InvitationRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InvitationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InvitationRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InvitationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto2(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto3(in *jlexer.Lexer, out *ChatUserPreview) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -52,7 +341,7 @@ func easyjson9b8f5552DecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *Cha
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552EncodeResuMatchInternalEntityDto(out *jwriter.Writer, in ChatUserPreview) {
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in ChatUserPreview) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -77,27 +366,27 @@ func easyjson9b8f5552EncodeResuMatchInternalEntityDto(out *jwriter.Writer, in Ch
 // MarshalJSON supports json.Marshaler interface
 func (v ChatUserPreview) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto(&w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChatUserPreview) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto(w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChatUserPreview) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto(&r, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChatUserPreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto(l, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto3(l, v)
 }
-func easyjson9b8f5552DecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *ChatShortResponse) {
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto4(in *jlexer.Lexer, out *ChatShortResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -122,6 +411,232 @@ func easyjson9b8f5552DecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *Ch
 			out.VacancyTitle = string(in.String())
 		case "user":
 			(out.User).UnmarshalEasyJSON(in)
+		case "unread_count":
+			out.UnreadCount = int(in.Int())
+		case "archived":
+			out.Archived = bool(in.Bool())
+		case "muted":
+			out.Muted = bool(in.Bool())
+		case "blocked":
+			out.Blocked = bool(in.Bool())
+		case "blocked_by_peer":
+			out.BlockedByPeer = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto4(out *jwriter.Writer, in ChatShortResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"vacancy_title\":"
+		out.RawString(prefix)
+		out.String(string(in.VacancyTitle))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"unread_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnreadCount))
+	}
+	{
+		const prefix string = ",\"archived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Archived))
+	}
+	{
+		const prefix string = ",\"muted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Muted))
+	}
+	{
+		const prefix string = ",\"blocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blocked))
+	}
+	{
+		const prefix string = ",\"blocked_by_peer\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.BlockedByPeer))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatShortResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatShortResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatShortResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatShortResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto4(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *ChatSettingsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "archived":
+			out.Archived = bool(in.Bool())
+		case "muted":
+			out.Muted = bool(in.Bool())
+		case "blocked":
+			out.Blocked = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in ChatSettingsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"archived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Archived))
+	}
+	{
+		const prefix string = ",\"muted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Muted))
+	}
+	{
+		const prefix string = ",\"blocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blocked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatSettingsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatSettingsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatSettingsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatSettingsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto5(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *ChatSettingsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "archived":
+			if in.IsNull() {
+				in.Skip()
+				out.Archived = nil
+			} else {
+				if out.Archived == nil {
+					out.Archived = new(bool)
+				}
+				*out.Archived = bool(in.Bool())
+			}
+		case "muted":
+			if in.IsNull() {
+				in.Skip()
+				out.Muted = nil
+			} else {
+				if out.Muted == nil {
+					out.Muted = new(bool)
+				}
+				*out.Muted = bool(in.Bool())
+			}
+		case "blocked":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocked = nil
+			} else {
+				if out.Blocked == nil {
+					out.Blocked = new(bool)
+				}
+				*out.Blocked = bool(in.Bool())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -132,52 +647,63 @@ func easyjson9b8f5552DecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *Ch
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552EncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in ChatShortResponse) {
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in ChatSettingsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
+	if in.Archived != nil {
+		const prefix string = ",\"archived\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		out.Bool(bool(*in.Archived))
 	}
-	{
-		const prefix string = ",\"vacancy_title\":"
-		out.RawString(prefix)
-		out.String(string(in.VacancyTitle))
+	if in.Muted != nil {
+		const prefix string = ",\"muted\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.Muted))
 	}
-	{
-		const prefix string = ",\"user\":"
-		out.RawString(prefix)
-		(in.User).MarshalEasyJSON(out)
+	if in.Blocked != nil {
+		const prefix string = ",\"blocked\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.Blocked))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatShortResponse) MarshalJSON() ([]byte, error) {
+func (v ChatSettingsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto1(&w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatShortResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto1(w, v)
+func (v ChatSettingsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatShortResponse) UnmarshalJSON(data []byte) error {
+func (v *ChatSettingsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto1(&r, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatShortResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto1(l, v)
+func (v *ChatSettingsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto6(l, v)
 }
-func easyjson9b8f5552DecodeResuMatchInternalEntityDto2(in *jlexer.Lexer, out *ChatResponseList) {
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto7(in *jlexer.Lexer, out *ChatResponseList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -213,7 +739,7 @@ func easyjson9b8f5552DecodeResuMatchInternalEntityDto2(in *jlexer.Lexer, out *Ch
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552EncodeResuMatchInternalEntityDto2(out *jwriter.Writer, in ChatResponseList) {
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto7(out *jwriter.Writer, in ChatResponseList) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -235,27 +761,27 @@ func easyjson9b8f5552EncodeResuMatchInternalEntityDto2(out *jwriter.Writer, in C
 // MarshalJSON supports json.Marshaler interface
 func (v ChatResponseList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto2(&w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChatResponseList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto2(w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChatResponseList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto2(&r, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChatResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto2(l, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto7(l, v)
 }
-func easyjson9b8f5552DecodeResuMatchInternalEntityDto3(in *jlexer.Lexer, out *ChatResponse) {
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto8(in *jlexer.Lexer, out *ChatResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -296,6 +822,24 @@ func easyjson9b8f5552DecodeResuMatchInternalEntityDto3(in *jlexer.Lexer, out *Ch
 				}
 				(*out.Resume).UnmarshalEasyJSON(in)
 			}
+		case "last_read_message_id":
+			out.LastReadMessageID = int(in.Int())
+		case "peer_last_read_message_id":
+			out.PeerLastReadMessageID = int(in.Int())
+		case "peer_presence":
+			if in.IsNull() {
+				in.Skip()
+				out.PeerPresence = nil
+			} else {
+				if out.PeerPresence == nil {
+					out.PeerPresence = new(PresenceResponse)
+				}
+				(*out.PeerPresence).UnmarshalEasyJSON(in)
+			}
+		case "blocked":
+			out.Blocked = bool(in.Bool())
+		case "blocked_by_peer":
+			out.BlockedByPeer = bool(in.Bool())
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -314,7 +858,7 @@ func easyjson9b8f5552DecodeResuMatchInternalEntityDto3(in *jlexer.Lexer, out *Ch
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in ChatResponse) {
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto8(out *jwriter.Writer, in ChatResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -341,6 +885,35 @@ func easyjson9b8f5552EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in C
 			(*in.Resume).MarshalEasyJSON(out)
 		}
 	}
+	{
+		const prefix string = ",\"last_read_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastReadMessageID))
+	}
+	{
+		const prefix string = ",\"peer_last_read_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerLastReadMessageID))
+	}
+	{
+		const prefix string = ",\"peer_presence\":"
+		out.RawString(prefix)
+		if in.PeerPresence == nil {
+			out.RawString("null")
+		} else {
+			(*in.PeerPresence).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"blocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blocked))
+	}
+	{
+		const prefix string = ",\"blocked_by_peer\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.BlockedByPeer))
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -357,23 +930,371 @@ func easyjson9b8f5552EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in C
 // MarshalJSON supports json.Marshaler interface
 func (v ChatResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto3(&w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChatResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552EncodeResuMatchInternalEntityDto3(w, v)
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChatResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto3(&r, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChatResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552DecodeResuMatchInternalEntityDto3(l, v)
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto8(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto9(in *jlexer.Lexer, out *ChatExportMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "author":
+			out.Author = string(in.String())
+		case "from_applicant":
+			out.FromApplicant = bool(in.Bool())
+		case "kind":
+			out.Kind = entity.MessageKind(in.String())
+		case "event":
+			out.Event = entity.SystemEvent(in.String())
+		case "payload":
+			out.Payload = string(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]string, 0, 4)
+					} else {
+						out.Attachments = []string{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Attachments = append(out.Attachments, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sent_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.SentAt).UnmarshalJSON(data))
+			}
+		case "edited_at":
+			if in.IsNull() {
+				in.Skip()
+				out.EditedAt = nil
+			} else {
+				if out.EditedAt == nil {
+					out.EditedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EditedAt).UnmarshalJSON(data))
+				}
+			}
+		case "deleted":
+			out.Deleted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto9(out *jwriter.Writer, in ChatExportMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.Author != "" {
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		out.String(string(in.Author))
+	}
+	{
+		const prefix string = ",\"from_applicant\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.FromApplicant))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	if in.Event != "" {
+		const prefix string = ",\"event\":"
+		out.RawString(prefix)
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	if len(in.Attachments) != 0 {
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Attachments {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sent_at\":"
+		out.RawString(prefix)
+		out.Raw((in.SentAt).MarshalJSON())
+	}
+	if in.EditedAt != nil {
+		const prefix string = ",\"edited_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.EditedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatExportMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatExportMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatExportMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatExportMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto9(l, v)
+}
+func easyjson9b8f5552DecodeResuMatchInternalEntityDto10(in *jlexer.Lexer, out *ChatExport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "vacancy_id":
+			out.VacancyID = int(in.Int())
+		case "vacancy_title":
+			out.VacancyTitle = string(in.String())
+		case "resume_id":
+			out.ResumeID = int(in.Int())
+		case "profession":
+			out.Profession = string(in.String())
+		case "applicant_name":
+			out.ApplicantName = string(in.String())
+		case "employer_name":
+			out.EmployerName = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "exported_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExportedAt).UnmarshalJSON(data))
+			}
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*ChatExportMessage, 0, 8)
+					} else {
+						out.Messages = []*ChatExportMessage{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *ChatExportMessage
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(ChatExportMessage)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552EncodeResuMatchInternalEntityDto10(out *jwriter.Writer, in ChatExport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"vacancy_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VacancyID))
+	}
+	{
+		const prefix string = ",\"vacancy_title\":"
+		out.RawString(prefix)
+		out.String(string(in.VacancyTitle))
+	}
+	{
+		const prefix string = ",\"resume_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ResumeID))
+	}
+	{
+		const prefix string = ",\"profession\":"
+		out.RawString(prefix)
+		out.String(string(in.Profession))
+	}
+	{
+		const prefix string = ",\"applicant_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ApplicantName))
+	}
+	{
+		const prefix string = ",\"employer_name\":"
+		out.RawString(prefix)
+		out.String(string(in.EmployerName))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"exported_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ExportedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Messages {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatExport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatExport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552EncodeResuMatchInternalEntityDto10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatExport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatExport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552DecodeResuMatchInternalEntityDto10(l, v)
 }
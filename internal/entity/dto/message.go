@@ -7,24 +7,159 @@ import (
 
 // easyjson:json
 type MessageResponse struct {
-	ID            int       `json:"id"`
-	ChatID        int       `json:"chat_id"`
-	SenderID      int       `json:"sender_id"`
-	ReceiverID    int       `json:"receiver_id"`
-	Avatar        string    `json:"avatar"`
-	FromApplicant bool      `json:"from_applicant"`
-	Payload       string    `json:"payload"`
-	SentAt        time.Time `json:"sent_at"`
+	ID            int                   `json:"id"`
+	ChatID        int                   `json:"chat_id"`
+	SenderID      int                   `json:"sender_id"`
+	ReceiverID    int                   `json:"receiver_id"`
+	Avatar        string                `json:"avatar"`
+	FromApplicant bool                  `json:"from_applicant"`
+	Kind          entity.MessageKind    `json:"kind"`
+	Event         entity.SystemEvent    `json:"event,omitempty"`
+	Payload       string                `json:"payload"`
+	ClientID      string                `json:"client_id,omitempty"`
+	Attachments   []*AttachmentResponse `json:"attachments,omitempty"`
+	SentAt        time.Time             `json:"sent_at"`
+	EditedAt      *time.Time            `json:"edited_at,omitempty"`
+	Deleted       bool                  `json:"deleted,omitempty"`
+	Read          bool                  `json:"read"`
+}
+
+// AttachmentResponse - описание вложения. Сам файл скачивается через GET /chat/{id}/attachments/{attachmentID}
+// easyjson:json
+type AttachmentResponse struct {
+	ID          int    `json:"id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
 }
 
 // easyjson:json
 type MessagesResponseList []*MessageResponse
 
+// ChatEvent - сообщение чата вместе с участниками, которым его нужно доставить. Работодатель получает
+// EmployerMessage: пока владелец анонимного резюме не раскрыт, в нем нет id и фото соискателя
+type ChatEvent struct {
+	Message         *MessageResponse
+	EmployerMessage *MessageResponse
+	ApplicantID     int
+	EmployerID      int
+}
+
+// easyjson:json
+type MessageSearchResponse struct {
+	Message *MessageResponse   `json:"message"`
+	Chat    *ChatShortResponse `json:"chat"`
+}
+
+// easyjson:json
+type MessageSearchResponseList []*MessageSearchResponse
+
 // easyjson:json
 type MessageRequest struct {
+	ChatID        int             `json:"chat_id"`
+	SenderID      int             `json:"sender_id"`
+	ReceiverID    int             `json:"receiver_id"`
+	SenderRole    entity.UserRole `json:"sender_role"`
+	Payload       string          `json:"payload"`
+	ClientID      string          `json:"client_id"`
+	AttachmentIDs []int           `json:"attachment_ids,omitempty"`
+}
+
+// MessageEditRequest - исправление (Payload) или удаление сообщения, пришедшее по websocket
+// easyjson:json
+type MessageEditRequest struct {
+	ChatID    int             `json:"chat_id"`
+	MessageID int             `json:"message_id"`
+	UserID    int             `json:"user_id"`
+	Role      entity.UserRole `json:"role"`
+	Payload   string          `json:"payload"`
+	Delete    bool            `json:"delete"`
+	ClientID  string          `json:"client_id"`
+}
+
+// easyjson:json
+type MessageEditResponse struct {
+	Payload  string    `json:"payload"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// easyjson:json
+type MessageEditResponseList []*MessageEditResponse
+
+// easyjson:json
+type ReadRequest struct {
 	ChatID     int             `json:"chat_id"`
-	SenderID   int             `json:"sender_id"`
-	ReceiverID int             `json:"receiver_id"`
-	SenderRole entity.UserRole `json:"sender_role"`
-	Payload    string          `json:"payload"`
+	ReaderID   int             `json:"reader_id"`
+	ReaderRole entity.UserRole `json:"reader_role"`
+	MessageID  int             `json:"message_id"`
+	ClientID   string          `json:"client_id"`
+}
+
+// ReadReceipt уходит собеседнику и остальным вкладкам читателя, LastReadMessageID - итоговый курсор
+// easyjson:json
+type ReadReceipt struct {
+	ChatID            int  `json:"chat_id"`
+	ReaderID          int  `json:"reader_id"`
+	ReceiverID        int  `json:"receiver_id"`
+	FromApplicant     bool `json:"from_applicant"`
+	LastReadMessageID int  `json:"last_read_message_id"`
+}
+
+// ReadEvent - отметка о прочтении вместе с участниками чата. Работодатель получает EmployerReceipt
+// без id соискателя, пока владелец анонимного резюме не раскрыт
+type ReadEvent struct {
+	Receipt         *ReadReceipt
+	EmployerReceipt *ReadReceipt
+	ApplicantID     int
+	EmployerID      int
+}
+
+// MessageAck подтверждает отправителю, что сообщение с его client_id сохранено
+// easyjson:json
+type MessageAck struct {
+	ClientID  string    `json:"client_id"`
+	ChatID    int       `json:"chat_id"`
+	MessageID int       `json:"message_id"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// easyjson:json
+type MessageError struct {
+	ClientID string `json:"client_id"`
+	ChatID   int    `json:"chat_id,omitempty"`
+	Status   int    `json:"status"`
+	Message  string `json:"message"`
+}
+
+// SyncRequest - последние события, которые клиент успел получить до обрыва соединения
+// easyjson:json
+type SyncRequest struct {
+	UserID             int             `json:"user_id"`
+	Role               entity.UserRole `json:"role"`
+	LastMessageID      int             `json:"last_message_id"`
+	LastNotificationID int             `json:"last_notification_id"`
+}
+
+// SyncResponse завершает догрузку. Если Complete=false, клиент повторяет sync с новыми курсорами
+// easyjson:json
+type SyncResponse struct {
+	LastMessageID      int  `json:"last_message_id"`
+	LastNotificationID int  `json:"last_notification_id"`
+	Complete           bool `json:"complete"`
+}
+
+// TypingRequest - сигнал о наборе текста. Не сохраняется и только пересылается собеседнику
+// easyjson:json
+type TypingRequest struct {
+	ChatID int             `json:"chat_id"`
+	UserID int             `json:"user_id"`
+	Role   entity.UserRole `json:"role"`
+	Typing bool            `json:"typing"`
+}
+
+// easyjson:json
+type TypingEvent struct {
+	ChatID int  `json:"chat_id"`
+	UserID int  `json:"user_id"`
+	Typing bool `json:"typing"`
 }
@@ -3,10 +3,12 @@
 package dto
 
 import (
+	entity "ResuMatch/internal/entity"
 	json "encoding/json"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +19,1225 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson4086215fDecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *MessagesResponseList) {
+func easyjson4086215fDecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *TypingRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "role":
+			out.Role = entity.UserRole(in.String())
+		case "typing":
+			out.Typing = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto(out *jwriter.Writer, in TypingRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"typing\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Typing))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypingRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypingRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypingRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypingRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *TypingEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "typing":
+			out.Typing = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in TypingEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"typing\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Typing))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypingEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypingEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypingEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypingEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto1(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto2(in *jlexer.Lexer, out *SyncResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "last_message_id":
+			out.LastMessageID = int(in.Int())
+		case "last_notification_id":
+			out.LastNotificationID = int(in.Int())
+		case "complete":
+			out.Complete = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto2(out *jwriter.Writer, in SyncResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"last_message_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LastMessageID))
+	}
+	{
+		const prefix string = ",\"last_notification_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastNotificationID))
+	}
+	{
+		const prefix string = ",\"complete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Complete))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SyncResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SyncResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SyncResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SyncResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto2(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto3(in *jlexer.Lexer, out *SyncRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "role":
+			out.Role = entity.UserRole(in.String())
+		case "last_message_id":
+			out.LastMessageID = int(in.Int())
+		case "last_notification_id":
+			out.LastNotificationID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in SyncRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"last_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastMessageID))
+	}
+	{
+		const prefix string = ",\"last_notification_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastNotificationID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SyncRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SyncRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SyncRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SyncRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto3(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto4(in *jlexer.Lexer, out *ReadRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "reader_id":
+			out.ReaderID = int(in.Int())
+		case "reader_role":
+			out.ReaderRole = entity.UserRole(in.String())
+		case "message_id":
+			out.MessageID = int(in.Int())
+		case "client_id":
+			out.ClientID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto4(out *jwriter.Writer, in ReadRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"reader_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReaderID))
+	}
+	{
+		const prefix string = ",\"reader_role\":"
+		out.RawString(prefix)
+		out.String(string(in.ReaderRole))
+	}
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessageID))
+	}
+	{
+		const prefix string = ",\"client_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto4(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *ReadReceipt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "reader_id":
+			out.ReaderID = int(in.Int())
+		case "receiver_id":
+			out.ReceiverID = int(in.Int())
+		case "from_applicant":
+			out.FromApplicant = bool(in.Bool())
+		case "last_read_message_id":
+			out.LastReadMessageID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in ReadReceipt) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"reader_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReaderID))
+	}
+	{
+		const prefix string = ",\"receiver_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReceiverID))
+	}
+	{
+		const prefix string = ",\"from_applicant\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.FromApplicant))
+	}
+	{
+		const prefix string = ",\"last_read_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastReadMessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadReceipt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadReceipt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadReceipt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadReceipt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto5(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *MessagesResponseList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(MessagesResponseList, 0, 8)
+			} else {
+				*out = MessagesResponseList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 *MessageResponse
+			if in.IsNull() {
+				in.Skip()
+				v1 = nil
+			} else {
+				if v1 == nil {
+					v1 = new(MessageResponse)
+				}
+				(*v1).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in MessagesResponseList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			if v3 == nil {
+				out.RawString("null")
+			} else {
+				(*v3).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessagesResponseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessagesResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessagesResponseList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessagesResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto6(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto7(in *jlexer.Lexer, out *MessageSearchResponseList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(MessageSearchResponseList, 0, 8)
+			} else {
+				*out = MessageSearchResponseList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 *MessageSearchResponse
+			if in.IsNull() {
+				in.Skip()
+				v4 = nil
+			} else {
+				if v4 == nil {
+					v4 = new(MessageSearchResponse)
+				}
+				(*v4).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto7(out *jwriter.Writer, in MessageSearchResponseList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v5, v6 := range in {
+			if v5 > 0 {
+				out.RawByte(',')
+			}
+			if v6 == nil {
+				out.RawString("null")
+			} else {
+				(*v6).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageSearchResponseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageSearchResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageSearchResponseList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageSearchResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto7(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto8(in *jlexer.Lexer, out *MessageSearchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(MessageResponse)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "chat":
+			if in.IsNull() {
+				in.Skip()
+				out.Chat = nil
+			} else {
+				if out.Chat == nil {
+					out.Chat = new(ChatShortResponse)
+				}
+				(*out.Chat).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto8(out *jwriter.Writer, in MessageSearchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"chat\":"
+		out.RawString(prefix)
+		if in.Chat == nil {
+			out.RawString("null")
+		} else {
+			(*in.Chat).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageSearchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageSearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageSearchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageSearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto8(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto9(in *jlexer.Lexer, out *MessageResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "sender_id":
+			out.SenderID = int(in.Int())
+		case "receiver_id":
+			out.ReceiverID = int(in.Int())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "from_applicant":
+			out.FromApplicant = bool(in.Bool())
+		case "kind":
+			out.Kind = entity.MessageKind(in.String())
+		case "event":
+			out.Event = entity.SystemEvent(in.String())
+		case "payload":
+			out.Payload = string(in.String())
+		case "client_id":
+			out.ClientID = string(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*AttachmentResponse, 0, 8)
+					} else {
+						out.Attachments = []*AttachmentResponse{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *AttachmentResponse
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(AttachmentResponse)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sent_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.SentAt).UnmarshalJSON(data))
+			}
+		case "edited_at":
+			if in.IsNull() {
+				in.Skip()
+				out.EditedAt = nil
+			} else {
+				if out.EditedAt == nil {
+					out.EditedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EditedAt).UnmarshalJSON(data))
+				}
+			}
+		case "deleted":
+			out.Deleted = bool(in.Bool())
+		case "read":
+			out.Read = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto9(out *jwriter.Writer, in MessageResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"sender_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SenderID))
+	}
+	{
+		const prefix string = ",\"receiver_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReceiverID))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"from_applicant\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.FromApplicant))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	if in.Event != "" {
+		const prefix string = ",\"event\":"
+		out.RawString(prefix)
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	if in.ClientID != "" {
+		const prefix string = ",\"client_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientID))
+	}
+	if len(in.Attachments) != 0 {
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Attachments {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sent_at\":"
+		out.RawString(prefix)
+		out.Raw((in.SentAt).MarshalJSON())
+	}
+	if in.EditedAt != nil {
+		const prefix string = ",\"edited_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.EditedAt).MarshalJSON())
+	}
+	if in.Deleted {
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"read\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Read))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto9(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto10(in *jlexer.Lexer, out *MessageRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "sender_id":
+			out.SenderID = int(in.Int())
+		case "receiver_id":
+			out.ReceiverID = int(in.Int())
+		case "sender_role":
+			out.SenderRole = entity.UserRole(in.String())
+		case "payload":
+			out.Payload = string(in.String())
+		case "client_id":
+			out.ClientID = string(in.String())
+		case "attachment_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.AttachmentIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AttachmentIDs == nil {
+					if !in.IsDelim(']') {
+						out.AttachmentIDs = make([]int, 0, 8)
+					} else {
+						out.AttachmentIDs = []int{}
+					}
+				} else {
+					out.AttachmentIDs = (out.AttachmentIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 int
+					v10 = int(in.Int())
+					out.AttachmentIDs = append(out.AttachmentIDs, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto10(out *jwriter.Writer, in MessageRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"sender_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SenderID))
+	}
+	{
+		const prefix string = ",\"receiver_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReceiverID))
+	}
+	{
+		const prefix string = ",\"sender_role\":"
+		out.RawString(prefix)
+		out.String(string(in.SenderRole))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"client_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientID))
+	}
+	if len(in.AttachmentIDs) != 0 {
+		const prefix string = ",\"attachment_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.AttachmentIDs {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto10(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto11(in *jlexer.Lexer, out *MessageError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "client_id":
+			out.ClientID = string(in.String())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "status":
+			out.Status = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto11(out *jwriter.Writer, in MessageError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"client_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ClientID))
+	}
+	if in.ChatID != 0 {
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto11(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto12(in *jlexer.Lexer, out *MessageEditResponseList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -26,76 +1246,259 @@ func easyjson4086215fDecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *Mes
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(MessagesResponseList, 0, 8)
+				*out = make(MessageEditResponseList, 0, 8)
 			} else {
-				*out = MessagesResponseList{}
+				*out = MessageEditResponseList{}
 			}
 		} else {
 			*out = (*out)[:0]
 		}
-		for !in.IsDelim(']') {
-			var v1 *MessageResponse
-			if in.IsNull() {
-				in.Skip()
-				v1 = nil
-			} else {
-				if v1 == nil {
-					v1 = new(MessageResponse)
-				}
-				(*v1).UnmarshalEasyJSON(in)
-			}
-			*out = append(*out, v1)
+		for !in.IsDelim(']') {
+			var v13 *MessageEditResponse
+			if in.IsNull() {
+				in.Skip()
+				v13 = nil
+			} else {
+				if v13 == nil {
+					v13 = new(MessageEditResponse)
+				}
+				(*v13).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v13)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto12(out *jwriter.Writer, in MessageEditResponseList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v14, v15 := range in {
+			if v14 > 0 {
+				out.RawByte(',')
+			}
+			if v15 == nil {
+				out.RawString("null")
+			} else {
+				(*v15).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageEditResponseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageEditResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageEditResponseList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageEditResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto12(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto13(in *jlexer.Lexer, out *MessageEditResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "payload":
+			out.Payload = string(in.String())
+		case "edited_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EditedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto13(out *jwriter.Writer, in MessageEditResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"edited_at\":"
+		out.RawString(prefix)
+		out.Raw((in.EditedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageEditResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageEditResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageEditResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageEditResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto13(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto14(in *jlexer.Lexer, out *MessageEditRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
 			in.WantComma()
+			continue
 		}
-		in.Delim(']')
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "message_id":
+			out.MessageID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "role":
+			out.Role = entity.UserRole(in.String())
+		case "payload":
+			out.Payload = string(in.String())
+		case "delete":
+			out.Delete = bool(in.Bool())
+		case "client_id":
+			out.ClientID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
+	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson4086215fEncodeResuMatchInternalEntityDto(out *jwriter.Writer, in MessagesResponseList) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v2, v3 := range in {
-			if v2 > 0 {
-				out.RawByte(',')
-			}
-			if v3 == nil {
-				out.RawString("null")
-			} else {
-				(*v3).MarshalEasyJSON(out)
-			}
-		}
-		out.RawByte(']')
+func easyjson4086215fEncodeResuMatchInternalEntityDto14(out *jwriter.Writer, in MessageEditRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessageID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"delete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Delete))
+	}
+	{
+		const prefix string = ",\"client_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientID))
 	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MessagesResponseList) MarshalJSON() ([]byte, error) {
+func (v MessageEditRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4086215fEncodeResuMatchInternalEntityDto(&w, v)
+	easyjson4086215fEncodeResuMatchInternalEntityDto14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MessagesResponseList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4086215fEncodeResuMatchInternalEntityDto(w, v)
+func (v MessageEditRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MessagesResponseList) UnmarshalJSON(data []byte) error {
+func (v *MessageEditRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4086215fDecodeResuMatchInternalEntityDto(&r, v)
+	easyjson4086215fDecodeResuMatchInternalEntityDto14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MessagesResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4086215fDecodeResuMatchInternalEntityDto(l, v)
+func (v *MessageEditRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto14(l, v)
 }
-func easyjson4086215fDecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *MessageResponse) {
+func easyjson4086215fDecodeResuMatchInternalEntityDto15(in *jlexer.Lexer, out *MessageAck) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -114,20 +1517,12 @@ func easyjson4086215fDecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *Me
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
+		case "client_id":
+			out.ClientID = string(in.String())
 		case "chat_id":
 			out.ChatID = int(in.Int())
-		case "sender_id":
-			out.SenderID = int(in.Int())
-		case "receiver_id":
-			out.ReceiverID = int(in.Int())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "from_applicant":
-			out.FromApplicant = bool(in.Bool())
-		case "payload":
-			out.Payload = string(in.String())
+		case "message_id":
+			out.MessageID = int(in.Int())
 		case "sent_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.SentAt).UnmarshalJSON(data))
@@ -142,14 +1537,14 @@ func easyjson4086215fDecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *Me
 		in.Consumed()
 	}
 }
-func easyjson4086215fEncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in MessageResponse) {
+func easyjson4086215fEncodeResuMatchInternalEntityDto15(out *jwriter.Writer, in MessageAck) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"client_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		out.String(string(in.ClientID))
 	}
 	{
 		const prefix string = ",\"chat_id\":"
@@ -157,58 +1552,217 @@ func easyjson4086215fEncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in M
 		out.Int(int(in.ChatID))
 	}
 	{
-		const prefix string = ",\"sender_id\":"
+		const prefix string = ",\"message_id\":"
 		out.RawString(prefix)
-		out.Int(int(in.SenderID))
+		out.Int(int(in.MessageID))
 	}
 	{
-		const prefix string = ",\"receiver_id\":"
+		const prefix string = ",\"sent_at\":"
 		out.RawString(prefix)
-		out.Int(int(in.ReceiverID))
+		out.Raw((in.SentAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageAck) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageAck) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageAck) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageAck) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto15(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto16(in *jlexer.Lexer, out *ChatEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(MessageResponse)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "ApplicantID":
+			out.ApplicantID = int(in.Int())
+		case "EmployerID":
+			out.EmployerID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto16(out *jwriter.Writer, in ChatEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"ApplicantID\":"
 		out.RawString(prefix)
-		out.String(string(in.Avatar))
+		out.Int(int(in.ApplicantID))
 	}
 	{
-		const prefix string = ",\"from_applicant\":"
+		const prefix string = ",\"EmployerID\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.FromApplicant))
+		out.Int(int(in.EmployerID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4086215fEncodeResuMatchInternalEntityDto16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4086215fDecodeResuMatchInternalEntityDto16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto16(l, v)
+}
+func easyjson4086215fDecodeResuMatchInternalEntityDto17(in *jlexer.Lexer, out *AttachmentResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "file_name":
+			out.FileName = string(in.String())
+		case "content_type":
+			out.ContentType = string(in.String())
+		case "size":
+			out.Size = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson4086215fEncodeResuMatchInternalEntityDto17(out *jwriter.Writer, in AttachmentResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"payload\":"
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"file_name\":"
 		out.RawString(prefix)
-		out.String(string(in.Payload))
+		out.String(string(in.FileName))
 	}
 	{
-		const prefix string = ",\"sent_at\":"
+		const prefix string = ",\"content_type\":"
 		out.RawString(prefix)
-		out.Raw((in.SentAt).MarshalJSON())
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MessageResponse) MarshalJSON() ([]byte, error) {
+func (v AttachmentResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4086215fEncodeResuMatchInternalEntityDto1(&w, v)
+	easyjson4086215fEncodeResuMatchInternalEntityDto17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MessageResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4086215fEncodeResuMatchInternalEntityDto1(w, v)
+func (v AttachmentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4086215fEncodeResuMatchInternalEntityDto17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MessageResponse) UnmarshalJSON(data []byte) error {
+func (v *AttachmentResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4086215fDecodeResuMatchInternalEntityDto1(&r, v)
+	easyjson4086215fDecodeResuMatchInternalEntityDto17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MessageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4086215fDecodeResuMatchInternalEntityDto1(l, v)
+func (v *AttachmentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4086215fDecodeResuMatchInternalEntityDto17(l, v)
 }
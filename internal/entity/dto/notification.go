@@ -0,0 +1,25 @@
+package dto
+
+import "ResuMatch/internal/entity"
+
+// NotificationTypeSettings - каналы доставки уведомлений одного типа
+// easyjson:json
+type NotificationTypeSettings struct {
+	Type     entity.NotificationType             `json:"type"`
+	Channels map[entity.NotificationChannel]bool `json:"channels"`
+}
+
+// NotificationSettings - настройки уведомлений пользователя. В ответе перечислены все типы,
+// которые он может получить, в запросе - только изменяемые. Без quiet_hours тихие часы выключены
+// easyjson:json
+type NotificationSettings struct {
+	Types      []*NotificationTypeSettings `json:"types"`
+	QuietHours *entity.QuietHours          `json:"quiet_hours"`
+}
+
+// UnreadNotificationsResponse - число непрочитанных уведомлений всего и по типам
+// easyjson:json
+type UnreadNotificationsResponse struct {
+	Total int                             `json:"total"`
+	Types map[entity.NotificationType]int `json:"types"`
+}
@@ -0,0 +1,411 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	entity "ResuMatch/internal/entity"
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9806e1DecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *UnreadNotificationsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "total":
+			out.Total = int(in.Int())
+		case "types":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Types = make(map[entity.NotificationType]int)
+				for !in.IsDelim('}') {
+					key := entity.NotificationType(in.String())
+					in.WantColon()
+					var v1 int
+					v1 = int(in.Int())
+					(out.Types)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeResuMatchInternalEntityDto(out *jwriter.Writer, in UnreadNotificationsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"types\":"
+		out.RawString(prefix)
+		if in.Types == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.Types {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.Int(int(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnreadNotificationsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeResuMatchInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnreadNotificationsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeResuMatchInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnreadNotificationsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeResuMatchInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnreadNotificationsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeResuMatchInternalEntityDto(l, v)
+}
+func easyjson9806e1DecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *NotificationTypeSettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = entity.NotificationType(in.String())
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Channels = make(map[entity.NotificationChannel]bool)
+				for !in.IsDelim('}') {
+					key := entity.NotificationChannel(in.String())
+					in.WantColon()
+					var v3 bool
+					v3 = bool(in.Bool())
+					(out.Channels)[key] = v3
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in NotificationTypeSettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix)
+		if in.Channels == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.Channels {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				out.Bool(bool(v4Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationTypeSettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeResuMatchInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationTypeSettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeResuMatchInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationTypeSettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeResuMatchInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationTypeSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeResuMatchInternalEntityDto1(l, v)
+}
+func easyjson9806e1DecodeResuMatchInternalEntityDto2(in *jlexer.Lexer, out *NotificationSettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "types":
+			if in.IsNull() {
+				in.Skip()
+				out.Types = nil
+			} else {
+				in.Delim('[')
+				if out.Types == nil {
+					if !in.IsDelim(']') {
+						out.Types = make([]*NotificationTypeSettings, 0, 8)
+					} else {
+						out.Types = []*NotificationTypeSettings{}
+					}
+				} else {
+					out.Types = (out.Types)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 *NotificationTypeSettings
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else {
+						if v5 == nil {
+							v5 = new(NotificationTypeSettings)
+						}
+						(*v5).UnmarshalEasyJSON(in)
+					}
+					out.Types = append(out.Types, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "quiet_hours":
+			if in.IsNull() {
+				in.Skip()
+				out.QuietHours = nil
+			} else {
+				if out.QuietHours == nil {
+					out.QuietHours = new(entity.QuietHours)
+				}
+				easyjson9806e1DecodeResuMatchInternalEntity(in, out.QuietHours)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeResuMatchInternalEntityDto2(out *jwriter.Writer, in NotificationSettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"types\":"
+		out.RawString(prefix[1:])
+		if in.Types == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Types {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				if v7 == nil {
+					out.RawString("null")
+				} else {
+					(*v7).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"quiet_hours\":"
+		out.RawString(prefix)
+		if in.QuietHours == nil {
+			out.RawString("null")
+		} else {
+			easyjson9806e1EncodeResuMatchInternalEntity(out, *in.QuietHours)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationSettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeResuMatchInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationSettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeResuMatchInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationSettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeResuMatchInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeResuMatchInternalEntityDto2(l, v)
+}
+func easyjson9806e1DecodeResuMatchInternalEntity(in *jlexer.Lexer, out *entity.QuietHours) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = string(in.String())
+		case "end":
+			out.End = string(in.String())
+		case "time_zone":
+			out.TimeZone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeResuMatchInternalEntity(out *jwriter.Writer, in entity.QuietHours) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Start))
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		out.String(string(in.End))
+	}
+	{
+		const prefix string = ",\"time_zone\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeZone))
+	}
+	out.RawByte('}')
+}
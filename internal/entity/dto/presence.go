@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"ResuMatch/internal/entity"
+	"time"
+)
+
+// easyjson:json
+type PresenceResponse struct {
+	UserID   int             `json:"user_id"`
+	Role     entity.UserRole `json:"role"`
+	Online   bool            `json:"online"`
+	LastSeen *time.Time      `json:"last_seen,omitempty"`
+}
@@ -0,0 +1,118 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	entity "ResuMatch/internal/entity"
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBc34f26fDecodeResuMatchInternalEntityDto(in *jlexer.Lexer, out *PresenceResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "role":
+			out.Role = entity.UserRole(in.String())
+		case "online":
+			out.Online = bool(in.Bool())
+		case "last_seen":
+			if in.IsNull() {
+				in.Skip()
+				out.LastSeen = nil
+			} else {
+				if out.LastSeen == nil {
+					out.LastSeen = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastSeen).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc34f26fEncodeResuMatchInternalEntityDto(out *jwriter.Writer, in PresenceResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Online))
+	}
+	if in.LastSeen != nil {
+		const prefix string = ",\"last_seen\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastSeen).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PresenceResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc34f26fEncodeResuMatchInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PresenceResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc34f26fEncodeResuMatchInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PresenceResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc34f26fDecodeResuMatchInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PresenceResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc34f26fDecodeResuMatchInternalEntityDto(l, v)
+}
@@ -47,12 +47,23 @@ type CreateResumeRequest struct {
 	AboutMe                   string               `json:"about_me" valid:"stringlength(10|500),optional"`
 	Specialization            string               `json:"specialization" valid:"required,stringlength(3|30)"`
 	Profession                string               `json:"profession" valid:"required,stringlength(3|50)"`
-	Education                 entity.EducationType `json:"education" valid:"required,in(secondary_school|incomplete_higher|higher|bachelor|master|phd)"`
-	EducationalInstitution    string               `json:"educational_institution" valid:"required,stringlength(3|50)"`
-	GraduationYear            string               `json:"graduation_year" valid:"required,customYearValidation"`
+	Education                 entity.EducationType `json:"education" valid:"in(secondary_school|incomplete_higher|higher|bachelor|master|phd),optional"`
+	EducationalInstitution    string               `json:"educational_institution" valid:"stringlength(3|50),optional"`
+	GraduationYear            string               `json:"graduation_year" valid:"customYearValidation,optional"`
 	Skills                    []string             `json:"skills" valid:"optional"`
 	AdditionalSpecializations []string             `json:"additional_specializations" valid:"optional"`
 	WorkExperiences           []WorkExperienceDTO  `json:"work_experiences" valid:"optional"`
+	DesiredSalary             int                  `json:"desired_salary" valid:"range(0|100000000),optional"`
+	SalaryCurrency            entity.Currency      `json:"salary_currency" valid:"in(rub|usd|eur),optional"`
+	Cities                    []string             `json:"cities" valid:"optional"`
+	ReadyToRelocate           bool                 `json:"ready_to_relocate" valid:"optional"`
+	ReadyForBusinessTrips     bool                 `json:"ready_for_business_trips" valid:"optional"`
+	WorkFormats               []string             `json:"work_formats" valid:"optional"`
+	EmploymentTypes           []string             `json:"employment_types" valid:"optional"`
+	Schedules                 []string             `json:"schedules" valid:"optional"`
+	Educations                []EducationDTO       `json:"educations" valid:"optional"`
+	Courses                   []CourseDTO          `json:"courses" valid:"optional"`
+	Languages                 []LanguageDTO        `json:"languages" valid:"optional"`
 }
 
 // easyjson:json
@@ -79,9 +90,77 @@ type ResumeResponse struct {
 	GraduationYear            string                   `json:"graduation_year,omitempty"`
 	CreatedAt                 string                   `json:"created_at"`
 	UpdatedAt                 string                   `json:"updated_at"`
+	Visibility                entity.ResumeVisibility  `json:"visibility,omitempty"`
+	AccessToken               string                   `json:"access_token,omitempty"` // Только для владельца резюме
+	IsAnonymous               bool                     `json:"is_anonymous"`
+	ContactsHidden            bool                     `json:"contacts_hidden"` // Имя, фото и контакты скрыты от просматривающего
+	DesiredSalary             int                      `json:"desired_salary,omitempty"`
+	SalaryCurrency            entity.Currency          `json:"salary_currency,omitempty"`
+	Cities                    []string                 `json:"cities"`
+	ReadyToRelocate           bool                     `json:"ready_to_relocate"`
+	ReadyForBusinessTrips     bool                     `json:"ready_for_business_trips"`
+	WorkFormats               []string                 `json:"work_formats"`
+	EmploymentTypes           []string                 `json:"employment_types"`
+	Schedules                 []string                 `json:"schedules"`
 	Skills                    []string                 `json:"skills"`
 	AdditionalSpecializations []string                 `json:"additional_specializations"`
 	WorkExperiences           []WorkExperienceResponse `json:"work_experiences"`
+	Educations                []EducationResponse      `json:"educations,omitempty"`
+	Courses                   []CourseResponse         `json:"courses,omitempty"`
+	Languages                 []LanguageResponse       `json:"languages,omitempty"`
+}
+
+// Уровень и учебное заведение указываются для каждой записи, основным в резюме становится самый высокий уровень
+// easyjson:json
+type EducationDTO struct {
+	Level          entity.EducationType `json:"level" valid:"required,in(secondary_school|incomplete_higher|higher|bachelor|master|phd)"`
+	Institution    string               `json:"institution" valid:"required,stringlength(3|100)"`
+	Faculty        string               `json:"faculty" valid:"stringlength(2|100),optional"`
+	Specialty      string               `json:"specialty" valid:"stringlength(2|100),optional"`
+	GraduationYear int                  `json:"graduation_year" valid:"required,range(1950|2100)"`
+}
+
+// FileID - id файла, загруженного через /resume/course/file
+// easyjson:json
+type CourseDTO struct {
+	Kind         entity.CourseKind `json:"kind" valid:"required,in(course|certificate)"`
+	Name         string            `json:"name" valid:"required,stringlength(2|100)"`
+	Organization string            `json:"organization" valid:"stringlength(2|100),optional"`
+	Year         int               `json:"year" valid:"range(1950|2100),optional"`
+	FileID       int               `json:"file_id" valid:"optional"`
+}
+
+// easyjson:json
+type LanguageDTO struct {
+	Language string               `json:"language" valid:"required,stringlength(2|30)"`
+	Level    entity.LanguageLevel `json:"level" valid:"required,in(A1|A2|B1|B2|C1|C2|native)"`
+}
+
+// easyjson:json
+type EducationResponse struct {
+	ID             int                  `json:"id"`
+	Level          entity.EducationType `json:"level"`
+	Institution    string               `json:"institution"`
+	Faculty        string               `json:"faculty,omitempty"`
+	Specialty      string               `json:"specialty,omitempty"`
+	GraduationYear int                  `json:"graduation_year"`
+}
+
+// easyjson:json
+type CourseResponse struct {
+	ID           int               `json:"id"`
+	Kind         entity.CourseKind `json:"kind"`
+	Name         string            `json:"name"`
+	Organization string            `json:"organization,omitempty"`
+	Year         int               `json:"year,omitempty"`
+	FileID       int               `json:"file_id,omitempty"`
+	FilePath     string            `json:"file_path,omitempty"`
+}
+
+// easyjson:json
+type LanguageResponse struct {
+	Language string               `json:"language"`
+	Level    entity.LanguageLevel `json:"level"`
 }
 
 // easyjson:json
@@ -103,12 +182,23 @@ type UpdateResumeRequest struct {
 	AboutMe                   string               `json:"about_me" valid:"stringlength(10|500), optional"`
 	Specialization            string               `json:"specialization" valid:"required,stringlength(3|30)"`
 	Profession                string               `json:"profession" valid:"required,stringlength(3|50)"`
-	Education                 entity.EducationType `json:"education" valid:"required,in(secondary_school|incomplete_higher|higher|bachelor|master|phd)"`
-	EducationalInstitution    string               `json:"educational_institution" valid:"required,stringlength(3|50)"`
-	GraduationYear            string               `json:"graduation_year" valid:"required,customYearValidation"`
+	Education                 entity.EducationType `json:"education" valid:"in(secondary_school|incomplete_higher|higher|bachelor|master|phd),optional"`
+	EducationalInstitution    string               `json:"educational_institution" valid:"stringlength(3|50),optional"`
+	GraduationYear            string               `json:"graduation_year" valid:"customYearValidation,optional"`
 	Skills                    []string             `json:"skills" valid:"optional"`
 	AdditionalSpecializations []string             `json:"additional_specializations" valid:"optional"`
 	WorkExperiences           []WorkExperienceDTO  `json:"work_experiences" valid:"optional"`
+	DesiredSalary             int                  `json:"desired_salary" valid:"range(0|100000000),optional"`
+	SalaryCurrency            entity.Currency      `json:"salary_currency" valid:"in(rub|usd|eur),optional"`
+	Cities                    []string             `json:"cities" valid:"optional"`
+	ReadyToRelocate           bool                 `json:"ready_to_relocate" valid:"optional"`
+	ReadyForBusinessTrips     bool                 `json:"ready_for_business_trips" valid:"optional"`
+	WorkFormats               []string             `json:"work_formats" valid:"optional"`
+	EmploymentTypes           []string             `json:"employment_types" valid:"optional"`
+	Schedules                 []string             `json:"schedules" valid:"optional"`
+	Educations                []EducationDTO       `json:"educations" valid:"optional"`
+	Courses                   []CourseDTO          `json:"courses" valid:"optional"`
+	Languages                 []LanguageDTO        `json:"languages" valid:"optional"`
 }
 
 // easyjson:json
@@ -123,8 +213,12 @@ type ResumeShortResponse struct {
 	ID             int                       `json:"id"`
 	ApplicantID    int                       `json:"applicant_id,omitempty"` // Keep for backward compatibility
 	Applicant      *ApplicantProfileResponse `json:"applicant"`              // Add applicant information
+	ContactsHidden bool                      `json:"contacts_hidden"`
 	Specialization string                    `json:"specialization"`
 	Profession     string                    `json:"profession"`
+	DesiredSalary  int                       `json:"desired_salary,omitempty"`
+	SalaryCurrency entity.Currency           `json:"salary_currency,omitempty"`
+	WorkFormats    []string                  `json:"work_formats,omitempty"`
 	WorkExperience WorkExperienceShort       `json:"work_experiences"`
 	CreatedAt      string                    `json:"created_at"`
 	UpdatedAt      string                    `json:"updated_at"`
@@ -164,6 +258,42 @@ type ResumeChatResponse struct {
 	Profession  string `json:"profession"`
 }
 
+// easyjson:json
+type ResumeVisibilityRequest struct {
+	Visibility      entity.ResumeVisibility `json:"visibility" valid:"required,in(public|employers_only|link_only|hidden)"`
+	RegenerateToken bool                    `json:"regenerate_token" valid:"optional"`
+	IsAnonymous     bool                    `json:"is_anonymous" valid:"optional"`
+}
+
+// easyjson:json
+type ResumeVisibilityResponse struct {
+	ID          int                     `json:"id"`
+	Visibility  entity.ResumeVisibility `json:"visibility"`
+	AccessToken string                  `json:"access_token,omitempty"`
+	IsAnonymous bool                    `json:"is_anonymous"`
+}
+
+// easyjson:json
+type ContactRequestResponse struct {
+	ID          int                         `json:"id"`
+	EmployerID  int                         `json:"employer_id"`
+	ApplicantID int                         `json:"applicant_id,omitempty"`
+	ResumeID    int                         `json:"resume_id"`
+	Status      entity.ContactRequestStatus `json:"status"`
+	CreatedAt   string                      `json:"created_at"`
+	UpdatedAt   string                      `json:"updated_at"`
+}
+
+// easyjson:json
+type BlockedEmployerResponse struct {
+	EmployerID  int    `json:"employer_id"`
+	CompanyName string `json:"company_name"`
+	BlockedAt   string `json:"blocked_at"`
+}
+
+// easyjson:json
+type BlockedEmployerResponseList []BlockedEmployerResponse
+
 // easyjson:json
 type ResumeApplicantShortResponseList []ResumeApplicantShortResponse
 
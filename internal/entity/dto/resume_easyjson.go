@@ -463,6 +463,175 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto3(in *jlexer.Lexer, out *Up
 				}
 				in.Delim(']')
 			}
+		case "desired_salary":
+			out.DesiredSalary = int(in.Int())
+		case "salary_currency":
+			out.SalaryCurrency = entity.Currency(in.String())
+		case "cities":
+			if in.IsNull() {
+				in.Skip()
+				out.Cities = nil
+			} else {
+				in.Delim('[')
+				if out.Cities == nil {
+					if !in.IsDelim(']') {
+						out.Cities = make([]string, 0, 4)
+					} else {
+						out.Cities = []string{}
+					}
+				} else {
+					out.Cities = (out.Cities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Cities = append(out.Cities, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ready_to_relocate":
+			out.ReadyToRelocate = bool(in.Bool())
+		case "ready_for_business_trips":
+			out.ReadyForBusinessTrips = bool(in.Bool())
+		case "work_formats":
+			if in.IsNull() {
+				in.Skip()
+				out.WorkFormats = nil
+			} else {
+				in.Delim('[')
+				if out.WorkFormats == nil {
+					if !in.IsDelim(']') {
+						out.WorkFormats = make([]string, 0, 4)
+					} else {
+						out.WorkFormats = []string{}
+					}
+				} else {
+					out.WorkFormats = (out.WorkFormats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.WorkFormats = append(out.WorkFormats, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "employment_types":
+			if in.IsNull() {
+				in.Skip()
+				out.EmploymentTypes = nil
+			} else {
+				in.Delim('[')
+				if out.EmploymentTypes == nil {
+					if !in.IsDelim(']') {
+						out.EmploymentTypes = make([]string, 0, 4)
+					} else {
+						out.EmploymentTypes = []string{}
+					}
+				} else {
+					out.EmploymentTypes = (out.EmploymentTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 string
+					v6 = string(in.String())
+					out.EmploymentTypes = append(out.EmploymentTypes, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "schedules":
+			if in.IsNull() {
+				in.Skip()
+				out.Schedules = nil
+			} else {
+				in.Delim('[')
+				if out.Schedules == nil {
+					if !in.IsDelim(']') {
+						out.Schedules = make([]string, 0, 4)
+					} else {
+						out.Schedules = []string{}
+					}
+				} else {
+					out.Schedules = (out.Schedules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Schedules = append(out.Schedules, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "educations":
+			if in.IsNull() {
+				in.Skip()
+				out.Educations = nil
+			} else {
+				in.Delim('[')
+				if out.Educations == nil {
+					if !in.IsDelim(']') {
+						out.Educations = make([]EducationDTO, 0, 0)
+					} else {
+						out.Educations = []EducationDTO{}
+					}
+				} else {
+					out.Educations = (out.Educations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 EducationDTO
+					(v8).UnmarshalEasyJSON(in)
+					out.Educations = append(out.Educations, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "courses":
+			if in.IsNull() {
+				in.Skip()
+				out.Courses = nil
+			} else {
+				in.Delim('[')
+				if out.Courses == nil {
+					if !in.IsDelim(']') {
+						out.Courses = make([]CourseDTO, 0, 1)
+					} else {
+						out.Courses = []CourseDTO{}
+					}
+				} else {
+					out.Courses = (out.Courses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 CourseDTO
+					(v9).UnmarshalEasyJSON(in)
+					out.Courses = append(out.Courses, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]LanguageDTO, 0, 2)
+					} else {
+						out.Languages = []LanguageDTO{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 LanguageDTO
+					(v10).UnmarshalEasyJSON(in)
+					out.Languages = append(out.Languages, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -514,11 +683,11 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in U
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v4, v5 := range in.Skills {
-				if v4 > 0 {
+			for v11, v12 := range in.Skills {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v5))
+				out.String(string(v12))
 			}
 			out.RawByte(']')
 		}
@@ -530,11 +699,11 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in U
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.AdditionalSpecializations {
-				if v6 > 0 {
+			for v13, v14 := range in.AdditionalSpecializations {
+				if v13 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v7))
+				out.String(string(v14))
 			}
 			out.RawByte(']')
 		}
@@ -546,11 +715,143 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto3(out *jwriter.Writer, in U
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.WorkExperiences {
-				if v8 > 0 {
+			for v15, v16 := range in.WorkExperiences {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				(v16).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"desired_salary\":"
+		out.RawString(prefix)
+		out.Int(int(in.DesiredSalary))
+	}
+	{
+		const prefix string = ",\"salary_currency\":"
+		out.RawString(prefix)
+		out.String(string(in.SalaryCurrency))
+	}
+	{
+		const prefix string = ",\"cities\":"
+		out.RawString(prefix)
+		if in.Cities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Cities {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ready_to_relocate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadyToRelocate))
+	}
+	{
+		const prefix string = ",\"ready_for_business_trips\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadyForBusinessTrips))
+	}
+	{
+		const prefix string = ",\"work_formats\":"
+		out.RawString(prefix)
+		if in.WorkFormats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.WorkFormats {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v20))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"employment_types\":"
+		out.RawString(prefix)
+		if in.EmploymentTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.EmploymentTypes {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v22))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"schedules\":"
+		out.RawString(prefix)
+		if in.Schedules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Schedules {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"educations\":"
+		out.RawString(prefix)
+		if in.Educations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Educations {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				(v26).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"courses\":"
+		out.RawString(prefix)
+		if in.Courses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Courses {
+				if v27 > 0 {
 					out.RawByte(',')
 				}
-				(v9).MarshalEasyJSON(out)
+				(v28).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Languages {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -581,73 +882,94 @@ func (v *UpdateResumeRequest) UnmarshalJSON(data []byte) error {
 func (v *UpdateResumeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson39b3a2f5DecodeResuMatchInternalEntityDto3(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto4(in *jlexer.Lexer, out *ResumeShortResponseList) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto4(in *jlexer.Lexer, out *ResumeVisibilityResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(ResumeShortResponseList, 0, 0)
-			} else {
-				*out = ResumeShortResponseList{}
-			}
-		} else {
-			*out = (*out)[:0]
+		if isTopLevel {
+			in.Consumed()
 		}
-		for !in.IsDelim(']') {
-			var v10 ResumeShortResponse
-			(v10).UnmarshalEasyJSON(in)
-			*out = append(*out, v10)
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
 			in.WantComma()
+			continue
 		}
-		in.Delim(']')
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "visibility":
+			out.Visibility = entity.ResumeVisibility(in.String())
+		case "access_token":
+			out.AccessToken = string(in.String())
+		case "is_anonymous":
+			out.IsAnonymous = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
+	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto4(out *jwriter.Writer, in ResumeShortResponseList) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v11, v12 := range in {
-			if v11 > 0 {
-				out.RawByte(',')
-			}
-			(v12).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto4(out *jwriter.Writer, in ResumeVisibilityResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.Visibility))
+	}
+	if in.AccessToken != "" {
+		const prefix string = ",\"access_token\":"
+		out.RawString(prefix)
+		out.String(string(in.AccessToken))
+	}
+	{
+		const prefix string = ",\"is_anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAnonymous))
 	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResumeShortResponseList) MarshalJSON() ([]byte, error) {
+func (v ResumeVisibilityResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson39b3a2f5EncodeResuMatchInternalEntityDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResumeShortResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ResumeVisibilityResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson39b3a2f5EncodeResuMatchInternalEntityDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResumeShortResponseList) UnmarshalJSON(data []byte) error {
+func (v *ResumeVisibilityResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson39b3a2f5DecodeResuMatchInternalEntityDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResumeShortResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ResumeVisibilityResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson39b3a2f5DecodeResuMatchInternalEntityDto4(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *ResumeShortResponse) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *ResumeVisibilityRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -666,13 +988,159 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *Re
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "applicant_id":
-			out.ApplicantID = int(in.Int())
-		case "applicant":
-			if in.IsNull() {
-				in.Skip()
+		case "visibility":
+			out.Visibility = entity.ResumeVisibility(in.String())
+		case "regenerate_token":
+			out.RegenerateToken = bool(in.Bool())
+		case "is_anonymous":
+			out.IsAnonymous = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in ResumeVisibilityRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"visibility\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Visibility))
+	}
+	{
+		const prefix string = ",\"regenerate_token\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RegenerateToken))
+	}
+	{
+		const prefix string = ",\"is_anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAnonymous))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResumeVisibilityRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResumeVisibilityRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResumeVisibilityRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResumeVisibilityRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *ResumeShortResponseList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ResumeShortResponseList, 0, 0)
+			} else {
+				*out = ResumeShortResponseList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v31 ResumeShortResponse
+			(v31).UnmarshalEasyJSON(in)
+			*out = append(*out, v31)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in ResumeShortResponseList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v32, v33 := range in {
+			if v32 > 0 {
+				out.RawByte(',')
+			}
+			(v33).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResumeShortResponseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResumeShortResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResumeShortResponseList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResumeShortResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(in *jlexer.Lexer, out *ResumeShortResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "applicant_id":
+			out.ApplicantID = int(in.Int())
+		case "applicant":
+			if in.IsNull() {
+				in.Skip()
 				out.Applicant = nil
 			} else {
 				if out.Applicant == nil {
@@ -680,10 +1148,39 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *Re
 				}
 				(*out.Applicant).UnmarshalEasyJSON(in)
 			}
+		case "contacts_hidden":
+			out.ContactsHidden = bool(in.Bool())
 		case "specialization":
 			out.Specialization = string(in.String())
 		case "profession":
 			out.Profession = string(in.String())
+		case "desired_salary":
+			out.DesiredSalary = int(in.Int())
+		case "salary_currency":
+			out.SalaryCurrency = entity.Currency(in.String())
+		case "work_formats":
+			if in.IsNull() {
+				in.Skip()
+				out.WorkFormats = nil
+			} else {
+				in.Delim('[')
+				if out.WorkFormats == nil {
+					if !in.IsDelim(']') {
+						out.WorkFormats = make([]string, 0, 4)
+					} else {
+						out.WorkFormats = []string{}
+					}
+				} else {
+					out.WorkFormats = (out.WorkFormats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 string
+					v34 = string(in.String())
+					out.WorkFormats = append(out.WorkFormats, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "work_experiences":
 			(out.WorkExperience).UnmarshalEasyJSON(in)
 		case "created_at":
@@ -700,7 +1197,7 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(in *jlexer.Lexer, out *Re
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in ResumeShortResponse) {
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(out *jwriter.Writer, in ResumeShortResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -723,6 +1220,11 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in R
 			(*in.Applicant).MarshalEasyJSON(out)
 		}
 	}
+	{
+		const prefix string = ",\"contacts_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ContactsHidden))
+	}
 	{
 		const prefix string = ",\"specialization\":"
 		out.RawString(prefix)
@@ -733,6 +1235,30 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in R
 		out.RawString(prefix)
 		out.String(string(in.Profession))
 	}
+	if in.DesiredSalary != 0 {
+		const prefix string = ",\"desired_salary\":"
+		out.RawString(prefix)
+		out.Int(int(in.DesiredSalary))
+	}
+	if in.SalaryCurrency != "" {
+		const prefix string = ",\"salary_currency\":"
+		out.RawString(prefix)
+		out.String(string(in.SalaryCurrency))
+	}
+	if len(in.WorkFormats) != 0 {
+		const prefix string = ",\"work_formats\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v35, v36 := range in.WorkFormats {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v36))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"work_experiences\":"
 		out.RawString(prefix)
@@ -754,27 +1280,27 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(out *jwriter.Writer, in R
 // MarshalJSON supports json.Marshaler interface
 func (v ResumeShortResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResumeShortResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto5(w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResumeShortResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResumeShortResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto5(l, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *ResumeResponse) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(in *jlexer.Lexer, out *ResumeResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -813,6 +1339,114 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *Re
 			out.CreatedAt = string(in.String())
 		case "updated_at":
 			out.UpdatedAt = string(in.String())
+		case "visibility":
+			out.Visibility = entity.ResumeVisibility(in.String())
+		case "access_token":
+			out.AccessToken = string(in.String())
+		case "is_anonymous":
+			out.IsAnonymous = bool(in.Bool())
+		case "contacts_hidden":
+			out.ContactsHidden = bool(in.Bool())
+		case "desired_salary":
+			out.DesiredSalary = int(in.Int())
+		case "salary_currency":
+			out.SalaryCurrency = entity.Currency(in.String())
+		case "cities":
+			if in.IsNull() {
+				in.Skip()
+				out.Cities = nil
+			} else {
+				in.Delim('[')
+				if out.Cities == nil {
+					if !in.IsDelim(']') {
+						out.Cities = make([]string, 0, 4)
+					} else {
+						out.Cities = []string{}
+					}
+				} else {
+					out.Cities = (out.Cities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 string
+					v37 = string(in.String())
+					out.Cities = append(out.Cities, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ready_to_relocate":
+			out.ReadyToRelocate = bool(in.Bool())
+		case "ready_for_business_trips":
+			out.ReadyForBusinessTrips = bool(in.Bool())
+		case "work_formats":
+			if in.IsNull() {
+				in.Skip()
+				out.WorkFormats = nil
+			} else {
+				in.Delim('[')
+				if out.WorkFormats == nil {
+					if !in.IsDelim(']') {
+						out.WorkFormats = make([]string, 0, 4)
+					} else {
+						out.WorkFormats = []string{}
+					}
+				} else {
+					out.WorkFormats = (out.WorkFormats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 string
+					v38 = string(in.String())
+					out.WorkFormats = append(out.WorkFormats, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "employment_types":
+			if in.IsNull() {
+				in.Skip()
+				out.EmploymentTypes = nil
+			} else {
+				in.Delim('[')
+				if out.EmploymentTypes == nil {
+					if !in.IsDelim(']') {
+						out.EmploymentTypes = make([]string, 0, 4)
+					} else {
+						out.EmploymentTypes = []string{}
+					}
+				} else {
+					out.EmploymentTypes = (out.EmploymentTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 string
+					v39 = string(in.String())
+					out.EmploymentTypes = append(out.EmploymentTypes, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "schedules":
+			if in.IsNull() {
+				in.Skip()
+				out.Schedules = nil
+			} else {
+				in.Delim('[')
+				if out.Schedules == nil {
+					if !in.IsDelim(']') {
+						out.Schedules = make([]string, 0, 4)
+					} else {
+						out.Schedules = []string{}
+					}
+				} else {
+					out.Schedules = (out.Schedules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 string
+					v40 = string(in.String())
+					out.Schedules = append(out.Schedules, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "skills":
 			if in.IsNull() {
 				in.Skip()
@@ -829,9 +1463,9 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *Re
 					out.Skills = (out.Skills)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 string
-					v13 = string(in.String())
-					out.Skills = append(out.Skills, v13)
+					var v41 string
+					v41 = string(in.String())
+					out.Skills = append(out.Skills, v41)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -852,9 +1486,9 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *Re
 					out.AdditionalSpecializations = (out.AdditionalSpecializations)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v14 string
-					v14 = string(in.String())
-					out.AdditionalSpecializations = append(out.AdditionalSpecializations, v14)
+					var v42 string
+					v42 = string(in.String())
+					out.AdditionalSpecializations = append(out.AdditionalSpecializations, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -875,49 +1509,118 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(in *jlexer.Lexer, out *Re
 					out.WorkExperiences = (out.WorkExperiences)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v15 WorkExperienceResponse
-					(v15).UnmarshalEasyJSON(in)
-					out.WorkExperiences = append(out.WorkExperiences, v15)
+					var v43 WorkExperienceResponse
+					(v43).UnmarshalEasyJSON(in)
+					out.WorkExperiences = append(out.WorkExperiences, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in ResumeResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
-	}
-	{
-		const prefix string = ",\"applicant_id\":"
-		out.RawString(prefix)
-		out.Int(int(in.ApplicantID))
-	}
-	if in.AboutMe != "" {
-		const prefix string = ",\"about_me\":"
-		out.RawString(prefix)
-		out.String(string(in.AboutMe))
-	}
-	if in.Specialization != "" {
-		const prefix string = ",\"specialization\":"
-		out.RawString(prefix)
-		out.String(string(in.Specialization))
-	}
-	if in.Profession != "" {
-		const prefix string = ",\"profession\":"
+		case "educations":
+			if in.IsNull() {
+				in.Skip()
+				out.Educations = nil
+			} else {
+				in.Delim('[')
+				if out.Educations == nil {
+					if !in.IsDelim(']') {
+						out.Educations = make([]EducationResponse, 0, 0)
+					} else {
+						out.Educations = []EducationResponse{}
+					}
+				} else {
+					out.Educations = (out.Educations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 EducationResponse
+					(v44).UnmarshalEasyJSON(in)
+					out.Educations = append(out.Educations, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "courses":
+			if in.IsNull() {
+				in.Skip()
+				out.Courses = nil
+			} else {
+				in.Delim('[')
+				if out.Courses == nil {
+					if !in.IsDelim(']') {
+						out.Courses = make([]CourseResponse, 0, 0)
+					} else {
+						out.Courses = []CourseResponse{}
+					}
+				} else {
+					out.Courses = (out.Courses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v45 CourseResponse
+					(v45).UnmarshalEasyJSON(in)
+					out.Courses = append(out.Courses, v45)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]LanguageResponse, 0, 2)
+					} else {
+						out.Languages = []LanguageResponse{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 LanguageResponse
+					(v46).UnmarshalEasyJSON(in)
+					out.Languages = append(out.Languages, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(out *jwriter.Writer, in ResumeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"applicant_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ApplicantID))
+	}
+	if in.AboutMe != "" {
+		const prefix string = ",\"about_me\":"
+		out.RawString(prefix)
+		out.String(string(in.AboutMe))
+	}
+	if in.Specialization != "" {
+		const prefix string = ",\"specialization\":"
+		out.RawString(prefix)
+		out.String(string(in.Specialization))
+	}
+	if in.Profession != "" {
+		const prefix string = ",\"profession\":"
 		out.RawString(prefix)
 		out.String(string(in.Profession))
 	}
@@ -946,6 +1649,110 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in R
 		out.RawString(prefix)
 		out.String(string(in.UpdatedAt))
 	}
+	if in.Visibility != "" {
+		const prefix string = ",\"visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.Visibility))
+	}
+	if in.AccessToken != "" {
+		const prefix string = ",\"access_token\":"
+		out.RawString(prefix)
+		out.String(string(in.AccessToken))
+	}
+	{
+		const prefix string = ",\"is_anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAnonymous))
+	}
+	{
+		const prefix string = ",\"contacts_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ContactsHidden))
+	}
+	if in.DesiredSalary != 0 {
+		const prefix string = ",\"desired_salary\":"
+		out.RawString(prefix)
+		out.Int(int(in.DesiredSalary))
+	}
+	if in.SalaryCurrency != "" {
+		const prefix string = ",\"salary_currency\":"
+		out.RawString(prefix)
+		out.String(string(in.SalaryCurrency))
+	}
+	{
+		const prefix string = ",\"cities\":"
+		out.RawString(prefix)
+		if in.Cities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Cities {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v48))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ready_to_relocate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadyToRelocate))
+	}
+	{
+		const prefix string = ",\"ready_for_business_trips\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadyForBusinessTrips))
+	}
+	{
+		const prefix string = ",\"work_formats\":"
+		out.RawString(prefix)
+		if in.WorkFormats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v49, v50 := range in.WorkFormats {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v50))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"employment_types\":"
+		out.RawString(prefix)
+		if in.EmploymentTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v51, v52 := range in.EmploymentTypes {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v52))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"schedules\":"
+		out.RawString(prefix)
+		if in.Schedules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Schedules {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v54))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"skills\":"
 		out.RawString(prefix)
@@ -953,11 +1760,11 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in R
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v16, v17 := range in.Skills {
-				if v16 > 0 {
+			for v55, v56 := range in.Skills {
+				if v55 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v17))
+				out.String(string(v56))
 			}
 			out.RawByte(']')
 		}
@@ -969,11 +1776,11 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in R
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v18, v19 := range in.AdditionalSpecializations {
-				if v18 > 0 {
+			for v57, v58 := range in.AdditionalSpecializations {
+				if v57 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v19))
+				out.String(string(v58))
 			}
 			out.RawByte(']')
 		}
@@ -985,11 +1792,53 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in R
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v20, v21 := range in.WorkExperiences {
-				if v20 > 0 {
+			for v59, v60 := range in.WorkExperiences {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				(v60).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Educations) != 0 {
+		const prefix string = ",\"educations\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v61, v62 := range in.Educations {
+				if v61 > 0 {
+					out.RawByte(',')
+				}
+				(v62).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Courses) != 0 {
+		const prefix string = ",\"courses\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v63, v64 := range in.Courses {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				(v64).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Languages) != 0 {
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v65, v66 := range in.Languages {
+				if v65 > 0 {
 					out.RawByte(',')
 				}
-				(v21).MarshalEasyJSON(out)
+				(v66).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1000,27 +1849,27 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(out *jwriter.Writer, in R
 // MarshalJSON supports json.Marshaler interface
 func (v ResumeResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResumeResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto6(w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResumeResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResumeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto6(l, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(in *jlexer.Lexer, out *ResumeChatResponse) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(in *jlexer.Lexer, out *ResumeChatResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1057,7 +1906,7 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(in *jlexer.Lexer, out *Re
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(out *jwriter.Writer, in ResumeChatResponse) {
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(out *jwriter.Writer, in ResumeChatResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1087,27 +1936,27 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(out *jwriter.Writer, in R
 // MarshalJSON supports json.Marshaler interface
 func (v ResumeChatResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResumeChatResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto7(w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResumeChatResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResumeChatResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto7(l, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(in *jlexer.Lexer, out *ResumeApplicantShortResponseList) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(in *jlexer.Lexer, out *ResumeApplicantShortResponseList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -1124,9 +1973,9 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(in *jlexer.Lexer, out *Re
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v22 ResumeApplicantShortResponse
-			(v22).UnmarshalEasyJSON(in)
-			*out = append(*out, v22)
+			var v67 ResumeApplicantShortResponse
+			(v67).UnmarshalEasyJSON(in)
+			*out = append(*out, v67)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -1135,16 +1984,16 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(in *jlexer.Lexer, out *Re
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(out *jwriter.Writer, in ResumeApplicantShortResponseList) {
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto10(out *jwriter.Writer, in ResumeApplicantShortResponseList) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v23, v24 := range in {
-			if v23 > 0 {
+		for v68, v69 := range in {
+			if v68 > 0 {
 				out.RawByte(',')
 			}
-			(v24).MarshalEasyJSON(out)
+			(v69).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
@@ -1153,27 +2002,27 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(out *jwriter.Writer, in R
 // MarshalJSON supports json.Marshaler interface
 func (v ResumeApplicantShortResponseList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResumeApplicantShortResponseList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto8(w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResumeApplicantShortResponseList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResumeApplicantShortResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto8(l, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(in *jlexer.Lexer, out *ResumeApplicantShortResponse) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(in *jlexer.Lexer, out *ResumeApplicantShortResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1222,9 +2071,9 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(in *jlexer.Lexer, out *Re
 					out.Skills = (out.Skills)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v25 string
-					v25 = string(in.String())
-					out.Skills = append(out.Skills, v25)
+					var v70 string
+					v70 = string(in.String())
+					out.Skills = append(out.Skills, v70)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1249,7 +2098,7 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(in *jlexer.Lexer, out *Re
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(out *jwriter.Writer, in ResumeApplicantShortResponse) {
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto11(out *jwriter.Writer, in ResumeApplicantShortResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1279,11 +2128,11 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(out *jwriter.Writer, in R
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v26, v27 := range in.Skills {
-				if v26 > 0 {
+			for v71, v72 := range in.Skills {
+				if v71 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v27))
+				out.String(string(v72))
 			}
 			out.RawByte(']')
 		}
@@ -1319,27 +2168,27 @@ func easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(out *jwriter.Writer, in R
 // MarshalJSON supports json.Marshaler interface
 func (v ResumeApplicantShortResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResumeApplicantShortResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto9(w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResumeApplicantShortResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResumeApplicantShortResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto9(l, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(in *jlexer.Lexer, out *DeleteResumeResponse) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto12(in *jlexer.Lexer, out *LanguageResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1358,10 +2207,10 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(in *jlexer.Lexer, out *D
 			continue
 		}
 		switch key {
-		case "success":
-			out.Success = bool(in.Bool())
-		case "message":
-			out.Message = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "level":
+			out.Level = entity.LanguageLevel(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1372,47 +2221,47 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(in *jlexer.Lexer, out *D
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto10(out *jwriter.Writer, in DeleteResumeResponse) {
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto12(out *jwriter.Writer, in LanguageResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"success\":"
+		const prefix string = ",\"language\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Success))
+		out.String(string(in.Language))
 	}
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"level\":"
 		out.RawString(prefix)
-		out.String(string(in.Message))
+		out.String(string(in.Level))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteResumeResponse) MarshalJSON() ([]byte, error) {
+func (v LanguageResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto10(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteResumeResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto10(w, v)
+func (v LanguageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteResumeResponse) UnmarshalJSON(data []byte) error {
+func (v *LanguageResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteResumeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto10(l, v)
+func (v *LanguageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto12(l, v)
 }
-func easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(in *jlexer.Lexer, out *CreateResumeRequest) {
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto13(in *jlexer.Lexer, out *LanguageDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1431,83 +2280,593 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(in *jlexer.Lexer, out *C
 			continue
 		}
 		switch key {
-		case "about_me":
-			out.AboutMe = string(in.String())
-		case "specialization":
-			out.Specialization = string(in.String())
-		case "profession":
-			out.Profession = string(in.String())
-		case "education":
-			out.Education = entity.EducationType(in.String())
-		case "educational_institution":
-			out.EducationalInstitution = string(in.String())
-		case "graduation_year":
-			out.GraduationYear = string(in.String())
-		case "skills":
-			if in.IsNull() {
-				in.Skip()
-				out.Skills = nil
-			} else {
-				in.Delim('[')
-				if out.Skills == nil {
-					if !in.IsDelim(']') {
-						out.Skills = make([]string, 0, 4)
-					} else {
-						out.Skills = []string{}
-					}
-				} else {
-					out.Skills = (out.Skills)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v28 string
-					v28 = string(in.String())
-					out.Skills = append(out.Skills, v28)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "additional_specializations":
-			if in.IsNull() {
-				in.Skip()
-				out.AdditionalSpecializations = nil
-			} else {
-				in.Delim('[')
-				if out.AdditionalSpecializations == nil {
-					if !in.IsDelim(']') {
-						out.AdditionalSpecializations = make([]string, 0, 4)
-					} else {
-						out.AdditionalSpecializations = []string{}
+		case "language":
+			out.Language = string(in.String())
+		case "level":
+			out.Level = entity.LanguageLevel(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto13(out *jwriter.Writer, in LanguageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LanguageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LanguageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LanguageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LanguageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto13(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto14(in *jlexer.Lexer, out *EducationResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "level":
+			out.Level = entity.EducationType(in.String())
+		case "institution":
+			out.Institution = string(in.String())
+		case "faculty":
+			out.Faculty = string(in.String())
+		case "specialty":
+			out.Specialty = string(in.String())
+		case "graduation_year":
+			out.GraduationYear = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto14(out *jwriter.Writer, in EducationResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	{
+		const prefix string = ",\"institution\":"
+		out.RawString(prefix)
+		out.String(string(in.Institution))
+	}
+	if in.Faculty != "" {
+		const prefix string = ",\"faculty\":"
+		out.RawString(prefix)
+		out.String(string(in.Faculty))
+	}
+	if in.Specialty != "" {
+		const prefix string = ",\"specialty\":"
+		out.RawString(prefix)
+		out.String(string(in.Specialty))
+	}
+	{
+		const prefix string = ",\"graduation_year\":"
+		out.RawString(prefix)
+		out.Int(int(in.GraduationYear))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EducationResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EducationResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EducationResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EducationResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto14(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto15(in *jlexer.Lexer, out *EducationDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "level":
+			out.Level = entity.EducationType(in.String())
+		case "institution":
+			out.Institution = string(in.String())
+		case "faculty":
+			out.Faculty = string(in.String())
+		case "specialty":
+			out.Specialty = string(in.String())
+		case "graduation_year":
+			out.GraduationYear = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto15(out *jwriter.Writer, in EducationDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Level))
+	}
+	{
+		const prefix string = ",\"institution\":"
+		out.RawString(prefix)
+		out.String(string(in.Institution))
+	}
+	{
+		const prefix string = ",\"faculty\":"
+		out.RawString(prefix)
+		out.String(string(in.Faculty))
+	}
+	{
+		const prefix string = ",\"specialty\":"
+		out.RawString(prefix)
+		out.String(string(in.Specialty))
+	}
+	{
+		const prefix string = ",\"graduation_year\":"
+		out.RawString(prefix)
+		out.Int(int(in.GraduationYear))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EducationDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EducationDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EducationDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EducationDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto15(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto16(in *jlexer.Lexer, out *DeleteResumeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto16(out *jwriter.Writer, in DeleteResumeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteResumeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteResumeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteResumeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteResumeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto16(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto17(in *jlexer.Lexer, out *CreateResumeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "about_me":
+			out.AboutMe = string(in.String())
+		case "specialization":
+			out.Specialization = string(in.String())
+		case "profession":
+			out.Profession = string(in.String())
+		case "education":
+			out.Education = entity.EducationType(in.String())
+		case "educational_institution":
+			out.EducationalInstitution = string(in.String())
+		case "graduation_year":
+			out.GraduationYear = string(in.String())
+		case "skills":
+			if in.IsNull() {
+				in.Skip()
+				out.Skills = nil
+			} else {
+				in.Delim('[')
+				if out.Skills == nil {
+					if !in.IsDelim(']') {
+						out.Skills = make([]string, 0, 4)
+					} else {
+						out.Skills = []string{}
+					}
+				} else {
+					out.Skills = (out.Skills)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 string
+					v73 = string(in.String())
+					out.Skills = append(out.Skills, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "additional_specializations":
+			if in.IsNull() {
+				in.Skip()
+				out.AdditionalSpecializations = nil
+			} else {
+				in.Delim('[')
+				if out.AdditionalSpecializations == nil {
+					if !in.IsDelim(']') {
+						out.AdditionalSpecializations = make([]string, 0, 4)
+					} else {
+						out.AdditionalSpecializations = []string{}
 					}
 				} else {
 					out.AdditionalSpecializations = (out.AdditionalSpecializations)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v29 string
-					v29 = string(in.String())
-					out.AdditionalSpecializations = append(out.AdditionalSpecializations, v29)
+					var v74 string
+					v74 = string(in.String())
+					out.AdditionalSpecializations = append(out.AdditionalSpecializations, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "work_experiences":
+			if in.IsNull() {
+				in.Skip()
+				out.WorkExperiences = nil
+			} else {
+				in.Delim('[')
+				if out.WorkExperiences == nil {
+					if !in.IsDelim(']') {
+						out.WorkExperiences = make([]WorkExperienceDTO, 0, 0)
+					} else {
+						out.WorkExperiences = []WorkExperienceDTO{}
+					}
+				} else {
+					out.WorkExperiences = (out.WorkExperiences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 WorkExperienceDTO
+					(v75).UnmarshalEasyJSON(in)
+					out.WorkExperiences = append(out.WorkExperiences, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "desired_salary":
+			out.DesiredSalary = int(in.Int())
+		case "salary_currency":
+			out.SalaryCurrency = entity.Currency(in.String())
+		case "cities":
+			if in.IsNull() {
+				in.Skip()
+				out.Cities = nil
+			} else {
+				in.Delim('[')
+				if out.Cities == nil {
+					if !in.IsDelim(']') {
+						out.Cities = make([]string, 0, 4)
+					} else {
+						out.Cities = []string{}
+					}
+				} else {
+					out.Cities = (out.Cities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 string
+					v76 = string(in.String())
+					out.Cities = append(out.Cities, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ready_to_relocate":
+			out.ReadyToRelocate = bool(in.Bool())
+		case "ready_for_business_trips":
+			out.ReadyForBusinessTrips = bool(in.Bool())
+		case "work_formats":
+			if in.IsNull() {
+				in.Skip()
+				out.WorkFormats = nil
+			} else {
+				in.Delim('[')
+				if out.WorkFormats == nil {
+					if !in.IsDelim(']') {
+						out.WorkFormats = make([]string, 0, 4)
+					} else {
+						out.WorkFormats = []string{}
+					}
+				} else {
+					out.WorkFormats = (out.WorkFormats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v77 string
+					v77 = string(in.String())
+					out.WorkFormats = append(out.WorkFormats, v77)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "employment_types":
+			if in.IsNull() {
+				in.Skip()
+				out.EmploymentTypes = nil
+			} else {
+				in.Delim('[')
+				if out.EmploymentTypes == nil {
+					if !in.IsDelim(']') {
+						out.EmploymentTypes = make([]string, 0, 4)
+					} else {
+						out.EmploymentTypes = []string{}
+					}
+				} else {
+					out.EmploymentTypes = (out.EmploymentTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 string
+					v78 = string(in.String())
+					out.EmploymentTypes = append(out.EmploymentTypes, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "schedules":
+			if in.IsNull() {
+				in.Skip()
+				out.Schedules = nil
+			} else {
+				in.Delim('[')
+				if out.Schedules == nil {
+					if !in.IsDelim(']') {
+						out.Schedules = make([]string, 0, 4)
+					} else {
+						out.Schedules = []string{}
+					}
+				} else {
+					out.Schedules = (out.Schedules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 string
+					v79 = string(in.String())
+					out.Schedules = append(out.Schedules, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "educations":
+			if in.IsNull() {
+				in.Skip()
+				out.Educations = nil
+			} else {
+				in.Delim('[')
+				if out.Educations == nil {
+					if !in.IsDelim(']') {
+						out.Educations = make([]EducationDTO, 0, 0)
+					} else {
+						out.Educations = []EducationDTO{}
+					}
+				} else {
+					out.Educations = (out.Educations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v80 EducationDTO
+					(v80).UnmarshalEasyJSON(in)
+					out.Educations = append(out.Educations, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "courses":
+			if in.IsNull() {
+				in.Skip()
+				out.Courses = nil
+			} else {
+				in.Delim('[')
+				if out.Courses == nil {
+					if !in.IsDelim(']') {
+						out.Courses = make([]CourseDTO, 0, 1)
+					} else {
+						out.Courses = []CourseDTO{}
+					}
+				} else {
+					out.Courses = (out.Courses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 CourseDTO
+					(v81).UnmarshalEasyJSON(in)
+					out.Courses = append(out.Courses, v81)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "work_experiences":
+		case "languages":
 			if in.IsNull() {
 				in.Skip()
-				out.WorkExperiences = nil
+				out.Languages = nil
 			} else {
 				in.Delim('[')
-				if out.WorkExperiences == nil {
+				if out.Languages == nil {
 					if !in.IsDelim(']') {
-						out.WorkExperiences = make([]WorkExperienceDTO, 0, 0)
+						out.Languages = make([]LanguageDTO, 0, 2)
 					} else {
-						out.WorkExperiences = []WorkExperienceDTO{}
+						out.Languages = []LanguageDTO{}
 					}
 				} else {
-					out.WorkExperiences = (out.WorkExperiences)[:0]
+					out.Languages = (out.Languages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v30 WorkExperienceDTO
-					(v30).UnmarshalEasyJSON(in)
-					out.WorkExperiences = append(out.WorkExperiences, v30)
+					var v82 LanguageDTO
+					(v82).UnmarshalEasyJSON(in)
+					out.Languages = append(out.Languages, v82)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1522,111 +2881,699 @@ func easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(in *jlexer.Lexer, out *C
 		in.Consumed()
 	}
 }
-func easyjson39b3a2f5EncodeResuMatchInternalEntityDto11(out *jwriter.Writer, in CreateResumeRequest) {
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto17(out *jwriter.Writer, in CreateResumeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"about_me\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AboutMe))
+	}
+	{
+		const prefix string = ",\"specialization\":"
+		out.RawString(prefix)
+		out.String(string(in.Specialization))
+	}
+	{
+		const prefix string = ",\"profession\":"
+		out.RawString(prefix)
+		out.String(string(in.Profession))
+	}
+	{
+		const prefix string = ",\"education\":"
+		out.RawString(prefix)
+		out.String(string(in.Education))
+	}
+	{
+		const prefix string = ",\"educational_institution\":"
+		out.RawString(prefix)
+		out.String(string(in.EducationalInstitution))
+	}
+	{
+		const prefix string = ",\"graduation_year\":"
+		out.RawString(prefix)
+		out.String(string(in.GraduationYear))
+	}
+	{
+		const prefix string = ",\"skills\":"
+		out.RawString(prefix)
+		if in.Skills == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Skills {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v84))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"additional_specializations\":"
+		out.RawString(prefix)
+		if in.AdditionalSpecializations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.AdditionalSpecializations {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v86))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"work_experiences\":"
+		out.RawString(prefix)
+		if in.WorkExperiences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v87, v88 := range in.WorkExperiences {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				(v88).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"desired_salary\":"
+		out.RawString(prefix)
+		out.Int(int(in.DesiredSalary))
+	}
+	{
+		const prefix string = ",\"salary_currency\":"
+		out.RawString(prefix)
+		out.String(string(in.SalaryCurrency))
+	}
+	{
+		const prefix string = ",\"cities\":"
+		out.RawString(prefix)
+		if in.Cities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.Cities {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v90))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ready_to_relocate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadyToRelocate))
+	}
+	{
+		const prefix string = ",\"ready_for_business_trips\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadyForBusinessTrips))
+	}
+	{
+		const prefix string = ",\"work_formats\":"
+		out.RawString(prefix)
+		if in.WorkFormats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v91, v92 := range in.WorkFormats {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v92))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"employment_types\":"
+		out.RawString(prefix)
+		if in.EmploymentTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v93, v94 := range in.EmploymentTypes {
+				if v93 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v94))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"schedules\":"
+		out.RawString(prefix)
+		if in.Schedules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.Schedules {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v96))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"educations\":"
+		out.RawString(prefix)
+		if in.Educations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v97, v98 := range in.Educations {
+				if v97 > 0 {
+					out.RawByte(',')
+				}
+				(v98).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"courses\":"
+		out.RawString(prefix)
+		if in.Courses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v99, v100 := range in.Courses {
+				if v99 > 0 {
+					out.RawByte(',')
+				}
+				(v100).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Languages {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				(v102).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateResumeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateResumeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateResumeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateResumeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto17(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto18(in *jlexer.Lexer, out *CourseResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "kind":
+			out.Kind = entity.CourseKind(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "organization":
+			out.Organization = string(in.String())
+		case "year":
+			out.Year = int(in.Int())
+		case "file_id":
+			out.FileID = int(in.Int())
+		case "file_path":
+			out.FilePath = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto18(out *jwriter.Writer, in CourseResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Organization != "" {
+		const prefix string = ",\"organization\":"
+		out.RawString(prefix)
+		out.String(string(in.Organization))
+	}
+	if in.Year != 0 {
+		const prefix string = ",\"year\":"
+		out.RawString(prefix)
+		out.Int(int(in.Year))
+	}
+	if in.FileID != 0 {
+		const prefix string = ",\"file_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FileID))
+	}
+	if in.FilePath != "" {
+		const prefix string = ",\"file_path\":"
+		out.RawString(prefix)
+		out.String(string(in.FilePath))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CourseResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CourseResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CourseResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CourseResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto18(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto19(in *jlexer.Lexer, out *CourseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = entity.CourseKind(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "organization":
+			out.Organization = string(in.String())
+		case "year":
+			out.Year = int(in.Int())
+		case "file_id":
+			out.FileID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto19(out *jwriter.Writer, in CourseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"about_me\":"
+		const prefix string = ",\"kind\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.AboutMe))
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"specialization\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Specialization))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"profession\":"
+		const prefix string = ",\"organization\":"
 		out.RawString(prefix)
-		out.String(string(in.Profession))
+		out.String(string(in.Organization))
 	}
 	{
-		const prefix string = ",\"education\":"
+		const prefix string = ",\"year\":"
 		out.RawString(prefix)
-		out.String(string(in.Education))
+		out.Int(int(in.Year))
 	}
 	{
-		const prefix string = ",\"educational_institution\":"
+		const prefix string = ",\"file_id\":"
 		out.RawString(prefix)
-		out.String(string(in.EducationalInstitution))
+		out.Int(int(in.FileID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CourseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CourseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CourseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CourseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto19(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto20(in *jlexer.Lexer, out *ContactRequestResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "employer_id":
+			out.EmployerID = int(in.Int())
+		case "applicant_id":
+			out.ApplicantID = int(in.Int())
+		case "resume_id":
+			out.ResumeID = int(in.Int())
+		case "status":
+			out.Status = entity.ContactRequestStatus(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto20(out *jwriter.Writer, in ContactRequestResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"graduation_year\":"
+		const prefix string = ",\"employer_id\":"
 		out.RawString(prefix)
-		out.String(string(in.GraduationYear))
+		out.Int(int(in.EmployerID))
+	}
+	if in.ApplicantID != 0 {
+		const prefix string = ",\"applicant_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ApplicantID))
 	}
 	{
-		const prefix string = ",\"skills\":"
+		const prefix string = ",\"resume_id\":"
 		out.RawString(prefix)
-		if in.Skills == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v31, v32 := range in.Skills {
-				if v31 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v32))
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.ResumeID))
 	}
 	{
-		const prefix string = ",\"additional_specializations\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		if in.AdditionalSpecializations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v33, v34 := range in.AdditionalSpecializations {
-				if v33 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v34))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"work_experiences\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		if in.WorkExperiences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ContactRequestResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ContactRequestResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ContactRequestResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ContactRequestResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto20(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto21(in *jlexer.Lexer, out *BlockedEmployerResponseList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(BlockedEmployerResponseList, 0, 1)
+			} else {
+				*out = BlockedEmployerResponseList{}
+			}
 		} else {
-			out.RawByte('[')
-			for v35, v36 := range in.WorkExperiences {
-				if v35 > 0 {
-					out.RawByte(',')
-				}
-				(v36).MarshalEasyJSON(out)
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v103 BlockedEmployerResponse
+			(v103).UnmarshalEasyJSON(in)
+			*out = append(*out, v103)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto21(out *jwriter.Writer, in BlockedEmployerResponseList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v104, v105 := range in {
+			if v104 > 0 {
+				out.RawByte(',')
 			}
-			out.RawByte(']')
+			(v105).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BlockedEmployerResponseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BlockedEmployerResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BlockedEmployerResponseList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BlockedEmployerResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto21(l, v)
+}
+func easyjson39b3a2f5DecodeResuMatchInternalEntityDto22(in *jlexer.Lexer, out *BlockedEmployerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "employer_id":
+			out.EmployerID = int(in.Int())
+		case "company_name":
+			out.CompanyName = string(in.String())
+		case "blocked_at":
+			out.BlockedAt = string(in.String())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson39b3a2f5EncodeResuMatchInternalEntityDto22(out *jwriter.Writer, in BlockedEmployerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"employer_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.EmployerID))
+	}
+	{
+		const prefix string = ",\"company_name\":"
+		out.RawString(prefix)
+		out.String(string(in.CompanyName))
+	}
+	{
+		const prefix string = ",\"blocked_at\":"
+		out.RawString(prefix)
+		out.String(string(in.BlockedAt))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateResumeRequest) MarshalJSON() ([]byte, error) {
+func (v BlockedEmployerResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto11(&w, v)
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateResumeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson39b3a2f5EncodeResuMatchInternalEntityDto11(w, v)
+func (v BlockedEmployerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson39b3a2f5EncodeResuMatchInternalEntityDto22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateResumeRequest) UnmarshalJSON(data []byte) error {
+func (v *BlockedEmployerResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(&r, v)
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateResumeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson39b3a2f5DecodeResuMatchInternalEntityDto11(l, v)
+func (v *BlockedEmployerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson39b3a2f5DecodeResuMatchInternalEntityDto22(l, v)
 }
@@ -5,3 +5,10 @@ type UploadStaticResponse struct {
 	ID   int    `json:"id"`
 	Path string `json:"path"`
 }
+
+// easyjson:json
+type UploadAttachmentResponse struct {
+	ID          int    `json:"id"`
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
+}
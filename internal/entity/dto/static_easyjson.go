@@ -90,3 +90,83 @@ func (v *UploadStaticResponse) UnmarshalJSON(data []byte) error {
 func (v *UploadStaticResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson3b290fb6DecodeResuMatchInternalEntityDto(l, v)
 }
+func easyjson3b290fb6DecodeResuMatchInternalEntityDto1(in *jlexer.Lexer, out *UploadAttachmentResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "content_type":
+			out.ContentType = string(in.String())
+		case "size":
+			out.Size = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3b290fb6EncodeResuMatchInternalEntityDto1(out *jwriter.Writer, in UploadAttachmentResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"content_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UploadAttachmentResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3b290fb6EncodeResuMatchInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UploadAttachmentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3b290fb6EncodeResuMatchInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UploadAttachmentResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3b290fb6DecodeResuMatchInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UploadAttachmentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3b290fb6DecodeResuMatchInternalEntityDto1(l, v)
+}
@@ -14,10 +14,11 @@ var (
 )
 
 const (
-	PSQLUniqueViolation   = "23505"
-	PSQLNotNullViolation  = "23502"
-	PSQLDatatypeViolation = "22P02"
-	PSQLCheckViolation    = "23514"
+	PSQLUniqueViolation     = "23505"
+	PSQLNotNullViolation    = "23502"
+	PSQLDatatypeViolation   = "22P02"
+	PSQLCheckViolation      = "23514"
+	PSQLForeignKeyViolation = "23503"
 )
 
 type Error struct {
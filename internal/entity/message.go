@@ -1,12 +1,122 @@
 package entity
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
+const (
+	DefaultMessagePageSize = 50
+	MaxMessagePageSize     = 100
+	MaxMessageAttachments  = 10
+	// MessageEditWindow - сколько времени после отправки сообщение можно исправить
+	MessageEditWindow = 24 * time.Hour
+)
+
+// MessageKind - вид сообщения: написанное участником чата или добавленное сервисом
+type MessageKind string
+
+const (
+	MessageKindUser   MessageKind = "user"
+	MessageKindSystem MessageKind = "system"
+)
+
+// SystemEvent - событие отклика, о котором сообщает системное сообщение
+type SystemEvent string
+
+const (
+	SystemEventResponse           SystemEvent = "response"
+	SystemEventResumeDownload     SystemEvent = "resume_download"
+	SystemEventInvitation         SystemEvent = "invitation"
+	SystemEventInvitationAccepted SystemEvent = "invitation_accepted"
+	SystemEventInvitationDeclined SystemEvent = "invitation_declined"
+)
+
+// Message - сообщение чата. У системных сообщений SenderID и FromApplicant не заполняются
 type Message struct {
+	ID            int           `json:"id"`
+	ChatID        int           `json:"chat_id"`
+	SenderID      int           `json:"sender_id"`
+	FromApplicant bool          `json:"from_applicant"`
+	Kind          MessageKind   `json:"kind"`
+	Event         SystemEvent   `json:"event"`
+	Payload       string        `json:"payload"`
+	ClientID      string        `json:"client_id"`
+	Attachments   []*Attachment `json:"attachments"`
+	SentAt        time.Time     `json:"sent_at"`
+	EditedAt      *time.Time    `json:"edited_at"`
+	DeletedAt     *time.Time    `json:"deleted_at"`
+}
+
+// IsSystem сообщает, добавлено ли сообщение сервисом, а не участником чата
+func (m *Message) IsSystem() bool {
+	return m.Kind == MessageKindSystem
+}
+
+// Deleted сообщает, удалено ли сообщение для всех участников чата
+func (m *Message) Deleted() bool {
+	return m.DeletedAt != nil
+}
+
+// MessageEdit - предыдущая редакция исправленного сообщения
+type MessageEdit struct {
+	ID        int       `json:"id"`
+	MessageID int       `json:"message_id"`
+	Payload   string    `json:"payload"`
+	EditedAt  time.Time `json:"edited_at"`
+}
+
+// Attachment - файл во вложении сообщения. Загружается до отправки сообщения и до привязки
+// к нему (MessageID = 0) виден только загрузившему его участнику чата
+type Attachment struct {
 	ID            int       `json:"id"`
 	ChatID        int       `json:"chat_id"`
-	SenderID      int       `json:"sender_id"`
+	MessageID     int       `json:"message_id"`
+	UploaderID    int       `json:"uploader_id"`
 	FromApplicant bool      `json:"from_applicant"`
-	Payload       string    `json:"payload"`
-	SentAt        time.Time `json:"sent_at"`
+	StaticID      int       `json:"static_id"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int       `json:"size"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// AttachmentFile - файл для скачивания: вложение сообщения или выгрузка переписки
+type AttachmentFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// MessagePage - курсор постраничной загрузки истории чата по id сообщений.
+// Без BeforeID и AfterID возвращается последняя страница переписки
+type MessagePage struct {
+	BeforeID int
+	AfterID  int
+	Limit    int
+}
+
+func (p *MessagePage) Validate() error {
+	if p.BeforeID < 0 || p.AfterID < 0 {
+		return NewError(ErrBadRequest, fmt.Errorf("id сообщения не может быть отрицательным"))
+	}
+
+	if p.BeforeID > 0 && p.AfterID > 0 {
+		return NewError(ErrBadRequest, fmt.Errorf("параметры before и after нельзя указывать одновременно"))
+	}
+
+	if p.Limit < 0 || p.Limit > MaxMessagePageSize {
+		return NewError(ErrBadRequest, fmt.Errorf("размер страницы должен быть от 1 до %d", MaxMessagePageSize))
+	}
+
+	if p.Limit == 0 {
+		p.Limit = DefaultMessagePageSize
+	}
+	return nil
+}
+
+// MessageWithChat - сообщение вместе с чатом, в котором оно отправлено
+type MessageWithChat struct {
+	Message Message
+	Chat    Chat
 }
@@ -1,17 +1,186 @@
 package entity
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 type NotificationType string
 
 const (
 	ApplyNotificationType NotificationType = "apply"
 	DownloadResumeType    NotificationType = "download_resume"
+	ContactRequestType    NotificationType = "contact_request"
+	InvitationType        NotificationType = "invitation"
+)
+
+const (
+	DefaultNotificationPageSize = 20
+	MaxNotificationPageSize     = 100
 )
 
-var AllowedNotificationTypes = map[string]NotificationType{
-	"apply":           ApplyNotificationType,
-	"download_resume": DownloadResumeType,
+// NotificationFilter - условия выборки уведомлений пользователя, пустые поля не учитываются.
+// Уведомления отдаются от новых к старым, а с AfterID - от старых к новым, чтобы синхронизация
+// после переподключения продолжалась с последнего полученного уведомления
+type NotificationFilter struct {
+	Types    []NotificationType
+	IsViewed *bool
+	AfterID  int
+	Limit    int
+	Offset   int
+}
+
+func (f *NotificationFilter) Validate() error {
+	for _, notificationType := range f.Types {
+		if _, ok := LookupNotificationType(notificationType); !ok {
+			return NewError(ErrBadRequest, fmt.Errorf("неизвестный тип уведомления: %s", notificationType))
+		}
+	}
+
+	if f.AfterID < 0 || f.Offset < 0 {
+		return NewError(ErrBadRequest, fmt.Errorf("смещение и id уведомления не могут быть отрицательными"))
+	}
+
+	if f.Limit < 0 || f.Limit > MaxNotificationPageSize {
+		return NewError(ErrBadRequest, fmt.Errorf("размер страницы должен быть от 1 до %d", MaxNotificationPageSize))
+	}
+
+	if f.Limit == 0 {
+		f.Limit = DefaultNotificationPageSize
+	}
+	return nil
+}
+
+// NotificationPayload - данные события, по которым строится превью. Снимок делается при создании
+// уведомления, поэтому превью любого типа читается одним запросом без соединений с таблицами объектов
+type NotificationPayload map[string]string
+
+// NotificationTypeSpec описывает тип уведомления. Новый тип добавляется записью в notificationTypes,
+// без отдельных запросов в репозитории и миграций
+type NotificationTypeSpec struct {
+	Type NotificationType
+	// Receiver - роль пользователя, которому приходят уведомления этого типа
+	Receiver UserRole
+	// Payload - поля, которые обязан заполнить создатель уведомления
+	Payload []string
+	// Render заполняет заголовок и текст превью по payload и именам участников
+	Render func(preview *NotificationPreview)
+	// GroupWindow - уведомления этого типа об одном объекте, пришедшие в течение окна после первого,
+	// показываются одной группой. Без окна тип не группируется
+	GroupWindow time.Duration
+	// RenderGroup заполняет текст группы из preview.GroupSize уведомлений, preview - последнее из них
+	RenderGroup func(preview *NotificationPreview)
+}
+
+var notificationTypes = []NotificationTypeSpec{
+	{
+		Type:     ApplyNotificationType,
+		Receiver: EmployerRole,
+		Payload:  []string{"vacancy_title"},
+		Render: func(p *NotificationPreview) {
+			p.Title = p.Payload["vacancy_title"]
+			p.Text = fmt.Sprintf("%s откликнулся на вакансию «%s»", p.ApplicantName, p.Title)
+		},
+		GroupWindow: time.Hour,
+		RenderGroup: func(p *NotificationPreview) {
+			p.Text = fmt.Sprintf("%d %s на вакансию «%s» за последний час",
+				p.GroupSize, pluralRu(p.GroupSize, "новый отклик", "новых отклика", "новых откликов"), p.Title)
+		},
+	},
+	{
+		Type:     DownloadResumeType,
+		Receiver: ApplicantRole,
+		Payload:  []string{"profession"},
+		Render: func(p *NotificationPreview) {
+			p.Title = p.Payload["profession"]
+			p.Text = fmt.Sprintf("%s скачал ваше резюме «%s»", p.EmployerName, p.Title)
+		},
+		GroupWindow: time.Hour,
+		RenderGroup: func(p *NotificationPreview) {
+			p.Text = fmt.Sprintf("Ваше резюме «%s» скачали %d %s за последний час",
+				p.Title, p.GroupSize, pluralRu(p.GroupSize, "раз", "раза", "раз"))
+		},
+	},
+	{
+		Type:     ContactRequestType,
+		Receiver: ApplicantRole,
+		Payload:  []string{"profession"},
+		Render: func(p *NotificationPreview) {
+			p.Title = p.Payload["profession"]
+			p.Text = fmt.Sprintf("%s запрашивает ваши контакты", p.EmployerName)
+		},
+	},
+	{
+		Type:     InvitationType,
+		Receiver: ApplicantRole,
+		Payload:  []string{"vacancy_title"},
+		Render: func(p *NotificationPreview) {
+			p.Title = p.Payload["vacancy_title"]
+			p.Text = fmt.Sprintf("%s приглашает вас на вакансию «%s»", p.EmployerName, p.Title)
+		},
+	},
+}
+
+// LookupNotificationType возвращает описание зарегистрированного типа уведомления
+func LookupNotificationType(notificationType NotificationType) (NotificationTypeSpec, bool) {
+	for _, spec := range notificationTypes {
+		if spec.Type == notificationType {
+			return spec, true
+		}
+	}
+	return NotificationTypeSpec{}, false
+}
+
+// NotificationTypesFor возвращает типы уведомлений, которые может получить пользователь с ролью role
+func NotificationTypesFor(role UserRole) []NotificationType {
+	var types []NotificationType
+	for _, spec := range notificationTypes {
+		if spec.Receiver == role {
+			types = append(types, spec.Type)
+		}
+	}
+	return types
+}
+
+// ValidatePayload проверяет, что в payload есть все поля, объявленные для типа
+func (s NotificationTypeSpec) ValidatePayload(payload NotificationPayload) error {
+	for _, field := range s.Payload {
+		if _, ok := payload[field]; !ok {
+			return NewError(ErrBadRequest, fmt.Errorf("в уведомлении типа %q нет поля %s", s.Type, field))
+		}
+	}
+	return nil
+}
+
+// RenderNotification заполняет заголовок и текст превью, для группы - текст всей группы.
+// Превью неизвестного типа остается без текста
+func RenderNotification(preview *NotificationPreview) {
+	spec, ok := LookupNotificationType(preview.Type)
+	if !ok {
+		return
+	}
+	spec.Render(preview)
+	if preview.GroupSize > 1 && spec.RenderGroup != nil {
+		spec.RenderGroup(preview)
+	}
+	preview.Text = strings.Join(strings.Fields(preview.Text), " ")
+}
+
+// pluralRu выбирает форму слова для числа n: 1 отклик, 2 отклика, 5 откликов
+func pluralRu(n int, one, few, many string) string {
+	n %= 100
+	if n >= 11 && n <= 14 {
+		return many
+	}
+	switch n % 10 {
+	case 1:
+		return one
+	case 2, 3, 4:
+		return few
+	default:
+		return many
+	}
 }
 
 type UserRole string
@@ -36,8 +205,14 @@ type Notification struct {
 	ReceiverRole UserRole         `json:"receiver_role"`
 	ObjectID     int              `json:"object_id"`
 	ResumeID     int              `json:"resume_id"`
-	IsViewed     bool             `json:"is_viewed"`
-	CreatedAt    time.Time        `json:"created_at"`
+	// GroupID - id первого уведомления группы, у уведомления вне группы совпадает с ID
+	GroupID   int                 `json:"group_id"`
+	Payload   NotificationPayload `json:"payload"`
+	IsViewed  bool                `json:"is_viewed"`
+	CreatedAt time.Time           `json:"created_at"`
+	// OutboxEventID - событие outbox, по которому создано уведомление. Повторная доставка события
+	// не создает уведомление еще раз
+	OutboxEventID int `json:"-"`
 }
 
 // easyjson:json
@@ -51,8 +226,15 @@ type NotificationPreview struct {
 	ApplicantName string           `json:"applicant_name"`
 	EmployerName  string           `json:"employer_name"`
 	Title         string           `json:"title"`
-	IsViewed      bool             `json:"is_viewed"`
-	CreatedAt     time.Time        `json:"created_at"`
+	// Text - готовая строка уведомления, чтобы клиенту не нужно было знать о каждом типе
+	Text    string              `json:"text"`
+	Payload NotificationPayload `json:"payload"`
+	// GroupID и GroupSize описывают группу, которую представляет превью. Следующее уведомление группы
+	// приходит превью с тем же GroupID, и клиент заменяет им прежнее. Группа прочитана, когда прочитаны все ее уведомления
+	GroupID   int       `json:"group_id"`
+	GroupSize int       `json:"group_size"`
+	IsViewed  bool      `json:"is_viewed"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // easyjson:json
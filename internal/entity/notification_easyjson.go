@@ -132,6 +132,28 @@ func easyjson9806e1DecodeResuMatchInternalEntity1(in *jlexer.Lexer, out *Notific
 			out.EmployerName = string(in.String())
 		case "title":
 			out.Title = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "payload":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Payload = make(NotificationPayload)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v4 string
+					v4 = string(in.String())
+					(out.Payload)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "group_size":
+			out.GroupSize = int(in.Int())
 		case "is_viewed":
 			out.IsViewed = bool(in.Bool())
 		case "created_at":
@@ -197,6 +219,42 @@ func easyjson9806e1EncodeResuMatchInternalEntity1(out *jwriter.Writer, in Notifi
 		out.RawString(prefix)
 		out.String(string(in.Title))
 	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		if in.Payload == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.Payload {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				out.String(string(v5Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"group_size\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupSize))
+	}
 	{
 		const prefix string = ",\"is_viewed\":"
 		out.RawString(prefix)
@@ -268,6 +326,24 @@ func easyjson9806e1DecodeResuMatchInternalEntity2(in *jlexer.Lexer, out *Notific
 			out.ObjectID = int(in.Int())
 		case "resume_id":
 			out.ResumeID = int(in.Int())
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "payload":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Payload = make(NotificationPayload)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v6 string
+					v6 = string(in.String())
+					(out.Payload)[key] = v6
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "is_viewed":
 			out.IsViewed = bool(in.Bool())
 		case "created_at":
@@ -328,6 +404,32 @@ func easyjson9806e1EncodeResuMatchInternalEntity2(out *jwriter.Writer, in Notifi
 		out.RawString(prefix)
 		out.Int(int(in.ResumeID))
 	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		if in.Payload == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v7First := true
+			for v7Name, v7Value := range in.Payload {
+				if v7First {
+					v7First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v7Name))
+				out.RawByte(':')
+				out.String(string(v7Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"is_viewed\":"
 		out.RawString(prefix)
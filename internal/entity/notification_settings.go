@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+	// Часовые пояса встроены в бинарник, чтобы тихие часы работали и в образе без tzdata
+	_ "time/tzdata"
+)
+
+// NotificationChannel - способ доставки уведомления
+type NotificationChannel string
+
+const (
+	// InAppChannel - уведомление сохраняется и показывается в списке уведомлений на сайте
+	InAppChannel NotificationChannel = "in_app"
+	// WebsocketChannel - уведомление приходит сразу, пока пользователь на сайте
+	WebsocketChannel NotificationChannel = "websocket"
+	// EmailChannel - уведомление отправляется письмом
+	EmailChannel NotificationChannel = "email"
+)
+
+var AllowedNotificationChannels = map[string]NotificationChannel{
+	"in_app":    InAppChannel,
+	"websocket": WebsocketChannel,
+	"email":     EmailChannel,
+}
+
+// DefaultNotificationChannels - каналы типа, для которого пользователь еще ничего не настраивал
+var DefaultNotificationChannels = map[NotificationChannel]bool{
+	InAppChannel:     true,
+	WebsocketChannel: true,
+	EmailChannel:     false,
+}
+
+// QuietHours - интервал, когда уведомления не приходят сразу и не отправляются письмом, а только
+// сохраняются на сайте. Время задается в часовом поясе пользователя, интервал может переходить через полночь
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	TimeZone string `json:"time_zone"`
+}
+
+const quietHoursLayout = "15:04"
+
+func (q *QuietHours) Validate() error {
+	start, err := time.Parse(quietHoursLayout, q.Start)
+	if err != nil {
+		return NewError(ErrBadRequest, fmt.Errorf("начало тихих часов должно быть в формате ЧЧ:ММ"))
+	}
+
+	end, err := time.Parse(quietHoursLayout, q.End)
+	if err != nil {
+		return NewError(ErrBadRequest, fmt.Errorf("конец тихих часов должен быть в формате ЧЧ:ММ"))
+	}
+
+	if start.Equal(end) {
+		return NewError(ErrBadRequest, fmt.Errorf("начало и конец тихих часов не должны совпадать"))
+	}
+
+	if _, err = time.LoadLocation(q.TimeZone); err != nil || q.TimeZone == "" {
+		return NewError(ErrBadRequest, fmt.Errorf("неизвестный часовой пояс: %s", q.TimeZone))
+	}
+	return nil
+}
+
+// Active сообщает, приходится ли момент t на тихие часы. Некорректные настройки тихие часы отключают
+func (q *QuietHours) Active(t time.Time) bool {
+	if q == nil {
+		return false
+	}
+
+	loc, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return false
+	}
+	start, err := time.Parse(quietHoursLayout, q.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(quietHoursLayout, q.End)
+	if err != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	now := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from < to {
+		return now >= from && now < to
+	}
+	return now >= from || now < to
+}
+
+// NotificationSettings - настройки уведомлений пользователя. Channels хранит только измененные
+// пользователем значения, остальные берутся из DefaultNotificationChannels
+type NotificationSettings struct {
+	UserID     int
+	Role       UserRole
+	Email      string
+	Channels   map[NotificationType]map[NotificationChannel]bool
+	QuietHours *QuietHours
+}
+
+// Enabled сообщает, включен ли канал для уведомлений типа notificationType
+func (s *NotificationSettings) Enabled(notificationType NotificationType, channel NotificationChannel) bool {
+	if enabled, ok := s.Channels[notificationType][channel]; ok {
+		return enabled
+	}
+	return DefaultNotificationChannels[channel]
+}
+
+// Set включает или отключает канал для уведомлений типа notificationType
+func (s *NotificationSettings) Set(notificationType NotificationType, channel NotificationChannel, enabled bool) {
+	if s.Channels == nil {
+		s.Channels = make(map[NotificationType]map[NotificationChannel]bool)
+	}
+	if s.Channels[notificationType] == nil {
+		s.Channels[notificationType] = make(map[NotificationChannel]bool)
+	}
+	s.Channels[notificationType][channel] = enabled
+}
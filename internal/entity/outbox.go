@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type EventType string
+
+const (
+	ResponseCreatedEvent    EventType = "response_created"
+	ResponseWithdrawnEvent  EventType = "response_withdrawn"
+	ResumeDownloadedEvent   EventType = "resume_downloaded"
+	VacancyUpdatedEvent     EventType = "vacancy_updated"
+	ContactRequestedEvent   EventType = "contact_requested"
+	InvitationCreatedEvent  EventType = "invitation_created"
+	InvitationAnsweredEvent EventType = "invitation_answered"
+	MessageEditedEvent      EventType = "message_edited"
+	MessageDeletedEvent     EventType = "message_deleted"
+)
+
+// OutboxEvent - доменное событие из outbox. Событие записывается в одной транзакции с изменением,
+// которое его породило, поэтому не теряется при падении между изменением и рассылкой
+type OutboxEvent struct {
+	ID   int
+	Type EventType
+	// Notification - уведомление, которое создается по событию. У событий без уведомления пустое
+	Notification *Notification
+	// Objects - id объектов, которых касается событие, например vacancy_id и applicant_id. По ним получатели
+	// находят изменившиеся данные, в том числе у событий без уведомления. message_id указывает на уже
+	// сохраненное сообщение чата, которое нужно разослать участникам
+	Objects map[string]int
+	// DeliveredSinks - получатели, уже обработавшие событие. При повторной доставке они пропускаются
+	DeliveredSinks []string
+	Attempts       int
+	CreatedAt      time.Time
+}
+
+// Delivered сообщает, обработал ли событие получатель sink
+func (e *OutboxEvent) Delivered(sink string) bool {
+	for _, delivered := range e.DeliveredSinks {
+		if delivered == sink {
+			return true
+		}
+	}
+	return false
+}
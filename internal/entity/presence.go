@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Presence - сетевой статус пользователя. LastSeen заполнен, только если пользователь уже отключался
+type Presence struct {
+	UserID   int
+	Role     UserRole
+	Online   bool
+	LastSeen *time.Time
+}
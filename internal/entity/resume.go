@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -25,20 +26,131 @@ var EducationTypeRu = map[EducationType]string{
 	PhD:              "Кандидат наук",
 }
 
+// EducationRank задает порядок уровней образования, основным в резюме считается самый высокий
+var EducationRank = map[EducationType]int{
+	SecondarySchool:  1,
+	IncompleteHigher: 2,
+	Higher:           3,
+	Bachelor:         3,
+	Master:           4,
+	PhD:              5,
+}
+
+type CourseKind string
+
+const (
+	CourseKindCourse      CourseKind = "course"
+	CourseKindCertificate CourseKind = "certificate"
+)
+
+var CourseKindRu = map[CourseKind]string{
+	CourseKindCourse:      "Курс",
+	CourseKindCertificate: "Сертификат",
+}
+
+// LanguageLevel - уровень владения языком по шкале CEFR
+type LanguageLevel string
+
+var LanguageLevelRu = map[LanguageLevel]string{
+	"A1":     "A1 — Начальный",
+	"A2":     "A2 — Элементарный",
+	"B1":     "B1 — Средний",
+	"B2":     "B2 — Средне-продвинутый",
+	"C1":     "C1 — Продвинутый",
+	"C2":     "C2 — В совершенстве",
+	"native": "Родной",
+}
+
+type Currency string
+
+const (
+	CurrencyRUB Currency = "rub"
+	CurrencyUSD Currency = "usd"
+	CurrencyEUR Currency = "eur"
+)
+
+var AllowedCurrencies = map[string]Currency{
+	"rub": CurrencyRUB,
+	"usd": CurrencyUSD,
+	"eur": CurrencyEUR,
+}
+
+var CurrencySymbols = map[Currency]string{
+	CurrencyRUB: "₽",
+	CurrencyUSD: "$",
+	CurrencyEUR: "€",
+}
+
+// Значения совпадают с перечислениями work_format_type, employment_type и schedule_type из вакансий
+var WorkFormatRu = map[string]string{
+	"office":    "Офис",
+	"hybrid":    "Гибрид",
+	"remote":    "Удаленно",
+	"traveling": "Разъездной",
+}
+
+var EmploymentTypeRu = map[string]string{
+	"full_time":  "Полная занятость",
+	"part_time":  "Частичная занятость",
+	"contract":   "Проектная работа",
+	"internship": "Стажировка",
+	"freelance":  "Фриланс",
+	"watch":      "Вахта",
+}
+
+var ScheduleRu = map[string]string{
+	"5/2":          "5/2",
+	"2/2":          "2/2",
+	"6/1":          "6/1",
+	"3/3":          "3/3",
+	"on_weekend":   "По выходным",
+	"by_agreement": "По договоренности",
+}
+
+type ResumeVisibility string
+
+const (
+	ResumeVisibilityPublic        ResumeVisibility = "public"
+	ResumeVisibilityEmployersOnly ResumeVisibility = "employers_only"
+	ResumeVisibilityLinkOnly      ResumeVisibility = "link_only"
+	ResumeVisibilityHidden        ResumeVisibility = "hidden"
+)
+
+var AllowedResumeVisibilities = map[string]ResumeVisibility{
+	"public":         ResumeVisibilityPublic,
+	"employers_only": ResumeVisibilityEmployersOnly,
+	"link_only":      ResumeVisibilityLinkOnly,
+	"hidden":         ResumeVisibilityHidden,
+}
+
 type Resume struct {
-	ID                        int              `json:"id"`
-	ApplicantID               int              `json:"applicant_id"`
-	AboutMe                   string           `json:"about_me,omitempty"`
-	SpecializationID          int              `json:"specialization_id,omitempty"`
-	Education                 EducationType    `json:"education,omitempty"`
-	EducationalInstitution    string           `json:"educational_institution,omitempty"`
-	GraduationYear            time.Time        `json:"graduation_year,omitempty"`
-	Profession                string           `json:"profession,omitempty"`
-	CreatedAt                 time.Time        `json:"created_at"`
-	UpdatedAt                 time.Time        `json:"updated_at"`
-	Skills                    []int            `json:"-"`
-	AdditionalSpecializations []int            `json:"-"`
-	WorkExperiences           []WorkExperience `json:"-"`
+	ID                        int               `json:"id"`
+	ApplicantID               int               `json:"applicant_id"`
+	AboutMe                   string            `json:"about_me,omitempty"`
+	SpecializationID          int               `json:"specialization_id,omitempty"`
+	Education                 EducationType     `json:"education,omitempty"`
+	EducationalInstitution    string            `json:"educational_institution,omitempty"`
+	GraduationYear            time.Time         `json:"graduation_year,omitempty"`
+	Profession                string            `json:"profession,omitempty"`
+	CreatedAt                 time.Time         `json:"created_at"`
+	UpdatedAt                 time.Time         `json:"updated_at"`
+	Visibility                ResumeVisibility  `json:"visibility"`
+	AccessToken               string            `json:"-"`
+	IsAnonymous               bool              `json:"is_anonymous"`
+	DesiredSalary             int               `json:"desired_salary,omitempty"`
+	SalaryCurrency            Currency          `json:"salary_currency,omitempty"`
+	ReadyToRelocate           bool              `json:"ready_to_relocate"`
+	ReadyForBusinessTrips     bool              `json:"ready_for_business_trips"`
+	WorkFormats               []string          `json:"work_formats"`
+	EmploymentTypes           []string          `json:"employment_types"`
+	Schedules                 []string          `json:"schedules"`
+	Skills                    []int             `json:"-"`
+	Cities                    []int             `json:"-"`
+	Educations                []ResumeEducation `json:"-"`
+	Courses                   []ResumeCourse    `json:"-"`
+	Languages                 []ResumeLanguage  `json:"-"`
+	AdditionalSpecializations []int             `json:"-"`
+	WorkExperiences           []WorkExperience  `json:"-"`
 }
 
 type WorkExperience struct {
@@ -54,6 +166,131 @@ type WorkExperience struct {
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+// ResumeEducation - одно из мест учебы соискателя
+type ResumeEducation struct {
+	ID             int           `json:"id"`
+	ResumeID       int           `json:"resume_id"`
+	Level          EducationType `json:"level"`
+	Institution    string        `json:"institution"`
+	Faculty        string        `json:"faculty,omitempty"`
+	Specialty      string        `json:"specialty,omitempty"`
+	GraduationYear int           `json:"graduation_year"`
+}
+
+// ResumeCourse - пройденный курс или полученный сертификат, FileID указывает на скан в статике
+type ResumeCourse struct {
+	ID           int        `json:"id"`
+	ResumeID     int        `json:"resume_id"`
+	Kind         CourseKind `json:"kind"`
+	Name         string     `json:"name"`
+	Organization string     `json:"organization,omitempty"`
+	Year         int        `json:"year,omitempty"`
+	FileID       int        `json:"file_id,omitempty"`
+}
+
+type ResumeLanguage struct {
+	ResumeID int           `json:"resume_id"`
+	Language string        `json:"language"`
+	Level    LanguageLevel `json:"level"`
+}
+
+// BlockedEmployer - работодатель, которому соискатель запретил просматривать свои резюме
+type BlockedEmployer struct {
+	ApplicantID int       `json:"applicant_id"`
+	EmployerID  int       `json:"employer_id"`
+	CompanyName string    `json:"company_name"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ContactRequestStatus string
+
+const (
+	ContactRequestPending  ContactRequestStatus = "pending"
+	ContactRequestAccepted ContactRequestStatus = "accepted"
+	ContactRequestDeclined ContactRequestStatus = "declined"
+)
+
+// ResumeFilter - дополнительные условия поиска резюме работодателем, пустые поля не учитываются
+type ResumeFilter struct {
+	SalaryFrom            int
+	SalaryTo              int
+	Currency              Currency
+	City                  string
+	ReadyToRelocate       bool
+	ReadyForBusinessTrips bool
+	WorkFormats           []string
+	EmploymentTypes       []string
+	Schedules             []string
+}
+
+// ResumeSearchParams - параметры расширенного поиска резюме работодателем.
+// Опыт работы задается в полных годах и считается по всем местам работы из резюме
+type ResumeSearchParams struct {
+	ResumeFilter
+	Query              string
+	Profession         string
+	SkillsAll          []string
+	SkillsAny          []string
+	Specializations    []string
+	ExperienceFrom     int
+	ExperienceTo       int
+	Education          []EducationType
+	GraduationYearFrom int
+	GraduationYearTo   int
+	ApplicantStatuses  []ApplicantStatus
+	UpdatedFrom        time.Time
+	Language           string
+	LanguageLevel      LanguageLevel
+	Course             string
+}
+
+func (p *ResumeSearchParams) Validate() error {
+	if p.ExperienceFrom < 0 || p.ExperienceTo < 0 ||
+		(p.ExperienceTo > 0 && p.ExperienceFrom > p.ExperienceTo) {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректный диапазон опыта работы"),
+		)
+	}
+
+	if p.GraduationYearFrom < 0 || p.GraduationYearTo < 0 ||
+		(p.GraduationYearTo > 0 && p.GraduationYearFrom > p.GraduationYearTo) {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректный диапазон года окончания учебы"),
+		)
+	}
+
+	if p.LanguageLevel != "" {
+		if _, ok := LanguageLevelRu[p.LanguageLevel]; !ok || p.Language == "" {
+			return NewError(
+				ErrBadRequest,
+				fmt.Errorf("уровень владения указывается вместе с языком"),
+			)
+		}
+	}
+
+	if p.SalaryTo > 0 && p.SalaryFrom > p.SalaryTo {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректный диапазон зарплаты"),
+		)
+	}
+
+	return nil
+}
+
+// ContactRequest - запрос работодателя на раскрытие контактов анонимного соискателя
+type ContactRequest struct {
+	ID          int                  `json:"id"`
+	EmployerID  int                  `json:"employer_id"`
+	ApplicantID int                  `json:"applicant_id"`
+	ResumeID    int                  `json:"resume_id"`
+	Status      ContactRequestStatus `json:"status"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
 func (r *Resume) Validate() error {
 	if r.ApplicantID <= 0 {
 		return NewError(
@@ -69,6 +306,128 @@ func (r *Resume) Validate() error {
 		)
 	}
 
+	if r.DesiredSalary < 0 {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректная желаемая зарплата"),
+		)
+	}
+
+	if _, ok := AllowedCurrencies[string(r.SalaryCurrency)]; !ok {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректная валюта: %s", r.SalaryCurrency),
+		)
+	}
+
+	for _, format := range r.WorkFormats {
+		if _, ok := WorkFormatRu[format]; !ok {
+			return NewError(
+				ErrBadRequest,
+				fmt.Errorf("некорректный формат работы: %s", format),
+			)
+		}
+	}
+
+	for _, employment := range r.EmploymentTypes {
+		if _, ok := EmploymentTypeRu[employment]; !ok {
+			return NewError(
+				ErrBadRequest,
+				fmt.Errorf("некорректный тип занятости: %s", employment),
+			)
+		}
+	}
+
+	for _, schedule := range r.Schedules {
+		if _, ok := ScheduleRu[schedule]; !ok {
+			return NewError(
+				ErrBadRequest,
+				fmt.Errorf("некорректный график работы: %s", schedule),
+			)
+		}
+	}
+
+	for i := range r.Educations {
+		if err := r.Educations[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	for i := range r.Courses {
+		if err := r.Courses[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	languages := make(map[string]struct{}, len(r.Languages))
+	for i := range r.Languages {
+		if err := r.Languages[i].Validate(); err != nil {
+			return err
+		}
+		key := strings.ToLower(r.Languages[i].Language)
+		if _, ok := languages[key]; ok {
+			return NewError(
+				ErrBadRequest,
+				fmt.Errorf("язык %s указан несколько раз", r.Languages[i].Language),
+			)
+		}
+		languages[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (e *ResumeEducation) Validate() error {
+	if _, ok := EducationTypeRu[e.Level]; !ok {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректный уровень образования: %s", e.Level),
+		)
+	}
+
+	if e.Institution == "" {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("не указано учебное заведение"),
+		)
+	}
+
+	return nil
+}
+
+func (c *ResumeCourse) Validate() error {
+	if _, ok := CourseKindRu[c.Kind]; !ok {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректный тип курса: %s", c.Kind),
+		)
+	}
+
+	if c.Name == "" {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("не указано название курса"),
+		)
+	}
+
+	return nil
+}
+
+func (l *ResumeLanguage) Validate() error {
+	if l.Language == "" {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("не указан язык"),
+		)
+	}
+
+	if _, ok := LanguageLevelRu[l.Level]; !ok {
+		return NewError(
+			ErrBadRequest,
+			fmt.Errorf("некорректный уровень владения языком: %s", l.Level),
+		)
+	}
+
 	return nil
 }
 
@@ -83,6 +442,14 @@ func (w *WorkExperience) Validate() error {
 	return nil
 }
 
+// GetVisibility возвращает видимость резюме, пустое значение считается публичным
+func (r *Resume) GetVisibility() ResumeVisibility {
+	if r.Visibility == "" {
+		return ResumeVisibilityPublic
+	}
+	return r.Visibility
+}
+
 func GetEducationTypeRu(educationType EducationType) string {
 	return EducationTypeRu[educationType]
 }
@@ -9,3 +9,9 @@ type Static struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// StaticContent - содержимое файла из закрытого бакета, которое отдается через приложение после проверки доступа
+type StaticContent struct {
+	Data        []byte
+	ContentType string
+}
@@ -17,6 +17,8 @@ var (
 	AuthServiceCallCounter    *prometheus.CounterVec
 	StaticServiceCallCounter  *prometheus.CounterVec
 	LayerErrorCounter         *prometheus.CounterVec
+	WebsocketConnections      *prometheus.GaugeVec
+	WebsocketSlowConsumers    *prometheus.CounterVec
 )
 
 func Init(namespace string) {
@@ -105,6 +107,24 @@ func Init(namespace string) {
 		[]string{"layer", "method"},
 	)
 
+	WebsocketConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "websocket_connections",
+			Help:      "Number of active websocket connections",
+		},
+		[]string{"role"},
+	)
+
+	WebsocketSlowConsumers = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "websocket_slow_consumers_total",
+			Help:      "Number of websocket connections closed because the client could not keep up",
+		},
+		[]string{"role"},
+	)
+
 	prometheus.MustRegister(
 		RequestCounter,
 		RequestDuration,
@@ -115,6 +135,8 @@ func Init(namespace string) {
 		AuthServiceCallCounter,
 		StaticServiceCallCounter,
 		LayerErrorCounter,
+		WebsocketConnections,
+		WebsocketSlowConsumers,
 	)
 
 }
@@ -10,6 +10,8 @@ import (
 	"time"
 )
 
+const unsubscribePath = "/api/v1/notification/unsubscribe"
+
 func generateToken(r *http.Request, sessionID string, cfg config.CSRFConfig) string {
 	h := hmac.New(sha256.New, []byte(cfg.Secret))
 	if sessionID != "" {
@@ -49,6 +51,12 @@ func CSRFMiddleware(cfg config.CSRFConfig) func(http.Handler) http.Handler {
 			// Проверяем, является ли это запрос статики
 			isStatic := strings.HasPrefix(r.URL.Path, "/api/v1/static/")
 
+			// Отписка в один клик приходит от почтового клиента без cookie и защищена подписью в ссылке
+			if r.Method == http.MethodPost && r.URL.Path == unsubscribePath {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			// Исключаем безопасные методы
 			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
 				if !isStatic {
@@ -142,6 +142,20 @@ func TestCSRFMiddleware(t *testing.T) {
 		require.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("One-click unsubscribe without token", func(t *testing.T) {
+		t.Parallel()
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/notification/unsubscribe?token=abc", nil)
+
+		middleware := CSRFMiddleware(cfg)
+		handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+
 	t.Run("Unsafe methods - invalid cases", func(t *testing.T) {
 		t.Parallel()
 		testCases := []struct {
@@ -87,4 +87,13 @@ func TestResponseRecorder(t *testing.T) {
 		require.Equal(t, len(data), size)
 		require.Equal(t, len(data), writer.size)
 	})
+
+	t.Run("Flush reaches underlying writer", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		writer := &hijackableResponseWriter{ResponseWriter: rec}
+
+		require.NoError(t, http.NewResponseController(writer).Flush())
+		require.True(t, rec.Flushed)
+	})
 }
@@ -30,3 +30,15 @@ func (h *hijackableResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error)
 	}
 	return nil, nil, fmt.Errorf("response writer does not implement http.Hijacker")
 }
+
+// Flush нужен потоку событий: без него ответ копится в буфере до конца запроса
+func (h *hijackableResponseWriter) Flush() {
+	if flusher, ok := h.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap позволяет http.ResponseController снять таймаут записи для долгих ответов
+func (h *hijackableResponseWriter) Unwrap() http.ResponseWriter {
+	return h.ResponseWriter
+}
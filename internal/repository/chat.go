@@ -10,5 +10,13 @@ type ChatRepository interface {
 	GetChatByID(ctx context.Context, chatID int) (*entity.Chat, error)
 	GetForUser(ctx context.Context, userID int, isApplicant bool) ([]*entity.Chat, error)
 	GetForVacancy(ctx context.Context, vacancyID, applicantID int) (*entity.Chat, error)
+	GetForResume(ctx context.Context, employerID, resumeID int) ([]*entity.Chat, error)
 	GetVacancyChatInfo(ctx context.Context, vacancyID, applicantID int) (*entity.VacancyChatInfo, error)
+	GetSettings(ctx context.Context, chatID int, isApplicant bool) (*entity.ChatSettings, error)
+	UpdateSettings(ctx context.Context, settings *entity.ChatSettings) (*entity.ChatSettings, error)
+	MarkRead(ctx context.Context, chatID int, isApplicant bool, messageID int) (int, error)
+	GetUnreadCount(ctx context.Context, userID int, isApplicant bool) (*entity.UnreadCount, error)
+	CreateInvitation(ctx context.Context, invitation *entity.Invitation, text string, event *entity.OutboxEvent) (*entity.Invitation, *entity.Message, error)
+	GetInvitationByID(ctx context.Context, id int) (*entity.Invitation, error)
+	AnswerInvitation(ctx context.Context, id int, status entity.InvitationStatus, systemEvent entity.SystemEvent, text string, event *entity.OutboxEvent) (*entity.Invitation, *entity.Message, error)
 }
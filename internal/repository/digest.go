@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"time"
+)
+
+type DigestRepository interface {
+	GetPendingDigests(ctx context.Context, unreadBefore time.Time) ([]*entity.Digest, error)
+	MarkDigestSent(ctx context.Context, userID int, role entity.UserRole, lastMessageID int) error
+	Unsubscribe(ctx context.Context, userID int, role entity.UserRole) error
+}
@@ -6,6 +6,17 @@ import (
 )
 
 type MessageRepository interface {
-	CreateMessage(ctx context.Context, chatID, senderID int, fromApplicant bool, payload string) (*entity.Message, error)
-	GetMessagesForChat(ctx context.Context, chatID int) ([]*entity.Message, error)
+	CreateMessage(ctx context.Context, chatID, senderID int, fromApplicant bool, payload, clientID string, attachmentIDs []int) (*entity.Message, error)
+	CreateSystemMessage(ctx context.Context, chatID int, event entity.SystemEvent, payload string) (*entity.Message, error)
+	CreateEventMessage(ctx context.Context, chatID, outboxEventID int, event entity.SystemEvent, payload string) (*entity.Message, error)
+	CreateAttachment(ctx context.Context, attachment *entity.Attachment) (*entity.Attachment, error)
+	GetAttachment(ctx context.Context, attachmentID int) (*entity.Attachment, error)
+	GetMessage(ctx context.Context, messageID int) (*entity.Message, error)
+	EditMessage(ctx context.Context, messageID int, payload string, event *entity.OutboxEvent) (*entity.Message, error)
+	DeleteMessage(ctx context.Context, messageID int, event *entity.OutboxEvent) (*entity.Message, error)
+	GetMessageEdits(ctx context.Context, messageID int) ([]*entity.MessageEdit, error)
+	GetMessagesForChat(ctx context.Context, chatID int, page entity.MessagePage) ([]*entity.Message, error)
+	GetMessagesForUserAfter(ctx context.Context, userID int, isApplicant bool, afterID, limit int) ([]*entity.MessageWithChat, error)
+	GetLastMessageIDForUser(ctx context.Context, userID int, isApplicant bool) (int, error)
+	SearchMessages(ctx context.Context, userID int, isApplicant bool, query string, limit, offset int) ([]*entity.MessageWithChat, error)
 }
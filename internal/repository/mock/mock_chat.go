@@ -41,6 +41,22 @@ func (m *MockChatRepository) EXPECT() *MockChatRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AnswerInvitation mocks base method.
+func (m *MockChatRepository) AnswerInvitation(ctx context.Context, id int, status entity.InvitationStatus, systemEvent entity.SystemEvent, text string, event *entity.OutboxEvent) (*entity.Invitation, *entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerInvitation", ctx, id, status, systemEvent, text, event)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(*entity.Message)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AnswerInvitation indicates an expected call of AnswerInvitation.
+func (mr *MockChatRepositoryMockRecorder) AnswerInvitation(ctx, id, status, systemEvent, text, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerInvitation", reflect.TypeOf((*MockChatRepository)(nil).AnswerInvitation), ctx, id, status, systemEvent, text, event)
+}
+
 // CreateChat mocks base method.
 func (m *MockChatRepository) CreateChat(ctx context.Context, vacancyID, resumeID, employerID, applicantID int) (*entity.Chat, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +72,22 @@ func (mr *MockChatRepositoryMockRecorder) CreateChat(ctx, vacancyID, resumeID, e
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatRepository)(nil).CreateChat), ctx, vacancyID, resumeID, employerID, applicantID)
 }
 
+// CreateInvitation mocks base method.
+func (m *MockChatRepository) CreateInvitation(ctx context.Context, invitation *entity.Invitation, text string, event *entity.OutboxEvent) (*entity.Invitation, *entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, invitation, text, event)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(*entity.Message)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockChatRepositoryMockRecorder) CreateInvitation(ctx, invitation, text, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockChatRepository)(nil).CreateInvitation), ctx, invitation, text, event)
+}
+
 // GetChatByID mocks base method.
 func (m *MockChatRepository) GetChatByID(ctx context.Context, chatID int) (*entity.Chat, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +103,21 @@ func (mr *MockChatRepositoryMockRecorder) GetChatByID(ctx, chatID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatByID", reflect.TypeOf((*MockChatRepository)(nil).GetChatByID), ctx, chatID)
 }
 
+// GetForResume mocks base method.
+func (m *MockChatRepository) GetForResume(ctx context.Context, employerID, resumeID int) ([]*entity.Chat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForResume", ctx, employerID, resumeID)
+	ret0, _ := ret[0].([]*entity.Chat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForResume indicates an expected call of GetForResume.
+func (mr *MockChatRepositoryMockRecorder) GetForResume(ctx, employerID, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForResume", reflect.TypeOf((*MockChatRepository)(nil).GetForResume), ctx, employerID, resumeID)
+}
+
 // GetForUser mocks base method.
 func (m *MockChatRepository) GetForUser(ctx context.Context, userID int, isApplicant bool) ([]*entity.Chat, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +148,51 @@ func (mr *MockChatRepositoryMockRecorder) GetForVacancy(ctx, vacancyID, applican
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForVacancy", reflect.TypeOf((*MockChatRepository)(nil).GetForVacancy), ctx, vacancyID, applicantID)
 }
 
+// GetInvitationByID mocks base method.
+func (m *MockChatRepository) GetInvitationByID(ctx context.Context, id int) (*entity.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitationByID indicates an expected call of GetInvitationByID.
+func (mr *MockChatRepositoryMockRecorder) GetInvitationByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationByID", reflect.TypeOf((*MockChatRepository)(nil).GetInvitationByID), ctx, id)
+}
+
+// GetSettings mocks base method.
+func (m *MockChatRepository) GetSettings(ctx context.Context, chatID int, isApplicant bool) (*entity.ChatSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, chatID, isApplicant)
+	ret0, _ := ret[0].(*entity.ChatSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MockChatRepositoryMockRecorder) GetSettings(ctx, chatID, isApplicant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MockChatRepository)(nil).GetSettings), ctx, chatID, isApplicant)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockChatRepository) GetUnreadCount(ctx context.Context, userID int, isApplicant bool) (*entity.UnreadCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID, isApplicant)
+	ret0, _ := ret[0].(*entity.UnreadCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockChatRepositoryMockRecorder) GetUnreadCount(ctx, userID, isApplicant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockChatRepository)(nil).GetUnreadCount), ctx, userID, isApplicant)
+}
+
 // GetVacancyChatInfo mocks base method.
 func (m *MockChatRepository) GetVacancyChatInfo(ctx context.Context, vacancyID, applicantID int) (*entity.VacancyChatInfo, error) {
 	m.ctrl.T.Helper()
@@ -115,3 +207,33 @@ func (mr *MockChatRepositoryMockRecorder) GetVacancyChatInfo(ctx, vacancyID, app
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVacancyChatInfo", reflect.TypeOf((*MockChatRepository)(nil).GetVacancyChatInfo), ctx, vacancyID, applicantID)
 }
+
+// MarkRead mocks base method.
+func (m *MockChatRepository) MarkRead(ctx context.Context, chatID int, isApplicant bool, messageID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, chatID, isApplicant, messageID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatRepositoryMockRecorder) MarkRead(ctx, chatID, isApplicant, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatRepository)(nil).MarkRead), ctx, chatID, isApplicant, messageID)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockChatRepository) UpdateSettings(ctx context.Context, settings *entity.ChatSettings) (*entity.ChatSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, settings)
+	ret0, _ := ret[0].(*entity.ChatSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockChatRepositoryMockRecorder) UpdateSettings(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockChatRepository)(nil).UpdateSettings), ctx, settings)
+}
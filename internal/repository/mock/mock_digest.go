@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ResuMatch/internal/repository (interfaces: DigestRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/repository/mock/mock_digest.go ResuMatch/internal/repository DigestRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	entity "ResuMatch/internal/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDigestRepository is a mock of DigestRepository interface.
+type MockDigestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestRepositoryMockRecorder is the mock recorder for MockDigestRepository.
+type MockDigestRepositoryMockRecorder struct {
+	mock *MockDigestRepository
+}
+
+// NewMockDigestRepository creates a new mock instance.
+func NewMockDigestRepository(ctrl *gomock.Controller) *MockDigestRepository {
+	mock := &MockDigestRepository{ctrl: ctrl}
+	mock.recorder = &MockDigestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestRepository) EXPECT() *MockDigestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPendingDigests mocks base method.
+func (m *MockDigestRepository) GetPendingDigests(ctx context.Context, unreadBefore time.Time) ([]*entity.Digest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDigests", ctx, unreadBefore)
+	ret0, _ := ret[0].([]*entity.Digest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDigests indicates an expected call of GetPendingDigests.
+func (mr *MockDigestRepositoryMockRecorder) GetPendingDigests(ctx, unreadBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDigests", reflect.TypeOf((*MockDigestRepository)(nil).GetPendingDigests), ctx, unreadBefore)
+}
+
+// MarkDigestSent mocks base method.
+func (m *MockDigestRepository) MarkDigestSent(ctx context.Context, userID int, role entity.UserRole, lastMessageID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDigestSent", ctx, userID, role, lastMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDigestSent indicates an expected call of MarkDigestSent.
+func (mr *MockDigestRepositoryMockRecorder) MarkDigestSent(ctx, userID, role, lastMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDigestSent", reflect.TypeOf((*MockDigestRepository)(nil).MarkDigestSent), ctx, userID, role, lastMessageID)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockDigestRepository) Unsubscribe(ctx context.Context, userID int, role entity.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockDigestRepositoryMockRecorder) Unsubscribe(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockDigestRepository)(nil).Unsubscribe), ctx, userID, role)
+}
@@ -41,32 +41,197 @@ func (m *MockMessageRepository) EXPECT() *MockMessageRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateAttachment mocks base method.
+func (m *MockMessageRepository) CreateAttachment(ctx context.Context, attachment *entity.Attachment) (*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, attachment)
+	ret0, _ := ret[0].(*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockMessageRepositoryMockRecorder) CreateAttachment(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockMessageRepository)(nil).CreateAttachment), ctx, attachment)
+}
+
+// CreateEventMessage mocks base method.
+func (m *MockMessageRepository) CreateEventMessage(ctx context.Context, chatID, outboxEventID int, event entity.SystemEvent, payload string) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventMessage", ctx, chatID, outboxEventID, event, payload)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventMessage indicates an expected call of CreateEventMessage.
+func (mr *MockMessageRepositoryMockRecorder) CreateEventMessage(ctx, chatID, outboxEventID, event, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateEventMessage), ctx, chatID, outboxEventID, event, payload)
+}
+
 // CreateMessage mocks base method.
-func (m *MockMessageRepository) CreateMessage(ctx context.Context, chatID, senderID int, fromApplicant bool, payload string) (*entity.Message, error) {
+func (m *MockMessageRepository) CreateMessage(ctx context.Context, chatID, senderID int, fromApplicant bool, payload, clientID string, attachmentIDs []int) (*entity.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMessage", ctx, chatID, senderID, fromApplicant, payload)
+	ret := m.ctrl.Call(m, "CreateMessage", ctx, chatID, senderID, fromApplicant, payload, clientID, attachmentIDs)
 	ret0, _ := ret[0].(*entity.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateMessage indicates an expected call of CreateMessage.
-func (mr *MockMessageRepositoryMockRecorder) CreateMessage(ctx, chatID, senderID, fromApplicant, payload any) *gomock.Call {
+func (mr *MockMessageRepositoryMockRecorder) CreateMessage(ctx, chatID, senderID, fromApplicant, payload, clientID, attachmentIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessage), ctx, chatID, senderID, fromApplicant, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessage), ctx, chatID, senderID, fromApplicant, payload, clientID, attachmentIDs)
+}
+
+// CreateSystemMessage mocks base method.
+func (m *MockMessageRepository) CreateSystemMessage(ctx context.Context, chatID int, event entity.SystemEvent, payload string) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSystemMessage", ctx, chatID, event, payload)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSystemMessage indicates an expected call of CreateSystemMessage.
+func (mr *MockMessageRepositoryMockRecorder) CreateSystemMessage(ctx, chatID, event, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystemMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateSystemMessage), ctx, chatID, event, payload)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockMessageRepository) DeleteMessage(ctx context.Context, messageID int, event *entity.OutboxEvent) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageID, event)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockMessageRepositoryMockRecorder) DeleteMessage(ctx, messageID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageRepository)(nil).DeleteMessage), ctx, messageID, event)
+}
+
+// EditMessage mocks base method.
+func (m *MockMessageRepository) EditMessage(ctx context.Context, messageID int, payload string, event *entity.OutboxEvent) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", ctx, messageID, payload, event)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockMessageRepositoryMockRecorder) EditMessage(ctx, messageID, payload, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockMessageRepository)(nil).EditMessage), ctx, messageID, payload, event)
+}
+
+// GetAttachment mocks base method.
+func (m *MockMessageRepository) GetAttachment(ctx context.Context, attachmentID int) (*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockMessageRepositoryMockRecorder) GetAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockMessageRepository)(nil).GetAttachment), ctx, attachmentID)
+}
+
+// GetLastMessageIDForUser mocks base method.
+func (m *MockMessageRepository) GetLastMessageIDForUser(ctx context.Context, userID int, isApplicant bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessageIDForUser", ctx, userID, isApplicant)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessageIDForUser indicates an expected call of GetLastMessageIDForUser.
+func (mr *MockMessageRepositoryMockRecorder) GetLastMessageIDForUser(ctx, userID, isApplicant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessageIDForUser", reflect.TypeOf((*MockMessageRepository)(nil).GetLastMessageIDForUser), ctx, userID, isApplicant)
+}
+
+// GetMessage mocks base method.
+func (m *MockMessageRepository) GetMessage(ctx context.Context, messageID int) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", ctx, messageID)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockMessageRepositoryMockRecorder) GetMessage(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockMessageRepository)(nil).GetMessage), ctx, messageID)
+}
+
+// GetMessageEdits mocks base method.
+func (m *MockMessageRepository) GetMessageEdits(ctx context.Context, messageID int) ([]*entity.MessageEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageEdits", ctx, messageID)
+	ret0, _ := ret[0].([]*entity.MessageEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageEdits indicates an expected call of GetMessageEdits.
+func (mr *MockMessageRepositoryMockRecorder) GetMessageEdits(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageEdits", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageEdits), ctx, messageID)
 }
 
 // GetMessagesForChat mocks base method.
-func (m *MockMessageRepository) GetMessagesForChat(ctx context.Context, chatID int) ([]*entity.Message, error) {
+func (m *MockMessageRepository) GetMessagesForChat(ctx context.Context, chatID int, page entity.MessagePage) ([]*entity.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMessagesForChat", ctx, chatID)
+	ret := m.ctrl.Call(m, "GetMessagesForChat", ctx, chatID, page)
 	ret0, _ := ret[0].([]*entity.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMessagesForChat indicates an expected call of GetMessagesForChat.
-func (mr *MockMessageRepositoryMockRecorder) GetMessagesForChat(ctx, chatID any) *gomock.Call {
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesForChat(ctx, chatID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChat", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesForChat), ctx, chatID, page)
+}
+
+// GetMessagesForUserAfter mocks base method.
+func (m *MockMessageRepository) GetMessagesForUserAfter(ctx context.Context, userID int, isApplicant bool, afterID, limit int) ([]*entity.MessageWithChat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesForUserAfter", ctx, userID, isApplicant, afterID, limit)
+	ret0, _ := ret[0].([]*entity.MessageWithChat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesForUserAfter indicates an expected call of GetMessagesForUserAfter.
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesForUserAfter(ctx, userID, isApplicant, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForUserAfter", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesForUserAfter), ctx, userID, isApplicant, afterID, limit)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageRepository) SearchMessages(ctx context.Context, userID int, isApplicant bool, query string, limit, offset int) ([]*entity.MessageWithChat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userID, isApplicant, query, limit, offset)
+	ret0, _ := ret[0].([]*entity.MessageWithChat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageRepositoryMockRecorder) SearchMessages(ctx, userID, isApplicant, query, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChat", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesForChat), ctx, chatID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageRepository)(nil).SearchMessages), ctx, userID, isApplicant, query, limit, offset)
 }
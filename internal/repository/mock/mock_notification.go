@@ -41,6 +41,21 @@ func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRec
 	return m.recorder
 }
 
+// CountUnreadNotifications mocks base method.
+func (m *MockNotificationRepository) CountUnreadNotifications(ctx context.Context, userID int, role entity.UserRole) (map[entity.NotificationType]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", ctx, userID, role)
+	ret0, _ := ret[0].(map[entity.NotificationType]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications.
+func (mr *MockNotificationRepositoryMockRecorder) CountUnreadNotifications(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).CountUnreadNotifications), ctx, userID, role)
+}
+
 // CreateNotification mocks base method.
 func (m *MockNotificationRepository) CreateNotification(ctx context.Context, notification *entity.Notification) error {
 	m.ctrl.T.Helper()
@@ -69,64 +84,63 @@ func (mr *MockNotificationRepositoryMockRecorder) DeleteAllNotifications(ctx, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).DeleteAllNotifications), ctx, userID, role)
 }
 
-// GetApplyNotificationPreview mocks base method.
-func (m *MockNotificationRepository) GetApplyNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error) {
+// DeleteNotification mocks base method.
+func (m *MockNotificationRepository) DeleteNotification(ctx context.Context, notificationID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetApplyNotificationPreview", ctx, notificationID)
-	ret0, _ := ret[0].(*entity.NotificationPreview)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteNotification", ctx, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetApplyNotificationPreview indicates an expected call of GetApplyNotificationPreview.
-func (mr *MockNotificationRepositoryMockRecorder) GetApplyNotificationPreview(ctx, notificationID any) *gomock.Call {
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockNotificationRepositoryMockRecorder) DeleteNotification(ctx, notificationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplyNotificationPreview", reflect.TypeOf((*MockNotificationRepository)(nil).GetApplyNotificationPreview), ctx, notificationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockNotificationRepository)(nil).DeleteNotification), ctx, notificationID)
 }
 
-// GetApplyNotificationsForUser mocks base method.
-func (m *MockNotificationRepository) GetApplyNotificationsForUser(ctx context.Context, notificationID int) ([]*entity.NotificationPreview, error) {
+// GetEventPreview mocks base method.
+func (m *MockNotificationRepository) GetEventPreview(ctx context.Context, notification *entity.Notification) (*entity.NotificationPreview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetApplyNotificationsForUser", ctx, notificationID)
-	ret0, _ := ret[0].([]*entity.NotificationPreview)
+	ret := m.ctrl.Call(m, "GetEventPreview", ctx, notification)
+	ret0, _ := ret[0].(*entity.NotificationPreview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetApplyNotificationsForUser indicates an expected call of GetApplyNotificationsForUser.
-func (mr *MockNotificationRepositoryMockRecorder) GetApplyNotificationsForUser(ctx, notificationID any) *gomock.Call {
+// GetEventPreview indicates an expected call of GetEventPreview.
+func (mr *MockNotificationRepositoryMockRecorder) GetEventPreview(ctx, notification any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplyNotificationsForUser", reflect.TypeOf((*MockNotificationRepository)(nil).GetApplyNotificationsForUser), ctx, notificationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventPreview", reflect.TypeOf((*MockNotificationRepository)(nil).GetEventPreview), ctx, notification)
 }
 
-// GetDownloadResumeNotificationPreview mocks base method.
-func (m *MockNotificationRepository) GetDownloadResumeNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error) {
+// GetGroupNotifications mocks base method.
+func (m *MockNotificationRepository) GetGroupNotifications(ctx context.Context, groupID, limit, offset int) ([]*entity.NotificationPreview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDownloadResumeNotificationPreview", ctx, notificationID)
-	ret0, _ := ret[0].(*entity.NotificationPreview)
+	ret := m.ctrl.Call(m, "GetGroupNotifications", ctx, groupID, limit, offset)
+	ret0, _ := ret[0].([]*entity.NotificationPreview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDownloadResumeNotificationPreview indicates an expected call of GetDownloadResumeNotificationPreview.
-func (mr *MockNotificationRepositoryMockRecorder) GetDownloadResumeNotificationPreview(ctx, notificationID any) *gomock.Call {
+// GetGroupNotifications indicates an expected call of GetGroupNotifications.
+func (mr *MockNotificationRepositoryMockRecorder) GetGroupNotifications(ctx, groupID, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadResumeNotificationPreview", reflect.TypeOf((*MockNotificationRepository)(nil).GetDownloadResumeNotificationPreview), ctx, notificationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).GetGroupNotifications), ctx, groupID, limit, offset)
 }
 
-// GetDownloadResumeNotificationsForUser mocks base method.
-func (m *MockNotificationRepository) GetDownloadResumeNotificationsForUser(ctx context.Context, notificationID int) ([]*entity.NotificationPreview, error) {
+// GetLastNotificationID mocks base method.
+func (m *MockNotificationRepository) GetLastNotificationID(ctx context.Context, userID int, role entity.UserRole) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDownloadResumeNotificationsForUser", ctx, notificationID)
-	ret0, _ := ret[0].([]*entity.NotificationPreview)
+	ret := m.ctrl.Call(m, "GetLastNotificationID", ctx, userID, role)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDownloadResumeNotificationsForUser indicates an expected call of GetDownloadResumeNotificationsForUser.
-func (mr *MockNotificationRepositoryMockRecorder) GetDownloadResumeNotificationsForUser(ctx, notificationID any) *gomock.Call {
+// GetLastNotificationID indicates an expected call of GetLastNotificationID.
+func (mr *MockNotificationRepositoryMockRecorder) GetLastNotificationID(ctx, userID, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadResumeNotificationsForUser", reflect.TypeOf((*MockNotificationRepository)(nil).GetDownloadResumeNotificationsForUser), ctx, notificationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastNotificationID", reflect.TypeOf((*MockNotificationRepository)(nil).GetLastNotificationID), ctx, userID, role)
 }
 
 // GetNotificationByID mocks base method.
@@ -144,6 +158,36 @@ func (mr *MockNotificationRepositoryMockRecorder) GetNotificationByID(ctx, notif
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationByID", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationByID), ctx, notificationID)
 }
 
+// GetNotificationPreview mocks base method.
+func (m *MockNotificationRepository) GetNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreview", ctx, notificationID)
+	ret0, _ := ret[0].(*entity.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreview indicates an expected call of GetNotificationPreview.
+func (mr *MockNotificationRepositoryMockRecorder) GetNotificationPreview(ctx, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreview", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationPreview), ctx, notificationID)
+}
+
+// GetNotificationsForUser mocks base method.
+func (m *MockNotificationRepository) GetNotificationsForUser(ctx context.Context, userID int, role entity.UserRole, filter entity.NotificationFilter) ([]*entity.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsForUser", ctx, userID, role, filter)
+	ret0, _ := ret[0].([]*entity.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationsForUser indicates an expected call of GetNotificationsForUser.
+func (mr *MockNotificationRepositoryMockRecorder) GetNotificationsForUser(ctx, userID, role, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsForUser", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationsForUser), ctx, userID, role, filter)
+}
+
 // ReadAllNotifications mocks base method.
 func (m *MockNotificationRepository) ReadAllNotifications(ctx context.Context, userID int, role string) error {
 	m.ctrl.T.Helper()
@@ -171,3 +215,17 @@ func (mr *MockNotificationRepositoryMockRecorder) ReadNotification(ctx, notifica
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNotification", reflect.TypeOf((*MockNotificationRepository)(nil).ReadNotification), ctx, notificationID)
 }
+
+// ReadNotificationGroup mocks base method.
+func (m *MockNotificationRepository) ReadNotificationGroup(ctx context.Context, groupID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadNotificationGroup", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadNotificationGroup indicates an expected call of ReadNotificationGroup.
+func (mr *MockNotificationRepositoryMockRecorder) ReadNotificationGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNotificationGroup", reflect.TypeOf((*MockNotificationRepository)(nil).ReadNotificationGroup), ctx, groupID)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ResuMatch/internal/repository (interfaces: NotificationSettingsRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/repository/mock/mock_notification_settings.go ResuMatch/internal/repository NotificationSettingsRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	entity "ResuMatch/internal/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationSettingsRepository is a mock of NotificationSettingsRepository interface.
+type MockNotificationSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSettingsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationSettingsRepositoryMockRecorder is the mock recorder for MockNotificationSettingsRepository.
+type MockNotificationSettingsRepositoryMockRecorder struct {
+	mock *MockNotificationSettingsRepository
+}
+
+// NewMockNotificationSettingsRepository creates a new mock instance.
+func NewMockNotificationSettingsRepository(ctrl *gomock.Controller) *MockNotificationSettingsRepository {
+	mock := &MockNotificationSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationSettingsRepository) EXPECT() *MockNotificationSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetNotificationSettings mocks base method.
+func (m *MockNotificationSettingsRepository) GetNotificationSettings(ctx context.Context, userID int, role entity.UserRole) (*entity.NotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSettings", ctx, userID, role)
+	ret0, _ := ret[0].(*entity.NotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationSettings indicates an expected call of GetNotificationSettings.
+func (mr *MockNotificationSettingsRepositoryMockRecorder) GetNotificationSettings(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSettings", reflect.TypeOf((*MockNotificationSettingsRepository)(nil).GetNotificationSettings), ctx, userID, role)
+}
+
+// UpdateNotificationSettings mocks base method.
+func (m *MockNotificationSettingsRepository) UpdateNotificationSettings(ctx context.Context, settings *entity.NotificationSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSettings", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotificationSettings indicates an expected call of UpdateNotificationSettings.
+func (mr *MockNotificationSettingsRepositoryMockRecorder) UpdateNotificationSettings(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSettings", reflect.TypeOf((*MockNotificationSettingsRepository)(nil).UpdateNotificationSettings), ctx, settings)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ResuMatch/internal/repository (interfaces: OutboxRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/repository/mock/mock_outbox.go ResuMatch/internal/repository OutboxRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	entity "ResuMatch/internal/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddEvent mocks base method.
+func (m *MockOutboxRepository) AddEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEvent indicates an expected call of AddEvent.
+func (mr *MockOutboxRepositoryMockRecorder) AddEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockOutboxRepository)(nil).AddEvent), ctx, event)
+}
+
+// ClaimEvents mocks base method.
+func (m *MockOutboxRepository) ClaimEvents(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimEvents", ctx, limit, maxAttempts, lease)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimEvents indicates an expected call of ClaimEvents.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimEvents(ctx, limit, maxAttempts, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimEvents", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimEvents), ctx, limit, maxAttempts, lease)
+}
+
+// MarkEventDelivered mocks base method.
+func (m *MockOutboxRepository) MarkEventDelivered(ctx context.Context, eventID int, sinks []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEventDelivered", ctx, eventID, sinks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEventDelivered indicates an expected call of MarkEventDelivered.
+func (mr *MockOutboxRepositoryMockRecorder) MarkEventDelivered(ctx, eventID, sinks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEventDelivered", reflect.TypeOf((*MockOutboxRepository)(nil).MarkEventDelivered), ctx, eventID, sinks)
+}
+
+// MarkEventFailed mocks base method.
+func (m *MockOutboxRepository) MarkEventFailed(ctx context.Context, eventID int, sinks []string, retryAt time.Time, deliveryErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEventFailed", ctx, eventID, sinks, retryAt, deliveryErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEventFailed indicates an expected call of MarkEventFailed.
+func (mr *MockOutboxRepositoryMockRecorder) MarkEventFailed(ctx, eventID, sinks, retryAt, deliveryErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEventFailed", reflect.TypeOf((*MockOutboxRepository)(nil).MarkEventFailed), ctx, eventID, sinks, retryAt, deliveryErr)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ResuMatch/internal/repository (interfaces: PresenceRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/repository/mock/mock_presence.go ResuMatch/internal/repository PresenceRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	entity "ResuMatch/internal/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPresenceRepository is a mock of PresenceRepository interface.
+type MockPresenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresenceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPresenceRepositoryMockRecorder is the mock recorder for MockPresenceRepository.
+type MockPresenceRepositoryMockRecorder struct {
+	mock *MockPresenceRepository
+}
+
+// NewMockPresenceRepository creates a new mock instance.
+func NewMockPresenceRepository(ctrl *gomock.Controller) *MockPresenceRepository {
+	mock := &MockPresenceRepository{ctrl: ctrl}
+	mock.recorder = &MockPresenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresenceRepository) EXPECT() *MockPresenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Connect mocks base method.
+func (m *MockPresenceRepository) Connect(ctx context.Context, userID int, role, connID string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", ctx, userID, role, connID, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockPresenceRepositoryMockRecorder) Connect(ctx, userID, role, connID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockPresenceRepository)(nil).Connect), ctx, userID, role, connID, ttl)
+}
+
+// Disconnect mocks base method.
+func (m *MockPresenceRepository) Disconnect(ctx context.Context, userID int, role, connID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disconnect", ctx, userID, role, connID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Disconnect indicates an expected call of Disconnect.
+func (mr *MockPresenceRepositoryMockRecorder) Disconnect(ctx, userID, role, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockPresenceRepository)(nil).Disconnect), ctx, userID, role, connID)
+}
+
+// GetPresence mocks base method.
+func (m *MockPresenceRepository) GetPresence(ctx context.Context, userID int, role string) (*entity.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", ctx, userID, role)
+	ret0, _ := ret[0].(*entity.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockPresenceRepositoryMockRecorder) GetPresence(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockPresenceRepository)(nil).GetPresence), ctx, userID, role)
+}
@@ -41,6 +41,62 @@ func (m *MockResumeRepository) EXPECT() *MockResumeRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddCities mocks base method.
+func (m *MockResumeRepository) AddCities(ctx context.Context, resumeID int, cityIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCities", ctx, resumeID, cityIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCities indicates an expected call of AddCities.
+func (mr *MockResumeRepositoryMockRecorder) AddCities(ctx, resumeID, cityIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCities", reflect.TypeOf((*MockResumeRepository)(nil).AddCities), ctx, resumeID, cityIDs)
+}
+
+// AddCourses mocks base method.
+func (m *MockResumeRepository) AddCourses(ctx context.Context, resumeID int, courses []entity.ResumeCourse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCourses", ctx, resumeID, courses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCourses indicates an expected call of AddCourses.
+func (mr *MockResumeRepositoryMockRecorder) AddCourses(ctx, resumeID, courses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCourses", reflect.TypeOf((*MockResumeRepository)(nil).AddCourses), ctx, resumeID, courses)
+}
+
+// AddEducations mocks base method.
+func (m *MockResumeRepository) AddEducations(ctx context.Context, resumeID int, educations []entity.ResumeEducation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEducations", ctx, resumeID, educations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEducations indicates an expected call of AddEducations.
+func (mr *MockResumeRepositoryMockRecorder) AddEducations(ctx, resumeID, educations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEducations", reflect.TypeOf((*MockResumeRepository)(nil).AddEducations), ctx, resumeID, educations)
+}
+
+// AddLanguages mocks base method.
+func (m *MockResumeRepository) AddLanguages(ctx context.Context, resumeID int, languages []entity.ResumeLanguage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLanguages", ctx, resumeID, languages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLanguages indicates an expected call of AddLanguages.
+func (mr *MockResumeRepositoryMockRecorder) AddLanguages(ctx, resumeID, languages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLanguages", reflect.TypeOf((*MockResumeRepository)(nil).AddLanguages), ctx, resumeID, languages)
+}
+
 // AddSkills mocks base method.
 func (m *MockResumeRepository) AddSkills(ctx context.Context, resumeID int, skillIDs []int) error {
 	m.ctrl.T.Helper()
@@ -84,6 +140,35 @@ func (mr *MockResumeRepositoryMockRecorder) AddWorkExperience(ctx, workExperienc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkExperience", reflect.TypeOf((*MockResumeRepository)(nil).AddWorkExperience), ctx, workExperience)
 }
 
+// BlockEmployer mocks base method.
+func (m *MockResumeRepository) BlockEmployer(ctx context.Context, applicantID, employerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockEmployer", ctx, applicantID, employerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockEmployer indicates an expected call of BlockEmployer.
+func (mr *MockResumeRepositoryMockRecorder) BlockEmployer(ctx, applicantID, employerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockEmployer", reflect.TypeOf((*MockResumeRepository)(nil).BlockEmployer), ctx, applicantID, employerID)
+}
+
+// ContactsDisclosed mocks base method.
+func (m *MockResumeRepository) ContactsDisclosed(ctx context.Context, applicantID, employerID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContactsDisclosed", ctx, applicantID, employerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContactsDisclosed indicates an expected call of ContactsDisclosed.
+func (mr *MockResumeRepositoryMockRecorder) ContactsDisclosed(ctx, applicantID, employerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactsDisclosed", reflect.TypeOf((*MockResumeRepository)(nil).ContactsDisclosed), ctx, applicantID, employerID)
+}
+
 // Create mocks base method.
 func (m *MockResumeRepository) Create(ctx context.Context, resume *entity.Resume) (*entity.Resume, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +184,21 @@ func (mr *MockResumeRepositoryMockRecorder) Create(ctx, resume any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockResumeRepository)(nil).Create), ctx, resume)
 }
 
+// CreateContactRequest mocks base method.
+func (m *MockResumeRepository) CreateContactRequest(ctx context.Context, request *entity.ContactRequest, event *entity.OutboxEvent) (*entity.ContactRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContactRequest", ctx, request, event)
+	ret0, _ := ret[0].(*entity.ContactRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContactRequest indicates an expected call of CreateContactRequest.
+func (mr *MockResumeRepositoryMockRecorder) CreateContactRequest(ctx, request, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContactRequest", reflect.TypeOf((*MockResumeRepository)(nil).CreateContactRequest), ctx, request, event)
+}
+
 // CreateSkillIfNotExists mocks base method.
 func (m *MockResumeRepository) CreateSkillIfNotExists(ctx context.Context, skillName string) (int, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +243,62 @@ func (mr *MockResumeRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockResumeRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteCities mocks base method.
+func (m *MockResumeRepository) DeleteCities(ctx context.Context, resumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCities", ctx, resumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCities indicates an expected call of DeleteCities.
+func (mr *MockResumeRepositoryMockRecorder) DeleteCities(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCities", reflect.TypeOf((*MockResumeRepository)(nil).DeleteCities), ctx, resumeID)
+}
+
+// DeleteCourses mocks base method.
+func (m *MockResumeRepository) DeleteCourses(ctx context.Context, resumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCourses", ctx, resumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCourses indicates an expected call of DeleteCourses.
+func (mr *MockResumeRepositoryMockRecorder) DeleteCourses(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCourses", reflect.TypeOf((*MockResumeRepository)(nil).DeleteCourses), ctx, resumeID)
+}
+
+// DeleteEducations mocks base method.
+func (m *MockResumeRepository) DeleteEducations(ctx context.Context, resumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEducations", ctx, resumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEducations indicates an expected call of DeleteEducations.
+func (mr *MockResumeRepositoryMockRecorder) DeleteEducations(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEducations", reflect.TypeOf((*MockResumeRepository)(nil).DeleteEducations), ctx, resumeID)
+}
+
+// DeleteLanguages mocks base method.
+func (m *MockResumeRepository) DeleteLanguages(ctx context.Context, resumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLanguages", ctx, resumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLanguages indicates an expected call of DeleteLanguages.
+func (mr *MockResumeRepositoryMockRecorder) DeleteLanguages(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLanguages", reflect.TypeOf((*MockResumeRepository)(nil).DeleteLanguages), ctx, resumeID)
+}
+
 // DeleteSkills mocks base method.
 func (m *MockResumeRepository) DeleteSkills(ctx context.Context, resumeID int) error {
 	m.ctrl.T.Helper()
@@ -199,6 +355,21 @@ func (mr *MockResumeRepositoryMockRecorder) DeleteWorkExperiences(ctx, resumeID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkExperiences", reflect.TypeOf((*MockResumeRepository)(nil).DeleteWorkExperiences), ctx, resumeID)
 }
 
+// FindCityIDsByNames mocks base method.
+func (m *MockResumeRepository) FindCityIDsByNames(ctx context.Context, cityNames []string) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCityIDsByNames", ctx, cityNames)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCityIDsByNames indicates an expected call of FindCityIDsByNames.
+func (mr *MockResumeRepositoryMockRecorder) FindCityIDsByNames(ctx, cityNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCityIDsByNames", reflect.TypeOf((*MockResumeRepository)(nil).FindCityIDsByNames), ctx, cityNames)
+}
+
 // FindSkillIDsByNames mocks base method.
 func (m *MockResumeRepository) FindSkillIDsByNames(ctx context.Context, skillNames []string) ([]int, error) {
 	m.ctrl.T.Helper()
@@ -245,18 +416,18 @@ func (mr *MockResumeRepositoryMockRecorder) FindSpecializationIDsByNames(ctx, sp
 }
 
 // GetAll mocks base method.
-func (m *MockResumeRepository) GetAll(ctx context.Context, limit, offset int) ([]entity.Resume, error) {
+func (m *MockResumeRepository) GetAll(ctx context.Context, employerID, limit, offset int) ([]entity.Resume, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "GetAll", ctx, employerID, limit, offset)
 	ret0, _ := ret[0].([]entity.Resume)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockResumeRepositoryMockRecorder) GetAll(ctx, limit, offset any) *gomock.Call {
+func (mr *MockResumeRepositoryMockRecorder) GetAll(ctx, employerID, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockResumeRepository)(nil).GetAll), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockResumeRepository)(nil).GetAll), ctx, employerID, limit, offset)
 }
 
 // GetAllResumesByApplicantID mocks base method.
@@ -274,6 +445,21 @@ func (mr *MockResumeRepositoryMockRecorder) GetAllResumesByApplicantID(ctx, appl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResumesByApplicantID", reflect.TypeOf((*MockResumeRepository)(nil).GetAllResumesByApplicantID), ctx, applicantID, limit, offset)
 }
 
+// GetBlockedEmployers mocks base method.
+func (m *MockResumeRepository) GetBlockedEmployers(ctx context.Context, applicantID int) ([]entity.BlockedEmployer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedEmployers", ctx, applicantID)
+	ret0, _ := ret[0].([]entity.BlockedEmployer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedEmployers indicates an expected call of GetBlockedEmployers.
+func (mr *MockResumeRepositoryMockRecorder) GetBlockedEmployers(ctx, applicantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedEmployers", reflect.TypeOf((*MockResumeRepository)(nil).GetBlockedEmployers), ctx, applicantID)
+}
+
 // GetByID mocks base method.
 func (m *MockResumeRepository) GetByID(ctx context.Context, id int) (*entity.Resume, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +475,81 @@ func (mr *MockResumeRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockResumeRepository)(nil).GetByID), ctx, id)
 }
 
+// GetCitiesByResumeID mocks base method.
+func (m *MockResumeRepository) GetCitiesByResumeID(ctx context.Context, resumeID int) ([]entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCitiesByResumeID", ctx, resumeID)
+	ret0, _ := ret[0].([]entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCitiesByResumeID indicates an expected call of GetCitiesByResumeID.
+func (mr *MockResumeRepositoryMockRecorder) GetCitiesByResumeID(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCitiesByResumeID", reflect.TypeOf((*MockResumeRepository)(nil).GetCitiesByResumeID), ctx, resumeID)
+}
+
+// GetContactRequestByID mocks base method.
+func (m *MockResumeRepository) GetContactRequestByID(ctx context.Context, id int) (*entity.ContactRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactRequestByID", ctx, id)
+	ret0, _ := ret[0].(*entity.ContactRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactRequestByID indicates an expected call of GetContactRequestByID.
+func (mr *MockResumeRepositoryMockRecorder) GetContactRequestByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactRequestByID", reflect.TypeOf((*MockResumeRepository)(nil).GetContactRequestByID), ctx, id)
+}
+
+// GetCoursesByResumeID mocks base method.
+func (m *MockResumeRepository) GetCoursesByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeCourse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoursesByResumeID", ctx, resumeID)
+	ret0, _ := ret[0].([]entity.ResumeCourse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoursesByResumeID indicates an expected call of GetCoursesByResumeID.
+func (mr *MockResumeRepositoryMockRecorder) GetCoursesByResumeID(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoursesByResumeID", reflect.TypeOf((*MockResumeRepository)(nil).GetCoursesByResumeID), ctx, resumeID)
+}
+
+// GetEducationsByResumeID mocks base method.
+func (m *MockResumeRepository) GetEducationsByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeEducation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEducationsByResumeID", ctx, resumeID)
+	ret0, _ := ret[0].([]entity.ResumeEducation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEducationsByResumeID indicates an expected call of GetEducationsByResumeID.
+func (mr *MockResumeRepositoryMockRecorder) GetEducationsByResumeID(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEducationsByResumeID", reflect.TypeOf((*MockResumeRepository)(nil).GetEducationsByResumeID), ctx, resumeID)
+}
+
+// GetLanguagesByResumeID mocks base method.
+func (m *MockResumeRepository) GetLanguagesByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeLanguage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLanguagesByResumeID", ctx, resumeID)
+	ret0, _ := ret[0].([]entity.ResumeLanguage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLanguagesByResumeID indicates an expected call of GetLanguagesByResumeID.
+func (mr *MockResumeRepositoryMockRecorder) GetLanguagesByResumeID(ctx, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLanguagesByResumeID", reflect.TypeOf((*MockResumeRepository)(nil).GetLanguagesByResumeID), ctx, resumeID)
+}
+
 // GetSkillsByResumeID mocks base method.
 func (m *MockResumeRepository) GetSkillsByResumeID(ctx context.Context, resumeID int) ([]entity.Skill, error) {
 	m.ctrl.T.Helper()
@@ -334,19 +595,79 @@ func (mr *MockResumeRepositoryMockRecorder) GetWorkExperienceByResumeID(ctx, res
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkExperienceByResumeID", reflect.TypeOf((*MockResumeRepository)(nil).GetWorkExperienceByResumeID), ctx, resumeID)
 }
 
+// HasAnonymousResume mocks base method.
+func (m *MockResumeRepository) HasAnonymousResume(ctx context.Context, applicantID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAnonymousResume", ctx, applicantID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAnonymousResume indicates an expected call of HasAnonymousResume.
+func (mr *MockResumeRepositoryMockRecorder) HasAnonymousResume(ctx, applicantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAnonymousResume", reflect.TypeOf((*MockResumeRepository)(nil).HasAnonymousResume), ctx, applicantID)
+}
+
+// IsEmployerBlocked mocks base method.
+func (m *MockResumeRepository) IsEmployerBlocked(ctx context.Context, applicantID, employerID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEmployerBlocked", ctx, applicantID, employerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEmployerBlocked indicates an expected call of IsEmployerBlocked.
+func (mr *MockResumeRepositoryMockRecorder) IsEmployerBlocked(ctx, applicantID, employerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmployerBlocked", reflect.TypeOf((*MockResumeRepository)(nil).IsEmployerBlocked), ctx, applicantID, employerID)
+}
+
+// ResumeSentToEmployer mocks base method.
+func (m *MockResumeRepository) ResumeSentToEmployer(ctx context.Context, resumeID, employerID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSentToEmployer", ctx, resumeID, employerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSentToEmployer indicates an expected call of ResumeSentToEmployer.
+func (mr *MockResumeRepositoryMockRecorder) ResumeSentToEmployer(ctx, resumeID, employerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSentToEmployer", reflect.TypeOf((*MockResumeRepository)(nil).ResumeSentToEmployer), ctx, resumeID, employerID)
+}
+
+// SearchResumesAdvanced mocks base method.
+func (m *MockResumeRepository) SearchResumesAdvanced(ctx context.Context, employerID int, params entity.ResumeSearchParams, limit, offset int) ([]entity.Resume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchResumesAdvanced", ctx, employerID, params, limit, offset)
+	ret0, _ := ret[0].([]entity.Resume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchResumesAdvanced indicates an expected call of SearchResumesAdvanced.
+func (mr *MockResumeRepositoryMockRecorder) SearchResumesAdvanced(ctx, employerID, params, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesAdvanced", reflect.TypeOf((*MockResumeRepository)(nil).SearchResumesAdvanced), ctx, employerID, params, limit, offset)
+}
+
 // SearchResumesByProfession mocks base method.
-func (m *MockResumeRepository) SearchResumesByProfession(ctx context.Context, profession string, limit, offset int) ([]entity.Resume, error) {
+func (m *MockResumeRepository) SearchResumesByProfession(ctx context.Context, employerID int, profession string, filter entity.ResumeFilter, limit, offset int) ([]entity.Resume, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchResumesByProfession", ctx, profession, limit, offset)
+	ret := m.ctrl.Call(m, "SearchResumesByProfession", ctx, employerID, profession, filter, limit, offset)
 	ret0, _ := ret[0].([]entity.Resume)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchResumesByProfession indicates an expected call of SearchResumesByProfession.
-func (mr *MockResumeRepositoryMockRecorder) SearchResumesByProfession(ctx, profession, limit, offset any) *gomock.Call {
+func (mr *MockResumeRepositoryMockRecorder) SearchResumesByProfession(ctx, employerID, profession, filter, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesByProfession", reflect.TypeOf((*MockResumeRepository)(nil).SearchResumesByProfession), ctx, profession, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesByProfession", reflect.TypeOf((*MockResumeRepository)(nil).SearchResumesByProfession), ctx, employerID, profession, filter, limit, offset)
 }
 
 // SearchResumesByProfessionForApplicant mocks base method.
@@ -364,6 +685,20 @@ func (mr *MockResumeRepositoryMockRecorder) SearchResumesByProfessionForApplican
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesByProfessionForApplicant", reflect.TypeOf((*MockResumeRepository)(nil).SearchResumesByProfessionForApplicant), ctx, applicantID, profession, limit, offset)
 }
 
+// UnblockEmployer mocks base method.
+func (m *MockResumeRepository) UnblockEmployer(ctx context.Context, applicantID, employerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockEmployer", ctx, applicantID, employerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockEmployer indicates an expected call of UnblockEmployer.
+func (mr *MockResumeRepositoryMockRecorder) UnblockEmployer(ctx, applicantID, employerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockEmployer", reflect.TypeOf((*MockResumeRepository)(nil).UnblockEmployer), ctx, applicantID, employerID)
+}
+
 // Update mocks base method.
 func (m *MockResumeRepository) Update(ctx context.Context, resume *entity.Resume) (*entity.Resume, error) {
 	m.ctrl.T.Helper()
@@ -379,6 +714,35 @@ func (mr *MockResumeRepositoryMockRecorder) Update(ctx, resume any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockResumeRepository)(nil).Update), ctx, resume)
 }
 
+// UpdateContactRequestStatus mocks base method.
+func (m *MockResumeRepository) UpdateContactRequestStatus(ctx context.Context, id int, status entity.ContactRequestStatus) (*entity.ContactRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContactRequestStatus", ctx, id, status)
+	ret0, _ := ret[0].(*entity.ContactRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContactRequestStatus indicates an expected call of UpdateContactRequestStatus.
+func (mr *MockResumeRepositoryMockRecorder) UpdateContactRequestStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContactRequestStatus", reflect.TypeOf((*MockResumeRepository)(nil).UpdateContactRequestStatus), ctx, id, status)
+}
+
+// UpdateVisibility mocks base method.
+func (m *MockResumeRepository) UpdateVisibility(ctx context.Context, resumeID int, visibility entity.ResumeVisibility, accessToken string, isAnonymous bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", ctx, resumeID, visibility, accessToken, isAnonymous)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockResumeRepositoryMockRecorder) UpdateVisibility(ctx, resumeID, visibility, accessToken, isAnonymous any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockResumeRepository)(nil).UpdateVisibility), ctx, resumeID, visibility, accessToken, isAnonymous)
+}
+
 // UpdateWorkExperience mocks base method.
 func (m *MockResumeRepository) UpdateWorkExperience(ctx context.Context, workExperience *entity.WorkExperience) (*entity.WorkExperience, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@
 package mock
 
 import (
+	entity "ResuMatch/internal/entity"
 	context "context"
 	reflect "reflect"
 
@@ -54,6 +55,21 @@ func (mr *MockStaticRepositoryMockRecorder) DeleteStatic(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStatic", reflect.TypeOf((*MockStaticRepository)(nil).DeleteStatic), ctx, id)
 }
 
+// DownloadStatic mocks base method.
+func (m *MockStaticRepository) DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadStatic", ctx, id)
+	ret0, _ := ret[0].(*entity.StaticContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadStatic indicates an expected call of DownloadStatic.
+func (mr *MockStaticRepositoryMockRecorder) DownloadStatic(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStatic", reflect.TypeOf((*MockStaticRepository)(nil).DownloadStatic), ctx, id)
+}
+
 // GetStatic mocks base method.
 func (m *MockStaticRepository) GetStatic(ctx context.Context, id int) (string, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +85,21 @@ func (mr *MockStaticRepositoryMockRecorder) GetStatic(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatic", reflect.TypeOf((*MockStaticRepository)(nil).GetStatic), ctx, id)
 }
 
+// UploadPrivateStatic mocks base method.
+func (m *MockStaticRepository) UploadPrivateStatic(ctx context.Context, fileName, contentType string, data []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPrivateStatic", ctx, fileName, contentType, data)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPrivateStatic indicates an expected call of UploadPrivateStatic.
+func (mr *MockStaticRepositoryMockRecorder) UploadPrivateStatic(ctx, fileName, contentType, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPrivateStatic", reflect.TypeOf((*MockStaticRepository)(nil).UploadPrivateStatic), ctx, fileName, contentType, data)
+}
+
 // UploadStatic mocks base method.
 func (m *MockStaticRepository) UploadStatic(ctx context.Context, fileName, contentType string, data []byte) (int, string, error) {
 	m.ctrl.T.Helper()
@@ -7,12 +7,16 @@ import (
 
 type NotificationRepository interface {
 	CreateNotification(ctx context.Context, notification *entity.Notification) error
-	GetApplyNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error)
-	GetDownloadResumeNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error)
+	GetNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error)
 	GetNotificationByID(ctx context.Context, notificationID int) (*entity.Notification, error)
-	GetApplyNotificationsForUser(ctx context.Context, notificationID int) ([]*entity.NotificationPreview, error)
-	GetDownloadResumeNotificationsForUser(ctx context.Context, notificationID int) ([]*entity.NotificationPreview, error)
+	GetNotificationsForUser(ctx context.Context, userID int, role entity.UserRole, filter entity.NotificationFilter) ([]*entity.NotificationPreview, error)
+	GetGroupNotifications(ctx context.Context, groupID, limit, offset int) ([]*entity.NotificationPreview, error)
+	CountUnreadNotifications(ctx context.Context, userID int, role entity.UserRole) (map[entity.NotificationType]int, error)
+	GetLastNotificationID(ctx context.Context, userID int, role entity.UserRole) (int, error)
+	GetEventPreview(ctx context.Context, notification *entity.Notification) (*entity.NotificationPreview, error)
 	ReadNotification(ctx context.Context, notificationID int) error
+	ReadNotificationGroup(ctx context.Context, groupID int) error
 	ReadAllNotifications(ctx context.Context, userID int, role string) error
+	DeleteNotification(ctx context.Context, notificationID int) error
 	DeleteAllNotifications(ctx context.Context, userID int, role string) error
 }
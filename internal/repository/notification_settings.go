@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+)
+
+type NotificationSettingsRepository interface {
+	GetNotificationSettings(ctx context.Context, userID int, role entity.UserRole) (*entity.NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, settings *entity.NotificationSettings) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"time"
+)
+
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, event *entity.OutboxEvent) error
+	ClaimEvents(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*entity.OutboxEvent, error)
+	MarkEventDelivered(ctx context.Context, eventID int, sinks []string) error
+	MarkEventFailed(ctx context.Context, eventID int, sinks []string, retryAt time.Time, deliveryErr string) error
+}
@@ -23,6 +23,20 @@ func NewChatRepository(db *sql.DB) repository.ChatRepository {
 	}
 }
 
+// chatBlockedColumns - флаги блокировки чата каждой из сторон, в порядке applicant, employer
+const chatBlockedColumns = `EXISTS (
+	    SELECT 1 FROM chat_participant_settings bs WHERE bs.chat_id = c.id AND bs.is_applicant AND bs.blocked
+	) AS applicant_blocked,
+	EXISTS (
+	    SELECT 1 FROM chat_participant_settings bs WHERE bs.chat_id = c.id AND NOT bs.is_applicant AND bs.blocked
+	) AS employer_blocked`
+
+// chatArchivedColumn - чат в архиве, пока после архивации в нем не появилось новых сообщений.
+// Ожидает настройки участника под псевдонимом s
+const chatArchivedColumn = `(s.archived_at IS NOT NULL AND NOT EXISTS (
+	    SELECT 1 FROM message am WHERE am.chat_id = c.id AND am.sent_at > s.archived_at
+	)) AS archived`
+
 func (r *ChatRepository) GetForVacancy(ctx context.Context, vacancyID, applicantID int) (*entity.Chat, error) {
 	requestID := utils.GetRequestID(ctx)
 
@@ -62,6 +76,60 @@ func (r *ChatRepository) GetForVacancy(ctx context.Context, vacancyID, applicant
 	return &chat, nil
 }
 
+// GetForResume возвращает чаты работодателя, начатые по откликам с резюме
+func (r *ChatRepository) GetForResume(ctx context.Context, employerID, resumeID int) ([]*entity.Chat, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"employer_id": employerID,
+		"resume_id":   resumeID,
+	}).Info("выполнение sql-запроса GetForResume")
+
+	query := `
+		SELECT id, vacancy_id, resume_id, employer_id, applicant_id, created_at, updated_at
+		FROM chat
+		WHERE employer_id = $1 AND resume_id = $2
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, employerID, resumeID)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении чатов по резюме: %w", err),
+		)
+	}
+	defer rows.Close()
+
+	chats := make([]*entity.Chat, 0)
+	for rows.Next() {
+		var chat entity.Chat
+		if err := rows.Scan(
+			&chat.ID,
+			&chat.VacancyID,
+			&chat.ResumeID,
+			&chat.EmployerID,
+			&chat.ApplicantID,
+			&chat.CreatedAt,
+			&chat.UpdatedAt,
+		); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при чтении чата: %w", err),
+			)
+		}
+		chats = append(chats, &chat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при переборе чатов: %w", err),
+		)
+	}
+	return chats, nil
+}
+
 func (r *ChatRepository) CreateChat(ctx context.Context, vacancyID, resumeID, employerID, applicantID int) (*entity.Chat, error) {
 	requestID := utils.GetRequestID(ctx)
 
@@ -128,8 +196,11 @@ func (r *ChatRepository) GetChatByID(ctx context.Context, chatID int) (*entity.C
 	}).Info("Выполнение sql-запроса получения чата по id GetChatByID")
 
 	query := `
-	SELECT id, vacancy_id, resume_id, applicant_id, employer_id, created_at, updated_at
-	FROM chat WHERE id=$1
+	SELECT c.id, c.vacancy_id, c.resume_id, c.applicant_id, c.employer_id,
+	       c.applicant_last_read_message_id, c.employer_last_read_message_id,
+	       ` + chatBlockedColumns + `,
+	       c.created_at, c.updated_at
+	FROM chat c WHERE c.id=$1
 	`
 
 	var chat entity.Chat
@@ -139,6 +210,10 @@ func (r *ChatRepository) GetChatByID(ctx context.Context, chatID int) (*entity.C
 		&chat.ResumeID,
 		&chat.ApplicantID,
 		&chat.EmployerID,
+		&chat.ApplicantLastReadID,
+		&chat.EmployerLastReadID,
+		&chat.ApplicantBlocked,
+		&chat.EmployerBlocked,
 		&chat.CreatedAt,
 		&chat.UpdatedAt,
 	)
@@ -167,15 +242,28 @@ func (r *ChatRepository) GetForUser(ctx context.Context, userID int, isApplicant
 		"isApplicant": isApplicant,
 	}).Info("Выполнение sql-запроса получения чатов пользователя")
 
+	// Непрочитанными считаются сообщения собеседника после курсора пользователя. Системные
+	// сообщения видят обе стороны, и в счетчик они не попадают
 	query := `
-        SELECT id, vacancy_id, resume_id, applicant_id, employer_id, created_at, updated_at
-        FROM chat
+        SELECT c.id, c.vacancy_id, c.resume_id, c.applicant_id, c.employer_id,
+               c.applicant_last_read_message_id, c.employer_last_read_message_id,
+               (
+                   SELECT COUNT(*) FROM message m
+                   WHERE m.chat_id = c.id AND m.kind = 'user' AND m.from_applicant <> $2
+                     AND m.id > CASE WHEN $2 THEN c.applicant_last_read_message_id ELSE c.employer_last_read_message_id END
+               ) AS unread_count,
+               ` + chatBlockedColumns + `,
+               ` + chatArchivedColumn + `,
+               COALESCE(s.muted, FALSE),
+               c.created_at, c.updated_at
+        FROM chat c
+        LEFT JOIN chat_participant_settings s ON s.chat_id = c.id AND s.is_applicant = $2
         WHERE
             CASE
-                WHEN $2 THEN applicant_id = $1
-                ELSE employer_id = $1
+                WHEN $2 THEN c.applicant_id = $1
+                ELSE c.employer_id = $1
             END
-        ORDER BY updated_at DESC
+        ORDER BY c.updated_at DESC
     `
 
 	rows, err := r.db.QueryContext(ctx, query, userID, isApplicant)
@@ -212,6 +300,13 @@ func (r *ChatRepository) GetForUser(ctx context.Context, userID int, isApplicant
 			&chat.ResumeID,
 			&chat.ApplicantID,
 			&chat.EmployerID,
+			&chat.ApplicantLastReadID,
+			&chat.EmployerLastReadID,
+			&chat.UnreadCount,
+			&chat.ApplicantBlocked,
+			&chat.EmployerBlocked,
+			&chat.Archived,
+			&chat.Muted,
 			&chat.CreatedAt,
 			&chat.UpdatedAt,
 		)
@@ -241,6 +336,102 @@ func (r *ChatRepository) GetForUser(ctx context.Context, userID int, isApplicant
 	return chats, nil
 }
 
+// GetSettings возвращает настройки чата участника. Если участник их не менял, возвращаются настройки по умолчанию
+func (r *ChatRepository) GetSettings(ctx context.Context, chatID int, isApplicant bool) (*entity.ChatSettings, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"chatID":      chatID,
+		"isApplicant": isApplicant,
+	}).Info("Выполнение sql-запроса получения настроек чата GetSettings")
+
+	query := `
+	SELECT s.chat_id, s.is_applicant, s.archived_at, ` + chatArchivedColumn + `, s.muted, s.blocked, s.updated_at
+	FROM chat_participant_settings s
+	JOIN chat c ON c.id = s.chat_id
+	WHERE s.chat_id = $1 AND s.is_applicant = $2
+	`
+
+	settings, err := scanChatSettings(r.db.QueryRowContext(ctx, query, chatID, isApplicant))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &entity.ChatSettings{ChatID: chatID, IsApplicant: isApplicant}, nil
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении настроек чата с id=%d: %w", chatID, err),
+		)
+	}
+	return settings, nil
+}
+
+// UpdateSettings сохраняет настройки чата участника целиком
+func (r *ChatRepository) UpdateSettings(ctx context.Context, settings *entity.ChatSettings) (*entity.ChatSettings, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"chatID":      settings.ChatID,
+		"isApplicant": settings.IsApplicant,
+	}).Info("Выполнение sql-запроса изменения настроек чата UpdateSettings")
+
+	query := `
+	WITH s AS (
+	    INSERT INTO chat_participant_settings (chat_id, is_applicant, archived_at, muted, blocked)
+	    VALUES ($1, $2, $3, $4, $5)
+	    ON CONFLICT (chat_id, is_applicant) DO UPDATE
+	    SET archived_at = EXCLUDED.archived_at,
+	        muted = EXCLUDED.muted,
+	        blocked = EXCLUDED.blocked,
+	        updated_at = NOW()
+	    RETURNING *
+	)
+	SELECT s.chat_id, s.is_applicant, s.archived_at, ` + chatArchivedColumn + `, s.muted, s.blocked, s.updated_at
+	FROM s
+	JOIN chat c ON c.id = s.chat_id
+	`
+
+	updated, err := scanChatSettings(r.db.QueryRowContext(
+		ctx,
+		query,
+		settings.ChatID,
+		settings.IsApplicant,
+		settings.ArchivedAt,
+		settings.Muted,
+		settings.Blocked,
+	))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("чат с id=%d не найден", settings.ChatID),
+			)
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при изменении настроек чата с id=%d: %w", settings.ChatID, err),
+		)
+	}
+	return updated, nil
+}
+
+func scanChatSettings(row *sql.Row) (*entity.ChatSettings, error) {
+	var settings entity.ChatSettings
+	err := row.Scan(
+		&settings.ChatID,
+		&settings.IsApplicant,
+		&settings.ArchivedAt,
+		&settings.Archived,
+		&settings.Muted,
+		&settings.Blocked,
+		&settings.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
 func (r *ChatRepository) GetVacancyChatInfo(ctx context.Context, vacancyID, applicantID int) (*entity.VacancyChatInfo, error) {
 	requestID := utils.GetRequestID(ctx)
 
@@ -276,3 +467,332 @@ func (r *ChatRepository) GetVacancyChatInfo(ctx context.Context, vacancyID, appl
 	}
 	return &info, nil
 }
+
+// MarkRead сдвигает курсор прочтения стороны чата вперед. Курсор никогда не уменьшается,
+// поэтому отметка из устаревшей вкладки не возвращает прочитанные сообщения в непрочитанные
+func (r *ChatRepository) MarkRead(ctx context.Context, chatID int, isApplicant bool, messageID int) (int, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"chatID":      chatID,
+		"isApplicant": isApplicant,
+		"messageID":   messageID,
+	}).Info("Выполнение sql-запроса отметки прочтения чата MarkRead")
+
+	query := `
+	UPDATE chat
+	SET applicant_last_read_message_id = CASE WHEN $2 THEN GREATEST(applicant_last_read_message_id, $3) ELSE applicant_last_read_message_id END,
+	    employer_last_read_message_id = CASE WHEN $2 THEN employer_last_read_message_id ELSE GREATEST(employer_last_read_message_id, $3) END
+	WHERE id = $1 AND EXISTS (
+	    SELECT 1 FROM message WHERE id = $3 AND chat_id = $1
+	)
+	RETURNING CASE WHEN $2 THEN applicant_last_read_message_id ELSE employer_last_read_message_id END
+	`
+
+	var lastReadID int
+	err := r.db.QueryRowContext(ctx, query, chatID, isApplicant, messageID).Scan(&lastReadID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("сообщение с id=%d не найдено в чате с id=%d", messageID, chatID),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"chatID":    chatID,
+			"error":     err,
+		}).Error("Не удалось отметить чат прочитанным")
+
+		return 0, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при отметке прочтения чата: %w", err),
+		)
+	}
+	return lastReadID, nil
+}
+
+func (r *ChatRepository) GetUnreadCount(ctx context.Context, userID int, isApplicant bool) (*entity.UnreadCount, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"userID":      userID,
+		"isApplicant": isApplicant,
+	}).Info("Выполнение sql-запроса подсчета непрочитанных сообщений GetUnreadCount")
+
+	query := `
+	SELECT COUNT(m.id), COUNT(DISTINCT m.chat_id)
+	FROM chat c
+	JOIN message m ON m.chat_id = c.id
+	WHERE
+	    CASE
+	        WHEN $2 THEN c.applicant_id = $1
+	        ELSE c.employer_id = $1
+	    END
+	    AND m.kind = 'user' AND m.from_applicant <> $2
+	    AND m.id > CASE WHEN $2 THEN c.applicant_last_read_message_id ELSE c.employer_last_read_message_id END
+	    AND NOT EXISTS (
+	        SELECT 1 FROM chat_participant_settings s
+	        WHERE s.chat_id = c.id AND s.is_applicant = $2 AND s.muted
+	    )
+	`
+
+	var count entity.UnreadCount
+	err := r.db.QueryRowContext(ctx, query, userID, isApplicant).Scan(&count.Messages, &count.Chats)
+	if err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"userID":    userID,
+			"error":     err,
+		}).Error("Не удалось посчитать непрочитанные сообщения")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при подсчете непрочитанных сообщений: %w", err),
+		)
+	}
+	return &count, nil
+}
+
+const invitationColumns = `id, vacancy_id, resume_id, employer_id, applicant_id, COALESCE(chat_id, 0), status, created_at, updated_at`
+
+func scanInvitation(row *sql.Row) (*entity.Invitation, error) {
+	var invitation entity.Invitation
+	err := row.Scan(
+		&invitation.ID,
+		&invitation.VacancyID,
+		&invitation.ResumeID,
+		&invitation.EmployerID,
+		&invitation.ApplicantID,
+		&invitation.ChatID,
+		&invitation.Status,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// CreateInvitation сохраняет приглашение, создает для него чат с системным сообщением text и записывает
+// событие о приглашении в одной транзакции. Id приглашения и сообщения известны только здесь, поэтому
+// они дописываются в событие: в объекты и в ObjectID уведомления.
+// Пригласить можно только на свою активную вакансию и только один раз
+func (r *ChatRepository) CreateInvitation(ctx context.Context, invitation *entity.Invitation, text string, event *entity.OutboxEvent) (*entity.Invitation, *entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"vacancyID":   invitation.VacancyID,
+		"resumeID":    invitation.ResumeID,
+		"applicantID": invitation.ApplicantID,
+	}).Info("Выполнение sql-запроса создания приглашения CreateInvitation")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции создания приглашения: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции создания приглашения")
+			}
+		}
+	}()
+
+	var chatID int
+	err = tx.QueryRowContext(ctx, `
+	INSERT INTO chat (vacancy_id, resume_id, employer_id, applicant_id)
+	SELECT v.id, $2, v.employer_id, $4
+	FROM vacancy v
+	WHERE v.id = $1 AND v.employer_id = $3 AND v.is_active = TRUE
+	RETURNING id
+	`, invitation.VacancyID, invitation.ResumeID, invitation.EmployerID, invitation.ApplicantID).Scan(&chatID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("активная вакансия с id=%d не найдена", invitation.VacancyID),
+			)
+		}
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при создании чата для приглашения: %w", err),
+		)
+	}
+
+	created, err := scanInvitation(tx.QueryRowContext(ctx, `
+	INSERT INTO vacancy_invitation (vacancy_id, resume_id, employer_id, applicant_id, chat_id)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING `+invitationColumns,
+		invitation.VacancyID, invitation.ResumeID, invitation.EmployerID, invitation.ApplicantID, chatID,
+	))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLUniqueViolation {
+			return nil, nil, entity.NewError(
+				entity.ErrAlreadyExists,
+				fmt.Errorf("соискатель уже приглашен на эту вакансию"),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при создании приглашения")
+
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при создании приглашения: %w", err),
+		)
+	}
+
+	message, err := insertSystemMessage(ctx, tx, chatID, 0, entity.SystemEventInvitation, text)
+	if err != nil {
+		return nil, nil, systemMessageError(err)
+	}
+
+	if event.Notification != nil {
+		event.Notification.ObjectID = created.ID
+	}
+	event.Objects = map[string]int{"invitation_id": created.ID, "chat_id": chatID, "message_id": message.ID}
+	if err = insertOutboxEvent(ctx, tx, event); err != nil {
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события о приглашении: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции создания приглашения: %w", err),
+		)
+	}
+	return created, message, nil
+}
+
+func (r *ChatRepository) GetInvitationByID(ctx context.Context, id int) (*entity.Invitation, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":    requestID,
+		"invitationID": id,
+	}).Info("Выполнение sql-запроса получения приглашения GetInvitationByID")
+
+	invitation, err := scanInvitation(r.db.QueryRowContext(ctx, `
+	SELECT `+invitationColumns+`
+	FROM vacancy_invitation
+	WHERE id = $1
+	`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("приглашение с id=%d не найдено", id),
+			)
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении приглашения: %w", err),
+		)
+	}
+	return invitation, nil
+}
+
+// AnswerInvitation меняет статус ожидающего приглашения. Принятое приглашение
+// в том же запросе записывается откликом соискателя на вакансию. Системное сообщение о решении
+// и событие event записываются в той же транзакции
+func (r *ChatRepository) AnswerInvitation(ctx context.Context, id int, status entity.InvitationStatus, systemEvent entity.SystemEvent, text string, event *entity.OutboxEvent) (*entity.Invitation, *entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":    requestID,
+		"invitationID": id,
+		"status":       status,
+	}).Info("Выполнение sql-запроса ответа на приглашение AnswerInvitation")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции ответа на приглашение: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции ответа на приглашение")
+			}
+		}
+	}()
+
+	invitation, err := scanInvitation(tx.QueryRowContext(ctx, `
+	WITH answered AS (
+	    UPDATE vacancy_invitation
+	    SET status = $1, updated_at = NOW()
+	    WHERE id = $2 AND status = 'pending'
+	    RETURNING *
+	), response AS (
+	    INSERT INTO vacancy_response (vacancy_id, applicant_id, resume_id, applied_at)
+	    SELECT vacancy_id, applicant_id, resume_id, NOW()
+	    FROM answered
+	    WHERE status = 'accepted'
+	    ON CONFLICT DO NOTHING
+	)
+	SELECT `+invitationColumns+`
+	FROM answered
+	`, status, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("приглашение с id=%d уже обработано", id),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при ответе на приглашение")
+
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при ответе на приглашение: %w", err),
+		)
+	}
+
+	event.Objects = map[string]int{"invitation_id": invitation.ID}
+	var message *entity.Message
+	if invitation.ChatID != 0 {
+		message, err = insertSystemMessage(ctx, tx, invitation.ChatID, 0, systemEvent, text)
+		if err != nil {
+			return nil, nil, systemMessageError(err)
+		}
+		event.Objects["chat_id"] = invitation.ChatID
+		event.Objects["message_id"] = message.ID
+	}
+
+	if err = insertOutboxEvent(ctx, tx, event); err != nil {
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события об ответе на приглашение: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции ответа на приглашение: %w", err),
+		)
+	}
+	return invitation, message, nil
+}
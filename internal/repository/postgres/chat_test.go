@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+// unreadCondition - системные сообщения видят обе стороны чата, поэтому непрочитанными
+// считаются только пользовательские сообщения собеседника
+var unreadCondition = regexp.QuoteMeta(`m.kind = 'user' AND m.from_applicant <> $2`)
+
+func TestChatRepository_GetForUser(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{
+		"id", "vacancy_id", "resume_id", "applicant_id", "employer_id",
+		"applicant_last_read_message_id", "employer_last_read_message_id", "unread_count",
+		"applicant_blocked", "employer_blocked", "archived", "muted", "created_at", "updated_at",
+	}
+	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []*entity.Chat
+		expectedErr    error
+	}{
+		{
+			name: "Счетчик без системных сообщений",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(unreadCondition).WithArgs(1, false).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(10, 2, 3, 4, 1, 5, 7, 2, false, false, false, false, fixedTime, fixedTime))
+			},
+			expectedResult: []*entity.Chat{{
+				ID: 10, VacancyID: 2, ResumeID: 3, ApplicantID: 4, EmployerID: 1,
+				ApplicantLastReadID: 5, EmployerLastReadID: 7, UnreadCount: 2,
+				CreatedAt: fixedTime, UpdatedAt: fixedTime,
+			}},
+		},
+		{
+			name: "Ошибка запроса",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(unreadCondition).WithArgs(1, false).WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &ChatRepository{db: db}
+			result, err := repo.GetForUser(context.Background(), 1, false)
+
+			if tc.expectedErr != nil {
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChatRepository_GetUnreadCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult *entity.UnreadCount
+		expectedErr    error
+	}{
+		{
+			name: "Счетчик без системных сообщений",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(unreadCondition).WithArgs(4, true).
+					WillReturnRows(sqlmock.NewRows([]string{"messages", "chats"}).AddRow(3, 2))
+			},
+			expectedResult: &entity.UnreadCount{Messages: 3, Chats: 2},
+		},
+		{
+			name: "Ошибка запроса",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(unreadCondition).WithArgs(4, true).WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &ChatRepository{db: db}
+			result, err := repo.GetUnreadCount(context.Background(), 4, true)
+
+			if tc.expectedErr != nil {
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
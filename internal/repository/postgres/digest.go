@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/utils"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type DigestRepository struct {
+	db *sql.DB
+}
+
+func NewDigestRepository(db *sql.DB) repository.DigestRepository {
+	return &DigestRepository{
+		db: db,
+	}
+}
+
+// GetPendingDigests собирает по чатам сообщения, непрочитанные с unreadBefore и еще не попавшие в письма.
+// Чаты, в которых пользователь отключил уведомления или заблокировал собеседника, в дайджест не входят
+func (r *DigestRepository) GetPendingDigests(ctx context.Context, unreadBefore time.Time) ([]*entity.Digest, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":    requestID,
+		"unreadBefore": unreadBefore,
+	}).Info("Выполнение sql-запроса получения дайджестов непрочитанных сообщений GetPendingDigests")
+
+	query := `
+	SELECT u.user_id, u.is_applicant, COALESCE(a.email, e.email), u.chat_id, v.title,
+	       COUNT(*) AS unread, MAX(u.message_id) AS last_message_id
+	FROM (
+	    SELECT c.id AS chat_id, c.vacancy_id, side.is_applicant, m.id AS message_id,
+	           CASE WHEN side.is_applicant THEN c.applicant_id ELSE c.employer_id END AS user_id
+	    FROM chat c
+	    CROSS JOIN (VALUES (TRUE), (FALSE)) AS side(is_applicant)
+	    JOIN message m ON m.chat_id = c.id
+	    WHERE m.kind = 'user'
+	      AND m.from_applicant <> side.is_applicant
+	      AND m.deleted_at IS NULL
+	      AND m.sent_at <= $1
+	      AND m.id > CASE WHEN side.is_applicant THEN c.applicant_last_read_message_id ELSE c.employer_last_read_message_id END
+	      AND NOT EXISTS (
+	          SELECT 1 FROM chat_participant_settings s
+	          WHERE s.chat_id = c.id AND s.is_applicant = side.is_applicant AND (s.muted OR s.blocked)
+	      )
+	) u
+	JOIN vacancy v ON v.id = u.vacancy_id
+	LEFT JOIN applicant a ON u.is_applicant AND a.id = u.user_id
+	LEFT JOIN employer e ON NOT u.is_applicant AND e.id = u.user_id
+	LEFT JOIN message_digest d ON d.user_id = u.user_id AND d.is_applicant = u.is_applicant
+	WHERE u.message_id > COALESCE(d.last_message_id, 0)
+	  AND NOT COALESCE(d.unsubscribed, FALSE)
+	GROUP BY u.user_id, u.is_applicant, a.email, e.email, u.chat_id, v.title
+	ORDER BY u.user_id, u.is_applicant, u.chat_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, unreadBefore)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении непрочитанных сообщений для дайджеста: %w", err),
+		)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     closeErr,
+			}).Error("Ошибка при закрытии строк дайджестов")
+		}
+	}()
+
+	var digests []*entity.Digest
+	var current *entity.Digest
+	for rows.Next() {
+		var (
+			userID      int
+			isApplicant bool
+			email       string
+			chat        entity.DigestChat
+		)
+		if err := rows.Scan(&userID, &isApplicant, &email, &chat.ChatID, &chat.VacancyTitle, &chat.Unread, &chat.LastMessageID); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при чтении строки дайджеста: %w", err),
+			)
+		}
+
+		role := entity.EmployerRole
+		if isApplicant {
+			role = entity.ApplicantRole
+		}
+		// Строки отсортированы по пользователю, поэтому чаты одного письма идут подряд
+		if current == nil || current.UserID != userID || current.Role != role {
+			current = &entity.Digest{UserID: userID, Role: role, Email: email}
+			digests = append(digests, current)
+		}
+		current.Chats = append(current.Chats, &chat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при переборе строк дайджестов: %w", err),
+		)
+	}
+	return digests, nil
+}
+
+// MarkDigestSent запоминает последнее сообщение, о котором пользователь получил письмо
+func (r *DigestRepository) MarkDigestSent(ctx context.Context, userID int, role entity.UserRole, lastMessageID int) error {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":     requestID,
+		"userID":        userID,
+		"role":          role,
+		"lastMessageID": lastMessageID,
+	}).Info("Выполнение sql-запроса отметки отправки дайджеста MarkDigestSent")
+
+	query := `
+	INSERT INTO message_digest (user_id, is_applicant, last_message_id, sent_at)
+	VALUES ($1, $2, $3, NOW())
+	ON CONFLICT (user_id, is_applicant) DO UPDATE
+	SET last_message_id = GREATEST(message_digest.last_message_id, EXCLUDED.last_message_id),
+	    sent_at = EXCLUDED.sent_at,
+	    updated_at = NOW()
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, role == entity.ApplicantRole, lastMessageID)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при сохранении отправки дайджеста: %w", err),
+		)
+	}
+	return nil
+}
+
+// Unsubscribe отключает письма-дайджесты пользователю
+func (r *DigestRepository) Unsubscribe(ctx context.Context, userID int, role entity.UserRole) error {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"userID":    userID,
+		"role":      role,
+	}).Info("Выполнение sql-запроса отписки от дайджестов Unsubscribe")
+
+	query := `
+	INSERT INTO message_digest (user_id, is_applicant, unsubscribed)
+	VALUES ($1, $2, TRUE)
+	ON CONFLICT (user_id, is_applicant) DO UPDATE
+	SET unsubscribed = TRUE,
+	    updated_at = NOW()
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, role == entity.ApplicantRole)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при отписке от дайджестов: %w", err),
+		)
+	}
+	return nil
+}
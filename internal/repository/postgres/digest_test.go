@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestRepository_GetPendingDigests(t *testing.T) {
+	t.Parallel()
+
+	// Системные сообщения видят оба участника, в дайджест они не попадают
+	query := regexp.QuoteMeta(`SELECT u.user_id, u.is_applicant, COALESCE(a.email, e.email), u.chat_id, v.title,`) +
+		`(?s).*` + regexp.QuoteMeta(`WHERE m.kind = 'user'`)
+	columns := []string{"user_id", "is_applicant", "email", "chat_id", "title", "unread", "last_message_id"}
+	unreadBefore := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []*entity.Digest
+		expectedErr    error
+	}{
+		{
+			name: "Чаты одного пользователя собираются в одно письмо",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, true, "applicant@mail.ru", 10, "Go-разработчик", 2, 105).
+					AddRow(1, true, "applicant@mail.ru", 11, "Тестировщик", 1, 120).
+					AddRow(1, false, "employer@mail.ru", 12, "Аналитик", 3, 130)
+				mock.ExpectQuery(query).WithArgs(unreadBefore).WillReturnRows(rows)
+			},
+			expectedResult: []*entity.Digest{
+				{
+					UserID: 1, Role: entity.ApplicantRole, Email: "applicant@mail.ru",
+					Chats: []*entity.DigestChat{
+						{ChatID: 10, VacancyTitle: "Go-разработчик", Unread: 2, LastMessageID: 105},
+						{ChatID: 11, VacancyTitle: "Тестировщик", Unread: 1, LastMessageID: 120},
+					},
+				},
+				{
+					UserID: 1, Role: entity.EmployerRole, Email: "employer@mail.ru",
+					Chats: []*entity.DigestChat{
+						{ChatID: 12, VacancyTitle: "Аналитик", Unread: 3, LastMessageID: 130},
+					},
+				},
+			},
+		},
+		{
+			name: "Ошибка запроса",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(unreadBefore).WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &DigestRepository{db: db}
+			result, err := repo.GetPendingDigests(context.Background(), unreadBefore)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
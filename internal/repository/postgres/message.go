@@ -7,6 +7,7 @@ import (
 	l "ResuMatch/pkg/logger"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/lib/pq"
@@ -23,32 +24,59 @@ func NewMessageRepository(db *sql.DB) repository.MessageRepository {
 	}
 }
 
-func (r *MessageRepository) CreateMessage(ctx context.Context, chatID, senderID int, fromApplicant bool, payload string) (*entity.Message, error) {
+// CreateMessage идемпотентен по clientID: повторная отправка возвращает уже сохраненное сообщение.
+// Вложения привязываются в той же транзакции, поэтому сообщение не появится без своих файлов
+func (r *MessageRepository) CreateMessage(ctx context.Context, chatID, senderID int, fromApplicant bool, payload, clientID string, attachmentIDs []int) (*entity.Message, error) {
 	requestID := utils.GetRequestID(ctx)
 	l.Log.WithFields(logrus.Fields{
-		"requestID": requestID,
+		"requestID":   requestID,
+		"clientID":    clientID,
+		"attachments": len(attachmentIDs),
 	}).Info("Выполнение sql-запроса создания сообщения CreateMessage")
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции создания сообщения: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции создания сообщения")
+			}
+		}
+	}()
+
 	query := `
-	INSERT INTO message (chat_id, sender_id, from_applicant, payload)
-	VALUES ($1, $2, $3, $4)
-	RETURNING id, chat_id, sender_id, from_applicant, payload, sent_at
+	INSERT INTO message (chat_id, sender_id, from_applicant, payload, client_message_id)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+	ON CONFLICT (chat_id, sender_id, from_applicant, client_message_id) WHERE client_message_id IS NOT NULL
+	DO UPDATE SET client_message_id = EXCLUDED.client_message_id
+	RETURNING id, chat_id, sender_id, from_applicant, kind, payload, COALESCE(client_message_id, ''), sent_at
 	`
 
 	var message entity.Message
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		chatID,
 		senderID,
 		fromApplicant,
 		payload,
+		clientID,
 	).Scan(
 		&message.ID,
 		&message.ChatID,
 		&message.SenderID,
 		&message.FromApplicant,
+		&message.Kind,
 		&message.Payload,
+		&message.ClientID,
 		&message.SentAt,
 	)
 
@@ -73,21 +101,520 @@ func (r *MessageRepository) CreateMessage(ctx context.Context, chatID, senderID
 			fmt.Errorf("ошибка при создании сообщения: %w", err),
 		)
 	}
+
+	if len(attachmentIDs) > 0 {
+		if err = r.attachToMessage(ctx, tx, &message, attachmentIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции создания сообщения: %w", err),
+		)
+	}
 	return &message, nil
 }
 
-func (r *MessageRepository) GetMessagesForChat(ctx context.Context, chatID int) ([]*entity.Message, error) {
+// CreateSystemMessage добавляет в чат сообщение о событии отклика. Отправителя у него нет, его видят оба участника
+func (r *MessageRepository) CreateSystemMessage(ctx context.Context, chatID int, event entity.SystemEvent, payload string) (*entity.Message, error) {
+	return r.createSystemMessage(ctx, chatID, 0, event, payload)
+}
+
+// CreateEventMessage добавляет системное сообщение о событии outbox outboxEventID. Повторная доставка
+// события не добавляет сообщение еще раз, а возвращает уже созданное
+func (r *MessageRepository) CreateEventMessage(ctx context.Context, chatID, outboxEventID int, event entity.SystemEvent, payload string) (*entity.Message, error) {
+	return r.createSystemMessage(ctx, chatID, outboxEventID, event, payload)
+}
+
+func (r *MessageRepository) createSystemMessage(ctx context.Context, chatID, outboxEventID int, event entity.SystemEvent, payload string) (*entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":     requestID,
+		"chatID":        chatID,
+		"outboxEventID": outboxEventID,
+		"event":         event,
+	}).Info("Выполнение sql-запроса создания системного сообщения CreateSystemMessage")
+
+	message, err := insertSystemMessage(ctx, r.db, chatID, outboxEventID, event, payload)
+	if err != nil {
+		return nil, systemMessageError(err)
+	}
+	return message, nil
+}
+
+// insertSystemMessage добавляет системное сообщение через q. Через него другие репозитории
+// пишут сообщение в своей транзакции, как insertOutboxEvent пишет событие
+func insertSystemMessage(ctx context.Context, q queryRower, chatID, outboxEventID int, event entity.SystemEvent, payload string) (*entity.Message, error) {
+	return scanMessage(q.QueryRowContext(ctx, `
+	WITH inserted AS (
+	    INSERT INTO message (chat_id, kind, event, payload, outbox_event_id)
+	    VALUES ($1, 'system', $2, $3, NULLIF($4, 0))
+	    ON CONFLICT (chat_id, outbox_event_id) DO NOTHING
+	    RETURNING *
+	), m AS (
+	    SELECT * FROM inserted
+	    UNION ALL
+	    SELECT * FROM message WHERE chat_id = $1 AND outbox_event_id = $4 AND NOT EXISTS (SELECT 1 FROM inserted)
+	)
+	SELECT m.id, m.chat_id, 0, FALSE, m.kind, m.event, m.payload, '', '[]'::json, m.sent_at, m.edited_at, m.deleted_at
+	FROM m
+	`, chatID, event, payload, outboxEventID))
+}
+
+// systemMessageError описывает ошибку сохранения системного сообщения для клиента
+func systemMessageError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLCheckViolation {
+		return entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("указаны неправильные данные системного сообщения: %w", pqErr),
+		)
+	}
+	return entity.NewError(
+		entity.ErrInternal,
+		fmt.Errorf("ошибка при создании системного сообщения: %w", err),
+	)
+}
+
+// attachToMessage привязывает к сообщению загруженные отправителем в этот чат вложения.
+// Уже привязанные к этому же сообщению вложения учитываются, чтобы повторная отправка прошла успешно
+func (r *MessageRepository) attachToMessage(ctx context.Context, tx *sql.Tx, message *entity.Message, attachmentIDs []int) error {
+	result, err := tx.ExecContext(ctx, `
+	UPDATE message_attachment
+	SET message_id = $1
+	WHERE id = ANY($2)
+	  AND chat_id = $3
+	  AND uploader_id = $4
+	  AND from_applicant = $5
+	  AND (message_id IS NULL OR message_id = $1)
+	`, message.ID, pq.Array(attachmentIDs), message.ChatID, message.SenderID, message.FromApplicant)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при привязке вложений к сообщению: %w", err),
+		)
+	}
+
+	attached, err := result.RowsAffected()
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении количества привязанных вложений: %w", err),
+		)
+	}
+	if int(attached) != len(attachmentIDs) {
+		return entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("вложение не найдено или уже отправлено в другом сообщении"),
+		)
+	}
+
+	var raw []byte
+	err = tx.QueryRowContext(ctx, `SELECT `+messageAttachmentsColumn+` FROM message m WHERE m.id = $1`, message.ID).Scan(&raw)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении вложений сообщения: %w", err),
+		)
+	}
+
+	message.Attachments, err = decodeAttachments(raw)
+	return err
+}
+
+func (r *MessageRepository) CreateAttachment(ctx context.Context, attachment *entity.Attachment) (*entity.Attachment, error) {
 	requestID := utils.GetRequestID(ctx)
 	l.Log.WithFields(logrus.Fields{
 		"requestID": requestID,
-	}).Info("Выполнение sql-запроса получения всех сообщений чата")
+		"chatID":    attachment.ChatID,
+		"staticID":  attachment.StaticID,
+	}).Info("Выполнение sql-запроса сохранения вложения CreateAttachment")
+
+	query := `
+	INSERT INTO message_attachment (chat_id, uploader_id, from_applicant, static_id, file_name, content_type, size)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id, created_at
+	`
+
+	created := *attachment
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		attachment.ChatID,
+		attachment.UploaderID,
+		attachment.FromApplicant,
+		attachment.StaticID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+	).Scan(&created.ID, &created.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLCheckViolation {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("указаны неправильные данные вложения: %w", pqErr),
+			)
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при сохранении вложения: %w", err),
+		)
+	}
+	return &created, nil
+}
+
+func (r *MessageRepository) GetAttachment(ctx context.Context, attachmentID int) (*entity.Attachment, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":    requestID,
+		"attachmentID": attachmentID,
+	}).Info("Выполнение sql-запроса получения вложения GetAttachment")
 
 	query := `
-	SELECT id, chat_id, sender_id, from_applicant, payload, sent_at
-	FROM message WHERE chat_id = $1
-	ORDER BY sent_at ASC
+	SELECT id, chat_id, COALESCE(message_id, 0), uploader_id, from_applicant, static_id, file_name, content_type, size, created_at
+	FROM message_attachment
+	WHERE id = $1
+	`
+
+	var attachment entity.Attachment
+	err := r.db.QueryRowContext(ctx, query, attachmentID).Scan(
+		&attachment.ID,
+		&attachment.ChatID,
+		&attachment.MessageID,
+		&attachment.UploaderID,
+		&attachment.FromApplicant,
+		&attachment.StaticID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("вложение с id=%d не найдено", attachmentID),
+			)
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении вложения: %w", err),
+		)
+	}
+	return &attachment, nil
+}
+
+// messageColumns - поля сообщения m в порядке messageScanDest. У системных сообщений нет отправителя
+const messageColumns = `m.id, m.chat_id, COALESCE(m.sender_id, 0), COALESCE(m.from_applicant, FALSE), m.kind, COALESCE(m.event, ''),
+	       m.payload, COALESCE(m.client_message_id, ''), ` + messageAttachmentsColumn + `, m.sent_at, m.edited_at, m.deleted_at`
+
+func messageScanDest(message *entity.Message, attachments *[]byte) []interface{} {
+	return []interface{}{
+		&message.ID,
+		&message.ChatID,
+		&message.SenderID,
+		&message.FromApplicant,
+		&message.Kind,
+		&message.Event,
+		&message.Payload,
+		&message.ClientID,
+		attachments,
+		&message.SentAt,
+		&message.EditedAt,
+		&message.DeletedAt,
+	}
+}
+
+func scanMessage(row *sql.Row) (*entity.Message, error) {
+	var message entity.Message
+	var attachments []byte
+	err := row.Scan(messageScanDest(&message, &attachments)...)
+	if err != nil {
+		return nil, err
+	}
+	if message.Attachments, err = decodeAttachments(attachments); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+func (r *MessageRepository) GetMessage(ctx context.Context, messageID int) (*entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"messageID": messageID,
+	}).Info("Выполнение sql-запроса получения сообщения GetMessage")
+
+	message, err := scanMessage(r.db.QueryRowContext(ctx, `
+	SELECT `+messageColumns+`
+	FROM message m
+	WHERE m.id = $1
+	`, messageID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("сообщение с id=%d не найдено", messageID),
+			)
+		}
+		var entityErr entity.Error
+		if errors.As(err, &entityErr) {
+			return nil, err
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении сообщения: %w", err),
+		)
+	}
+	return message, nil
+}
+
+// EditMessage заменяет текст сообщения, сохраняя прежнюю редакцию в истории правок, и записывает
+// событие о правке в той же транзакции. Удаленное сообщение исправить нельзя
+func (r *MessageRepository) EditMessage(ctx context.Context, messageID int, payload string, event *entity.OutboxEvent) (*entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"messageID": messageID,
+	}).Info("Выполнение sql-запроса исправления сообщения EditMessage")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции исправления сообщения: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции исправления сообщения")
+			}
+		}
+	}()
+
+	message, err := scanMessage(tx.QueryRowContext(ctx, `
+	WITH previous AS (
+	    SELECT id, payload
+	    FROM message
+	    WHERE id = $1 AND deleted_at IS NULL
+	    FOR UPDATE
+	), history AS (
+	    INSERT INTO message_edit (message_id, payload)
+	    SELECT id, payload FROM previous
+	), m AS (
+	    UPDATE message
+	    SET payload = $2, edited_at = NOW()
+	    FROM previous
+	    WHERE message.id = previous.id
+	    RETURNING message.*
+	)
+	SELECT `+messageColumns+`
+	FROM m
+	`, messageID, payload))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("сообщение с id=%d не найдено или удалено", messageID),
+			)
+		}
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLCheckViolation {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("указаны неправильные данные при исправлении сообщения: %w", pqErr),
+			)
+		}
+		var entityErr entity.Error
+		if errors.As(err, &entityErr) {
+			return nil, err
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при исправлении сообщения: %w", err),
+		)
+	}
+
+	if err = r.commitMessageEvent(ctx, tx, event); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// DeleteMessage удаляет сообщение для всех участников: текст, вложения и история правок
+// стираются, а сама запись остается в переписке как отметка об удалении. Событие об удалении
+// записывается в той же транзакции
+func (r *MessageRepository) DeleteMessage(ctx context.Context, messageID int, event *entity.OutboxEvent) (*entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"messageID": messageID,
+	}).Info("Выполнение sql-запроса удаления сообщения DeleteMessage")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции удаления сообщения: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции удаления сообщения")
+			}
+		}
+	}()
+
+	message, err := scanMessage(tx.QueryRowContext(ctx, `
+	WITH edits AS (
+	    DELETE FROM message_edit WHERE message_id = $1
+	), attachments AS (
+	    DELETE FROM message_attachment WHERE message_id = $1
+	), m AS (
+	    UPDATE message
+	    SET payload = '', deleted_at = NOW()
+	    WHERE id = $1 AND deleted_at IS NULL
+	    RETURNING *
+	)
+	SELECT m.id, m.chat_id, COALESCE(m.sender_id, 0), COALESCE(m.from_applicant, FALSE), m.kind, COALESCE(m.event, ''),
+	       m.payload, COALESCE(m.client_message_id, ''), '[]'::json, m.sent_at, m.edited_at, m.deleted_at
+	FROM m
+	`, messageID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("сообщение с id=%d не найдено или уже удалено", messageID),
+			)
+		}
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при удалении сообщения: %w", err),
+		)
+	}
+
+	if err = r.commitMessageEvent(ctx, tx, event); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// commitMessageEvent записывает событие об изменении сообщения и фиксирует транзакцию изменения
+func (r *MessageRepository) commitMessageEvent(ctx context.Context, tx *sql.Tx, event *entity.OutboxEvent) error {
+	if err := insertOutboxEvent(ctx, tx, event); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события об изменении сообщения: %w", err),
+		)
+	}
+	if err := tx.Commit(); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции изменения сообщения: %w", err),
+		)
+	}
+	return nil
+}
+
+// GetMessageEdits возвращает прежние редакции сообщения от старых к новым
+func (r *MessageRepository) GetMessageEdits(ctx context.Context, messageID int) ([]*entity.MessageEdit, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"messageID": messageID,
+	}).Info("Выполнение sql-запроса получения истории правок сообщения GetMessageEdits")
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT id, message_id, payload, edited_at
+	FROM message_edit
+	WHERE message_id = $1
+	ORDER BY id ASC
+	`, messageID)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении истории правок сообщения: %w", err),
+		)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     closeErr,
+			}).Error("Ошибка при закрытии строк результата истории правок сообщения")
+		}
+	}()
+
+	var edits []*entity.MessageEdit
+	for rows.Next() {
+		var edit entity.MessageEdit
+		if err = rows.Scan(&edit.ID, &edit.MessageID, &edit.Payload, &edit.EditedAt); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании истории правок сообщения: %w", err),
+			)
+		}
+		edits = append(edits, &edit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка итерации по строкам истории правок сообщения: %w", err),
+		)
+	}
+	return edits, nil
+}
+
+func (r *MessageRepository) GetMessagesForChat(ctx context.Context, chatID int, page entity.MessagePage) ([]*entity.Message, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"chatID":    chatID,
+		"beforeID":  page.BeforeID,
+		"afterID":   page.AfterID,
+		"limit":     page.Limit,
+	}).Info("Выполнение sql-запроса получения страницы сообщений чата")
+
+	// Страница всегда отдается в хронологическом порядке. При движении назад
+	// берем ближайшие к курсору сообщения и разворачиваем их
+	query := `
+	SELECT ` + messageColumns + `
+	FROM message m
+	WHERE m.id IN (
+	    SELECT id
+	    FROM message
+	    WHERE chat_id = $1 AND ($2 = 0 OR id < $2)
+	    ORDER BY id DESC
+	    LIMIT $3
+	)
+	ORDER BY m.id ASC
     `
-	rows, err := r.db.QueryContext(ctx, query, chatID)
+	cursor := page.BeforeID
+	if page.AfterID > 0 {
+		query = `
+	SELECT ` + messageColumns + `
+	FROM message m
+	WHERE m.chat_id = $1 AND m.id > $2
+	ORDER BY m.id ASC
+	LIMIT $3
+    `
+		cursor = page.AfterID
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, chatID, cursor, page.Limit)
 	if err != nil {
 		l.Log.WithFields(logrus.Fields{
 			"chatID": chatID,
@@ -112,14 +639,8 @@ func (r *MessageRepository) GetMessagesForChat(ctx context.Context, chatID int)
 
 	for rows.Next() {
 		var message entity.Message
-		err = rows.Scan(
-			&message.ID,
-			&message.ChatID,
-			&message.SenderID,
-			&message.FromApplicant,
-			&message.Payload,
-			&message.SentAt,
-		)
+		var attachments []byte
+		err = rows.Scan(messageScanDest(&message, &attachments)...)
 		if err != nil {
 			l.Log.WithFields(logrus.Fields{
 				"requestID": requestID,
@@ -132,6 +653,9 @@ func (r *MessageRepository) GetMessagesForChat(ctx context.Context, chatID int)
 				fmt.Errorf("ошибка при получении сообщений чата с id %d", chatID),
 			)
 		}
+		if message.Attachments, err = decodeAttachments(attachments); err != nil {
+			return nil, err
+		}
 		messages = append(messages, &message)
 	}
 
@@ -144,3 +668,203 @@ func (r *MessageRepository) GetMessagesForChat(ctx context.Context, chatID int)
 
 	return messages, nil
 }
+
+func (r *MessageRepository) SearchMessages(ctx context.Context, userID int, isApplicant bool, query string, limit, offset int) ([]*entity.MessageWithChat, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"userID":      userID,
+		"isApplicant": isApplicant,
+		"query":       query,
+	}).Info("Выполнение sql-запроса поиска сообщений SearchMessages")
+
+	sqlQuery := `
+	SELECT ` + messageWithChatColumns + `
+	FROM message m
+	JOIN chat c ON c.id = m.chat_id
+	WHERE
+	    CASE
+	        WHEN $2 THEN c.applicant_id = $1
+	        ELSE c.employer_id = $1
+	    END
+	    AND m.deleted_at IS NULL AND m.kind = 'user'
+	    AND to_tsvector('russian', m.payload) @@ plainto_tsquery('russian', $3)
+	ORDER BY ts_rank(to_tsvector('russian', m.payload), plainto_tsquery('russian', $3)) DESC, m.id DESC
+	LIMIT $4 OFFSET $5
+	`
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, userID, isApplicant, query, limit, offset)
+	if err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"userID":    userID,
+			"error":     err,
+		}).Error("Не удалось выполнить поиск сообщений")
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при поиске сообщений: %w", err),
+		)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     closeErr,
+			}).Error("Ошибка при закрытии строк результата поиска сообщений")
+		}
+	}()
+
+	return scanMessagesWithChat(rows, requestID, "поиске сообщений")
+}
+
+// GetMessagesForUserAfter возвращает сообщения всех чатов пользователя, пришедшие после
+// указанного id. Используется для догрузки пропущенного после переподключения
+func (r *MessageRepository) GetMessagesForUserAfter(ctx context.Context, userID int, isApplicant bool, afterID, limit int) ([]*entity.MessageWithChat, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"userID":      userID,
+		"isApplicant": isApplicant,
+		"afterID":     afterID,
+	}).Info("Выполнение sql-запроса получения пропущенных сообщений GetMessagesForUserAfter")
+
+	query := `
+	SELECT ` + messageWithChatColumns + `
+	FROM message m
+	JOIN chat c ON c.id = m.chat_id
+	WHERE
+	    CASE
+	        WHEN $2 THEN c.applicant_id = $1
+	        ELSE c.employer_id = $1
+	    END
+	    AND m.id > $3
+	ORDER BY m.id ASC
+	LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, isApplicant, afterID, limit)
+	if err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"userID":    userID,
+			"error":     err,
+		}).Error("Не удалось получить пропущенные сообщения")
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении пропущенных сообщений: %w", err),
+		)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     closeErr,
+			}).Error("Ошибка при закрытии строк результата пропущенных сообщений")
+		}
+	}()
+
+	return scanMessagesWithChat(rows, requestID, "получении пропущенных сообщений")
+}
+
+// GetLastMessageIDForUser возвращает id последнего сообщения во всех чатах пользователя или 0, если сообщений нет
+func (r *MessageRepository) GetLastMessageIDForUser(ctx context.Context, userID int, isApplicant bool) (int, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   utils.GetRequestID(ctx),
+		"userID":      userID,
+		"isApplicant": isApplicant,
+	}).Info("Выполнение sql-запроса получения последнего сообщения пользователя GetLastMessageIDForUser")
+
+	query := `
+	SELECT COALESCE(MAX(m.id), 0)
+	FROM message m
+	JOIN chat c ON c.id = m.chat_id
+	WHERE
+	    CASE
+	        WHEN $2 THEN c.applicant_id = $1
+	        ELSE c.employer_id = $1
+	    END
+	`
+
+	var lastID int
+	if err := r.db.QueryRowContext(ctx, query, userID, isApplicant).Scan(&lastID); err != nil {
+		return 0, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении последнего сообщения пользователя: %w", err),
+		)
+	}
+	return lastID, nil
+}
+
+// messageAttachmentsColumn собирает вложения сообщения m в JSON-массив, чтобы история
+// загружалась одним запросом без отдельного обращения за файлами каждого сообщения
+const messageAttachmentsColumn = `COALESCE((
+	    SELECT json_agg(json_build_object(
+	        'id', a.id, 'file_name', a.file_name, 'content_type', a.content_type, 'size', a.size
+	    ) ORDER BY a.id)
+	    FROM message_attachment a
+	    WHERE a.message_id = m.id
+	), '[]')`
+
+func decodeAttachments(raw []byte) ([]*entity.Attachment, error) {
+	var attachments []*entity.Attachment
+	if err := json.Unmarshal(raw, &attachments); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при разборе вложений сообщения: %w", err),
+		)
+	}
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+	return attachments, nil
+}
+
+const messageWithChatColumns = messageColumns + `,
+	       c.id, c.vacancy_id, c.resume_id, c.applicant_id, c.employer_id,
+	       c.applicant_last_read_message_id, c.employer_last_read_message_id, c.created_at, c.updated_at`
+
+func scanMessagesWithChat(rows *sql.Rows, requestID, operation string) ([]*entity.MessageWithChat, error) {
+	var results []*entity.MessageWithChat
+	for rows.Next() {
+		var result entity.MessageWithChat
+		var attachments []byte
+		dest := append(messageScanDest(&result.Message, &attachments),
+			&result.Chat.ID,
+			&result.Chat.VacancyID,
+			&result.Chat.ResumeID,
+			&result.Chat.ApplicantID,
+			&result.Chat.EmployerID,
+			&result.Chat.ApplicantLastReadID,
+			&result.Chat.EmployerLastReadID,
+			&result.Chat.CreatedAt,
+			&result.Chat.UpdatedAt,
+		)
+		err := rows.Scan(dest...)
+		if err != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     err,
+			}).Errorf("Ошибка при сканировании строки результата при %s", operation)
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при %s: %w", operation, err),
+			)
+		}
+		if result.Message.Attachments, err = decodeAttachments(attachments); err != nil {
+			return nil, err
+		}
+		results = append(results, &result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка итерации по строкам при %s: %w", operation, err),
+		)
+	}
+
+	return results, nil
+}
@@ -7,8 +7,10 @@ import (
 	l "ResuMatch/pkg/logger"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,119 +22,131 @@ func NewNotificationRepository(db *sql.DB) (repository.NotificationRepository, e
 	return &NotificationRepository{DB: db}, nil
 }
 
-func (r *NotificationRepository) GetApplyNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error) {
-	requestID := utils.GetRequestID(ctx)
-
-	l.Log.WithFields(logrus.Fields{
-		"requestID": requestID,
-	}).Info("Выполнение sql-запроса получения уведомлений откликов GetApplyNotificationPreview")
-
-	query := `
-		SELECT 
-			n.id,
-			n.type,
-			n.sender_id,
-			n.receiver_id,
-			n.object_id,
-			n.resume_id,
-			n.is_viewed,
-			n.created_at,
-			a.first_name AS applicant_name,
-			e.company_name AS employer_name,
-			v.title
-		FROM notification n
-		LEFT JOIN applicant a ON n.sender_id = a.id
-		LEFT JOIN employer e ON n.receiver_id = e.id
-		LEFT JOIN vacancy v ON n.object_id = v.id
-		WHERE n.id = $1 AND n.type = 'apply'
-	`
-
-	var preview entity.NotificationPreview
+// notificationParticipantsJoin добавляет имена участников уведомления n. Они определяются
+// по роли отправителя, все остальное берется из payload
+const notificationParticipantsJoin = `
+	LEFT JOIN applicant a ON a.id = CASE WHEN n.sender_role = 'applicant' THEN n.sender_id ELSE n.receiver_id END
+	LEFT JOIN employer e ON e.id = CASE WHEN n.sender_role = 'employer' THEN n.sender_id ELSE n.receiver_id END
+`
+
+// notificationPreviewQuery собирает превью отдельных уведомлений любого типа, каждое как группу из одного
+const notificationPreviewQuery = `
+	SELECT
+		n.id,
+		n.type,
+		n.sender_id,
+		n.receiver_id,
+		n.object_id,
+		n.resume_id,
+		n.payload,
+		n.is_viewed,
+		n.created_at,
+		COALESCE(a.first_name, '') AS applicant_name,
+		COALESCE(e.company_name, '') AS employer_name,
+		COALESCE(n.group_id, n.id) AS group_id,
+		1 AS group_size
+	FROM notification n
+` + notificationParticipantsJoin
+
+// notificationGroupQuery собирает превью групп уведомлений, отобранных условием where по строкам g.
+// Превью группы строится по ее последнему уведомлению, группа прочитана, если прочитаны все ее уведомления.
+// having отбирает группы по агрегатам
+func notificationGroupQuery(where, having string) string {
+	return `
+	SELECT
+		n.id,
+		n.type,
+		n.sender_id,
+		n.receiver_id,
+		n.object_id,
+		n.resume_id,
+		n.payload,
+		s.is_viewed,
+		n.created_at,
+		COALESCE(a.first_name, '') AS applicant_name,
+		COALESCE(e.company_name, '') AS employer_name,
+		s.group_id,
+		s.group_size
+	FROM (
+		SELECT
+			COALESCE(g.group_id, g.id) AS group_id,
+			MAX(g.id) AS last_id,
+			COUNT(*) AS group_size,
+			bool_and(g.is_viewed) AS is_viewed
+		FROM notification g
+		WHERE ` + where + `
+		GROUP BY COALESCE(g.group_id, g.id)
+		HAVING ` + having + `
+	) s
+	JOIN notification n ON n.id = s.last_id
+` + notificationParticipantsJoin
+}
 
-	err := r.DB.QueryRowContext(ctx, query, notificationID).Scan(
+func scanNotificationPreview(scan func(dest ...any) error) (*entity.NotificationPreview, error) {
+	var (
+		preview entity.NotificationPreview
+		payload []byte
+	)
+	err := scan(
 		&preview.ID,
 		&preview.Type,
 		&preview.SenderID,
 		&preview.ReceiverID,
 		&preview.ObjectID,
 		&preview.ResumeID,
+		&payload,
 		&preview.IsViewed,
 		&preview.CreatedAt,
 		&preview.ApplicantName,
 		&preview.EmployerName,
-		&preview.Title,
+		&preview.GroupID,
+		&preview.GroupSize,
 	)
-
 	if err != nil {
-		l.Log.WithFields(logrus.Fields{
-			"requestID":      requestID,
-			"notificationID": notificationID,
-			"error":          err,
-		}).Error("Ошибка при получении уведомления")
-		return nil, entity.NewError(
-			entity.ErrNotFound,
-			fmt.Errorf("ошибка при получении уведомления: %v", err),
-		)
+		return nil, err
 	}
 
+	if err = json.Unmarshal(payload, &preview.Payload); err != nil {
+		return nil, fmt.Errorf("некорректный payload уведомления %d: %w", preview.ID, err)
+	}
 	return &preview, nil
 }
 
-func (r *NotificationRepository) GetDownloadResumeNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error) {
+// GetNotificationPreview возвращает превью группы, в которую попало уведомление, чтобы websocket-клиент
+// обновил уже показанную группу, а не добавлял еще одну строку
+func (r *NotificationRepository) GetNotificationPreview(ctx context.Context, notificationID int) (*entity.NotificationPreview, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
 		"requestID":      requestID,
 		"notificationID": notificationID,
-	}).Info("Выполнение sql-запроса получения уведомлений о скачивании резюме GetDownloadResumeNotificationPreview")
-
-	query := `
-		SELECT 
-			n.id,
-			n.type,
-			n.sender_id,
-			n.receiver_id,
-			n.object_id,
-			n.resume_id,
-			n.is_viewed,
-			n.created_at,
-			a.first_name AS applicant_name,
-			e.company_name AS employer_name,
-			r.profession AS title
-		FROM notification n
-		LEFT JOIN resume r ON r.id = n.object_id
-		LEFT JOIN applicant a ON a.id = r.applicant_id
-		LEFT JOIN employer e ON e.id = n.sender_id
-		WHERE n.id = $1 AND n.type = 'download_resume'
-	`
+	}).Info("Выполнение sql-запроса получения превью уведомления GetNotificationPreview")
 
-	var preview entity.NotificationPreview
-	err := r.DB.QueryRowContext(ctx, query, notificationID).Scan(
-		&preview.ID,
-		&preview.Type,
-		&preview.SenderID,
-		&preview.ReceiverID,
-		&preview.ObjectID,
-		&preview.ResumeID,
-		&preview.IsViewed,
-		&preview.CreatedAt,
-		&preview.ApplicantName,
-		&preview.EmployerName,
-		&preview.Title,
+	query := notificationGroupQuery(
+		`COALESCE(g.group_id, g.id) = (SELECT COALESCE(group_id, id) FROM notification WHERE id = $1)`,
+		`TRUE`,
 	)
 
+	preview, err := scanNotificationPreview(r.DB.QueryRowContext(ctx, query, notificationID).Scan)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("уведомление с id=%d не найдено", notificationID),
+			)
+		}
 		l.Log.WithFields(logrus.Fields{
-			"requestID": requestID,
-			"error":     err,
-		}).Error("Ошибка при выполнении запроса GetDownloadResumeNotificationPreview")
+			"requestID":      requestID,
+			"notificationID": notificationID,
+			"error":          err,
+		}).Error("Ошибка при получении превью уведомления")
 		return nil, entity.NewError(
-			entity.ErrNotFound,
-			fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", err),
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении превью уведомления: %w", err),
 		)
 	}
 
-	return &preview, nil
+	return preview, nil
 }
 
 func (r *NotificationRepository) GetNotificationByID(ctx context.Context, notificationID int) (*entity.Notification, error) {
@@ -153,6 +167,7 @@ func (r *NotificationRepository) GetNotificationByID(ctx context.Context, notifi
 			receiver_role,
 			object_id,
 			resume_id,
+			COALESCE(group_id, id),
 			is_viewed,
 			created_at
 		FROM notification
@@ -171,6 +186,7 @@ func (r *NotificationRepository) GetNotificationByID(ctx context.Context, notifi
 		&n.ReceiverRole,
 		&n.ObjectID,
 		&n.ResumeID,
+		&n.GroupID,
 		&n.IsViewed,
 		&n.CreatedAt,
 	)
@@ -198,6 +214,9 @@ func (r *NotificationRepository) GetNotificationByID(ctx context.Context, notifi
 	return &n, nil
 }
 
+// CreateNotification сохраняет уведомление. Если тип группируется и у получателя уже есть группа
+// того же типа об этом объекте, начатая в пределах окна, уведомление добавляется в нее.
+// Уведомление по уже обработанному событию outbox не создается повторно, возвращается сохраненное
 func (r *NotificationRepository) CreateNotification(ctx context.Context, notification *entity.Notification) error {
 	requestID := utils.GetRequestID(ctx)
 
@@ -206,20 +225,57 @@ func (r *NotificationRepository) CreateNotification(ctx context.Context, notific
 	}).Info("Выполнение sql-запроса создания уведомления CreateNotification")
 
 	query := `
-		INSERT INTO notification (
-			type,
-			sender_id,
-		    sender_role,
-			receiver_id,
-		    receiver_role,
-			object_id,
-		    resume_id,
-			is_viewed
+		WITH inserted AS (
+			INSERT INTO notification (
+				type,
+				sender_id,
+				sender_role,
+				receiver_id,
+				receiver_role,
+				object_id,
+				resume_id,
+				payload,
+				is_viewed,
+				group_id,
+				outbox_event_id
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, (
+				SELECT h.id
+				FROM notification h
+				WHERE $10 > 0
+				  AND h.receiver_id = $4 AND h.receiver_role = $5
+				  AND h.type = $1 AND h.object_id = $6
+				  AND h.group_id IS NULL
+				  AND h.created_at > NOW() - make_interval(secs => $10)
+				ORDER BY h.created_at DESC
+				LIMIT 1
+			), NULLIF($11, 0))
+			ON CONFLICT (outbox_event_id) DO NOTHING
+			RETURNING id, COALESCE(group_id, id) AS group_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id
+		SELECT id, group_id FROM inserted
+		UNION ALL
+		SELECT id, COALESCE(group_id, id)
+		FROM notification
+		WHERE outbox_event_id = $11 AND NOT EXISTS (SELECT 1 FROM inserted)
 	`
 
+	var window float64
+	if spec, ok := entity.LookupNotificationType(notification.Type); ok {
+		window = spec.GroupWindow.Seconds()
+	}
+
+	payload := []byte("{}")
+	if notification.Payload != nil {
+		var err error
+		if payload, err = json.Marshal(notification.Payload); err != nil {
+			return entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректный payload уведомления: %w", err),
+			)
+		}
+	}
+
 	err := r.DB.QueryRow(
 		query,
 		notification.Type,
@@ -229,8 +285,11 @@ func (r *NotificationRepository) CreateNotification(ctx context.Context, notific
 		notification.ReceiverRole,
 		notification.ObjectID,
 		notification.ResumeID,
+		payload,
 		notification.IsViewed,
-	).Scan(&notification.ID)
+		window,
+		notification.OutboxEventID,
+	).Scan(&notification.ID, &notification.GroupID)
 
 	if err != nil {
 		l.Log.WithFields(logrus.Fields{
@@ -250,6 +309,7 @@ func (r *NotificationRepository) CreateNotification(ctx context.Context, notific
 		"notification_id": notification.ID,
 		"type":            notification.Type,
 		"receiver_id":     notification.ReceiverID,
+		"group_id":        notification.GroupID,
 	}).Info("Уведомление успешно создано")
 
 	return nil
@@ -315,21 +375,7 @@ func (r *NotificationRepository) ReadAllNotifications(ctx context.Context, userI
 		"role":      role,
 	}).Info("Выполнение sql-запроса ReadAllNotifications")
 
-	var query string
-	switch role {
-	case "applicant":
-		query = `
-			UPDATE notification
-			SET is_viewed = true
-			WHERE receiver_id = $1 AND type = 'download_resume'
-		`
-	case "employer":
-		query = `
-			UPDATE notification
-			SET is_viewed = true
-			WHERE receiver_id = $1 AND type = 'apply'
-		`
-	default:
+	if _, ok := entity.AllowedUserRoles[role]; !ok {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
 			"role":      role,
@@ -340,7 +386,13 @@ func (r *NotificationRepository) ReadAllNotifications(ctx context.Context, userI
 		)
 	}
 
-	_, err := r.DB.ExecContext(ctx, query, userID)
+	query := `
+		UPDATE notification
+		SET is_viewed = true
+		WHERE receiver_id = $1 AND receiver_role = $2
+	`
+
+	_, err := r.DB.ExecContext(ctx, query, userID, role)
 	if err != nil {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
@@ -364,19 +416,7 @@ func (r *NotificationRepository) DeleteAllNotifications(ctx context.Context, use
 		"role":      role,
 	}).Info("Выполнение sql-запроса DeleteAllNotifications")
 
-	var query string
-	switch role {
-	case "applicant":
-		query = `
-			DELETE FROM notification
-			WHERE receiver_id = $1 AND type = 'download_resume'
-		`
-	case "employer":
-		query = `
-			DELETE FROM notification
-			WHERE receiver_id = $1 AND type = 'apply'
-		`
-	default:
+	if _, ok := entity.AllowedUserRoles[role]; !ok {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
 			"role":      role,
@@ -387,7 +427,12 @@ func (r *NotificationRepository) DeleteAllNotifications(ctx context.Context, use
 		)
 	}
 
-	_, err := r.DB.ExecContext(ctx, query, userID)
+	query := `
+		DELETE FROM notification
+		WHERE receiver_id = $1 AND receiver_role = $2
+	`
+
+	_, err := r.DB.ExecContext(ctx, query, userID, role)
 	if err != nil {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
@@ -402,45 +447,68 @@ func (r *NotificationRepository) DeleteAllNotifications(ctx context.Context, use
 	return nil
 }
 
-func (r *NotificationRepository) GetApplyNotificationsForUser(ctx context.Context, userID int) ([]*entity.NotificationPreview, error) {
+// GetNotificationsForUser возвращает страницу групп уведомлений всех типов, адресованных пользователю с ролью role.
+// Фильтр уже проверен в сервисе, AfterID и IsViewed относятся к группе целиком
+func (r *NotificationRepository) GetNotificationsForUser(ctx context.Context, userID int, role entity.UserRole, filter entity.NotificationFilter) ([]*entity.NotificationPreview, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
 		"requestID": requestID,
 		"userID":    userID,
-	}).Info("Выполнение sql-запроса получения всех уведомлений откликов для пользователя GetApplyNotificationsForUser")
+		"role":      role,
+		"filter":    filter,
+	}).Info("Выполнение sql-запроса получения уведомлений пользователя GetNotificationsForUser")
 
-	query := `
-		SELECT 
-			n.id,
-			n.type,
-			n.sender_id,
-			n.receiver_id,
-			n.object_id,
-			n.resume_id,
-			n.is_viewed,
-			n.created_at,
-			a.first_name AS applicant_name,
-			e.company_name AS employer_name,
-			v.title
-		FROM notification n
-		LEFT JOIN applicant a ON n.sender_id = a.id
-		LEFT JOIN employer e ON n.receiver_id = e.id
-		LEFT JOIN vacancy v ON n.object_id = v.id
-		WHERE n.type = 'apply' AND n.receiver_id = $1
-		ORDER BY n.created_at DESC
+	order := "n.created_at DESC, n.id DESC"
+	if filter.AfterID > 0 {
+		order = "n.id ASC"
+	}
+	query := notificationGroupQuery(
+		`g.receiver_id = $1 AND g.receiver_role = $2
+		  AND (cardinality($3::TEXT[]) = 0 OR g.type = ANY($3))`,
+		`($4::BOOLEAN IS NULL OR bool_and(g.is_viewed) = $4)
+		  AND MAX(g.id) > $5`,
+	) + `
+		ORDER BY ` + order + `
+		LIMIT $6 OFFSET $7
 	`
 
-	rows, err := r.DB.QueryContext(ctx, query, userID)
+	types := make([]string, 0, len(filter.Types))
+	for _, notificationType := range filter.Types {
+		types = append(types, string(notificationType))
+	}
+
+	return r.queryNotificationPreviews(ctx, "GetNotificationsForUser", query, userID, role, pq.Array(types), filter.IsViewed, filter.AfterID, filter.Limit, filter.Offset)
+}
+
+// GetGroupNotifications возвращает страницу уведомлений группы groupID от новых к старым
+func (r *NotificationRepository) GetGroupNotifications(ctx context.Context, groupID, limit, offset int) ([]*entity.NotificationPreview, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID": utils.GetRequestID(ctx),
+		"groupID":   groupID,
+	}).Info("Выполнение sql-запроса получения уведомлений группы GetGroupNotifications")
+
+	query := notificationPreviewQuery + `
+		WHERE COALESCE(n.group_id, n.id) = $1
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return r.queryNotificationPreviews(ctx, "GetGroupNotifications", query, groupID, limit, offset)
+}
+
+func (r *NotificationRepository) queryNotificationPreviews(ctx context.Context, method, query string, args ...any) ([]*entity.NotificationPreview, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
-			"userID":    userID,
 			"error":     err,
-		}).Error("Ошибка при выполнении запроса GetApplyNotificationsForUser")
+		}).Errorf("Ошибка при выполнении запроса %s", method)
 		return nil, entity.NewError(
 			entity.ErrInternal,
-			fmt.Errorf("ошибка при выполнении запроса GetApplyNotificationsForUser: %v", err),
+			fmt.Errorf("ошибка при выполнении запроса %s: %v", method, err),
 		)
 	}
 
@@ -456,20 +524,7 @@ func (r *NotificationRepository) GetApplyNotificationsForUser(ctx context.Contex
 	var notifications []*entity.NotificationPreview
 
 	for rows.Next() {
-		var preview entity.NotificationPreview
-		err := rows.Scan(
-			&preview.ID,
-			&preview.Type,
-			&preview.SenderID,
-			&preview.ReceiverID,
-			&preview.ObjectID,
-			&preview.ResumeID,
-			&preview.IsViewed,
-			&preview.CreatedAt,
-			&preview.ApplicantName,
-			&preview.EmployerName,
-			&preview.Title,
-		)
+		preview, err := scanNotificationPreview(rows.Scan)
 		if err != nil {
 			l.Log.WithFields(logrus.Fields{
 				"requestID": requestID,
@@ -477,10 +532,10 @@ func (r *NotificationRepository) GetApplyNotificationsForUser(ctx context.Contex
 			}).Error("Ошибка при сканировании строки результата")
 			return nil, entity.NewError(
 				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании строки результата запроса GetApplyNotificationsForUser: %v", err),
+				fmt.Errorf("ошибка при сканировании строки результата запроса %s: %v", method, err),
 			)
 		}
-		notifications = append(notifications, &preview)
+		notifications = append(notifications, preview)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -490,54 +545,41 @@ func (r *NotificationRepository) GetApplyNotificationsForUser(ctx context.Contex
 		}).Error("Ошибка при обходе результатов запроса")
 		return nil, entity.NewError(
 			entity.ErrInternal,
-			fmt.Errorf("ошибка при обходе результатов запроса GetApplyNotificationsForUser: %v", err),
+			fmt.Errorf("ошибка при обходе результатов запроса %s: %v", method, err),
 		)
 	}
 
 	return notifications, nil
 }
 
-func (r *NotificationRepository) GetDownloadResumeNotificationsForUser(ctx context.Context, userID int) ([]*entity.NotificationPreview, error) {
+// CountUnreadNotifications возвращает число непрочитанных уведомлений пользователя по типам
+func (r *NotificationRepository) CountUnreadNotifications(ctx context.Context, userID int, role entity.UserRole) (map[entity.NotificationType]int, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
 		"requestID": requestID,
 		"userID":    userID,
-	}).Info("Выполнение sql-запроса получения всех уведомлений о скачивании резюме для пользователя")
+		"role":      role,
+	}).Info("Выполнение sql-запроса подсчета непрочитанных уведомлений CountUnreadNotifications")
 
 	query := `
-		SELECT 
-			n.id,
-			n.type,
-			n.sender_id,
-			n.receiver_id,
-			n.object_id,
-			n.resume_id,
-			n.is_viewed,
-			n.created_at,
-			a.first_name AS applicant_name,
-			e.company_name AS employer_name,
-			r.profession AS title
-		FROM notification n
-		LEFT JOIN resume r ON r.id = n.object_id
-		LEFT JOIN applicant a ON a.id = r.applicant_id
-		LEFT JOIN employer e ON e.id = n.sender_id
-		WHERE n.receiver_id = $1 AND n.type = 'download_resume'
-		ORDER BY n.created_at DESC
+		SELECT type, COUNT(*)
+		FROM notification
+		WHERE receiver_id = $1 AND receiver_role = $2 AND NOT is_viewed
+		GROUP BY type
 	`
 
-	rows, err := r.DB.QueryContext(ctx, query, userID)
+	rows, err := r.DB.QueryContext(ctx, query, userID, role)
 	if err != nil {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
 			"error":     err,
-		}).Error("Ошибка при выполнении запроса GetDownloadResumeNotificationsForUser")
+		}).Error("Ошибка при выполнении запроса CountUnreadNotifications")
 		return nil, entity.NewError(
 			entity.ErrInternal,
-			fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationsForUser: %v", err),
+			fmt.Errorf("ошибка при подсчете непрочитанных уведомлений: %w", err),
 		)
 	}
-
 	defer func(rows *sql.Rows) {
 		err := rows.Close()
 		if err != nil {
@@ -547,46 +589,167 @@ func (r *NotificationRepository) GetDownloadResumeNotificationsForUser(ctx conte
 		}
 	}(rows)
 
-	var notifications []*entity.NotificationPreview
-
+	counts := make(map[entity.NotificationType]int)
 	for rows.Next() {
-		var preview entity.NotificationPreview
-		err := rows.Scan(
-			&preview.ID,
-			&preview.Type,
-			&preview.SenderID,
-			&preview.ReceiverID,
-			&preview.ObjectID,
-			&preview.ResumeID,
-			&preview.IsViewed,
-			&preview.CreatedAt,
-			&preview.ApplicantName,
-			&preview.EmployerName,
-			&preview.Title,
+		var (
+			notificationType entity.NotificationType
+			count            int
 		)
-		if err != nil {
-			l.Log.WithFields(logrus.Fields{
-				"requestID": requestID,
-				"error":     err,
-			}).Error("Ошибка при сканировании результата GetDownloadResumeNotificationsForUser")
+		if err := rows.Scan(&notificationType, &count); err != nil {
 			return nil, entity.NewError(
 				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании результата запроса GetDownloadResumeNotificationsForUser: %v", err),
+				fmt.Errorf("ошибка при чтении числа непрочитанных уведомлений: %w", err),
 			)
 		}
-		notifications = append(notifications, &preview)
+		counts[notificationType] = count
 	}
-
 	if err := rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при обходе числа непрочитанных уведомлений: %w", err),
+		)
+	}
+
+	return counts, nil
+}
+
+// GetLastNotificationID возвращает id последнего уведомления пользователя или 0, если уведомлений нет
+func (r *NotificationRepository) GetLastNotificationID(ctx context.Context, userID int, role entity.UserRole) (int, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID": utils.GetRequestID(ctx),
+		"userID":    userID,
+		"role":      role,
+	}).Info("Выполнение sql-запроса получения последнего уведомления пользователя GetLastNotificationID")
+
+	query := `
+		SELECT COALESCE(MAX(id), 0)
+		FROM notification
+		WHERE receiver_id = $1 AND receiver_role = $2
+	`
+
+	var lastID int
+	if err := r.DB.QueryRowContext(ctx, query, userID, role).Scan(&lastID); err != nil {
+		return 0, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении последнего уведомления пользователя: %w", err),
+		)
+	}
+	return lastID, nil
+}
+
+// GetEventPreview собирает превью уведомления из события outbox для письма и websocket. Уведомление могло
+// не сохраниться на сайте, поэтому имена участников берутся по отправителю и получателю, а размер группы -
+// по сохраненному уведомлению того же события. Без сохраненного уведомления превью считается одиночным
+func (r *NotificationRepository) GetEventPreview(ctx context.Context, notification *entity.Notification) (*entity.NotificationPreview, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":     utils.GetRequestID(ctx),
+		"outboxEventID": notification.OutboxEventID,
+		"receiverID":    notification.ReceiverID,
+	}).Info("Выполнение sql-запроса получения превью уведомления по событию GetEventPreview")
+
+	query := `
+		WITH stored AS (
+			SELECT id, COALESCE(group_id, id) AS group_id
+			FROM notification
+			WHERE outbox_event_id = $4
+		)
+		SELECT
+			COALESCE(a.first_name, '') AS applicant_name,
+			COALESCE(e.company_name, '') AS employer_name,
+			GREATEST((
+				SELECT COUNT(*)
+				FROM notification g
+				JOIN stored s ON COALESCE(g.group_id, g.id) = s.group_id AND g.id <= s.id
+			), 1) AS group_size
+		FROM (SELECT $1::int AS sender_id, $2::text AS sender_role, $3::int AS receiver_id) n
+` + notificationParticipantsJoin
+
+	preview := &entity.NotificationPreview{
+		Type:       notification.Type,
+		SenderID:   notification.SenderID,
+		ReceiverID: notification.ReceiverID,
+		ObjectID:   notification.ObjectID,
+		ResumeID:   notification.ResumeID,
+		Payload:    notification.Payload,
+		CreatedAt:  notification.CreatedAt,
+	}
+	err := r.DB.QueryRowContext(ctx, query,
+		notification.SenderID,
+		notification.SenderRole,
+		notification.ReceiverID,
+		notification.OutboxEventID,
+	).Scan(&preview.ApplicantName, &preview.EmployerName, &preview.GroupSize)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении превью уведомления по событию: %w", err),
+		)
+	}
+	return preview, nil
+}
+
+func (r *NotificationRepository) DeleteNotification(ctx context.Context, notificationID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":      requestID,
+		"notificationID": notificationID,
+	}).Info("Выполнение sql-запроса DeleteNotification")
+
+	result, err := r.DB.ExecContext(ctx, `DELETE FROM notification WHERE id = $1`, notificationID)
+	if err != nil {
 		l.Log.WithFields(logrus.Fields{
 			"requestID": requestID,
 			"error":     err,
-		}).Error("ошибка после итерации по строкам GetDownloadResumeNotificationsForUser")
-		return nil, entity.NewError(
+		}).Error("Ошибка при выполнении DeleteNotification")
+		return entity.NewError(
 			entity.ErrInternal,
-			fmt.Errorf("ошибка после итерации по строкам запроса GetDownloadResumeNotificationsForUser: %v", err),
+			fmt.Errorf("ошибка при удалении уведомления: %w", err),
 		)
 	}
 
-	return notifications, nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("не удалось получить число удаленных уведомлений: %w", err),
+		)
+	}
+	if rowsAffected == 0 {
+		return entity.NewError(
+			entity.ErrNotFound,
+			fmt.Errorf("уведомление с id=%d не найдено", notificationID),
+		)
+	}
+
+	return nil
+}
+
+// ReadNotificationGroup отмечает прочитанными все уведомления группы groupID
+func (r *NotificationRepository) ReadNotificationGroup(ctx context.Context, groupID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"groupID":   groupID,
+	}).Info("Выполнение sql-запроса ReadNotificationGroup")
+
+	query := `
+		UPDATE notification
+		SET is_viewed = true
+		WHERE COALESCE(group_id, id) = $1 AND NOT is_viewed
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, groupID); err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("Ошибка при выполнении ReadNotificationGroup")
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при отметке группы уведомлений прочитанной: %w", err),
+		)
+	}
+
+	return nil
 }
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/utils"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+type NotificationSettingsRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationSettingsRepository(db *sql.DB) repository.NotificationSettingsRepository {
+	return &NotificationSettingsRepository{
+		db: db,
+	}
+}
+
+// GetNotificationSettings возвращает настройки вместе с адресом почты пользователя,
+// на который отправляются уведомления по каналу email
+func (r *NotificationSettingsRepository) GetNotificationSettings(ctx context.Context, userID int, role entity.UserRole) (*entity.NotificationSettings, error) {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"userID":    userID,
+		"role":      role,
+	}).Info("Выполнение sql-запроса получения настроек уведомлений GetNotificationSettings")
+
+	isApplicant := role == entity.ApplicantRole
+	settings := &entity.NotificationSettings{UserID: userID, Role: role}
+
+	var start, end, timeZone sql.NullString
+	err := r.db.QueryRowContext(ctx, `
+	SELECT COALESCE(a.email, e.email, ''), to_char(q.start_time, 'HH24:MI'), to_char(q.end_time, 'HH24:MI'), q.time_zone
+	FROM (SELECT $1::INTEGER AS user_id, $2::BOOLEAN AS is_applicant) u
+	LEFT JOIN applicant a ON u.is_applicant AND a.id = u.user_id
+	LEFT JOIN employer e ON NOT u.is_applicant AND e.id = u.user_id
+	LEFT JOIN notification_quiet_hours q ON q.user_id = u.user_id AND q.is_applicant = u.is_applicant
+	`, userID, isApplicant).Scan(&settings.Email, &start, &end, &timeZone)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении тихих часов: %w", err),
+		)
+	}
+	if start.Valid {
+		settings.QuietHours = &entity.QuietHours{Start: start.String, End: end.String, TimeZone: timeZone.String}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT type, channel, enabled
+	FROM notification_preference
+	WHERE user_id = $1 AND is_applicant = $2
+	`, userID, isApplicant)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении настроек уведомлений: %w", err),
+		)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     closeErr,
+			}).Error("Ошибка при закрытии строк настроек уведомлений")
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			notificationType entity.NotificationType
+			channel          entity.NotificationChannel
+			enabled          bool
+		)
+		if err = rows.Scan(&notificationType, &channel, &enabled); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при чтении настройки уведомлений: %w", err),
+			)
+		}
+		settings.Set(notificationType, channel, enabled)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при переборе настроек уведомлений: %w", err),
+		)
+	}
+	return settings, nil
+}
+
+// UpdateNotificationSettings сохраняет переданные каналы и заменяет тихие часы в одной транзакции.
+// Каналы, которых нет в settings.Channels, остаются прежними
+func (r *NotificationSettingsRepository) UpdateNotificationSettings(ctx context.Context, settings *entity.NotificationSettings) error {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"userID":    settings.UserID,
+		"role":      settings.Role,
+	}).Info("Выполнение sql-запроса изменения настроек уведомлений UpdateNotificationSettings")
+
+	isApplicant := settings.Role == entity.ApplicantRole
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции изменения настроек уведомлений: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции изменения настроек уведомлений")
+			}
+		}
+	}()
+
+	// Порядок записи фиксирован, чтобы параллельные изменения не блокировали строки друг друга крест-накрест
+	types := make([]entity.NotificationType, 0, len(settings.Channels))
+	for notificationType := range settings.Channels {
+		types = append(types, notificationType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	for _, notificationType := range types {
+		channels := make([]entity.NotificationChannel, 0, len(settings.Channels[notificationType]))
+		for channel := range settings.Channels[notificationType] {
+			channels = append(channels, channel)
+		}
+		sort.Slice(channels, func(i, j int) bool { return channels[i] < channels[j] })
+
+		for _, channel := range channels {
+			_, err = tx.ExecContext(ctx, `
+			INSERT INTO notification_preference (user_id, is_applicant, type, channel, enabled)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (user_id, is_applicant, type, channel) DO UPDATE
+			SET enabled = EXCLUDED.enabled,
+			    updated_at = NOW()
+			`, settings.UserID, isApplicant, notificationType, channel, settings.Channels[notificationType][channel])
+			if err != nil {
+				return entity.NewError(
+					entity.ErrInternal,
+					fmt.Errorf("ошибка при сохранении настройки уведомлений: %w", err),
+				)
+			}
+		}
+	}
+
+	if settings.QuietHours == nil {
+		_, err = tx.ExecContext(ctx, `
+		DELETE FROM notification_quiet_hours
+		WHERE user_id = $1 AND is_applicant = $2
+		`, settings.UserID, isApplicant)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO notification_quiet_hours (user_id, is_applicant, start_time, end_time, time_zone)
+		VALUES ($1, $2, $3::TIME, $4::TIME, $5)
+		ON CONFLICT (user_id, is_applicant) DO UPDATE
+		SET start_time = EXCLUDED.start_time,
+		    end_time = EXCLUDED.end_time,
+		    time_zone = EXCLUDED.time_zone,
+		    updated_at = NOW()
+		`, settings.UserID, isApplicant, settings.QuietHours.Start, settings.QuietHours.End, settings.QuietHours.TimeZone)
+	}
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при сохранении тихих часов: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции изменения настроек уведомлений: %w", err),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationSettingsRepository_GetNotificationSettings(t *testing.T) {
+	t.Parallel()
+
+	quietHoursQuery := regexp.QuoteMeta(`SELECT COALESCE(a.email, e.email, ''), to_char(q.start_time, 'HH24:MI')`)
+	preferencesQuery := regexp.QuoteMeta(`SELECT type, channel, enabled`)
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult *entity.NotificationSettings
+		expectedErr    error
+	}{
+		{
+			name: "Измененные каналы и тихие часы",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(quietHoursQuery).WithArgs(1, false).
+					WillReturnRows(sqlmock.NewRows([]string{"email", "start", "end", "time_zone"}).
+						AddRow("employer@mail.ru", "22:00", "08:00", "Europe/Moscow"))
+				mock.ExpectQuery(preferencesQuery).WithArgs(1, false).
+					WillReturnRows(sqlmock.NewRows([]string{"type", "channel", "enabled"}).
+						AddRow("apply", "websocket", false).
+						AddRow("apply", "email", true))
+			},
+			expectedResult: &entity.NotificationSettings{
+				UserID: 1,
+				Role:   entity.EmployerRole,
+				Email:  "employer@mail.ru",
+				Channels: map[entity.NotificationType]map[entity.NotificationChannel]bool{
+					entity.ApplyNotificationType: {entity.WebsocketChannel: false, entity.EmailChannel: true},
+				},
+				QuietHours: &entity.QuietHours{Start: "22:00", End: "08:00", TimeZone: "Europe/Moscow"},
+			},
+		},
+		{
+			name: "Пользователь ничего не настраивал",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(quietHoursQuery).WithArgs(1, false).
+					WillReturnRows(sqlmock.NewRows([]string{"email", "start", "end", "time_zone"}).
+						AddRow("employer@mail.ru", nil, nil, nil))
+				mock.ExpectQuery(preferencesQuery).WithArgs(1, false).
+					WillReturnRows(sqlmock.NewRows([]string{"type", "channel", "enabled"}))
+			},
+			expectedResult: &entity.NotificationSettings{UserID: 1, Role: entity.EmployerRole, Email: "employer@mail.ru"},
+		},
+		{
+			name: "Ошибка запроса",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(quietHoursQuery).WithArgs(1, false).WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &NotificationSettingsRepository{db: db}
+			result, err := repo.GetNotificationSettings(context.Background(), 1, entity.EmployerRole)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationSettingsRepository_UpdateNotificationSettings(t *testing.T) {
+	t.Parallel()
+
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO notification_preference (user_id, is_applicant, type, channel, enabled)`)
+
+	testCases := []struct {
+		name        string
+		settings    *entity.NotificationSettings
+		setupMock   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name: "Каналы сохраняются по порядку, тихие часы заменяются",
+			settings: &entity.NotificationSettings{
+				UserID: 2,
+				Role:   entity.ApplicantRole,
+				Channels: map[entity.NotificationType]map[entity.NotificationChannel]bool{
+					entity.InvitationType:     {entity.WebsocketChannel: false, entity.EmailChannel: true},
+					entity.DownloadResumeType: {entity.InAppChannel: false},
+				},
+				QuietHours: &entity.QuietHours{Start: "23:00", End: "07:30", TimeZone: "Asia/Yekaterinburg"},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(upsertQuery).WithArgs(2, true, entity.DownloadResumeType, entity.InAppChannel, false).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(upsertQuery).WithArgs(2, true, entity.InvitationType, entity.EmailChannel, true).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(upsertQuery).WithArgs(2, true, entity.InvitationType, entity.WebsocketChannel, false).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO notification_quiet_hours`)).
+					WithArgs(2, true, "23:00", "07:30", "Asia/Yekaterinburg").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "Без тихих часов они удаляются",
+			settings: &entity.NotificationSettings{UserID: 2, Role: entity.ApplicantRole},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM notification_quiet_hours`)).WithArgs(2, true).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Ошибка сохранения откатывает транзакцию",
+			settings: &entity.NotificationSettings{
+				UserID:   2,
+				Role:     entity.ApplicantRole,
+				Channels: map[entity.NotificationType]map[entity.NotificationChannel]bool{entity.InvitationType: {entity.EmailChannel: true}},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(upsertQuery).WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &NotificationSettingsRepository{db: db}
+			err = repo.UpdateNotificationSettings(context.Background(), tc.settings)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
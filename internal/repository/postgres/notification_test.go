@@ -14,33 +14,17 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestNotificationRepository_GetApplyNotificationPreview(t *testing.T) {
+func TestNotificationRepository_GetNotificationPreview(t *testing.T) {
 	t.Parallel()
 
-	query := regexp.QuoteMeta(`
-		SELECT 
-			n.id,
-			n.type,
-			n.sender_id,
-			n.receiver_id,
-			n.object_id,
-			n.resume_id,
-			n.is_viewed,
-			n.created_at,
-			a.first_name AS applicant_name,
-			e.company_name AS employer_name,
-			v.title
-		FROM notification n
-		LEFT JOIN applicant a ON n.sender_id = a.id
-		LEFT JOIN employer e ON n.receiver_id = e.id
-		LEFT JOIN vacancy v ON n.object_id = v.id
-		WHERE n.id = $1 AND n.type = 'apply'
-	`)
+	query := regexp.QuoteMeta(notificationGroupQuery(
+		`COALESCE(g.group_id, g.id) = (SELECT COALESCE(group_id, id) FROM notification WHERE id = $1)`,
+		`TRUE`,
+	))
 
 	columns := []string{
-		"id", "type", "sender_id", "receiver_id", "object_id",
-		"resume_id", "is_viewed", "created_at", "applicant_name",
-		"employer_name", "title",
+		"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
+		"payload", "is_viewed", "created_at", "applicant_name", "employer_name", "group_id", "group_size",
 	}
 
 	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
@@ -50,10 +34,10 @@ func TestNotificationRepository_GetApplyNotificationPreview(t *testing.T) {
 		notificationID int
 		expectedResult *entity.NotificationPreview
 		expectedErr    error
-		setupMock      func(mock sqlmock.Sqlmock, notificationID int)
+		setupMock      func(mock sqlmock.Sqlmock)
 	}{
 		{
-			name:           "Успешное получение превью уведомления о отклике",
+			name:           "Превью с payload и именами участников",
 			notificationID: 1,
 			expectedResult: &entity.NotificationPreview{
 				ID:            1,
@@ -62,273 +46,96 @@ func TestNotificationRepository_GetApplyNotificationPreview(t *testing.T) {
 				ReceiverID:    200,
 				ObjectID:      300,
 				ResumeID:      400,
-				IsViewed:      false,
+				Payload:       entity.NotificationPayload{"vacancy_title": "Backend Developer"},
 				CreatedAt:     fixedTime,
 				ApplicantName: "Иван",
 				EmployerName:  "ООО Рога и Копыта",
-				Title:         "Backend Developer",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						1, "apply", 100, 200, 300, 400, false, fixedTime,
-						"Иван", "ООО Рога и Копыта", "Backend Developer",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Успешное получение превью с просмотренным уведомлением",
-			notificationID: 2,
-			expectedResult: &entity.NotificationPreview{
-				ID:            2,
-				Type:          entity.ApplyNotificationType,
-				SenderID:      101,
-				ReceiverID:    201,
-				ObjectID:      301,
-				ResumeID:      401,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Петр",
-				EmployerName:  "ИП Сидоров",
-				Title:         "Frontend Developer",
+				GroupID:       1,
+				GroupSize:     1,
 			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						2, "apply", 101, 201, 301, 401, true, fixedTime,
-						"Петр", "ИП Сидоров", "Frontend Developer",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(
+						1, "apply", 100, 200, 300, 400, []byte(`{"vacancy_title": "Backend Developer"}`),
+						false, fixedTime, "Иван", "ООО Рога и Копыта", 1, 1,
+					))
 			},
 		},
 		{
-			name:           "Превью с пустыми значениями в JOIN полях",
-			notificationID: 3,
+			name:           "Уведомление попало в группу - возвращается вся группа",
+			notificationID: 12,
 			expectedResult: &entity.NotificationPreview{
-				ID:            3,
+				ID:            12,
 				Type:          entity.ApplyNotificationType,
-				SenderID:      102,
-				ReceiverID:    202,
-				ObjectID:      302,
-				ResumeID:      402,
-				IsViewed:      false,
+				SenderID:      100,
+				ReceiverID:    200,
+				ObjectID:      300,
+				ResumeID:      400,
+				Payload:       entity.NotificationPayload{"vacancy_title": "Backend Developer"},
 				CreatedAt:     fixedTime,
-				ApplicantName: "",
-				EmployerName:  "",
-				Title:         "",
+				ApplicantName: "Иван",
+				EmployerName:  "ООО Рога и Копыта",
+				GroupID:       1,
+				GroupSize:     12,
 			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						3, "apply", 102, 202, 302, 402, false, fixedTime,
-						"", "", "",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(12).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(
+						12, "apply", 100, 200, 300, 400, []byte(`{"vacancy_title": "Backend Developer"}`),
+						false, fixedTime, "Иван", "ООО Рога и Копыта", 1, 12,
+					))
 			},
 		},
 		{
-			name:           "Превью с частично заполненными JOIN полями",
-			notificationID: 4,
+			name:           "Тип, о котором репозиторий ничего не знает",
+			notificationID: 1,
 			expectedResult: &entity.NotificationPreview{
-				ID:            4,
-				Type:          entity.ApplyNotificationType,
-				SenderID:      103,
-				ReceiverID:    203,
-				ObjectID:      303,
-				ResumeID:      403,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Анна",
-				EmployerName:  "",
-				Title:         "DevOps Engineer",
+				ID:           1,
+				Type:         "status_changed",
+				SenderID:     200,
+				ReceiverID:   100,
+				ObjectID:     300,
+				Payload:      entity.NotificationPayload{"status": "accepted"},
+				IsViewed:     true,
+				CreatedAt:    fixedTime,
+				EmployerName: "ООО Рога и Копыта",
+				GroupID:      1,
+				GroupSize:    1,
 			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						4, "apply", 103, 203, 303, 403, true, fixedTime,
-						"Анна", "", "DevOps Engineer",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(
+						1, "status_changed", 200, 100, 300, 0, []byte(`{"status": "accepted"}`),
+						true, fixedTime, "", "ООО Рога и Копыта", 1, 1,
+					))
 			},
 		},
 		{
 			name:           "Уведомление не найдено",
-			notificationID: 999,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при получении уведомления: %v", sql.ErrNoRows),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnError(sql.ErrNoRows)
-			},
-		},
-		{
-			name:           "Ошибка базы данных при выполнении запроса",
-			notificationID: 5,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при получении уведомления: %v", errors.New("connection lost")),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnError(errors.New("connection lost"))
-			},
-		},
-		{
-			name:           "Ошибка сканирования - неверный тип данных",
-			notificationID: 6,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при получении уведомления: %v", errors.New(`sql: Scan error on column index 0, name "id": converting driver.Value type string ("invalid_id") to a int: invalid syntax`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						"invalid_id", "apply", 100, 200, 300, 400, false, fixedTime,
-						"Иван", "ООО Рога и Копыта", "Backend Developer",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с нулевыми ID",
-			notificationID: 7,
-			expectedResult: &entity.NotificationPreview{
-				ID:            7,
-				Type:          entity.ApplyNotificationType,
-				SenderID:      0,
-				ReceiverID:    0,
-				ObjectID:      0,
-				ResumeID:      0,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Тест",
-				EmployerName:  "Тест Компания",
-				Title:         "Тест Позиция",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						7, "apply", 0, 0, 0, 0, false, fixedTime,
-						"Тест", "Тест Компания", "Тест Позиция",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с максимальными значениями ID",
-			notificationID: 2147483647, // max int32
-			expectedResult: &entity.NotificationPreview{
-				ID:            2147483647,
-				Type:          entity.ApplyNotificationType,
-				SenderID:      2147483646,
-				ReceiverID:    2147483645,
-				ObjectID:      2147483644,
-				ResumeID:      2147483643,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Максимальный",
-				EmployerName:  "Максимальная Компания",
-				Title:         "Максимальная Позиция",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						2147483647, "apply", 2147483646, 2147483645, 2147483644, 2147483643, true, fixedTime,
-						"Максимальный", "Максимальная Компания", "Максимальная Позиция",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с длинными строками",
-			notificationID: 8,
-			expectedResult: &entity.NotificationPreview{
-				ID:            8,
-				Type:          entity.ApplyNotificationType,
-				SenderID:      104,
-				ReceiverID:    204,
-				ObjectID:      304,
-				ResumeID:      404,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Очень Длинное Имя Соискателя Которое Может Быть В Базе Данных",
-				EmployerName:  "Очень Длинное Название Компании Работодателя Которое Тоже Может Быть В Базе",
-				Title:         "Очень Длинное Название Вакансии Senior Full Stack Developer with Machine Learning Experience",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						8, "apply", 104, 204, 304, 404, false, fixedTime,
-						"Очень Длинное Имя Соискателя Которое Может Быть В Базе Данных",
-						"Очень Длинное Название Компании Работодателя Которое Тоже Может Быть В Базе",
-						"Очень Длинное Название Вакансии Senior Full Stack Developer with Machine Learning Experience",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			notificationID: 1,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
 			},
+			expectedErr: entity.ErrNotFound,
 		},
 		{
-			name:           "Превью с отрицательным ID уведомления",
-			notificationID: -1,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при получении уведомления: %v", sql.ErrNoRows),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnError(sql.ErrNoRows)
+			name:           "Поврежденный payload",
+			notificationID: 1,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(
+						1, "apply", 100, 200, 300, 400, []byte(`{`), false, fixedTime, "Иван", "ООО Рога и Копыта", 1, 1,
+					))
 			},
+			expectedErr: entity.ErrInternal,
 		},
 		{
-			name:           "Ошибка сканирования NULL значений",
-			notificationID: 10,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при получении уведомления: %v", errors.New(`sql: Scan error on column index 8, name "applicant_name": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						10, "apply", 102, 202, 302, 402, false, fixedTime,
-						nil, "Компания", "Позиция",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			name:           "Ошибка базы данных",
+			notificationID: 1,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
 	}
 
@@ -339,435 +146,114 @@ func TestNotificationRepository_GetApplyNotificationPreview(t *testing.T) {
 
 			db, mock, err := sqlmock.New()
 			require.NoError(t, err)
-			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
-				mock.ExpectClose()
-				err := db.Close()
-				require.NoError(t, err)
-			}(db, mock)
+			defer db.Close()
 
-			tc.setupMock(mock, tc.notificationID)
+			tc.setupMock(mock)
 
 			repo := &NotificationRepository{DB: db}
-			ctx := context.Background()
-
-			result, err := repo.GetApplyNotificationPreview(ctx, tc.notificationID)
+			result, err := repo.GetNotificationPreview(context.Background(), tc.notificationID)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
 				var repoErr entity.Error
 				require.ErrorAs(t, err, &repoErr)
-				require.Equal(t, tc.expectedErr.Error(), err.Error())
-				require.Nil(t, result)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
 			} else {
 				require.NoError(t, err)
-				require.NotNil(t, result)
-				require.Equal(t, tc.expectedResult.ID, result.ID)
-				require.Equal(t, tc.expectedResult.Type, result.Type)
-				require.Equal(t, tc.expectedResult.SenderID, result.SenderID)
-				require.Equal(t, tc.expectedResult.ReceiverID, result.ReceiverID)
-				require.Equal(t, tc.expectedResult.ObjectID, result.ObjectID)
-				require.Equal(t, tc.expectedResult.ResumeID, result.ResumeID)
-				require.Equal(t, tc.expectedResult.IsViewed, result.IsViewed)
-				require.Equal(t, tc.expectedResult.CreatedAt.Unix(), result.CreatedAt.Unix())
-				require.Equal(t, tc.expectedResult.ApplicantName, result.ApplicantName)
-				require.Equal(t, tc.expectedResult.EmployerName, result.EmployerName)
-				require.Equal(t, tc.expectedResult.Title, result.Title)
+				require.Equal(t, tc.expectedResult, result)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestNotificationRepository_GetDownloadResumeNotificationPreview(t *testing.T) {
+func TestNotificationRepository_GetNotificationsForUser(t *testing.T) {
 	t.Parallel()
 
-	query := regexp.QuoteMeta(`
-		SELECT 
-			n.id,
-			n.type,
-			n.sender_id,
-			n.receiver_id,
-			n.object_id,
-			n.resume_id,
-			n.is_viewed,
-			n.created_at,
-			a.first_name AS applicant_name,
-			e.company_name AS employer_name,
-			r.profession AS title
-		FROM notification n
-		LEFT JOIN resume r ON r.id = n.object_id
-		LEFT JOIN applicant a ON a.id = r.applicant_id
-		LEFT JOIN employer e ON e.id = n.sender_id
-		WHERE n.id = $1 AND n.type = 'download_resume'
+	filterQuery := func(order string) string {
+		return regexp.QuoteMeta(notificationGroupQuery(
+			`g.receiver_id = $1 AND g.receiver_role = $2
+		  AND (cardinality($3::TEXT[]) = 0 OR g.type = ANY($3))`,
+			`($4::BOOLEAN IS NULL OR bool_and(g.is_viewed) = $4)
+		  AND MAX(g.id) > $5`,
+		) + `
+		ORDER BY ` + order + `
+		LIMIT $6 OFFSET $7
 	`)
+	}
+	query := filterQuery("n.created_at DESC, n.id DESC")
+	unread := false
 
 	columns := []string{
-		"id", "type", "sender_id", "receiver_id", "object_id",
-		"resume_id", "is_viewed", "created_at", "applicant_name",
-		"employer_name", "title",
+		"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
+		"payload", "is_viewed", "created_at", "applicant_name", "employer_name", "group_id", "group_size",
 	}
-
-	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
-
-	testCases := []struct {
-		name           string
-		notificationID int
-		expectedResult *entity.NotificationPreview
-		expectedErr    error
-		setupMock      func(mock sqlmock.Sqlmock, notificationID int)
-	}{
-		{
-			name:           "Успешное получение превью уведомления о скачивании резюме",
-			notificationID: 1,
-			expectedResult: &entity.NotificationPreview{
-				ID:            1,
-				Type:          entity.DownloadResumeType,
-				SenderID:      100,
-				ReceiverID:    200,
-				ObjectID:      300,
-				ResumeID:      400,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Анна",
-				EmployerName:  "ООО ТехКомпани",
-				Title:         "Frontend Developer",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						1, "download_resume", 100, 200, 300, 400, false, fixedTime,
-						"Анна", "ООО ТехКомпани", "Frontend Developer",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Успешное получение превью с просмотренным уведомлением",
-			notificationID: 2,
-			expectedResult: &entity.NotificationPreview{
-				ID:            2,
-				Type:          entity.DownloadResumeType,
-				SenderID:      101,
-				ReceiverID:    201,
-				ObjectID:      301,
-				ResumeID:      401,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Михаил",
-				EmployerName:  "Стартап Инновации",
-				Title:         "Data Scientist",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						2, "download_resume", 101, 201, 301, 401, true, fixedTime,
-						"Михаил", "Стартап Инновации", "Data Scientist",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с пустыми значениями в JOIN полях",
-			notificationID: 3,
-			expectedResult: &entity.NotificationPreview{
-				ID:            3,
-				Type:          entity.DownloadResumeType,
-				SenderID:      102,
-				ReceiverID:    202,
-				ObjectID:      302,
-				ResumeID:      402,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "",
-				EmployerName:  "",
-				Title:         "",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						3, "download_resume", 102, 202, 302, 402, false, fixedTime,
-						"", "", "",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с частично заполненными JOIN полями",
-			notificationID: 4,
-			expectedResult: &entity.NotificationPreview{
-				ID:            4,
-				Type:          entity.DownloadResumeType,
-				SenderID:      103,
-				ReceiverID:    203,
-				ObjectID:      303,
-				ResumeID:      403,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Елена",
-				EmployerName:  "",
-				Title:         "UX/UI Designer",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						4, "download_resume", 103, 203, 303, 403, true, fixedTime,
-						"Елена", "", "UX/UI Designer",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с только именем соискателя",
-			notificationID: 5,
-			expectedResult: &entity.NotificationPreview{
-				ID:            5,
-				Type:          entity.DownloadResumeType,
-				SenderID:      104,
-				ReceiverID:    204,
-				ObjectID:      304,
-				ResumeID:      404,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Дмитрий",
-				EmployerName:  "",
-				Title:         "",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						5, "download_resume", 104, 204, 304, 404, false, fixedTime,
-						"Дмитрий", "", "",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Уведомление не найдено",
-			notificationID: 999,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", sql.ErrNoRows),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnError(sql.ErrNoRows)
-			},
-		},
-		{
-			name:           "Ошибка базы данных при выполнении запроса",
-			notificationID: 6,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", errors.New("database connection failed")),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnError(errors.New("database connection failed"))
-			},
-		},
-		{
-			name:           "Ошибка сканирования - неверный тип данных для ID",
-			notificationID: 7,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", errors.New(`sql: Scan error on column index 0, name "id": converting driver.Value type string ("invalid_id") to a int: invalid syntax`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						"invalid_id", "download_resume", 100, 200, 300, 400, false, fixedTime,
-						"Тест", "Тест Компания", "Тест Профессия",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Ошибка сканирования - неверный тип данных для времени",
-			notificationID: 8,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", errors.New(`sql: Scan error on column index 7, name "created_at": unsupported Scan, storing driver.Value type string into type *time.Time`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						8, "download_resume", 100, 200, 300, 400, false, "invalid_time",
-						"Тест", "Тест Компания", "Тест Профессия",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Ошибка сканирования NULL значений",
-			notificationID: 9,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", errors.New(`sql: Scan error on column index 8, name "applicant_name": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						9, "download_resume", 100, 200, 300, 400, false, fixedTime,
-						nil, "Компания", "Профессия",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
-		{
-			name:           "Превью с нулевыми ID",
-			notificationID: 10,
-			expectedResult: &entity.NotificationPreview{
-				ID:            10,
-				Type:          entity.DownloadResumeType,
-				SenderID:      0,
-				ReceiverID:    0,
-				ObjectID:      0,
-				ResumeID:      0,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Нулевой",
-				EmployerName:  "Нулевая Компания",
-				Title:         "Нулевая Профессия",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						10, "download_resume", 0, 0, 0, 0, false, fixedTime,
-						"Нулевой", "Нулевая Компания", "Нулевая Профессия",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
-			},
-		},
+
+	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		filter         entity.NotificationFilter
+		expectedResult []*entity.NotificationPreview
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
 		{
-			name:           "Превью с максимальными значениями ID",
-			notificationID: 2147483647, // max int32
-			expectedResult: &entity.NotificationPreview{
-				ID:            2147483647,
-				Type:          entity.DownloadResumeType,
-				SenderID:      2147483646,
-				ReceiverID:    2147483645,
-				ObjectID:      2147483644,
-				ResumeID:      2147483643,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Максимальный",
-				EmployerName:  "Максимальная Компания",
-				Title:         "Максимальная Профессия",
+			name:   "Уведомления разных типов одним запросом, повторные скачивания резюме - одной группой",
+			filter: entity.NotificationFilter{Limit: 20},
+			expectedResult: []*entity.NotificationPreview{
+				{
+					ID: 3, Type: entity.InvitationType, SenderID: 200, ReceiverID: 100, ObjectID: 7, ResumeID: 400,
+					Payload: entity.NotificationPayload{"vacancy_title": "Backend Developer"}, CreatedAt: fixedTime,
+					ApplicantName: "Иван", EmployerName: "ООО Рога и Копыта", GroupID: 3, GroupSize: 1,
+				},
+				{
+					ID: 2, Type: entity.DownloadResumeType, SenderID: 200, ReceiverID: 100, ObjectID: 400, ResumeID: 400,
+					Payload: entity.NotificationPayload{"profession": "Go-разработчик"}, IsViewed: true, CreatedAt: fixedTime,
+					ApplicantName: "Иван", EmployerName: "ООО Рога и Копыта", GroupID: 1, GroupSize: 2,
+				},
 			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						2147483647, "download_resume", 2147483646, 2147483645, 2147483644, 2147483643, true, fixedTime,
-						"Максимальный", "Максимальная Компания", "Максимальная Профессия",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole, "{}", nil, 0, 20, 0).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(3, "invitation", 200, 100, 7, 400, []byte(`{"vacancy_title": "Backend Developer"}`),
+							false, fixedTime, "Иван", "ООО Рога и Копыта", 3, 1).
+						AddRow(2, "download_resume", 200, 100, 400, 400, []byte(`{"profession": "Go-разработчик"}`),
+							true, fixedTime, "Иван", "ООО Рога и Копыта", 1, 2))
 			},
 		},
 		{
-			name:           "Превью с длинными строками",
-			notificationID: 11,
-			expectedResult: &entity.NotificationPreview{
-				ID:            11,
-				Type:          entity.DownloadResumeType,
-				SenderID:      105,
-				ReceiverID:    205,
-				ObjectID:      305,
-				ResumeID:      405,
-				IsViewed:      false,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Очень Длинное Имя Соискателя Которое Может Быть В Базе Данных Системы",
-				EmployerName:  "Очень Длинное Название Компании Работодателя Которое Тоже Может Быть В Базе Данных",
-				Title:         "Очень Длинное Название Профессии Senior Full Stack Developer with Machine Learning and AI Experience",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						11, "download_resume", 105, 205, 305, 405, false, fixedTime,
-						"Очень Длинное Имя Соискателя Которое Может Быть В Базе Данных Системы",
-						"Очень Длинное Название Компании Работодателя Которое Тоже Может Быть В Базе Данных",
-						"Очень Длинное Название Профессии Senior Full Stack Developer with Machine Learning and AI Experience",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			name:   "Фильтр по типам и непрочитанным, вторая страница",
+			filter: entity.NotificationFilter{Types: []entity.NotificationType{entity.InvitationType, entity.ContactRequestType}, IsViewed: &unread, Limit: 10, Offset: 10},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole, `{"invitation","contact_request"}`, false, 0, 10, 10).
+					WillReturnRows(sqlmock.NewRows(columns))
 			},
 		},
 		{
-			name:           "Превью с отрицательным ID уведомления",
-			notificationID: -1,
-			expectedResult: nil,
-			expectedErr: entity.NewError(
-				entity.ErrNotFound,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationPreview: %v", sql.ErrNoRows),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnError(sql.ErrNoRows)
+			name:   "Синхронизация после переподключения - от старых к новым",
+			filter: entity.NotificationFilter{AfterID: 8, Limit: 100},
+			expectedResult: []*entity.NotificationPreview{
+				{
+					ID: 9, Type: entity.ContactRequestType, SenderID: 200, ReceiverID: 100, ResumeID: 400,
+					Payload: entity.NotificationPayload{"profession": "Go-разработчик"}, CreatedAt: fixedTime,
+					ApplicantName: "Иван", EmployerName: "ООО Рога и Копыта", GroupID: 9, GroupSize: 1,
+				},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(filterQuery("n.id ASC")).WithArgs(100, entity.ApplicantRole, "{}", nil, 8, 100, 0).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(9, "contact_request", 200, 100, 0, 400, []byte(`{"profession": "Go-разработчик"}`),
+							false, fixedTime, "Иван", "ООО Рога и Копыта", 9, 1))
 			},
 		},
 		{
-			name:           "Превью с специальными символами в строках",
-			notificationID: 12,
-			expectedResult: &entity.NotificationPreview{
-				ID:            12,
-				Type:          entity.DownloadResumeType,
-				SenderID:      106,
-				ReceiverID:    206,
-				ObjectID:      306,
-				ResumeID:      406,
-				IsViewed:      true,
-				CreatedAt:     fixedTime,
-				ApplicantName: "Иван-Петр О'Коннор",
-				EmployerName:  "ООО \"Рога & Копыта\"",
-				Title:         "C++ Developer (Senior)",
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
-				rows := sqlmock.NewRows(columns).
-					AddRow(
-						12, "download_resume", 106, 206, 306, 406, true, fixedTime,
-						"Иван-Петр О'Коннор", "ООО \"Рога & Копыта\"", "C++ Developer (Senior)",
-					)
-				mock.ExpectQuery(query).
-					WithArgs(notificationID).
-					WillReturnRows(rows)
+			name:   "Ошибка базы данных",
+			filter: entity.NotificationFilter{Limit: 20},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole, "{}", nil, 0, 20, 0).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
 	}
 
@@ -778,39 +264,21 @@ func TestNotificationRepository_GetDownloadResumeNotificationPreview(t *testing.
 
 			db, mock, err := sqlmock.New()
 			require.NoError(t, err)
-			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
-				mock.ExpectClose()
-				err := db.Close()
-				require.NoError(t, err)
-			}(db, mock)
+			defer db.Close()
 
-			tc.setupMock(mock, tc.notificationID)
+			tc.setupMock(mock)
 
 			repo := &NotificationRepository{DB: db}
-			ctx := context.Background()
-
-			result, err := repo.GetDownloadResumeNotificationPreview(ctx, tc.notificationID)
+			result, err := repo.GetNotificationsForUser(context.Background(), 100, entity.ApplicantRole, tc.filter)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
 				var repoErr entity.Error
 				require.ErrorAs(t, err, &repoErr)
-				require.Equal(t, tc.expectedErr.Error(), err.Error())
-				require.Nil(t, result)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
 			} else {
 				require.NoError(t, err)
-				require.NotNil(t, result)
-				require.Equal(t, tc.expectedResult.ID, result.ID)
-				require.Equal(t, tc.expectedResult.Type, result.Type)
-				require.Equal(t, tc.expectedResult.SenderID, result.SenderID)
-				require.Equal(t, tc.expectedResult.ReceiverID, result.ReceiverID)
-				require.Equal(t, tc.expectedResult.ObjectID, result.ObjectID)
-				require.Equal(t, tc.expectedResult.ResumeID, result.ResumeID)
-				require.Equal(t, tc.expectedResult.IsViewed, result.IsViewed)
-				require.Equal(t, tc.expectedResult.CreatedAt.Unix(), result.CreatedAt.Unix())
-				require.Equal(t, tc.expectedResult.ApplicantName, result.ApplicantName)
-				require.Equal(t, tc.expectedResult.EmployerName, result.EmployerName)
-				require.Equal(t, tc.expectedResult.Title, result.Title)
+				require.Equal(t, tc.expectedResult, result)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -830,6 +298,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			receiver_role,
 			object_id,
 			resume_id,
+			COALESCE(group_id, id),
 			is_viewed,
 			created_at
 		FROM notification
@@ -838,7 +307,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 
 	columns := []string{
 		"id", "type", "sender_id", "sender_role", "receiver_id",
-		"receiver_role", "object_id", "resume_id", "is_viewed", "created_at",
+		"receiver_role", "object_id", "resume_id", "group_id", "is_viewed", "created_at",
 	}
 
 	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
@@ -862,6 +331,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 				ReceiverRole: entity.EmployerRole,
 				ObjectID:     300,
 				ResumeID:     400,
+				GroupID:      1,
 				IsViewed:     false,
 				CreatedAt:    fixedTime,
 			},
@@ -869,7 +339,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						1, "apply", 100, "applicant", 200, "employer", 300, 400, false, fixedTime,
+						1, "apply", 100, "applicant", 200, "employer", 300, 400, 1, false, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -888,6 +358,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 				ReceiverRole: entity.ApplicantRole,
 				ObjectID:     301,
 				ResumeID:     401,
+				GroupID:      2,
 				IsViewed:     true,
 				CreatedAt:    fixedTime,
 			},
@@ -895,7 +366,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						2, "download_resume", 101, "employer", 201, "applicant", 301, 401, true, fixedTime,
+						2, "download_resume", 101, "employer", 201, "applicant", 301, 401, 2, true, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -914,6 +385,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 				ReceiverRole: entity.EmployerRole,
 				ObjectID:     302,
 				ResumeID:     402,
+				GroupID:      3,
 				IsViewed:     false,
 				CreatedAt:    fixedTime,
 			},
@@ -921,7 +393,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						3, "apply", 102, "applicant", 202, "employer", 302, 402, false, fixedTime,
+						3, "apply", 102, "applicant", 202, "employer", 302, 402, 3, false, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -940,6 +412,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 				ReceiverRole: entity.ApplicantRole,
 				ObjectID:     0,
 				ResumeID:     0,
+				GroupID:      4,
 				IsViewed:     true,
 				CreatedAt:    fixedTime,
 			},
@@ -947,7 +420,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						4, "download_resume", 0, "employer", 0, "applicant", 0, 0, true, fixedTime,
+						4, "download_resume", 0, "employer", 0, "applicant", 0, 0, 4, true, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -966,6 +439,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 				ReceiverRole: entity.EmployerRole,
 				ObjectID:     2147483644,
 				ResumeID:     2147483643,
+				GroupID:      2147483647,
 				IsViewed:     false,
 				CreatedAt:    fixedTime,
 			},
@@ -973,7 +447,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						2147483647, "apply", 2147483646, "applicant", 2147483645, "employer", 2147483644, 2147483643, false, fixedTime,
+						2147483647, "apply", 2147483646, "applicant", 2147483645, "employer", 2147483644, 2147483643, 2147483647, false, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -1019,7 +493,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						"invalid_id", "apply", 100, "applicant", 200, "employer", 300, 400, false, fixedTime,
+						"invalid_id", "apply", 100, "applicant", 200, "employer", 300, 400, 1, false, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -1032,12 +506,12 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			expectedResult: nil,
 			expectedErr: entity.NewError(
 				entity.ErrInternal,
-				fmt.Errorf("ошибка при выполнении SQL-запроса: %v", errors.New(`sql: Scan error on column index 9, name "created_at": unsupported Scan, storing driver.Value type string into type *time.Time`)),
+				fmt.Errorf("ошибка при выполнении SQL-запроса: %v", errors.New(`sql: Scan error on column index 10, name "created_at": unsupported Scan, storing driver.Value type string into type *time.Time`)),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						7, "apply", 100, "applicant", 200, "employer", 300, 400, false, "invalid_time",
+						7, "apply", 100, "applicant", 200, "employer", 300, 400, 7, false, "invalid_time",
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -1050,12 +524,12 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			expectedResult: nil,
 			expectedErr: entity.NewError(
 				entity.ErrInternal,
-				fmt.Errorf("ошибка при выполнении SQL-запроса: %v", errors.New(`sql: Scan error on column index 8, name "is_viewed": sql/driver: couldn't convert "invalid_bool" into type bool`)),
+				fmt.Errorf("ошибка при выполнении SQL-запроса: %v", errors.New(`sql: Scan error on column index 9, name "is_viewed": sql/driver: couldn't convert "invalid_bool" into type bool`)),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						8, "apply", 100, "applicant", 200, "employer", 300, 400, "invalid_bool", fixedTime,
+						8, "apply", 100, "applicant", 200, "employer", 300, 400, 8, "invalid_bool", fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -1073,7 +547,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						9, 123, 100, "applicant", 200, "employer", 300, 400, false, fixedTime,
+						9, 123, 100, "applicant", 200, "employer", 300, 400, 9, false, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -1091,7 +565,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, notificationID int) {
 				rows := sqlmock.NewRows(columns).
 					AddRow(
-						10, "apply", 100, 456, 200, "employer", 300, 400, false, fixedTime,
+						10, "apply", 100, 456, 200, "employer", 300, 400, 10, false, fixedTime,
 					)
 				mock.ExpectQuery(query).
 					WithArgs(notificationID).
@@ -1193,6 +667,7 @@ func TestNotificationRepository_GetNotificationByID(t *testing.T) {
 				require.Equal(t, tc.expectedResult.ReceiverRole, result.ReceiverRole)
 				require.Equal(t, tc.expectedResult.ObjectID, result.ObjectID)
 				require.Equal(t, tc.expectedResult.ResumeID, result.ResumeID)
+				require.Equal(t, tc.expectedResult.GroupID, result.GroupID)
 				require.Equal(t, tc.expectedResult.IsViewed, result.IsViewed)
 				require.Equal(t, tc.expectedResult.CreatedAt.Unix(), result.CreatedAt.Unix())
 			}
@@ -1205,28 +680,58 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 	t.Parallel()
 
 	query := regexp.QuoteMeta(`
-		INSERT INTO notification (
-			type,
-			sender_id,
-		    sender_role,
-			receiver_id,
-		    receiver_role,
-			object_id,
-		    resume_id,
-			is_viewed
+		WITH inserted AS (
+			INSERT INTO notification (
+				type,
+				sender_id,
+				sender_role,
+				receiver_id,
+				receiver_role,
+				object_id,
+				resume_id,
+				payload,
+				is_viewed,
+				group_id,
+				outbox_event_id
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, (
+				SELECT h.id
+				FROM notification h
+				WHERE $10 > 0
+				  AND h.receiver_id = $4 AND h.receiver_role = $5
+				  AND h.type = $1 AND h.object_id = $6
+				  AND h.group_id IS NULL
+				  AND h.created_at > NOW() - make_interval(secs => $10)
+				ORDER BY h.created_at DESC
+				LIMIT 1
+			), NULLIF($11, 0))
+			ON CONFLICT (outbox_event_id) DO NOTHING
+			RETURNING id, COALESCE(group_id, id) AS group_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id
+		SELECT id, group_id FROM inserted
+		UNION ALL
+		SELECT id, COALESCE(group_id, id)
+		FROM notification
+		WHERE outbox_event_id = $11 AND NOT EXISTS (SELECT 1 FROM inserted)
 	`)
 
+	// Отклики и скачивания резюме группируются в пределах часа, остальные типы - нет
+	groupWindow := map[entity.NotificationType]float64{
+		entity.ApplyNotificationType: 3600,
+		entity.DownloadResumeType:    3600,
+		entity.InvitationType:        0,
+	}
+
 	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
 
 	testCases := []struct {
 		name         string
 		notification *entity.Notification
 		expectedID   int
-		expectedErr  error
-		setupMock    func(mock sqlmock.Sqlmock, notification *entity.Notification)
+		// expectedGroupID задается, если уведомление должно попасть в уже существующую группу
+		expectedGroupID int
+		expectedErr     error
+		setupMock       func(mock sqlmock.Sqlmock, notification *entity.Notification)
 	}{
 		{
 			name: "Успешное создание уведомления о отклике",
@@ -1244,7 +749,106 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 			expectedID:  1,
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
-				rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(1, 1)
+				mock.ExpectQuery(query).
+					WithArgs(
+						notification.Type,
+						notification.SenderID,
+						notification.SenderRole,
+						notification.ReceiverID,
+						notification.ReceiverRole,
+						notification.ObjectID,
+						notification.ResumeID,
+						[]byte("{}"),
+						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
+					).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Повторная доставка события возвращает сохраненное уведомление",
+			notification: &entity.Notification{
+				Type:          entity.DownloadResumeType,
+				SenderID:      1,
+				SenderRole:    entity.EmployerRole,
+				ReceiverID:    2,
+				ReceiverRole:  entity.ApplicantRole,
+				ObjectID:      3,
+				ResumeID:      3,
+				OutboxEventID: 9,
+			},
+			expectedID:      7,
+			expectedGroupID: 7,
+			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(7, 7)
+				mock.ExpectQuery(query).
+					WithArgs(
+						notification.Type,
+						notification.SenderID,
+						notification.SenderRole,
+						notification.ReceiverID,
+						notification.ReceiverRole,
+						notification.ObjectID,
+						notification.ResumeID,
+						[]byte("{}"),
+						notification.IsViewed,
+						groupWindow[notification.Type],
+						9,
+					).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Отклик в пределах окна попадает в группу",
+			notification: &entity.Notification{
+				Type:         entity.ApplyNotificationType,
+				SenderID:     101,
+				SenderRole:   entity.ApplicantRole,
+				ReceiverID:   200,
+				ReceiverRole: entity.EmployerRole,
+				ObjectID:     300,
+				ResumeID:     401,
+				Payload:      entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+			},
+			expectedID:      5,
+			expectedGroupID: 1,
+			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(5, 1)
+				mock.ExpectQuery(query).
+					WithArgs(
+						notification.Type,
+						notification.SenderID,
+						notification.SenderRole,
+						notification.ReceiverID,
+						notification.ReceiverRole,
+						notification.ObjectID,
+						notification.ResumeID,
+						[]byte(`{"vacancy_title":"Backend Developer"}`),
+						notification.IsViewed,
+						3600.0,
+						notification.OutboxEventID,
+					).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Payload сохраняется как JSON",
+			notification: &entity.Notification{
+				Type:         entity.InvitationType,
+				SenderID:     200,
+				SenderRole:   entity.EmployerRole,
+				ReceiverID:   100,
+				ReceiverRole: entity.ApplicantRole,
+				ObjectID:     7,
+				ResumeID:     600,
+				Payload:      entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+			},
+			expectedID:  4,
+			expectedErr: nil,
+			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(4, 4)
 				mock.ExpectQuery(query).
 					WithArgs(
 						notification.Type,
@@ -1254,7 +858,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte(`{"vacancy_title":"Backend Developer"}`),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnRows(rows)
 			},
@@ -1275,7 +882,7 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 			expectedID:  2,
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
-				rows := sqlmock.NewRows([]string{"id"}).AddRow(2)
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(2, 2)
 				mock.ExpectQuery(query).
 					WithArgs(
 						notification.Type,
@@ -1285,7 +892,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnRows(rows)
 			},
@@ -1306,7 +916,7 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 			expectedID:  3,
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
-				rows := sqlmock.NewRows([]string{"id"}).AddRow(3)
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(3, 3)
 				mock.ExpectQuery(query).
 					WithArgs(
 						notification.Type,
@@ -1316,7 +926,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnRows(rows)
 			},
@@ -1337,7 +950,7 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 			expectedID:  4,
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
-				rows := sqlmock.NewRows([]string{"id"}).AddRow(4)
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(4, 4)
 				mock.ExpectQuery(query).
 					WithArgs(
 						notification.Type,
@@ -1347,7 +960,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnRows(rows)
 			},
@@ -1368,7 +984,7 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 			expectedID:  2147483647,
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
-				rows := sqlmock.NewRows([]string{"id"}).AddRow(2147483647)
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow(2147483647, 2147483647)
 				mock.ExpectQuery(query).
 					WithArgs(
 						notification.Type,
@@ -1378,7 +994,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnRows(rows)
 			},
@@ -1411,7 +1030,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnError(errors.New("database connection failed"))
 			},
@@ -1444,7 +1066,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnError(errors.New("duplicate key value violates unique constraint"))
 			},
@@ -1477,7 +1102,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnError(errors.New("foreign key constraint violation"))
 			},
@@ -1501,7 +1129,7 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 				fmt.Errorf("ошибка при создании уведомления: %v", errors.New(`sql: Scan error on column index 0, name "id": converting driver.Value type string ("invalid_id") to a int: invalid syntax`)),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, notification *entity.Notification) {
-				rows := sqlmock.NewRows([]string{"id"}).AddRow("invalid_id")
+				rows := sqlmock.NewRows([]string{"id", "group_id"}).AddRow("invalid_id", 0)
 				mock.ExpectQuery(query).
 					WithArgs(
 						notification.Type,
@@ -1511,7 +1139,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnRows(rows)
 			},
@@ -1544,7 +1175,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -1577,7 +1211,10 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 						notification.ReceiverRole,
 						notification.ObjectID,
 						notification.ResumeID,
+						[]byte("{}"),
 						notification.IsViewed,
+						groupWindow[notification.Type],
+						notification.OutboxEventID,
 					).
 					WillReturnError(errors.New("query timeout"))
 			},
@@ -1618,6 +1255,9 @@ func TestNotificationRepository_CreateNotification(t *testing.T) {
 				require.NoError(t, err)
 				// При успехе ID должен быть установлен
 				require.Equal(t, tc.expectedID, tc.notification.ID)
+				if tc.expectedGroupID != 0 {
+					require.Equal(t, tc.expectedGroupID, tc.notification.GroupID)
+				}
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -1815,16 +1455,10 @@ func TestNotificationRepository_ReadNotification(t *testing.T) {
 func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 	t.Parallel()
 
-	applicantQuery := regexp.QuoteMeta(`
-		UPDATE notification
-		SET is_viewed = true
-		WHERE receiver_id = $1 AND type = 'download_resume'
-	`)
-
-	employerQuery := regexp.QuoteMeta(`
+	query := regexp.QuoteMeta(`
 		UPDATE notification
 		SET is_viewed = true
-		WHERE receiver_id = $1 AND type = 'apply'
+		WHERE receiver_id = $1 AND receiver_role = $2
 	`)
 
 	testCases := []struct {
@@ -1840,8 +1474,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 3)) // 3 строки затронуты
 			},
 		},
@@ -1851,8 +1485,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 5)) // 5 строк затронуты
 			},
 		},
@@ -1862,8 +1496,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк затронуто
 			},
 		},
@@ -1873,8 +1507,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк затронуто
 			},
 		},
@@ -1884,8 +1518,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк затронуто
 			},
 		},
@@ -1895,8 +1529,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 1)) // 1 строка затронута
 			},
 		},
@@ -1957,8 +1591,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении ReadAllNotifications: %v", errors.New("database connection failed")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("database connection failed"))
 			},
 		},
@@ -1971,8 +1605,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении ReadAllNotifications: %v", errors.New("database connection failed")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("database connection failed"))
 			},
 		},
@@ -1985,8 +1619,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении ReadAllNotifications: %v", errors.New("query timeout")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("query timeout"))
 			},
 		},
@@ -1999,8 +1633,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении ReadAllNotifications: %v", errors.New("table lock timeout")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("table lock timeout"))
 			},
 		},
@@ -2010,8 +1644,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк затронуто
 			},
 		},
@@ -2021,8 +1655,8 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк затронуто
 			},
 		},
@@ -2064,14 +1698,9 @@ func TestNotificationRepository_ReadAllNotifications(t *testing.T) {
 func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 	t.Parallel()
 
-	applicantQuery := regexp.QuoteMeta(`
-		DELETE FROM notification
-		WHERE receiver_id = $1 AND type = 'download_resume'
-	`)
-
-	employerQuery := regexp.QuoteMeta(`
+	query := regexp.QuoteMeta(`
 		DELETE FROM notification
-		WHERE receiver_id = $1 AND type = 'apply'
+		WHERE receiver_id = $1 AND receiver_role = $2
 	`)
 
 	testCases := []struct {
@@ -2087,8 +1716,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 3)) // 3 строки удалены
 			},
 		},
@@ -2098,8 +1727,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 5)) // 5 строк удалены
 			},
 		},
@@ -2109,8 +1738,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
 			},
 		},
@@ -2120,8 +1749,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
 			},
 		},
@@ -2131,8 +1760,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
 			},
 		},
@@ -2142,8 +1771,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 1)) // 1 строка удалена
 			},
 		},
@@ -2153,8 +1782,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "applicant",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 1)) // 1 строка удалена
 			},
 		},
@@ -2164,8 +1793,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 			role:        "employer",
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnResult(sqlmock.NewResult(0, 100)) // 100 строк удалено
 			},
 		},
@@ -2238,8 +1867,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении DeleteAllNotifications: %v", errors.New("database connection failed")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("database connection failed"))
 			},
 		},
@@ -2252,8 +1881,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении DeleteAllNotifications: %v", errors.New("database connection failed")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("database connection failed"))
 			},
 		},
@@ -2266,8 +1895,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении DeleteAllNotifications: %v", errors.New("query timeout")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("query timeout"))
 			},
 		},
@@ -2280,8 +1909,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении DeleteAllNotifications: %v", errors.New("table lock timeout")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("table lock timeout"))
 			},
 		},
@@ -2294,8 +1923,8 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении DeleteAllNotifications: %v", errors.New("foreign key constraint violation")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("foreign key constraint violation"))
 			},
 		},
@@ -2308,32 +1937,108 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 				fmt.Errorf("ошибка при выполнении DeleteAllNotifications: %v", errors.New("permission denied")),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
 					WillReturnError(errors.New("permission denied"))
 			},
 		},
 		{
-			name:        "Отрицательный ID пользователя для соискателя",
-			userID:      -1,
-			role:        "applicant",
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(applicantQuery).
-					WithArgs(userID).
-					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
+			name:        "Отрицательный ID пользователя для соискателя",
+			userID:      -1,
+			role:        "applicant",
+			expectedErr: nil,
+			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
+					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
+			},
+		},
+		{
+			name:        "Отрицательный ID пользователя для работодателя",
+			userID:      -1,
+			role:        "employer",
+			expectedErr: nil,
+			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
+				mock.ExpectExec(query).
+					WithArgs(userID, role).
+					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock, tc.userID, tc.role)
+
+			repo := &NotificationRepository{DB: db}
+			ctx := context.Background()
+
+			err = repo.DeleteAllNotifications(ctx, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepository_CountUnreadNotifications(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT type, COUNT(*)
+		FROM notification
+		WHERE receiver_id = $1 AND receiver_role = $2 AND NOT is_viewed
+		GROUP BY type
+	`)
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult map[entity.NotificationType]int
+		expectedErr    error
+	}{
+		{
+			name: "Непрочитанные по типам",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole).
+					WillReturnRows(sqlmock.NewRows([]string{"type", "count"}).
+						AddRow("download_resume", 3).
+						AddRow("invitation", 1))
+			},
+			expectedResult: map[entity.NotificationType]int{entity.DownloadResumeType: 3, entity.InvitationType: 1},
+		},
+		{
+			name: "Все прочитано",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole).
+					WillReturnRows(sqlmock.NewRows([]string{"type", "count"}))
 			},
+			expectedResult: map[entity.NotificationType]int{},
 		},
 		{
-			name:        "Отрицательный ID пользователя для работодателя",
-			userID:      -1,
-			role:        "employer",
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int, role string) {
-				mock.ExpectExec(employerQuery).
-					WithArgs(userID).
-					WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк удалено
+			name: "Ошибка базы данных",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
 	}
 
@@ -2344,357 +2049,186 @@ func TestNotificationRepository_DeleteAllNotifications(t *testing.T) {
 
 			db, mock, err := sqlmock.New()
 			require.NoError(t, err)
-			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
-				mock.ExpectClose()
-				err := db.Close()
-				require.NoError(t, err)
-			}(db, mock)
+			defer db.Close()
 
-			tc.setupMock(mock, tc.userID, tc.role)
+			tc.setupMock(mock)
 
 			repo := &NotificationRepository{DB: db}
-			ctx := context.Background()
-
-			err = repo.DeleteAllNotifications(ctx, tc.userID, tc.role)
+			result, err := repo.CountUnreadNotifications(context.Background(), 100, entity.ApplicantRole)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
 				var repoErr entity.Error
 				require.ErrorAs(t, err, &repoErr)
-				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestNotificationRepository_GetApplyNotificationsForUser(t *testing.T) {
+func TestNotificationRepository_GetLastNotificationID(t *testing.T) {
 	t.Parallel()
 
 	query := regexp.QuoteMeta(`
-        SELECT 
-            n.id,
-            n.type,
-            n.sender_id,
-            n.receiver_id,
-            n.object_id,
-            n.resume_id,
-            n.is_viewed,
-            n.created_at,
-            a.first_name AS applicant_name,
-            e.company_name AS employer_name,
-            v.title
-        FROM notification n
-        LEFT JOIN applicant a ON n.sender_id = a.id
-        LEFT JOIN employer e ON n.receiver_id = e.id
-        LEFT JOIN vacancy v ON n.object_id = v.id
-        WHERE n.type = 'apply' AND n.receiver_id = $1
-        ORDER BY n.created_at DESC
-    `)
-
-	fixedTime1 := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
-	fixedTime2 := time.Date(2024, 1, 14, 10, 30, 0, 0, time.UTC)
-	fixedTime3 := time.Date(2024, 1, 13, 8, 15, 0, 0, time.UTC)
+		SELECT COALESCE(MAX(id), 0)
+		FROM notification
+		WHERE receiver_id = $1 AND receiver_role = $2
+	`)
 
 	testCases := []struct {
-		name                  string
-		userID                int
-		expectedNotifications []*entity.NotificationPreview
-		expectedErr           error
-		setupMock             func(mock sqlmock.Sqlmock, userID int)
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult int
+		expectedErr    error
 	}{
 		{
-			name:   "Успешное получение одного уведомления",
-			userID: 100,
-			expectedNotifications: []*entity.NotificationPreview{
-				{
-					ID:            1,
-					Type:          entity.ApplyNotificationType,
-					SenderID:      200,
-					ReceiverID:    100,
-					ObjectID:      300,
-					ResumeID:      400,
-					IsViewed:      false,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Иван",
-					EmployerName:  "ООО Компания",
-					Title:         "Разработчик Go",
-				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					1, "apply", 200, 100, 300, 400,
-					false, fixedTime1, "Иван", "ООО Компания", "Разработчик Go",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:   "Успешное получение множественных уведомлений в правильном порядке",
-			userID: 101,
-			expectedNotifications: []*entity.NotificationPreview{
-				{
-					ID:            1,
-					Type:          entity.ApplyNotificationType,
-					SenderID:      201,
-					ReceiverID:    101,
-					ObjectID:      301,
-					ResumeID:      401,
-					IsViewed:      false,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Петр",
-					EmployerName:  "ООО Рога",
-					Title:         "Backend Developer",
-				},
-				{
-					ID:            2,
-					Type:          entity.ApplyNotificationType,
-					SenderID:      202,
-					ReceiverID:    101,
-					ObjectID:      302,
-					ResumeID:      402,
-					IsViewed:      true,
-					CreatedAt:     fixedTime2,
-					ApplicantName: "Анна",
-					EmployerName:  "ООО Копыта",
-					Title:         "Frontend Developer",
-				},
-				{
-					ID:            3,
-					Type:          entity.ApplyNotificationType,
-					SenderID:      203,
-					ReceiverID:    101,
-					ObjectID:      303,
-					ResumeID:      403,
-					IsViewed:      false,
-					CreatedAt:     fixedTime3,
-					ApplicantName: "Мария",
-					EmployerName:  "ООО Хвосты",
-					Title:         "Fullstack Developer",
-				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).
-					AddRow(1, "apply", 201, 101, 301, 401, false, fixedTime1, "Петр", "ООО Рога", "Backend Developer").
-					AddRow(2, "apply", 202, 101, 302, 402, true, fixedTime2, "Анна", "ООО Копыта", "Frontend Developer").
-					AddRow(3, "apply", 203, 101, 303, 403, false, fixedTime3, "Мария", "ООО Хвосты", "Fullstack Developer")
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Успешное получение пустого списка уведомлений",
-			userID:                102,
-			expectedNotifications: nil,
-			expectedErr:           nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				})
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Успешное получение уведомлений с NULL значениями в JOIN полях",
-			userID:                103,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании строки результата запроса GetApplyNotificationsForUser: %v", errors.New(`sql: Scan error on column index 8, name "applicant_name": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					4, "apply", 204, 103, 304, 404,
-					false, fixedTime1, nil, nil, nil,
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Успешное получение уведомлений с частично NULL значениями",
-			userID:                104,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании строки результата запроса GetApplyNotificationsForUser: %v", errors.New(`sql: Scan error on column index 9, name "employer_name": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					5, "apply", 205, 104, 305, 405,
-					true, fixedTime1, "Сергей", nil, "DevOps Engineer",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Последнее уведомление",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
 			},
+			expectedResult: 42,
 		},
 		{
-			name:   "Успешное получение уведомлений с нулевыми ID",
-			userID: 0,
-			expectedNotifications: []*entity.NotificationPreview{
-				{
-					ID:            6,
-					Type:          entity.ApplyNotificationType,
-					SenderID:      0,
-					ReceiverID:    0,
-					ObjectID:      0,
-					ResumeID:      0,
-					IsViewed:      false,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Тест",
-					EmployerName:  "Тест Компания",
-					Title:         "Тест Вакансия",
-				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					6, "apply", 0, 0, 0, 0,
-					false, fixedTime1, "Тест", "Тест Компания", "Тест Вакансия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Ошибка базы данных",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(100, entity.ApplicantRole).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &NotificationRepository{DB: db}
+			result, err := repo.GetLastNotificationID(context.Background(), 100, entity.ApplicantRole)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepository_GetEventPreview(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`FROM (SELECT $1::int AS sender_id, $2::text AS sender_role, $3::int AS receiver_id) n`)
+	notification := &entity.Notification{
+		Type:          entity.ApplyNotificationType,
+		SenderID:      3,
+		SenderRole:    entity.ApplicantRole,
+		ReceiverID:    4,
+		ReceiverRole:  entity.EmployerRole,
+		ObjectID:      7,
+		Payload:       entity.NotificationPayload{"vacancy_title": "Go-разработчик"},
+		OutboxEventID: 9,
+	}
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult *entity.NotificationPreview
+		expectedErr    error
+	}{
 		{
-			name:   "Успешное получение уведомлений с максимальными ID",
-			userID: 2147483647,
-			expectedNotifications: []*entity.NotificationPreview{
-				{
-					ID:            2147483647,
-					Type:          entity.ApplyNotificationType,
-					SenderID:      2147483646,
-					ReceiverID:    2147483647,
-					ObjectID:      2147483645,
-					ResumeID:      2147483644,
-					IsViewed:      true,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Максимум",
-					EmployerName:  "Максимум Компания",
-					Title:         "Максимум Вакансия",
-				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					2147483647, "apply", 2147483646, 2147483647, 2147483645, 2147483644,
-					true, fixedTime1, "Максимум", "Максимум Компания", "Максимум Вакансия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Превью с именами участников и размером группы",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(3, entity.ApplicantRole, 4, 9).
+					WillReturnRows(sqlmock.NewRows([]string{"applicant_name", "employer_name", "group_size"}).
+						AddRow("Иван", "ООО Ромашка", 2))
 			},
-		},
-		{
-			name:                  "Ошибка базы данных при выполнении запроса",
-			userID:                105,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при выполнении запроса GetApplyNotificationsForUser: %v", errors.New("database connection failed")),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnError(errors.New("database connection failed"))
+			expectedResult: &entity.NotificationPreview{
+				Type: entity.ApplyNotificationType, SenderID: 3, ReceiverID: 4, ObjectID: 7,
+				Payload:       entity.NotificationPayload{"vacancy_title": "Go-разработчик"},
+				ApplicantName: "Иван", EmployerName: "ООО Ромашка", GroupSize: 2,
 			},
 		},
 		{
-			name:                  "Ошибка таймаута базы данных",
-			userID:                106,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при выполнении запроса GetApplyNotificationsForUser: %v", errors.New("query timeout")),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnError(errors.New("query timeout"))
+			name: "Ошибка базы данных",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(3, entity.ApplicantRole, 4, 9).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &NotificationRepository{DB: db}
+			result, err := repo.GetEventPreview(context.Background(), notification)
+
+			if tc.expectedErr != nil {
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepository_DeleteNotification(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`DELETE FROM notification WHERE id = $1`)
+
+	testCases := []struct {
+		name        string
+		setupMock   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
 		{
-			name:                  "Ошибка сканирования строки результата",
-			userID:                107,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании строки результата запроса GetApplyNotificationsForUser: %v", errors.New(`sql: Scan error on column index 0, name "id": converting driver.Value type string ("invalid_id") to a int: invalid syntax`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					"invalid_id", "apply", 207, 107, 307, 407,
-					false, fixedTime1, "Тест", "Тест Компания", "Тест Вакансия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Уведомление удалено",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 		},
 		{
-			name:                  "Ошибка сканирования типа уведомления",
-			userID:                108,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании строки результата запроса GetApplyNotificationsForUser: %v", errors.New(`sql: Scan error on column index 1, name "type": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					7, nil, 208, 108, 308, 408,
-					false, fixedTime1, "Тест", "Тест Компания", "Тест Вакансия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Уведомление не найдено",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
 			},
+			expectedErr: entity.ErrNotFound,
 		},
 		{
-			name:                  "Ошибка сканирования времени создания",
-			userID:                109,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании строки результата запроса GetApplyNotificationsForUser: %v", errors.New(`sql: Scan error on column index 7, name "created_at": unsupported Scan, storing driver.Value type string into type *time.Time`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					8, "apply", 209, 109, 309, 409,
-					false, "invalid_time", "Тест", "Тест Компания", "Тест Вакансия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Отрицательный ID пользователя",
-			userID:                -1,
-			expectedNotifications: nil,
-			expectedErr:           nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				})
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Ошибка базы данных",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(5).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
 	}
 
@@ -2705,359 +2239,140 @@ func TestNotificationRepository_GetApplyNotificationsForUser(t *testing.T) {
 
 			db, mock, err := sqlmock.New()
 			require.NoError(t, err)
-			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
-				mock.ExpectClose()
-				err := db.Close()
-				require.NoError(t, err)
-			}(db, mock)
+			defer db.Close()
 
-			tc.setupMock(mock, tc.userID)
+			tc.setupMock(mock)
 
 			repo := &NotificationRepository{DB: db}
-			ctx := context.Background()
-
-			notifications, err := repo.GetApplyNotificationsForUser(ctx, tc.userID)
+			err = repo.DeleteNotification(context.Background(), 5)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
-				require.Nil(t, notifications)
 				var repoErr entity.Error
 				require.ErrorAs(t, err, &repoErr)
-				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expectedNotifications, notifications)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestNotificationRepository_GetDownloadResumeNotificationsForUser(t *testing.T) {
+func TestNotificationRepository_GetGroupNotifications(t *testing.T) {
 	t.Parallel()
 
-	query := regexp.QuoteMeta(`
-        SELECT 
-            n.id,
-            n.type,
-            n.sender_id,
-            n.receiver_id,
-            n.object_id,
-            n.resume_id,
-            n.is_viewed,
-            n.created_at,
-            a.first_name AS applicant_name,
-            e.company_name AS employer_name,
-            r.profession AS title
-        FROM notification n
-        LEFT JOIN resume r ON r.id = n.object_id
-        LEFT JOIN applicant a ON a.id = r.applicant_id
-        LEFT JOIN employer e ON e.id = n.sender_id
-        WHERE n.receiver_id = $1 AND n.type = 'download_resume'
-        ORDER BY n.created_at DESC
-    `)
-
-	fixedTime1 := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
-	fixedTime2 := time.Date(2024, 1, 14, 10, 30, 0, 0, time.UTC)
-	fixedTime3 := time.Date(2024, 1, 13, 8, 15, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(notificationPreviewQuery + `
+		WHERE COALESCE(n.group_id, n.id) = $1
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT $2 OFFSET $3
+	`)
+
+	columns := []string{
+		"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
+		"payload", "is_viewed", "created_at", "applicant_name", "employer_name", "group_id", "group_size",
+	}
+
+	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
 
 	testCases := []struct {
-		name                  string
-		userID                int
-		expectedNotifications []*entity.NotificationPreview
-		expectedErr           error
-		setupMock             func(mock sqlmock.Sqlmock, userID int)
+		name           string
+		expectedResult []*entity.NotificationPreview
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
 	}{
 		{
-			name:   "Успешное получение одного уведомления",
-			userID: 100,
-			expectedNotifications: []*entity.NotificationPreview{
-				{
-					ID:            1,
-					Type:          entity.DownloadResumeType,
-					SenderID:      200,
-					ReceiverID:    100,
-					ObjectID:      300,
-					ResumeID:      400,
-					IsViewed:      false,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Иван",
-					EmployerName:  "ООО Компания",
-					Title:         "Разработчик Go",
-				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					1, "download_resume", 200, 100, 300, 400,
-					false, fixedTime1, "Иван", "ООО Компания", "Разработчик Go",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:   "Успешное получение множественных уведомлений в правильном порядке",
-			userID: 101,
-			expectedNotifications: []*entity.NotificationPreview{
-				{
-					ID:            1,
-					Type:          entity.DownloadResumeType,
-					SenderID:      201,
-					ReceiverID:    101,
-					ObjectID:      301,
-					ResumeID:      401,
-					IsViewed:      false,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Петр",
-					EmployerName:  "ООО Рога",
-					Title:         "Backend Developer",
-				},
-				{
-					ID:            2,
-					Type:          entity.DownloadResumeType,
-					SenderID:      202,
-					ReceiverID:    101,
-					ObjectID:      302,
-					ResumeID:      402,
-					IsViewed:      true,
-					CreatedAt:     fixedTime2,
-					ApplicantName: "Анна",
-					EmployerName:  "ООО Копыта",
-					Title:         "Frontend Developer",
-				},
-				{
-					ID:            3,
-					Type:          entity.DownloadResumeType,
-					SenderID:      203,
-					ReceiverID:    101,
-					ObjectID:      303,
-					ResumeID:      403,
-					IsViewed:      false,
-					CreatedAt:     fixedTime3,
-					ApplicantName: "Мария",
-					EmployerName:  "ООО Хвосты",
-					Title:         "Fullstack Developer",
-				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).
-					AddRow(1, "download_resume", 201, 101, 301, 401, false, fixedTime1, "Петр", "ООО Рога", "Backend Developer").
-					AddRow(2, "download_resume", 202, 101, 302, 402, true, fixedTime2, "Анна", "ООО Копыта", "Frontend Developer").
-					AddRow(3, "download_resume", 203, 101, 303, 403, false, fixedTime3, "Мария", "ООО Хвосты", "Fullstack Developer")
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Успешное получение пустого списка уведомлений",
-			userID:                102,
-			expectedNotifications: nil,
-			expectedErr:           nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				})
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Успешное получение уведомлений с NULL значениями в JOIN полях",
-			userID:                103,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании результата запроса GetDownloadResumeNotificationsForUser: %v", errors.New(`sql: Scan error on column index 8, name "applicant_name": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					4, "download_resume", 204, 103, 304, 404,
-					false, fixedTime1, nil, nil, nil,
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Успешное получение уведомлений с частично NULL значениями",
-			userID:                104,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании результата запроса GetDownloadResumeNotificationsForUser: %v", errors.New(`sql: Scan error on column index 9, name "employer_name": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					5, "download_resume", 205, 104, 305, 405,
-					true, fixedTime1, "Сергей", nil, "DevOps Engineer",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:   "Успешное получение уведомлений с нулевыми ID",
-			userID: 0,
-			expectedNotifications: []*entity.NotificationPreview{
+			name: "Отклики группы по отдельности",
+			expectedResult: []*entity.NotificationPreview{
 				{
-					ID:            6,
-					Type:          entity.DownloadResumeType,
-					SenderID:      0,
-					ReceiverID:    0,
-					ObjectID:      0,
-					ResumeID:      0,
-					IsViewed:      false,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Тест",
-					EmployerName:  "Тест Компания",
-					Title:         "Тест Профессия",
+					ID: 7, Type: entity.ApplyNotificationType, SenderID: 101, ReceiverID: 200, ObjectID: 300, ResumeID: 401,
+					Payload: entity.NotificationPayload{"vacancy_title": "Backend Developer"}, CreatedAt: fixedTime,
+					ApplicantName: "Петр", GroupID: 1, GroupSize: 1,
 				},
-			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					6, "download_resume", 0, 0, 0, 0,
-					false, fixedTime1, "Тест", "Тест Компания", "Тест Профессия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:   "Успешное получение уведомлений с максимальными ID",
-			userID: 2147483647,
-			expectedNotifications: []*entity.NotificationPreview{
 				{
-					ID:            2147483647,
-					Type:          entity.DownloadResumeType,
-					SenderID:      2147483646,
-					ReceiverID:    2147483647,
-					ObjectID:      2147483645,
-					ResumeID:      2147483644,
-					IsViewed:      true,
-					CreatedAt:     fixedTime1,
-					ApplicantName: "Максимум",
-					EmployerName:  "Максимум Компания",
-					Title:         "Максимум Профессия",
+					ID: 1, Type: entity.ApplyNotificationType, SenderID: 100, ReceiverID: 200, ObjectID: 300, ResumeID: 400,
+					Payload: entity.NotificationPayload{"vacancy_title": "Backend Developer"}, IsViewed: true, CreatedAt: fixedTime,
+					ApplicantName: "Иван", GroupID: 1, GroupSize: 1,
 				},
 			},
-			expectedErr: nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					2147483647, "download_resume", 2147483646, 2147483647, 2147483645, 2147483644,
-					true, fixedTime1, "Максимум", "Максимум Компания", "Максимум Профессия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Ошибка базы данных при выполнении запроса",
-			userID:                105,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationsForUser: %v", errors.New("database connection failed")),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnError(errors.New("database connection failed"))
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 20, 0).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(7, "apply", 101, 200, 300, 401, []byte(`{"vacancy_title": "Backend Developer"}`),
+							false, fixedTime, "Петр", "", 1, 1).
+						AddRow(1, "apply", 100, 200, 300, 400, []byte(`{"vacancy_title": "Backend Developer"}`),
+							true, fixedTime, "Иван", "", 1, 1))
 			},
 		},
 		{
-			name:                  "Ошибка таймаута базы данных",
-			userID:                106,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при выполнении запроса GetDownloadResumeNotificationsForUser: %v", errors.New("query timeout")),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnError(errors.New("query timeout"))
+			name: "Ошибка базы данных",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 20, 0).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &NotificationRepository{DB: db}
+			result, err := repo.GetGroupNotifications(context.Background(), 1, 20, 0)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepository_ReadNotificationGroup(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		UPDATE notification
+		SET is_viewed = true
+		WHERE COALESCE(group_id, id) = $1 AND NOT is_viewed
+	`)
+
+	testCases := []struct {
+		name        string
+		setupMock   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
 		{
-			name:                  "Ошибка сканирования строки результата",
-			userID:                107,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании результата запроса GetDownloadResumeNotificationsForUser: %v", errors.New(`sql: Scan error on column index 0, name "id": converting driver.Value type string ("invalid_id") to a int: invalid syntax`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					"invalid_id", "download_resume", 207, 107, 307, 407,
-					false, fixedTime1, "Тест", "Тест Компания", "Тест Профессия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Группа прочитана",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 12))
 			},
 		},
 		{
-			name:                  "Ошибка сканирования типа уведомления",
-			userID:                108,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании результата запроса GetDownloadResumeNotificationsForUser: %v", errors.New(`sql: Scan error on column index 1, name "type": converting NULL to string is unsupported`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					7, nil, 208, 108, 308, 408,
-					false, fixedTime1, "Тест", "Тест Компания", "Тест Профессия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Группа уже была прочитана",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 		},
 		{
-			name:                  "Ошибка сканирования времени создания",
-			userID:                109,
-			expectedNotifications: nil,
-			expectedErr: entity.NewError(
-				entity.ErrInternal,
-				fmt.Errorf("ошибка при сканировании результата запроса GetDownloadResumeNotificationsForUser: %v", errors.New(`sql: Scan error on column index 7, name "created_at": unsupported Scan, storing driver.Value type string into type *time.Time`)),
-			),
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				}).AddRow(
-					8, "download_resume", 209, 109, 309, 409,
-					false, "invalid_time", "Тест", "Тест Компания", "Тест Профессия",
-				)
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
-			},
-		},
-		{
-			name:                  "Отрицательный ID пользователя",
-			userID:                -1,
-			expectedNotifications: nil,
-			expectedErr:           nil,
-			setupMock: func(mock sqlmock.Sqlmock, userID int) {
-				rows := sqlmock.NewRows([]string{
-					"id", "type", "sender_id", "receiver_id", "object_id", "resume_id",
-					"is_viewed", "created_at", "applicant_name", "employer_name", "title",
-				})
-				mock.ExpectQuery(query).WithArgs(userID).WillReturnRows(rows)
+			name: "Ошибка базы данных",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(1).WillReturnError(errors.New("db error"))
 			},
+			expectedErr: entity.ErrInternal,
 		},
 	}
 
@@ -3068,28 +2383,20 @@ func TestNotificationRepository_GetDownloadResumeNotificationsForUser(t *testing
 
 			db, mock, err := sqlmock.New()
 			require.NoError(t, err)
-			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
-				mock.ExpectClose()
-				err := db.Close()
-				require.NoError(t, err)
-			}(db, mock)
+			defer db.Close()
 
-			tc.setupMock(mock, tc.userID)
+			tc.setupMock(mock)
 
 			repo := &NotificationRepository{DB: db}
-			ctx := context.Background()
-
-			notifications, err := repo.GetDownloadResumeNotificationsForUser(ctx, tc.userID)
+			err = repo.ReadNotificationGroup(context.Background(), 1)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
-				require.Nil(t, notifications)
 				var repoErr entity.Error
 				require.ErrorAs(t, err, &repoErr)
-				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expectedNotifications, notifications)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
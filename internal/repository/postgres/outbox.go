@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/utils"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) repository.OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// queryRower - *sql.DB или *sql.Tx. Через него другие репозитории пишут событие в своей транзакции
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// insertOutboxEvent записывает событие в outbox и заполняет его ID
+func insertOutboxEvent(ctx context.Context, q queryRower, event *entity.OutboxEvent) error {
+	var notification []byte
+	if event.Notification != nil {
+		var err error
+		if notification, err = json.Marshal(event.Notification); err != nil {
+			return fmt.Errorf("не удалось сериализовать уведомление события %s: %w", event.Type, err)
+		}
+	}
+
+	objects := []byte("{}")
+	if len(event.Objects) > 0 {
+		var err error
+		if objects, err = json.Marshal(event.Objects); err != nil {
+			return fmt.Errorf("не удалось сериализовать объекты события %s: %w", event.Type, err)
+		}
+	}
+
+	query := `
+		INSERT INTO outbox_event (type, notification, objects)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	return q.QueryRowContext(ctx, query, event.Type, notification, objects).Scan(&event.ID, &event.CreatedAt)
+}
+
+// AddEvent записывает событие, которое не сопровождается изменением данных
+func (r *OutboxRepository) AddEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	requestID := utils.GetRequestID(ctx)
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"type":      event.Type,
+	}).Info("Выполнение sql-запроса записи события в outbox AddEvent")
+
+	if err := insertOutboxEvent(ctx, r.db, event); err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("Ошибка при записи события в outbox")
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события в outbox: %w", err),
+		)
+	}
+	return nil
+}
+
+// ClaimEvents забирает до limit недоставленных событий, время повтора которых наступило, и откладывает
+// их на lease. Пока событие обрабатывается, другие экземпляры диспетчера его не возьмут, а если
+// обработчик упадет, событие вернется в очередь по истечении lease. События, исчерпавшие maxAttempts,
+// остаются в таблице с последней ошибкой
+func (r *OutboxRepository) ClaimEvents(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*entity.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_event
+		SET attempts = attempts + 1,
+		    next_attempt_at = NOW() + make_interval(secs => $3)
+		WHERE id IN (
+		    SELECT id
+		    FROM outbox_event
+		    WHERE delivered_at IS NULL AND next_attempt_at <= NOW() AND attempts < $2
+		    ORDER BY id
+		    LIMIT $1
+		    FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, type, notification, objects, delivered_sinks, attempts, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, maxAttempts, lease.Seconds())
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении событий из outbox: %w", err),
+		)
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			l.Log.Errorf("не удалось закрыть rows: %v", err)
+		}
+	}(rows)
+
+	var events []*entity.OutboxEvent
+	for rows.Next() {
+		var (
+			event        entity.OutboxEvent
+			notification []byte
+			objects      []byte
+		)
+		if err := rows.Scan(
+			&event.ID,
+			&event.Type,
+			&notification,
+			&objects,
+			pq.Array(&event.DeliveredSinks),
+			&event.Attempts,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при чтении события из outbox: %w", err),
+			)
+		}
+		if notification != nil {
+			event.Notification = &entity.Notification{}
+			if err := json.Unmarshal(notification, event.Notification); err != nil {
+				return nil, entity.NewError(
+					entity.ErrInternal,
+					fmt.Errorf("некорректное уведомление события %d: %w", event.ID, err),
+				)
+			}
+		}
+		if err := json.Unmarshal(objects, &event.Objects); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("некорректные объекты события %d: %w", event.ID, err),
+			)
+		}
+		if len(event.Objects) == 0 {
+			event.Objects = nil
+		}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при обходе событий из outbox: %w", err),
+		)
+	}
+
+	// RETURNING не сохраняет порядок подзапроса, а события одного объекта лучше доставлять по порядку
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+	return events, nil
+}
+
+// MarkEventDelivered отмечает событие доставленным всем получателям sinks
+func (r *OutboxRepository) MarkEventDelivered(ctx context.Context, eventID int, sinks []string) error {
+	query := `
+		UPDATE outbox_event
+		SET delivered_sinks = $2,
+		    delivered_at = NOW(),
+		    last_error = ''
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, eventID, pq.Array(sinks)); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при отметке доставки события %d: %w", eventID, err),
+		)
+	}
+	return nil
+}
+
+// MarkEventFailed сохраняет получателей, которым событие уже доставлено, и откладывает повтор до retryAt
+func (r *OutboxRepository) MarkEventFailed(ctx context.Context, eventID int, sinks []string, retryAt time.Time, deliveryErr string) error {
+	query := `
+		UPDATE outbox_event
+		SET delivered_sinks = $2,
+		    next_attempt_at = $3,
+		    last_error = $4
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, eventID, pq.Array(sinks), retryAt, deliveryErr); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при сохранении неудачной доставки события %d: %w", eventID, err),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_AddEvent(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`INSERT INTO outbox_event (type, notification, objects)`)
+	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		event       *entity.OutboxEvent
+		setupMock   func(mock sqlmock.Sqlmock)
+		expectedID  int
+		expectedErr error
+	}{
+		{
+			name: "Событие с уведомлением",
+			event: &entity.OutboxEvent{
+				Type:         entity.ResumeDownloadedEvent,
+				Notification: &entity.Notification{Type: entity.DownloadResumeType, SenderID: 1, ReceiverID: 2, ObjectID: 3},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(entity.ResumeDownloadedEvent, sqlmock.AnyArg(), []byte("{}")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, fixedTime))
+			},
+			expectedID: 5,
+		},
+		{
+			name: "Событие без уведомления",
+			event: &entity.OutboxEvent{
+				Type:    entity.VacancyUpdatedEvent,
+				Objects: map[string]int{"vacancy_id": 3},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(entity.VacancyUpdatedEvent, []byte(nil), []byte(`{"vacancy_id":3}`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(6, fixedTime))
+			},
+			expectedID: 6,
+		},
+		{
+			name:  "Ошибка записи",
+			event: &entity.OutboxEvent{Type: entity.ResponseCreatedEvent},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(entity.ResponseCreatedEvent, []byte(nil), []byte("{}")).WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &OutboxRepository{db: db}
+			err = repo.AddEvent(context.Background(), tc.event)
+
+			if tc.expectedErr != nil {
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedID, tc.event.ID)
+				require.Equal(t, fixedTime, tc.event.CreatedAt)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOutboxRepository_ClaimEvents(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`UPDATE outbox_event`)
+	columns := []string{"id", "type", "notification", "objects", "delivered_sinks", "attempts", "created_at"}
+	fixedTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []*entity.OutboxEvent
+		expectedErr    error
+	}{
+		{
+			name: "События упорядочены по id",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(10, 5, float64(60)).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(8, "response_withdrawn", nil, []byte(`{"vacancy_id":3,"applicant_id":1}`), "{}", 1, fixedTime).
+						AddRow(7, "response_created", []byte(`{"type":"apply","sender_id":1,"receiver_id":2}`), []byte("{}"), "{notification}", 2, fixedTime))
+			},
+			expectedResult: []*entity.OutboxEvent{
+				{
+					ID:             7,
+					Type:           entity.ResponseCreatedEvent,
+					Notification:   &entity.Notification{Type: entity.ApplyNotificationType, SenderID: 1, ReceiverID: 2},
+					DeliveredSinks: []string{"notification"},
+					Attempts:       2,
+					CreatedAt:      fixedTime,
+				},
+				{
+					ID:             8,
+					Type:           entity.ResponseWithdrawnEvent,
+					Objects:        map[string]int{"vacancy_id": 3, "applicant_id": 1},
+					DeliveredSinks: []string{},
+					Attempts:       1,
+					CreatedAt:      fixedTime,
+				},
+			},
+		},
+		{
+			name: "Некорректное уведомление",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(10, 5, float64(60)).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(7, "response_created", []byte(`{`), []byte("{}"), "{}", 1, fixedTime))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+		{
+			name: "Ошибка запроса",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(10, 5, float64(60)).WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &OutboxRepository{db: db}
+			result, err := repo.ClaimEvents(context.Background(), 10, 5, time.Minute)
+
+			if tc.expectedErr != nil {
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOutboxRepository_MarkEventFailed(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`UPDATE outbox_event`)
+	retryAt := time.Date(2024, 1, 15, 12, 0, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		setupMock   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name: "Повтор отложен",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(7, `{"notification"}`, retryAt, "chat: chat unavailable").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Ошибка запроса",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WithArgs(7, `{"notification"}`, retryAt, "chat: chat unavailable").
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.setupMock(mock)
+
+			repo := &OutboxRepository{db: db}
+			err = repo.MarkEventFailed(context.Background(), 7, []string{"notification"}, retryAt, "chat: chat unavailable")
+
+			if tc.expectedErr != nil {
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.ErrorIs(t, repoErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
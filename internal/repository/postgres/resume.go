@@ -9,8 +9,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-
-	// "strings"
+	"strings"
 
 	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
@@ -34,10 +33,14 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *entity.Resume) (*
 	query := `
 		INSERT INTO resume (
 			applicant_id, about_me, specialization_id, education, 
-			educational_institution, graduation_year, profession, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+			educational_institution, graduation_year, profession,
+			desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+			work_formats, employment_types, schedules, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
 		RETURNING id, applicant_id, about_me, specialization_id, education, 
-				  educational_institution, graduation_year, profession, created_at, updated_at
+				  educational_institution, graduation_year, profession, created_at, updated_at,
+				  desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+				  work_formats, employment_types, schedules
 	`
 
 	var createdResume entity.Resume
@@ -51,6 +54,13 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *entity.Resume) (*
 		resume.EducationalInstitution,
 		resume.GraduationYear,
 		resume.Profession,
+		resume.DesiredSalary,
+		resume.SalaryCurrency,
+		resume.ReadyToRelocate,
+		resume.ReadyForBusinessTrips,
+		stringArray(resume.WorkFormats),
+		stringArray(resume.EmploymentTypes),
+		stringArray(resume.Schedules),
 	).Scan(
 		&createdResume.ID,
 		&createdResume.ApplicantID,
@@ -62,6 +72,13 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *entity.Resume) (*
 		&createdResume.Profession,
 		&createdResume.CreatedAt,
 		&createdResume.UpdatedAt,
+		&createdResume.DesiredSalary,
+		&createdResume.SalaryCurrency,
+		&createdResume.ReadyToRelocate,
+		&createdResume.ReadyForBusinessTrips,
+		pq.Array(&createdResume.WorkFormats),
+		pq.Array(&createdResume.EmploymentTypes),
+		pq.Array(&createdResume.Schedules),
 	)
 
 	if err != nil {
@@ -325,12 +342,16 @@ func (r *ResumeRepository) GetByID(ctx context.Context, id int) (*entity.Resume,
 
 	query := `
 		SELECT id, applicant_id, about_me, specialization_id, education, 
-			   educational_institution, graduation_year, profession, created_at, updated_at
+			   educational_institution, graduation_year, profession, created_at, updated_at,
+			   visibility, access_token, is_anonymous,
+			   desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+			   work_formats, employment_types, schedules
 	FROM resume
 	WHERE id = $1
 `
 
 	var resume entity.Resume
+	var accessToken sql.NullString
 	err := r.DB.QueryRowContext(ctx, query, id).Scan(
 		&resume.ID,
 		&resume.ApplicantID,
@@ -342,6 +363,16 @@ func (r *ResumeRepository) GetByID(ctx context.Context, id int) (*entity.Resume,
 		&resume.Profession,
 		&resume.CreatedAt,
 		&resume.UpdatedAt,
+		&resume.Visibility,
+		&accessToken,
+		&resume.IsAnonymous,
+		&resume.DesiredSalary,
+		&resume.SalaryCurrency,
+		&resume.ReadyToRelocate,
+		&resume.ReadyForBusinessTrips,
+		pq.Array(&resume.WorkFormats),
+		pq.Array(&resume.EmploymentTypes),
+		pq.Array(&resume.Schedules),
 	)
 
 	if err != nil {
@@ -365,6 +396,10 @@ func (r *ResumeRepository) GetByID(ctx context.Context, id int) (*entity.Resume,
 		)
 	}
 
+	if accessToken.Valid {
+		resume.AccessToken = accessToken.String
+	}
+
 	return &resume, nil
 }
 
@@ -743,10 +778,19 @@ func (r *ResumeRepository) Update(ctx context.Context, resume *entity.Resume) (*
 			educational_institution = $4,
 			graduation_year = $5,
 			profession = $6,
+			desired_salary = $7,
+			salary_currency = $8,
+			ready_to_relocate = $9,
+			ready_for_business_trips = $10,
+			work_formats = $11,
+			employment_types = $12,
+			schedules = $13,
 			updated_at = NOW()
-		WHERE id = $7 AND applicant_id = $8
+		WHERE id = $14 AND applicant_id = $15
 		RETURNING id, applicant_id, about_me, specialization_id, education, 
-				  educational_institution, graduation_year, profession, created_at, updated_at
+				  educational_institution, graduation_year, profession, created_at, updated_at,
+				  desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+				  work_formats, employment_types, schedules
 	`
 
 	var updatedResume entity.Resume
@@ -759,6 +803,13 @@ func (r *ResumeRepository) Update(ctx context.Context, resume *entity.Resume) (*
 		resume.EducationalInstitution,
 		resume.GraduationYear,
 		resume.Profession,
+		resume.DesiredSalary,
+		resume.SalaryCurrency,
+		resume.ReadyToRelocate,
+		resume.ReadyForBusinessTrips,
+		stringArray(resume.WorkFormats),
+		stringArray(resume.EmploymentTypes),
+		stringArray(resume.Schedules),
 		resume.ID,
 		resume.ApplicantID,
 	).Scan(
@@ -772,6 +823,13 @@ func (r *ResumeRepository) Update(ctx context.Context, resume *entity.Resume) (*
 		&updatedResume.Profession,
 		&updatedResume.CreatedAt,
 		&updatedResume.UpdatedAt,
+		&updatedResume.DesiredSalary,
+		&updatedResume.SalaryCurrency,
+		&updatedResume.ReadyToRelocate,
+		&updatedResume.ReadyForBusinessTrips,
+		pq.Array(&updatedResume.WorkFormats),
+		pq.Array(&updatedResume.EmploymentTypes),
+		pq.Array(&updatedResume.Schedules),
 	)
 
 	if err != nil {
@@ -1157,23 +1215,30 @@ func (r *ResumeRepository) DeleteWorkExperience(ctx context.Context, id int) err
 	return nil
 }
 
-// GetAll получает список всех резюме
-func (r *ResumeRepository) GetAll(ctx context.Context, limit int, offset int) ([]entity.Resume, error) {
+// GetAll получает список резюме, доступных работодателю в общем списке
+func (r *ResumeRepository) GetAll(ctx context.Context, employerID int, limit int, offset int) ([]entity.Resume, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
-		"requestID": requestID,
+		"requestID":  requestID,
+		"employerID": employerID,
 	}).Info("sql-запрос в БД на получение всех резюме GetAll")
 
 	query := `
 		SELECT id, applicant_id, about_me, specialization_id, education, 
-			   educational_institution, graduation_year, profession, created_at, updated_at
+			   educational_institution, graduation_year, profession, created_at, updated_at, is_anonymous,
+			   desired_salary, salary_currency, work_formats
 		FROM resume
+		WHERE visibility IN ('public', 'employers_only')
+		  AND NOT EXISTS (
+			  SELECT 1 FROM applicant_blocked_employer b
+			  WHERE b.applicant_id = resume.applicant_id AND b.employer_id = $1
+		  )
 		ORDER BY updated_at DESC
-		LIMIT $1 OFFSET $2
+		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.DB.QueryContext(ctx, query, limit, offset)
+	rows, err := r.DB.QueryContext(ctx, query, employerID, limit, offset)
 	if err != nil {
 
 		l.Log.WithFields(logrus.Fields{
@@ -1211,6 +1276,10 @@ func (r *ResumeRepository) GetAll(ctx context.Context, limit int, offset int) ([
 			&resume.Profession,
 			&resume.CreatedAt,
 			&resume.UpdatedAt,
+			&resume.IsAnonymous,
+			&resume.DesiredSalary,
+			&resume.SalaryCurrency,
+			pq.Array(&resume.WorkFormats),
 		)
 		if err != nil {
 
@@ -1786,25 +1855,42 @@ func (r *ResumeRepository) CreateSpecializationIfNotExists(ctx context.Context,
 	return id, nil
 }
 
-// SearchResumesByProfession ищет резюме по профессии
-func (r *ResumeRepository) SearchResumesByProfession(ctx context.Context, profession string, limit int, offset int) ([]entity.Resume, error) {
+// SearchResumesByProfession ищет по профессии среди резюме, доступных работодателю
+func (r *ResumeRepository) SearchResumesByProfession(ctx context.Context, employerID int, profession string, filter entity.ResumeFilter, limit int, offset int) ([]entity.Resume, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
 		"requestID":  requestID,
+		"employerID": employerID,
 		"profession": profession,
+		"filter":     filter,
 	}).Info("sql-запрос в БД на поиск резюме по профессии SearchResumesByProfession")
 
 	query := `
         SELECT id, applicant_id, about_me, specialization_id, education, 
-               educational_institution, graduation_year, profession, created_at, updated_at
+               educational_institution, graduation_year, profession, created_at, updated_at, is_anonymous,
+               desired_salary, salary_currency, work_formats
         FROM resume
         WHERE profession ILIKE $1
-        ORDER BY updated_at DESC
-        LIMIT $2 OFFSET $3
+          AND visibility IN ('public', 'employers_only')
+          AND NOT EXISTS (
+              SELECT 1 FROM applicant_blocked_employer b
+              WHERE b.applicant_id = resume.applicant_id AND b.employer_id = $2
+          )
     `
 
-	rows, err := r.DB.QueryContext(ctx, query, "%"+profession+"%", limit, offset)
+	params := []interface{}{"%" + profession + "%", employerID}
+	whereClauses, params := resumeFilterClauses(filter, params)
+	for _, clause := range whereClauses {
+		query += "\n          AND " + clause
+	}
+
+	query += fmt.Sprintf(`
+        ORDER BY updated_at DESC
+        LIMIT $%d OFFSET $%d`, len(params)+1, len(params)+2)
+	params = append(params, limit, offset)
+
+	rows, err := r.DB.QueryContext(ctx, query, params...)
 	if err != nil {
 
 		l.Log.WithFields(logrus.Fields{
@@ -1841,6 +1927,128 @@ func (r *ResumeRepository) SearchResumesByProfession(ctx context.Context, profes
 			&resume.Profession,
 			&resume.CreatedAt,
 			&resume.UpdatedAt,
+			&resume.IsAnonymous,
+			&resume.DesiredSalary,
+			&resume.SalaryCurrency,
+			pq.Array(&resume.WorkFormats),
+		)
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     err,
+			}).Error("ошибка при сканировании резюме")
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании резюме: %w", err),
+			)
+		}
+		resumes = append(resumes, resume)
+	}
+
+	if err := rows.Err(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при итерации по резюме")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по резюме: %w", err),
+		)
+	}
+
+	return resumes, nil
+}
+
+// SearchResumesAdvanced ищет резюме для работодателя по набору фильтров.
+// Результаты упорядочены по релевантности: совпадение текста и число найденных навыков
+func (r *ResumeRepository) SearchResumesAdvanced(ctx context.Context, employerID int, params entity.ResumeSearchParams, limit int, offset int) ([]entity.Resume, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":  requestID,
+		"employerID": employerID,
+		"params":     params,
+	}).Info("sql-запрос в БД на расширенный поиск резюме SearchResumesAdvanced")
+
+	query := `
+        SELECT id, applicant_id, about_me, specialization_id, education, 
+               educational_institution, graduation_year, profession, created_at, updated_at, is_anonymous,
+               desired_salary, salary_currency, work_formats
+        FROM resume
+        WHERE visibility IN ('public', 'employers_only')
+          AND NOT EXISTS (
+              SELECT 1 FROM applicant_blocked_employer b
+              WHERE b.applicant_id = resume.applicant_id AND b.employer_id = $1
+          )
+    `
+
+	queryParams := []interface{}{employerID}
+	whereClauses, queryParams := resumeFilterClauses(params.ResumeFilter, queryParams)
+	searchClauses, relevance, queryParams := resumeSearchClauses(params, queryParams)
+	for _, clause := range append(whereClauses, searchClauses...) {
+		query += "\n          AND " + clause
+	}
+
+	orderBy := "updated_at DESC"
+	if relevance != "" {
+		orderBy = relevance + " DESC, updated_at DESC"
+	}
+	query += fmt.Sprintf(`
+        ORDER BY %s
+        LIMIT $%d OFFSET $%d`, orderBy, len(queryParams)+1, len(queryParams)+2)
+	queryParams = append(queryParams, limit, offset)
+
+	rows, err := r.DB.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLDatatypeViolation {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректные параметры поиска резюме: %w", err),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при расширенном поиске резюме")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при расширенном поиске резюме: %w", err),
+		)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}()
+
+	var resumes []entity.Resume
+	for rows.Next() {
+		var resume entity.Resume
+		err := rows.Scan(
+			&resume.ID,
+			&resume.ApplicantID,
+			&resume.AboutMe,
+			&resume.SpecializationID,
+			&resume.Education,
+			&resume.EducationalInstitution,
+			&resume.GraduationYear,
+			&resume.Profession,
+			&resume.CreatedAt,
+			&resume.UpdatedAt,
+			&resume.IsAnonymous,
+			&resume.DesiredSalary,
+			&resume.SalaryCurrency,
+			pq.Array(&resume.WorkFormats),
 		)
 		if err != nil {
 
@@ -1958,3 +2166,1015 @@ func (r *ResumeRepository) SearchResumesByProfessionForApplicant(ctx context.Con
 
 	return resumes, nil
 }
+
+// UpdateVisibility обновляет настройки приватности резюме: видимость, токен доступа по ссылке и анонимность
+func (r *ResumeRepository) UpdateVisibility(ctx context.Context, resumeID int, visibility entity.ResumeVisibility, accessToken string, isAnonymous bool) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"resumeID":    resumeID,
+		"visibility":  visibility,
+		"isAnonymous": isAnonymous,
+	}).Info("sql-запрос в БД на обновление видимости резюме UpdateVisibility")
+
+	query := `
+		UPDATE resume
+		SET visibility = $1, access_token = NULLIF($2, ''), is_anonymous = $3, updated_at = NOW()
+		WHERE id = $4
+	`
+
+	result, err := r.DB.ExecContext(ctx, query, visibility, accessToken, isAnonymous, resumeID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLDatatypeViolation {
+			return entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("неправильный формат видимости резюме: %w", err),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при обновлении видимости резюме")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при обновлении видимости резюме: %w", err),
+		)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении количества затронутых строк: %w", err),
+		)
+	}
+
+	if rowsAffected == 0 {
+		return entity.NewError(
+			entity.ErrNotFound,
+			fmt.Errorf("резюме с id=%d не найдено", resumeID),
+		)
+	}
+
+	return nil
+}
+
+// ResumeSentToEmployer проверяет, откликался ли соискатель этим резюме на вакансии работодателя
+func (r *ResumeRepository) ResumeSentToEmployer(ctx context.Context, resumeID, employerID int) (bool, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":  requestID,
+		"resumeID":   resumeID,
+		"employerID": employerID,
+	}).Info("sql-запрос в БД на проверку отклика резюме работодателю ResumeSentToEmployer")
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM vacancy_response vr
+			JOIN vacancy v ON v.id = vr.vacancy_id
+			WHERE vr.resume_id = $1 AND v.employer_id = $2
+		)
+	`
+
+	var exists bool
+	if err := r.DB.QueryRowContext(ctx, query, resumeID, employerID).Scan(&exists); err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при проверке отклика резюме работодателю")
+
+		return false, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при проверке отклика резюме работодателю: %w", err),
+		)
+	}
+
+	return exists, nil
+}
+
+// IsEmployerBlocked проверяет, заблокировал ли соискатель работодателя
+func (r *ResumeRepository) IsEmployerBlocked(ctx context.Context, applicantID, employerID int) (bool, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"applicantID": applicantID,
+		"employerID":  employerID,
+	}).Info("sql-запрос в БД на проверку блокировки работодателя IsEmployerBlocked")
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM applicant_blocked_employer
+			WHERE applicant_id = $1 AND employer_id = $2
+		)
+	`
+
+	var blocked bool
+	if err := r.DB.QueryRowContext(ctx, query, applicantID, employerID).Scan(&blocked); err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при проверке блокировки работодателя")
+
+		return false, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при проверке блокировки работодателя: %w", err),
+		)
+	}
+
+	return blocked, nil
+}
+
+// BlockEmployer запрещает работодателю просматривать резюме соискателя
+func (r *ResumeRepository) BlockEmployer(ctx context.Context, applicantID, employerID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"applicantID": applicantID,
+		"employerID":  employerID,
+	}).Info("sql-запрос в БД на блокировку работодателя BlockEmployer")
+
+	query := `
+		INSERT INTO applicant_blocked_employer (applicant_id, employer_id)
+		VALUES ($1, $2)
+		ON CONFLICT (applicant_id, employer_id) DO NOTHING
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, applicantID, employerID); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+			return entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("работодатель с id=%d не найден", employerID),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при блокировке работодателя")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при блокировке работодателя: %w", err),
+		)
+	}
+
+	return nil
+}
+
+// UnblockEmployer снимает блокировку работодателя
+func (r *ResumeRepository) UnblockEmployer(ctx context.Context, applicantID, employerID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"applicantID": applicantID,
+		"employerID":  employerID,
+	}).Info("sql-запрос в БД на разблокировку работодателя UnblockEmployer")
+
+	query := `
+		DELETE FROM applicant_blocked_employer
+		WHERE applicant_id = $1 AND employer_id = $2
+	`
+
+	result, err := r.DB.ExecContext(ctx, query, applicantID, employerID)
+	if err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при разблокировке работодателя")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при разблокировке работодателя: %w", err),
+		)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении количества затронутых строк: %w", err),
+		)
+	}
+
+	if rowsAffected == 0 {
+		return entity.NewError(
+			entity.ErrNotFound,
+			fmt.Errorf("работодатель с id=%d не заблокирован", employerID),
+		)
+	}
+
+	return nil
+}
+
+// GetBlockedEmployers получает список работодателей, заблокированных соискателем
+func (r *ResumeRepository) GetBlockedEmployers(ctx context.Context, applicantID int) ([]entity.BlockedEmployer, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"applicantID": applicantID,
+	}).Info("sql-запрос в БД на получение заблокированных работодателей GetBlockedEmployers")
+
+	query := `
+		SELECT b.applicant_id, b.employer_id, e.company_name, b.created_at
+		FROM applicant_blocked_employer b
+		JOIN employer e ON e.id = b.employer_id
+		WHERE b.applicant_id = $1
+		ORDER BY b.created_at DESC
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, applicantID)
+	if err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при получении заблокированных работодателей")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении заблокированных работодателей: %w", err),
+		)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}()
+
+	var blocked []entity.BlockedEmployer
+	for rows.Next() {
+		var employer entity.BlockedEmployer
+		if err := rows.Scan(
+			&employer.ApplicantID,
+			&employer.EmployerID,
+			&employer.CompanyName,
+			&employer.CreatedAt,
+		); err != nil {
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании заблокированного работодателя: %w", err),
+			)
+		}
+		blocked = append(blocked, employer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по заблокированным работодателям: %w", err),
+		)
+	}
+
+	return blocked, nil
+}
+
+// ContactsDisclosed проверяет, раскрыты ли контакты соискателя работодателю:
+// соискатель откликался на его вакансию или принял запрос контактов
+func (r *ResumeRepository) ContactsDisclosed(ctx context.Context, applicantID, employerID int) (bool, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"applicantID": applicantID,
+		"employerID":  employerID,
+	}).Info("sql-запрос в БД на проверку раскрытия контактов ContactsDisclosed")
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM vacancy_response vr
+			JOIN vacancy v ON v.id = vr.vacancy_id
+			WHERE vr.applicant_id = $1 AND v.employer_id = $2
+		) OR EXISTS (
+			SELECT 1 FROM contact_request
+			WHERE applicant_id = $1 AND employer_id = $2 AND status = 'accepted'
+		)
+	`
+
+	var disclosed bool
+	if err := r.DB.QueryRowContext(ctx, query, applicantID, employerID).Scan(&disclosed); err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при проверке раскрытия контактов")
+
+		return false, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при проверке раскрытия контактов: %w", err),
+		)
+	}
+
+	return disclosed, nil
+}
+
+// HasAnonymousResume проверяет, есть ли у соискателя хотя бы одно анонимное резюме
+func (r *ResumeRepository) HasAnonymousResume(ctx context.Context, applicantID int) (bool, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"applicantID": applicantID,
+	}).Info("sql-запрос в БД на проверку анонимных резюме HasAnonymousResume")
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM resume
+			WHERE applicant_id = $1 AND is_anonymous
+		)
+	`
+
+	var anonymous bool
+	if err := r.DB.QueryRowContext(ctx, query, applicantID).Scan(&anonymous); err != nil {
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при проверке анонимных резюме")
+
+		return false, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при проверке анонимных резюме: %w", err),
+		)
+	}
+
+	return anonymous, nil
+}
+
+// CreateContactRequest создает запрос контактов и записывает событие о нем в одной транзакции.
+// Id запроса дописывается в ObjectID уведомления события. Отклоненный ранее запрос можно отправить повторно,
+// ожидающий или принятый запрос повторно не создается
+func (r *ResumeRepository) CreateContactRequest(ctx context.Context, request *entity.ContactRequest, event *entity.OutboxEvent) (*entity.ContactRequest, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"employerID":  request.EmployerID,
+		"applicantID": request.ApplicantID,
+		"resumeID":    request.ResumeID,
+	}).Info("sql-запрос в БД на создание запроса контактов CreateContactRequest")
+
+	query := `
+		INSERT INTO contact_request (employer_id, applicant_id, resume_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (employer_id, applicant_id) DO UPDATE
+		SET status = 'pending', resume_id = EXCLUDED.resume_id, updated_at = NOW()
+		WHERE contact_request.status = 'declined'
+		RETURNING id, employer_id, applicant_id, resume_id, status, created_at, updated_at
+	`
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции создания запроса контактов: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции создания запроса контактов")
+			}
+		}
+	}()
+
+	var created entity.ContactRequest
+	err = tx.QueryRowContext(ctx, query, request.EmployerID, request.ApplicantID, request.ResumeID).Scan(
+		&created.ID,
+		&created.EmployerID,
+		&created.ApplicantID,
+		&created.ResumeID,
+		&created.Status,
+		&created.CreatedAt,
+		&created.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrAlreadyExists,
+				fmt.Errorf("запрос контактов уже отправлен"),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при создании запроса контактов")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при создании запроса контактов: %w", err),
+		)
+	}
+
+	if event.Notification != nil {
+		event.Notification.ObjectID = created.ID
+	}
+	if err = insertOutboxEvent(ctx, tx, event); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события о запросе контактов: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции создания запроса контактов: %w", err),
+		)
+	}
+	return &created, nil
+}
+
+// GetContactRequestByID получает запрос контактов по id
+func (r *ResumeRepository) GetContactRequestByID(ctx context.Context, id int) (*entity.ContactRequest, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":        requestID,
+		"contactRequestID": id,
+	}).Info("sql-запрос в БД на получение запроса контактов GetContactRequestByID")
+
+	query := `
+		SELECT id, employer_id, applicant_id, resume_id, status, created_at, updated_at
+		FROM contact_request
+		WHERE id = $1
+	`
+
+	var request entity.ContactRequest
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(
+		&request.ID,
+		&request.EmployerID,
+		&request.ApplicantID,
+		&request.ResumeID,
+		&request.Status,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("запрос контактов с id=%d не найден", id),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при получении запроса контактов")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении запроса контактов: %w", err),
+		)
+	}
+
+	return &request, nil
+}
+
+// UpdateContactRequestStatus меняет статус ожидающего запроса контактов
+func (r *ResumeRepository) UpdateContactRequestStatus(ctx context.Context, id int, status entity.ContactRequestStatus) (*entity.ContactRequest, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":        requestID,
+		"contactRequestID": id,
+		"status":           status,
+	}).Info("sql-запрос в БД на изменение статуса запроса контактов UpdateContactRequestStatus")
+
+	query := `
+		UPDATE contact_request
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND status = 'pending'
+		RETURNING id, employer_id, applicant_id, resume_id, status, created_at, updated_at
+	`
+
+	var request entity.ContactRequest
+	err := r.DB.QueryRowContext(ctx, query, status, id).Scan(
+		&request.ID,
+		&request.EmployerID,
+		&request.ApplicantID,
+		&request.ResumeID,
+		&request.Status,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("запрос контактов с id=%d уже обработан", id),
+			)
+		}
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при изменении статуса запроса контактов")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при изменении статуса запроса контактов: %w", err),
+		)
+	}
+
+	return &request, nil
+}
+
+// stringArray подготавливает срез для колонок-массивов, объявленных как NOT NULL DEFAULT '{}'
+func stringArray(values []string) interface{} {
+	if values == nil {
+		values = []string{}
+	}
+	return pq.Array(values)
+}
+
+// resumeFilterClauses дописывает значения фильтра к параметрам запроса и возвращает условия для WHERE
+func resumeFilterClauses(filter entity.ResumeFilter, params []interface{}) ([]string, []interface{}) {
+	var clauses []string
+	placeholder := func(value interface{}) string {
+		params = append(params, value)
+		return fmt.Sprintf("$%d", len(params))
+	}
+
+	if filter.SalaryFrom > 0 {
+		clauses = append(clauses, "desired_salary >= "+placeholder(filter.SalaryFrom))
+	}
+	if filter.SalaryTo > 0 {
+		clauses = append(clauses, "desired_salary <= "+placeholder(filter.SalaryTo))
+	}
+	if filter.Currency != "" {
+		clauses = append(clauses, "salary_currency = "+placeholder(filter.Currency))
+	}
+	if filter.City != "" {
+		clauses = append(clauses, fmt.Sprintf(`EXISTS (
+              SELECT 1 FROM resume_city rc
+              JOIN city c ON c.id = rc.city_id
+              WHERE rc.resume_id = resume.id AND c.name = %s
+          )`, placeholder(filter.City)))
+	}
+	if filter.ReadyToRelocate {
+		clauses = append(clauses, "ready_to_relocate")
+	}
+	if filter.ReadyForBusinessTrips {
+		clauses = append(clauses, "ready_for_business_trips")
+	}
+	// Массивы пересекаются, если у резюме есть хотя бы одно из запрошенных значений
+	if len(filter.WorkFormats) > 0 {
+		clauses = append(clauses, fmt.Sprintf("work_formats && %s::work_format_type[]", placeholder(pq.Array(filter.WorkFormats))))
+	}
+	if len(filter.EmploymentTypes) > 0 {
+		clauses = append(clauses, fmt.Sprintf("employment_types && %s::employment_type[]", placeholder(pq.Array(filter.EmploymentTypes))))
+	}
+	if len(filter.Schedules) > 0 {
+		clauses = append(clauses, fmt.Sprintf("schedules && %s::schedule_type[]", placeholder(pq.Array(filter.Schedules))))
+	}
+
+	return clauses, params
+}
+
+// resumeSearchClauses дописывает параметры расширенного поиска к запросу.
+// Возвращает условия для WHERE и выражение релевантности, пустое если ранжировать не по чему
+func resumeSearchClauses(search entity.ResumeSearchParams, params []interface{}) ([]string, string, []interface{}) {
+	var clauses []string
+	var relevance []string
+	placeholder := func(value interface{}) string {
+		params = append(params, value)
+		return fmt.Sprintf("$%d", len(params))
+	}
+
+	if search.Profession != "" {
+		clauses = append(clauses, "profession ILIKE "+placeholder("%"+search.Profession+"%"))
+	}
+	if search.Query != "" {
+		tsQuery := fmt.Sprintf("plainto_tsquery('russian', %s)", placeholder(search.Query))
+		aboutMe := "to_tsvector('russian', COALESCE(resume.about_me, ''))"
+		experience := "to_tsvector('russian', COALESCE(we.duties, '') || ' ' || COALESCE(we.achievements, ''))"
+		clauses = append(clauses, fmt.Sprintf(`(%[1]s @@ %[2]s OR EXISTS (
+              SELECT 1 FROM work_experience we
+              WHERE we.resume_id = resume.id AND %[3]s @@ %[2]s
+          ))`, aboutMe, tsQuery, experience))
+		relevance = append(relevance,
+			fmt.Sprintf("ts_rank(%s, %s)", aboutMe, tsQuery),
+			fmt.Sprintf(`COALESCE((
+              SELECT MAX(ts_rank(%s, %s)) FROM work_experience we
+              WHERE we.resume_id = resume.id
+          ), 0)`, experience, tsQuery))
+	}
+	if len(search.SkillsAll) > 0 {
+		clauses = append(clauses, fmt.Sprintf(`ARRAY(
+              SELECT s.name FROM resume_skill rs
+              JOIN skill s ON s.id = rs.skill_id
+              WHERE rs.resume_id = resume.id
+          ) @> %s::text[]`, placeholder(pq.Array(search.SkillsAll))))
+	}
+	if len(search.SkillsAny) > 0 {
+		skills := placeholder(pq.Array(search.SkillsAny))
+		clauses = append(clauses, fmt.Sprintf(`EXISTS (
+              SELECT 1 FROM resume_skill rs
+              JOIN skill s ON s.id = rs.skill_id
+              WHERE rs.resume_id = resume.id AND s.name = ANY(%s)
+          )`, skills))
+		// Каждый совпавший навык добавляет к релевантности больше, чем совпадение текста
+		relevance = append(relevance, fmt.Sprintf(`(
+              SELECT COUNT(*) FROM resume_skill rs
+              JOIN skill s ON s.id = rs.skill_id
+              WHERE rs.resume_id = resume.id AND s.name = ANY(%s)
+          )`, skills))
+	}
+	if len(search.Specializations) > 0 {
+		specializations := placeholder(pq.Array(search.Specializations))
+		clauses = append(clauses, fmt.Sprintf(`(specialization_id IN (
+              SELECT id FROM specialization WHERE name = ANY(%[1]s)
+          ) OR EXISTS (
+              SELECT 1 FROM resume_specialization rsp
+              JOIN specialization sp ON sp.id = rsp.specialization_id
+              WHERE rsp.resume_id = resume.id AND sp.name = ANY(%[1]s)
+          ))`, specializations))
+	}
+	if search.ExperienceFrom > 0 || search.ExperienceTo > 0 {
+		// Стаж в годах: сумма дней по всем местам работы, текущее место считается до сегодняшнего дня
+		experienceYears := `(
+              SELECT COALESCE(SUM(
+                  CASE WHEN we.until_now OR we.end_date IS NULL THEN CURRENT_DATE ELSE we.end_date END - we.start_date
+              ), 0) / 365.25
+              FROM work_experience we
+              WHERE we.resume_id = resume.id
+          )`
+		if search.ExperienceFrom > 0 {
+			clauses = append(clauses, experienceYears+" >= "+placeholder(search.ExperienceFrom))
+		}
+		if search.ExperienceTo > 0 {
+			clauses = append(clauses, experienceYears+" < "+placeholder(search.ExperienceTo+1))
+		}
+	}
+	if len(search.Education) > 0 || search.GraduationYearFrom > 0 || search.GraduationYearTo > 0 {
+		// Уровень и год окончания проверяются по одной записи об образовании
+		var conditions []string
+		if len(search.Education) > 0 {
+			education := make([]string, 0, len(search.Education))
+			for _, level := range search.Education {
+				education = append(education, string(level))
+			}
+			conditions = append(conditions, fmt.Sprintf("re.level = ANY(%s::education_type[])", placeholder(pq.Array(education))))
+		}
+		if search.GraduationYearFrom > 0 {
+			conditions = append(conditions, "re.graduation_year >= "+placeholder(search.GraduationYearFrom))
+		}
+		if search.GraduationYearTo > 0 {
+			conditions = append(conditions, "re.graduation_year <= "+placeholder(search.GraduationYearTo))
+		}
+		clauses = append(clauses, fmt.Sprintf(`EXISTS (
+              SELECT 1 FROM resume_education re
+              WHERE re.resume_id = resume.id AND %s
+          )`, strings.Join(conditions, " AND ")))
+	}
+	if search.Language != "" {
+		condition := "LOWER(rl.language) = LOWER(" + placeholder(search.Language) + ")"
+		if search.LanguageLevel != "" {
+			// Уровни в типе language_level_type объявлены по возрастанию, родной язык выше C2
+			condition += fmt.Sprintf(" AND rl.level >= %s::language_level_type", placeholder(string(search.LanguageLevel)))
+		}
+		clauses = append(clauses, fmt.Sprintf(`EXISTS (
+              SELECT 1 FROM resume_language rl
+              WHERE rl.resume_id = resume.id AND %s
+          )`, condition))
+	}
+	if search.Course != "" {
+		clauses = append(clauses, fmt.Sprintf(`EXISTS (
+              SELECT 1 FROM resume_course rc
+              WHERE rc.resume_id = resume.id AND rc.name ILIKE %s
+          )`, placeholder("%"+search.Course+"%")))
+	}
+	if len(search.ApplicantStatuses) > 0 {
+		statuses := make([]string, 0, len(search.ApplicantStatuses))
+		for _, status := range search.ApplicantStatuses {
+			statuses = append(statuses, string(status))
+		}
+		clauses = append(clauses, fmt.Sprintf(`EXISTS (
+              SELECT 1 FROM applicant a
+              WHERE a.id = resume.applicant_id AND a.status = ANY(%s::applicant_status_type[])
+          )`, placeholder(pq.Array(statuses))))
+	}
+	if !search.UpdatedFrom.IsZero() {
+		clauses = append(clauses, "updated_at >= "+placeholder(search.UpdatedFrom))
+	}
+
+	if len(relevance) == 0 {
+		return clauses, "", params
+	}
+	return clauses, "(" + strings.Join(relevance, " + ") + ")", params
+}
+
+func (r *ResumeRepository) AddCities(ctx context.Context, resumeID int, cityIDs []int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на добавление городов к резюме AddCities")
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при начале транзакции для добавления городов")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции для добавления городов: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции добавления городов")
+			}
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO resume_city (resume_id, city_id)
+		VALUES ($1, $2)
+	`)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при подготовке запроса для добавления городов")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при подготовке запроса для добавления городов: %w", err),
+		)
+	}
+	defer func(stmt *sql.Stmt) {
+		err := stmt.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть statement: %v", err)
+		}
+	}(stmt)
+
+	for _, cityID := range cityIDs {
+		_, err = stmt.ExecContext(ctx, resumeID, cityID)
+		if err != nil {
+
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) {
+				switch pqErr.Code {
+				case entity.PSQLUniqueViolation:
+					continue // Пропускаем дубликаты
+				case entity.PSQLForeignKeyViolation:
+					return entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("город с id=%d не найден", cityID),
+					)
+				}
+			}
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"cityID":    cityID,
+				"error":     err,
+			}).Error("ошибка при добавлении города к резюме")
+
+			return entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при добавлении города к резюме: %w", err),
+			)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при коммите транзакции добавления городов")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при коммите транзакции добавления городов: %w", err),
+		)
+	}
+
+	return nil
+}
+
+func (r *ResumeRepository) GetCitiesByResumeID(ctx context.Context, resumeID int) ([]entity.City, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на получение городов резюме GetCitiesByResumeID")
+
+	query := `
+		SELECT c.id, c.name
+		FROM city c
+		JOIN resume_city rc ON c.id = rc.city_id
+		WHERE rc.resume_id = $1
+		ORDER BY c.name
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при получении городов резюме")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении городов резюме: %w", err),
+		)
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}(rows)
+
+	var cities []entity.City
+	for rows.Next() {
+		var city entity.City
+		if err := rows.Scan(&city.ID, &city.Name); err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при сканировании города")
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании города: %w", err),
+			)
+		}
+		cities = append(cities, city)
+	}
+
+	if err := rows.Err(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при итерации по городам")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по городам: %w", err),
+		)
+	}
+
+	return cities, nil
+}
+
+func (r *ResumeRepository) DeleteCities(ctx context.Context, resumeID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на удаление городов резюме DeleteCities")
+
+	query := `
+		DELETE FROM resume_city
+		WHERE resume_id = $1
+	`
+
+	_, err := r.DB.ExecContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при удалении городов резюме")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при удалении городов резюме: %w", err),
+		)
+	}
+
+	return nil
+}
+
+// FindCityIDsByNames возвращает id городов из справочника, неизвестный город считается ошибкой запроса
+func (r *ResumeRepository) FindCityIDsByNames(ctx context.Context, cityNames []string) ([]int, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на поиск ID городов по названиям FindCityIDsByNames")
+
+	if len(cityNames) == 0 {
+		return []int{}, nil
+	}
+
+	query := `
+		SELECT name, id
+		FROM city
+		WHERE name = ANY($1)
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, pq.Array(cityNames))
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при поиске ID городов по названиям")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при поиске ID городов по названиям: %w", err),
+		)
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}(rows)
+
+	found := make(map[string]int, len(cityNames))
+	for rows.Next() {
+		var name string
+		var id int
+		if err := rows.Scan(&name, &id); err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"error":     err,
+			}).Error("ошибка при сканировании ID города")
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании ID города: %w", err),
+			)
+		}
+		found[name] = id
+	}
+
+	if err := rows.Err(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при итерации по городам")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по городам: %w", err),
+		)
+	}
+
+	cityIDs := make([]int, 0, len(cityNames))
+	for _, name := range cityNames {
+		id, ok := found[name]
+		if !ok {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("город %s не найден", name),
+			)
+		}
+		cityIDs = append(cityIDs, id)
+	}
+
+	return cityIDs, nil
+}
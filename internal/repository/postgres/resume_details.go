@@ -0,0 +1,663 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/utils"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+// Образование, курсы и языки резюме хранятся в отдельных таблицах и перезаписываются целиком,
+// как навыки и опыт работы
+
+func (r *ResumeRepository) AddEducations(ctx context.Context, resumeID int, educations []entity.ResumeEducation) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на добавление образования к резюме AddEducations")
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при начале транзакции для добавления образования")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции для добавления образования: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции добавления образования")
+			}
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO resume_education (resume_id, level, institution, faculty, specialty, graduation_year)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6)
+	`)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при подготовке запроса для добавления образования")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при подготовке запроса для добавления образования: %w", err),
+		)
+	}
+	defer func(stmt *sql.Stmt) {
+		err := stmt.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть statement: %v", err)
+		}
+	}(stmt)
+
+	for _, education := range educations {
+		_, err = stmt.ExecContext(ctx, resumeID, education.Level, education.Institution,
+			education.Faculty, education.Specialty, education.GraduationYear)
+		if err != nil {
+
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) {
+				switch pqErr.Code {
+				case entity.PSQLCheckViolation, entity.PSQLDatatypeViolation:
+					return entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("неправильный формат данных об образовании"),
+					)
+				}
+			}
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при добавлении образования к резюме")
+
+			return entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при добавлении образования к резюме: %w", err),
+			)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при коммите транзакции добавления образования")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при коммите транзакции добавления образования: %w", err),
+		)
+	}
+
+	return nil
+}
+
+func (r *ResumeRepository) GetEducationsByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeEducation, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на получение образования резюме GetEducationsByResumeID")
+
+	query := `
+		SELECT id, resume_id, level, institution, COALESCE(faculty, ''), COALESCE(specialty, ''), graduation_year
+		FROM resume_education
+		WHERE resume_id = $1
+		ORDER BY graduation_year DESC, id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при получении образования резюме")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении образования резюме: %w", err),
+		)
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}(rows)
+
+	var educations []entity.ResumeEducation
+	for rows.Next() {
+		var education entity.ResumeEducation
+		if err := rows.Scan(
+			&education.ID,
+			&education.ResumeID,
+			&education.Level,
+			&education.Institution,
+			&education.Faculty,
+			&education.Specialty,
+			&education.GraduationYear,
+		); err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при сканировании образования")
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании образования: %w", err),
+			)
+		}
+		educations = append(educations, education)
+	}
+
+	if err := rows.Err(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при итерации по образованию")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по образованию: %w", err),
+		)
+	}
+
+	return educations, nil
+}
+
+func (r *ResumeRepository) DeleteEducations(ctx context.Context, resumeID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на удаление образования резюме DeleteEducations")
+
+	query := `
+		DELETE FROM resume_education
+		WHERE resume_id = $1
+	`
+
+	_, err := r.DB.ExecContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при удалении образования резюме")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при удалении образования резюме: %w", err),
+		)
+	}
+
+	return nil
+}
+
+func (r *ResumeRepository) AddCourses(ctx context.Context, resumeID int, courses []entity.ResumeCourse) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на добавление курсов к резюме AddCourses")
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при начале транзакции для добавления курсов")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции для добавления курсов: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции добавления курсов")
+			}
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO resume_course (resume_id, kind, name, organization, year, file_id)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, 0), NULLIF($6, 0))
+	`)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при подготовке запроса для добавления курсов")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при подготовке запроса для добавления курсов: %w", err),
+		)
+	}
+	defer func(stmt *sql.Stmt) {
+		err := stmt.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть statement: %v", err)
+		}
+	}(stmt)
+
+	for _, course := range courses {
+		_, err = stmt.ExecContext(ctx, resumeID, course.Kind, course.Name,
+			course.Organization, course.Year, course.FileID)
+		if err != nil {
+
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) {
+				switch pqErr.Code {
+				case entity.PSQLForeignKeyViolation:
+					return entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("файл с id=%d не найден", course.FileID),
+					)
+				case entity.PSQLCheckViolation, entity.PSQLDatatypeViolation:
+					return entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("неправильный формат данных о курсе"),
+					)
+				}
+			}
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при добавлении курса к резюме")
+
+			return entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при добавлении курса к резюме: %w", err),
+			)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при коммите транзакции добавления курсов")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при коммите транзакции добавления курсов: %w", err),
+		)
+	}
+
+	return nil
+}
+
+func (r *ResumeRepository) GetCoursesByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeCourse, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на получение курсов резюме GetCoursesByResumeID")
+
+	query := `
+		SELECT id, resume_id, kind, name, COALESCE(organization, ''), COALESCE(year, 0), COALESCE(file_id, 0)
+		FROM resume_course
+		WHERE resume_id = $1
+		ORDER BY year DESC NULLS LAST, id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при получении курсов резюме")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении курсов резюме: %w", err),
+		)
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}(rows)
+
+	var courses []entity.ResumeCourse
+	for rows.Next() {
+		var course entity.ResumeCourse
+		if err := rows.Scan(
+			&course.ID,
+			&course.ResumeID,
+			&course.Kind,
+			&course.Name,
+			&course.Organization,
+			&course.Year,
+			&course.FileID,
+		); err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при сканировании курса")
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании курса: %w", err),
+			)
+		}
+		courses = append(courses, course)
+	}
+
+	if err := rows.Err(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при итерации по курсам")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по курсам: %w", err),
+		)
+	}
+
+	return courses, nil
+}
+
+func (r *ResumeRepository) DeleteCourses(ctx context.Context, resumeID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на удаление курсов резюме DeleteCourses")
+
+	query := `
+		DELETE FROM resume_course
+		WHERE resume_id = $1
+	`
+
+	_, err := r.DB.ExecContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при удалении курсов резюме")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при удалении курсов резюме: %w", err),
+		)
+	}
+
+	return nil
+}
+
+func (r *ResumeRepository) AddLanguages(ctx context.Context, resumeID int, languages []entity.ResumeLanguage) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на добавление языков к резюме AddLanguages")
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при начале транзакции для добавления языков")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции для добавления языков: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции добавления языков")
+			}
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO resume_language (resume_id, language, level)
+		VALUES ($1, $2, $3)
+	`)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при подготовке запроса для добавления языков")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при подготовке запроса для добавления языков: %w", err),
+		)
+	}
+	defer func(stmt *sql.Stmt) {
+		err := stmt.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть statement: %v", err)
+		}
+	}(stmt)
+
+	for _, language := range languages {
+		_, err = stmt.ExecContext(ctx, resumeID, language.Language, language.Level)
+		if err != nil {
+
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) {
+				switch pqErr.Code {
+				case entity.PSQLUniqueViolation:
+					return entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("язык %s указан несколько раз", language.Language),
+					)
+				case entity.PSQLCheckViolation, entity.PSQLDatatypeViolation:
+					return entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("неправильный формат данных о языке"),
+					)
+				}
+			}
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при добавлении языка к резюме")
+
+			return entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при добавлении языка к резюме: %w", err),
+			)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"error":     err,
+		}).Error("ошибка при коммите транзакции добавления языков")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при коммите транзакции добавления языков: %w", err),
+		)
+	}
+
+	return nil
+}
+
+func (r *ResumeRepository) GetLanguagesByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeLanguage, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на получение языков резюме GetLanguagesByResumeID")
+
+	query := `
+		SELECT resume_id, language, level
+		FROM resume_language
+		WHERE resume_id = $1
+		ORDER BY level DESC, language
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при получении языков резюме")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при получении языков резюме: %w", err),
+		)
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+			}).Errorf("не удалось закрыть rows: %v", err)
+		}
+	}(rows)
+
+	var languages []entity.ResumeLanguage
+	for rows.Next() {
+		var language entity.ResumeLanguage
+		if err := rows.Scan(&language.ResumeID, &language.Language, &language.Level); err != nil {
+
+			l.Log.WithFields(logrus.Fields{
+				"requestID": requestID,
+				"resumeID":  resumeID,
+				"error":     err,
+			}).Error("ошибка при сканировании языка")
+
+			return nil, entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при сканировании языка: %w", err),
+			)
+		}
+		languages = append(languages, language)
+	}
+
+	if err := rows.Err(); err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при итерации по языкам")
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при итерации по языкам: %w", err),
+		)
+	}
+
+	return languages, nil
+}
+
+func (r *ResumeRepository) DeleteLanguages(ctx context.Context, resumeID int) error {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("sql-запрос в БД на удаление языков резюме DeleteLanguages")
+
+	query := `
+		DELETE FROM resume_language
+		WHERE resume_id = $1
+	`
+
+	_, err := r.DB.ExecContext(ctx, query, resumeID)
+	if err != nil {
+
+		l.Log.WithFields(logrus.Fields{
+			"requestID": requestID,
+			"resumeID":  resumeID,
+			"error":     err,
+		}).Error("ошибка при удалении языков резюме")
+
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при удалении языков резюме: %w", err),
+		)
+	}
+
+	return nil
+}
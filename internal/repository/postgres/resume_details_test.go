@@ -0,0 +1,560 @@
+package postgres
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeRepository_AddEducations(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		INSERT INTO resume_education (resume_id, level, institution, faculty, specialty, graduation_year)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6)
+	`)
+
+	educations := []entity.ResumeEducation{
+		{Level: entity.Bachelor, Institution: "МГТУ им. Баумана", Specialty: "Информатика", GraduationYear: 2018},
+		{Level: entity.Master, Institution: "МФТИ", Faculty: "ФПМИ", GraduationYear: 2020},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedErr error
+		setupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное добавление образования",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, entity.Bachelor, "МГТУ им. Баумана", "", "Информатика", 2018).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(query).
+					WithArgs(1, entity.Master, "МФТИ", "ФПМИ", "", 2020).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Ошибка - год окончания вне допустимого диапазона",
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("неправильный формат данных об образовании"),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, entity.Bachelor, "МГТУ им. Баумана", "", "Информатика", 2018).
+					WillReturnError(&pq.Error{Code: entity.PSQLCheckViolation})
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Ошибка - начало транзакции",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при начале транзакции для добавления образования: %w", errors.New("transaction error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(errors.New("transaction error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			err = repo.AddEducations(context.Background(), 1, educations)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_GetEducationsByResumeID(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT id, resume_id, level, institution, COALESCE(faculty, ''), COALESCE(specialty, ''), graduation_year
+		FROM resume_education
+		WHERE resume_id = $1
+		ORDER BY graduation_year DESC, id
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult []entity.ResumeEducation
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное получение образования",
+			expectedResult: []entity.ResumeEducation{
+				{ID: 2, ResumeID: 1, Level: entity.Master, Institution: "МФТИ", Faculty: "ФПМИ", GraduationYear: 2020},
+				{ID: 1, ResumeID: 1, Level: entity.Bachelor, Institution: "МГТУ им. Баумана", Specialty: "Информатика", GraduationYear: 2018},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resume_id", "level", "institution", "faculty", "specialty", "graduation_year"}).
+						AddRow(2, 1, "master", "МФТИ", "ФПМИ", "", 2020).
+						AddRow(1, 1, "bachelor", "МГТУ им. Баумана", "", "Информатика", 2018))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при получении образования резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.GetEducationsByResumeID(context.Background(), 1)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_AddCourses(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		INSERT INTO resume_course (resume_id, kind, name, organization, year, file_id)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, 0), NULLIF($6, 0))
+	`)
+
+	courses := []entity.ResumeCourse{
+		{Kind: entity.CourseKindCertificate, Name: "AWS Solutions Architect", Organization: "Amazon", Year: 2022, FileID: 7},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedErr error
+		setupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное добавление курсов",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, entity.CourseKindCertificate, "AWS Solutions Architect", "Amazon", 2022, 7).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Ошибка - файл сертификата не найден",
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("файл с id=%d не найден", 7),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, entity.CourseKindCertificate, "AWS Solutions Architect", "Amazon", 2022, 7).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при добавлении курса к резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, entity.CourseKindCertificate, "AWS Solutions Architect", "Amazon", 2022, 7).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			err = repo.AddCourses(context.Background(), 1, courses)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_GetCoursesByResumeID(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT id, resume_id, kind, name, COALESCE(organization, ''), COALESCE(year, 0), COALESCE(file_id, 0)
+		FROM resume_course
+		WHERE resume_id = $1
+		ORDER BY year DESC NULLS LAST, id
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult []entity.ResumeCourse
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное получение курсов",
+			expectedResult: []entity.ResumeCourse{
+				{ID: 2, ResumeID: 1, Kind: entity.CourseKindCertificate, Name: "AWS Solutions Architect", Organization: "Amazon", Year: 2022, FileID: 7},
+				{ID: 1, ResumeID: 1, Kind: entity.CourseKindCourse, Name: "Go для начинающих"},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resume_id", "kind", "name", "organization", "year", "file_id"}).
+						AddRow(2, 1, "certificate", "AWS Solutions Architect", "Amazon", 2022, 7).
+						AddRow(1, 1, "course", "Go для начинающих", "", 0, 0))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при получении курсов резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.GetCoursesByResumeID(context.Background(), 1)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_AddLanguages(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		INSERT INTO resume_language (resume_id, language, level)
+		VALUES ($1, $2, $3)
+	`)
+
+	languages := []entity.ResumeLanguage{
+		{Language: "Английский", Level: "B2"},
+		{Language: "Немецкий", Level: "A2"},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedErr error
+		setupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное добавление языков",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, "Английский", entity.LanguageLevel("B2")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(query).
+					WithArgs(1, "Немецкий", entity.LanguageLevel("A2")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Ошибка - язык указан повторно",
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("язык %s указан несколько раз", "Английский"),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectPrepare(query)
+				mock.ExpectExec(query).
+					WithArgs(1, "Английский", entity.LanguageLevel("B2")).
+					WillReturnError(&pq.Error{Code: entity.PSQLUniqueViolation})
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			err = repo.AddLanguages(context.Background(), 1, languages)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_GetLanguagesByResumeID(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT resume_id, language, level
+		FROM resume_language
+		WHERE resume_id = $1
+		ORDER BY level DESC, language
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult []entity.ResumeLanguage
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное получение языков",
+			expectedResult: []entity.ResumeLanguage{
+				{ResumeID: 1, Language: "Русский", Level: "native"},
+				{ResumeID: 1, Language: "Английский", Level: "B2"},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"resume_id", "language", "level"}).
+						AddRow(1, "Русский", "native").
+						AddRow(1, "Английский", "B2"))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при получении языков резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.GetLanguagesByResumeID(context.Background(), 1)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_DeleteResumeDetails(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		table       string
+		delete      func(r *ResumeRepository) error
+		dbErr       error
+		expectedErr error
+	}{
+		{
+			name:   "Успешное удаление образования",
+			table:  "resume_education",
+			delete: func(r *ResumeRepository) error { return r.DeleteEducations(context.Background(), 1) },
+		},
+		{
+			name:   "Ошибка удаления курсов",
+			table:  "resume_course",
+			delete: func(r *ResumeRepository) error { return r.DeleteCourses(context.Background(), 1) },
+			dbErr:  errors.New("database error"),
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при удалении курсов резюме: %w", errors.New("database error")),
+			),
+		},
+		{
+			name:   "Успешное удаление языков",
+			table:  "resume_language",
+			delete: func(r *ResumeRepository) error { return r.DeleteLanguages(context.Background(), 1) },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			exec := mock.ExpectExec(regexp.QuoteMeta("DELETE FROM " + tc.table)).WithArgs(1)
+			if tc.dbErr != nil {
+				exec.WillReturnError(tc.dbErr)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, 2))
+			}
+
+			err = tc.delete(&ResumeRepository{DB: db})
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
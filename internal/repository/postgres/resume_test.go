@@ -25,15 +25,21 @@ func TestResumeRepository_Create(t *testing.T) {
 		"id", "applicant_id", "about_me", "specialization_id",
 		"education", "educational_institution", "graduation_year",
 		"profession", "created_at", "updated_at",
+		"desired_salary", "salary_currency", "ready_to_relocate", "ready_for_business_trips",
+		"work_formats", "employment_types", "schedules",
 	}
 
 	query := regexp.QuoteMeta(`
 		INSERT INTO resume (
 			applicant_id, about_me, specialization_id, education, 
-			educational_institution, graduation_year, profession, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+			educational_institution, graduation_year, profession,
+			desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+			work_formats, employment_types, schedules, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
 		RETURNING id, applicant_id, about_me, specialization_id, education, 
-				  educational_institution, graduation_year, profession, created_at, updated_at
+				  educational_institution, graduation_year, profession, created_at, updated_at,
+				  desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+				  work_formats, employment_types, schedules
 	`)
 
 	testCases := []struct {
@@ -77,6 +83,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnRows(
 						sqlmock.NewRows(columns).
@@ -91,6 +104,13 @@ func TestResumeRepository_Create(t *testing.T) {
 								resume.Profession,
 								now,
 								now,
+								0,
+								"rub",
+								false,
+								false,
+								"{}",
+								"{}",
+								"{}",
 							),
 					)
 			},
@@ -127,6 +147,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnRows(
 						sqlmock.NewRows(columns).
@@ -141,6 +168,13 @@ func TestResumeRepository_Create(t *testing.T) {
 								resume.Profession,
 								now,
 								now,
+								0,
+								"rub",
+								false,
+								false,
+								"{}",
+								"{}",
+								"{}",
 							),
 					)
 			},
@@ -171,6 +205,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnError(&pq.Error{Code: entity.PSQLUniqueViolation})
 			},
@@ -201,6 +242,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnError(&pq.Error{Code: entity.PSQLNotNullViolation})
 			},
@@ -231,6 +279,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnError(&pq.Error{Code: entity.PSQLDatatypeViolation})
 			},
@@ -261,6 +316,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnError(&pq.Error{Code: entity.PSQLCheckViolation})
 			},
@@ -291,6 +353,13 @@ func TestResumeRepository_Create(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 					).
 					WillReturnError(errors.New("database error"))
 			},
@@ -897,11 +966,17 @@ func TestResumeRepository_GetByID(t *testing.T) {
 		"id", "applicant_id", "about_me", "specialization_id",
 		"education", "educational_institution", "graduation_year",
 		"profession", "created_at", "updated_at",
+		"visibility", "access_token", "is_anonymous",
+		"desired_salary", "salary_currency", "ready_to_relocate", "ready_for_business_trips",
+		"work_formats", "employment_types", "schedules",
 	}
 
 	query := regexp.QuoteMeta(`
 		SELECT id, applicant_id, about_me, specialization_id, education, 
-			   educational_institution, graduation_year, profession, created_at, updated_at
+			   educational_institution, graduation_year, profession, created_at, updated_at,
+			   visibility, access_token, is_anonymous,
+			   desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+			   work_formats, employment_types, schedules
 		FROM resume
 		WHERE id = $1
 	`)
@@ -927,6 +1002,13 @@ func TestResumeRepository_GetByID(t *testing.T) {
 				Profession:             "Программист",
 				CreatedAt:              now,
 				UpdatedAt:              now,
+				Visibility:             entity.ResumeVisibilityPublic,
+				DesiredSalary:          150000,
+				SalaryCurrency:         entity.CurrencyRUB,
+				ReadyToRelocate:        true,
+				WorkFormats:            []string{"remote", "hybrid"},
+				EmploymentTypes:        []string{"full_time"},
+				Schedules:              []string{"5/2"},
 			},
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, resumeID int) {
@@ -945,6 +1027,61 @@ func TestResumeRepository_GetByID(t *testing.T) {
 								"Программист",
 								now,
 								now,
+								string(entity.ResumeVisibilityPublic),
+								nil,
+								false,
+								150000,
+								"rub",
+								true,
+								false,
+								"{remote,hybrid}",
+								"{full_time}",
+								"{5/2}",
+							),
+					)
+			},
+		},
+		{
+			name:     "Успешное получение резюме с доступом по ссылке",
+			resumeID: 2,
+			expectedResult: &entity.Resume{
+				ID:             2,
+				ApplicantID:    1,
+				Profession:     "Программист",
+				CreatedAt:      now,
+				UpdatedAt:      now,
+				Visibility:     entity.ResumeVisibilityLinkOnly,
+				AccessToken:    "secret-token",
+				IsAnonymous:    true,
+				SalaryCurrency: entity.CurrencyUSD,
+			},
+			expectedErr: nil,
+			setupMock: func(mock sqlmock.Sqlmock, resumeID int) {
+				mock.ExpectQuery(query).
+					WithArgs(resumeID).
+					WillReturnRows(
+						sqlmock.NewRows(columns).
+							AddRow(
+								2,
+								1,
+								"",
+								0,
+								"",
+								"",
+								time.Time{},
+								"Программист",
+								now,
+								now,
+								string(entity.ResumeVisibilityLinkOnly),
+								"secret-token",
+								true,
+								0,
+								"usd",
+								false,
+								false,
+								"{}",
+								"{}",
+								"{}",
 							),
 					)
 			},
@@ -1018,6 +1155,15 @@ func TestResumeRepository_GetByID(t *testing.T) {
 				require.Equal(t, tc.expectedResult.Profession, result.Profession)
 				require.Equal(t, tc.expectedResult.CreatedAt, result.CreatedAt)
 				require.Equal(t, tc.expectedResult.UpdatedAt, result.UpdatedAt)
+				require.Equal(t, tc.expectedResult.Visibility, result.Visibility)
+				require.Equal(t, tc.expectedResult.AccessToken, result.AccessToken)
+				require.Equal(t, tc.expectedResult.IsAnonymous, result.IsAnonymous)
+				require.Equal(t, tc.expectedResult.DesiredSalary, result.DesiredSalary)
+				require.Equal(t, tc.expectedResult.SalaryCurrency, result.SalaryCurrency)
+				require.Equal(t, tc.expectedResult.ReadyToRelocate, result.ReadyToRelocate)
+				require.ElementsMatch(t, tc.expectedResult.WorkFormats, result.WorkFormats)
+				require.ElementsMatch(t, tc.expectedResult.EmploymentTypes, result.EmploymentTypes)
+				require.ElementsMatch(t, tc.expectedResult.Schedules, result.Schedules)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -1728,6 +1874,8 @@ func TestResumeRepository_Update(t *testing.T) {
 		"id", "applicant_id", "about_me", "specialization_id",
 		"education", "educational_institution", "graduation_year",
 		"profession", "created_at", "updated_at",
+		"desired_salary", "salary_currency", "ready_to_relocate", "ready_for_business_trips",
+		"work_formats", "employment_types", "schedules",
 	}
 
 	query := regexp.QuoteMeta(`
@@ -1739,10 +1887,19 @@ func TestResumeRepository_Update(t *testing.T) {
 			educational_institution = $4,
 			graduation_year = $5,
 			profession = $6,
+			desired_salary = $7,
+			salary_currency = $8,
+			ready_to_relocate = $9,
+			ready_for_business_trips = $10,
+			work_formats = $11,
+			employment_types = $12,
+			schedules = $13,
 			updated_at = NOW()
-		WHERE id = $7 AND applicant_id = $8
+		WHERE id = $14 AND applicant_id = $15
 		RETURNING id, applicant_id, about_me, specialization_id, education, 
-				  educational_institution, graduation_year, profession, created_at, updated_at
+				  educational_institution, graduation_year, profession, created_at, updated_at,
+				  desired_salary, salary_currency, ready_to_relocate, ready_for_business_trips,
+				  work_formats, employment_types, schedules
 	`)
 
 	testCases := []struct {
@@ -1786,6 +1943,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -1802,6 +1966,13 @@ func TestResumeRepository_Update(t *testing.T) {
 								resume.Profession,
 								createdAt,
 								updatedAt,
+								0,
+								"rub",
+								false,
+								false,
+								"{}",
+								"{}",
+								"{}",
 							),
 					)
 			},
@@ -1832,6 +2003,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -1864,6 +2042,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -1896,6 +2081,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -1928,6 +2120,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -1960,6 +2159,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -1992,6 +2198,13 @@ func TestResumeRepository_Update(t *testing.T) {
 						resume.EducationalInstitution,
 						resume.GraduationYear,
 						resume.Profession,
+						resume.DesiredSalary,
+						string(resume.SalaryCurrency),
+						resume.ReadyToRelocate,
+						resume.ReadyForBusinessTrips,
+						"{}",
+						"{}",
+						"{}",
 						resume.ID,
 						resume.ApplicantID,
 					).
@@ -2884,6 +3097,7 @@ func TestResumeRepository_DeleteWorkExperience(t *testing.T) {
 func TestResumeRepository_GetAll(t *testing.T) {
 	t.Parallel()
 
+	employerID := 1
 	graduationDate := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
 	createdAt := time.Now().Add(-48 * time.Hour)
 	updatedAt := time.Now()
@@ -2891,15 +3105,22 @@ func TestResumeRepository_GetAll(t *testing.T) {
 	columns := []string{
 		"id", "applicant_id", "about_me", "specialization_id",
 		"education", "educational_institution", "graduation_year",
-		"profession", "created_at", "updated_at",
+		"profession", "created_at", "updated_at", "is_anonymous",
+		"desired_salary", "salary_currency", "work_formats",
 	}
 
 	query := regexp.QuoteMeta(`
 		SELECT id, applicant_id, about_me, specialization_id, education, 
-			   educational_institution, graduation_year, profession, created_at, updated_at
+			   educational_institution, graduation_year, profession, created_at, updated_at, is_anonymous,
+			   desired_salary, salary_currency, work_formats
 		FROM resume
+		WHERE visibility IN ('public', 'employers_only')
+		  AND NOT EXISTS (
+			  SELECT 1 FROM applicant_blocked_employer b
+			  WHERE b.applicant_id = resume.applicant_id AND b.employer_id = $1
+		  )
 		ORDER BY updated_at DESC
-		LIMIT $1 OFFSET $2
+		LIMIT $2 OFFSET $3
 	`)
 
 	testCases := []struct {
@@ -2954,6 +3175,10 @@ func TestResumeRepository_GetAll(t *testing.T) {
 						"Программист",
 						createdAt,
 						updatedAt,
+						false,
+						0,
+						"rub",
+						"{}",
 					).
 					AddRow(
 						2,
@@ -2966,9 +3191,13 @@ func TestResumeRepository_GetAll(t *testing.T) {
 						"Младший программист",
 						createdAt,
 						updatedAt,
+						false,
+						0,
+						"rub",
+						"{}",
 					)
 				mock.ExpectQuery(query).
-					WithArgs(limit, offset).
+					WithArgs(employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -2981,7 +3210,7 @@ func TestResumeRepository_GetAll(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, limit, offset int) {
 				rows := sqlmock.NewRows(columns)
 				mock.ExpectQuery(query).
-					WithArgs(limit, offset).
+					WithArgs(employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -2996,7 +3225,7 @@ func TestResumeRepository_GetAll(t *testing.T) {
 			),
 			setupMock: func(mock sqlmock.Sqlmock, limit, offset int) {
 				mock.ExpectQuery(query).
-					WithArgs(limit, offset).
+					WithArgs(employerID, limit, offset).
 					WillReturnError(errors.New("database error"))
 			},
 		},
@@ -3022,9 +3251,13 @@ func TestResumeRepository_GetAll(t *testing.T) {
 						"Программист",
 						createdAt,
 						updatedAt,
+						false,
+						0,
+						"rub",
+						"{}",
 					)
 				mock.ExpectQuery(query).
-					WithArgs(limit, offset).
+					WithArgs(employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -3050,9 +3283,13 @@ func TestResumeRepository_GetAll(t *testing.T) {
 						"Программист",
 						createdAt,
 						updatedAt,
+						false,
+						0,
+						"rub",
+						"{}",
 					)
 				mock.ExpectQuery(query).
-					WithArgs(limit, offset).
+					WithArgs(employerID, limit, offset).
 					WillReturnRows(rows)
 				rows.CloseError(errors.New("iteration error"))
 			},
@@ -3077,7 +3314,7 @@ func TestResumeRepository_GetAll(t *testing.T) {
 			repo := &ResumeRepository{DB: db}
 			ctx := context.Background()
 
-			result, err := repo.GetAll(ctx, tc.limit, tc.offset)
+			result, err := repo.GetAll(ctx, employerID, tc.limit, tc.offset)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -4044,22 +4281,30 @@ func TestResumeRepository_CreateSpecializationIfNotExists(t *testing.T) {
 func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 	t.Parallel()
 
+	employerID := 1
 	graduationDate := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
 	now := time.Now()
 
 	columns := []string{
 		"id", "applicant_id", "about_me", "specialization_id",
 		"education", "educational_institution", "graduation_year",
-		"profession", "created_at", "updated_at",
+		"profession", "created_at", "updated_at", "is_anonymous",
+		"desired_salary", "salary_currency", "work_formats",
 	}
 
 	query := regexp.QuoteMeta(`
         SELECT id, applicant_id, about_me, specialization_id, education, 
-               educational_institution, graduation_year, profession, created_at, updated_at
+               educational_institution, graduation_year, profession, created_at, updated_at, is_anonymous,
+               desired_salary, salary_currency, work_formats
         FROM resume
         WHERE profession ILIKE $1
+          AND visibility IN ('public', 'employers_only')
+          AND NOT EXISTS (
+              SELECT 1 FROM applicant_blocked_employer b
+              WHERE b.applicant_id = resume.applicant_id AND b.employer_id = $2
+          )
         ORDER BY updated_at DESC
-        LIMIT $2 OFFSET $3
+        LIMIT $3 OFFSET $4
     `)
 
 	testCases := []struct {
@@ -4116,6 +4361,10 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 						"Backend Developer",
 						now,
 						now,
+						false,
+						0,
+						"rub",
+						"{}",
 					).
 					AddRow(
 						2,
@@ -4128,9 +4377,13 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 						"Frontend Developer",
 						now,
 						now,
+						false,
+						0,
+						"rub",
+						"{}",
 					)
 				mock.ExpectQuery(query).
-					WithArgs("%"+profession+"%", limit, offset).
+					WithArgs("%"+profession+"%", employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -4144,7 +4397,7 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock, profession string, limit, offset int) {
 				rows := sqlmock.NewRows(columns)
 				mock.ExpectQuery(query).
-					WithArgs("%"+profession+"%", limit, offset).
+					WithArgs("%"+profession+"%", employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -4160,7 +4413,7 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 			),
 			setupMock: func(mock sqlmock.Sqlmock, profession string, limit, offset int) {
 				mock.ExpectQuery(query).
-					WithArgs("%"+profession+"%", limit, offset).
+					WithArgs("%"+profession+"%", employerID, limit, offset).
 					WillReturnError(errors.New("database error"))
 			},
 		},
@@ -4187,10 +4440,14 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 						"Backend Developer",
 						now,
 						now,
+						false,
+						0,
+						"rub",
+						"{}",
 					).
 					RowError(0, errors.New("scan error"))
 				mock.ExpectQuery(query).
-					WithArgs("%"+profession+"%", limit, offset).
+					WithArgs("%"+profession+"%", employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -4217,9 +4474,13 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 						"Backend Developer",
 						now,
 						now,
+						false,
+						0,
+						"rub",
+						"{}",
 					)
 				mock.ExpectQuery(query).
-					WithArgs("%"+profession+"%", limit, offset).
+					WithArgs("%"+profession+"%", employerID, limit, offset).
 					WillReturnRows(rows)
 				rows.CloseError(errors.New("rows error"))
 			},
@@ -4247,10 +4508,14 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 						"Backend Developer",
 						now,
 						now,
+						false,
+						0,
+						"rub",
+						"{}",
 					)
 				rows.CloseError(errors.New("close error"))
 				mock.ExpectQuery(query).
-					WithArgs("%"+profession+"%", limit, offset).
+					WithArgs("%"+profession+"%", employerID, limit, offset).
 					WillReturnRows(rows)
 			},
 		},
@@ -4274,7 +4539,7 @@ func TestResumeRepository_SearchResumesByProfession(t *testing.T) {
 			repo := &ResumeRepository{DB: db}
 			ctx := context.Background()
 
-			result, err := repo.SearchResumesByProfession(ctx, tc.profession, tc.limit, tc.offset)
+			result, err := repo.SearchResumesByProfession(ctx, employerID, tc.profession, entity.ResumeFilter{}, tc.limit, tc.offset)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -4570,3 +4835,1059 @@ func TestResumeRepository_SearchResumesByProfessionForApplicant(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeRepository_UpdateVisibility(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		UPDATE resume
+		SET visibility = $1, access_token = NULLIF($2, ''), is_anonymous = $3, updated_at = NOW()
+		WHERE id = $4
+	`)
+
+	testCases := []struct {
+		name        string
+		resumeID    int
+		visibility  entity.ResumeVisibility
+		accessToken string
+		expectedErr error
+		setupMock   func(mock sqlmock.Sqlmock, resumeID int, visibility entity.ResumeVisibility, accessToken string)
+	}{
+		{
+			name:        "Успешное изменение видимости с токеном",
+			resumeID:    1,
+			visibility:  entity.ResumeVisibilityLinkOnly,
+			accessToken: "token",
+			setupMock: func(mock sqlmock.Sqlmock, resumeID int, visibility entity.ResumeVisibility, accessToken string) {
+				mock.ExpectExec(query).
+					WithArgs(visibility, accessToken, false, resumeID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:       "Ошибка - резюме не найдено",
+			resumeID:   999,
+			visibility: entity.ResumeVisibilityHidden,
+			expectedErr: entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("резюме с id=%d не найдено", 999),
+			),
+			setupMock: func(mock sqlmock.Sqlmock, resumeID int, visibility entity.ResumeVisibility, accessToken string) {
+				mock.ExpectExec(query).
+					WithArgs(visibility, accessToken, false, resumeID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name:       "Ошибка - неверное значение видимости",
+			resumeID:   1,
+			visibility: "unknown",
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("неправильный формат видимости резюме: %w", &pq.Error{Code: entity.PSQLDatatypeViolation}),
+			),
+			setupMock: func(mock sqlmock.Sqlmock, resumeID int, visibility entity.ResumeVisibility, accessToken string) {
+				mock.ExpectExec(query).
+					WithArgs(visibility, accessToken, false, resumeID).
+					WillReturnError(&pq.Error{Code: entity.PSQLDatatypeViolation})
+			},
+		},
+		{
+			name:       "Ошибка - внутренняя ошибка при выполнении запроса",
+			resumeID:   1,
+			visibility: entity.ResumeVisibilityPublic,
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при обновлении видимости резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock, resumeID int, visibility entity.ResumeVisibility, accessToken string) {
+				mock.ExpectExec(query).
+					WithArgs(visibility, accessToken, false, resumeID).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock, tc.resumeID, tc.visibility, tc.accessToken)
+
+			repo := &ResumeRepository{DB: db}
+			err = repo.UpdateVisibility(context.Background(), tc.resumeID, tc.visibility, tc.accessToken, false)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_IsEmployerBlocked(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT EXISTS (
+			SELECT 1 FROM applicant_blocked_employer
+			WHERE applicant_id = $1 AND employer_id = $2
+		)
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult bool
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "Работодатель заблокирован",
+			expectedResult: true,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+		},
+		{
+			name:           "Работодатель не заблокирован",
+			expectedResult: false,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при проверке блокировки работодателя: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1, 2).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.IsEmployerBlocked(context.Background(), 1, 2)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_BlockEmployer(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		INSERT INTO applicant_blocked_employer (applicant_id, employer_id)
+		VALUES ($1, $2)
+		ON CONFLICT (applicant_id, employer_id) DO NOTHING
+	`)
+
+	testCases := []struct {
+		name        string
+		expectedErr error
+		setupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешная блокировка",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).
+					WithArgs(1, 2).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		{
+			name: "Ошибка - работодатель не найден",
+			expectedErr: entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("работодатель с id=%d не найден", 2),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).
+					WithArgs(1, 2).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при блокировке работодателя: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).
+					WithArgs(1, 2).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			err = repo.BlockEmployer(context.Background(), 1, 2)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_GetBlockedEmployers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	query := regexp.QuoteMeta(`
+		SELECT b.applicant_id, b.employer_id, e.company_name, b.created_at
+		FROM applicant_blocked_employer b
+		JOIN employer e ON e.id = b.employer_id
+		WHERE b.applicant_id = $1
+		ORDER BY b.created_at DESC
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult []entity.BlockedEmployer
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное получение списка",
+			expectedResult: []entity.BlockedEmployer{
+				{ApplicantID: 1, EmployerID: 2, CompanyName: "Рога и копыта", CreatedAt: now},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"applicant_id", "employer_id", "company_name", "created_at"}).
+						AddRow(1, 2, "Рога и копыта", now))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при получении заблокированных работодателей: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.GetBlockedEmployers(context.Background(), 1)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_ContactsDisclosed(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM vacancy_response vr
+			JOIN vacancy v ON v.id = vr.vacancy_id
+			WHERE vr.applicant_id = $1 AND v.employer_id = $2
+		) OR EXISTS (
+			SELECT 1 FROM contact_request
+			WHERE applicant_id = $1 AND employer_id = $2 AND status = 'accepted'
+		)
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult bool
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "Контакты раскрыты",
+			expectedResult: true,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"disclosed"}).AddRow(true))
+			},
+		},
+		{
+			name:           "Контакты скрыты",
+			expectedResult: false,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"disclosed"}).AddRow(false))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при проверке раскрытия контактов: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1, 2).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.ContactsDisclosed(context.Background(), 1, 2)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_HasAnonymousResume(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT EXISTS (
+			SELECT 1 FROM resume
+			WHERE applicant_id = $1 AND is_anonymous
+		)
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult bool
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "Есть анонимное резюме",
+			expectedResult: true,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+		},
+		{
+			name:           "Анонимных резюме нет",
+			expectedResult: false,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при проверке анонимных резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.HasAnonymousResume(context.Background(), 1)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_CreateContactRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	columns := []string{"id", "employer_id", "applicant_id", "resume_id", "status", "created_at", "updated_at"}
+
+	query := regexp.QuoteMeta(`
+		INSERT INTO contact_request (employer_id, applicant_id, resume_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (employer_id, applicant_id) DO UPDATE
+		SET status = 'pending', resume_id = EXCLUDED.resume_id, updated_at = NOW()
+		WHERE contact_request.status = 'declined'
+		RETURNING id, employer_id, applicant_id, resume_id, status, created_at, updated_at
+	`)
+	outboxQuery := regexp.QuoteMeta(`INSERT INTO outbox_event`)
+
+	testCases := []struct {
+		name           string
+		expectedResult *entity.ContactRequest
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Успешное создание запроса",
+			expectedResult: &entity.ContactRequest{
+				ID:          1,
+				EmployerID:  2,
+				ApplicantID: 3,
+				ResumeID:    4,
+				Status:      entity.ContactRequestPending,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(2, 3, 4).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 2, 3, 4, "pending", now, now))
+				mock.ExpectQuery(outboxQuery).
+					WithArgs(entity.ContactRequestedEvent, sqlmock.AnyArg(), []byte("{}")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, now))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Ошибка - не удалось записать событие",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при записи события о запросе контактов: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(2, 3, 4).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 2, 3, 4, "pending", now, now))
+				mock.ExpectQuery(outboxQuery).
+					WithArgs(entity.ContactRequestedEvent, sqlmock.AnyArg(), []byte("{}")).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Ошибка - запрос уже отправлен",
+			expectedErr: entity.NewError(
+				entity.ErrAlreadyExists,
+				fmt.Errorf("запрос контактов уже отправлен"),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(2, 3, 4).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при создании запроса контактов: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(2, 3, 4).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			event := &entity.OutboxEvent{
+				Type:         entity.ContactRequestedEvent,
+				Notification: &entity.Notification{Type: entity.ContactRequestType, SenderID: 2, ReceiverID: 3},
+			}
+			result, err := repo.CreateContactRequest(context.Background(), &entity.ContactRequest{
+				EmployerID:  2,
+				ApplicantID: 3,
+				ResumeID:    4,
+			}, event)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+				require.Equal(t, result.ID, event.Notification.ObjectID)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_UpdateContactRequestStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	columns := []string{"id", "employer_id", "applicant_id", "resume_id", "status", "created_at", "updated_at"}
+
+	query := regexp.QuoteMeta(`
+		UPDATE contact_request
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND status = 'pending'
+		RETURNING id, employer_id, applicant_id, resume_id, status, created_at, updated_at
+	`)
+
+	testCases := []struct {
+		name        string
+		expectedErr error
+		setupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Запрос принят",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(entity.ContactRequestAccepted, 1).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 2, 3, 4, "accepted", now, now))
+			},
+		},
+		{
+			name: "Ошибка - запрос уже обработан",
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("запрос контактов с id=%d уже обработан", 1),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(entity.ContactRequestAccepted, 1).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.UpdateContactRequestStatus(context.Background(), 1, entity.ContactRequestAccepted)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, entity.ContactRequestAccepted, result.Status)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_SearchResumesByProfessionWithFilter(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{
+		"id", "applicant_id", "about_me", "specialization_id",
+		"education", "educational_institution", "graduation_year",
+		"profession", "created_at", "updated_at", "is_anonymous",
+		"desired_salary", "salary_currency", "work_formats",
+	}
+
+	query := regexp.QuoteMeta(`
+        SELECT id, applicant_id, about_me, specialization_id, education, 
+               educational_institution, graduation_year, profession, created_at, updated_at, is_anonymous,
+               desired_salary, salary_currency, work_formats
+        FROM resume
+        WHERE profession ILIKE $1
+          AND visibility IN ('public', 'employers_only')
+          AND NOT EXISTS (
+              SELECT 1 FROM applicant_blocked_employer b
+              WHERE b.applicant_id = resume.applicant_id AND b.employer_id = $2
+          )
+          AND desired_salary >= $3
+          AND desired_salary <= $4
+          AND salary_currency = $5
+          AND EXISTS (
+              SELECT 1 FROM resume_city rc
+              JOIN city c ON c.id = rc.city_id
+              WHERE rc.resume_id = resume.id AND c.name = $6
+          )
+          AND ready_to_relocate
+          AND work_formats && $7::work_format_type[]
+          AND schedules && $8::schedule_type[]
+        ORDER BY updated_at DESC
+        LIMIT $9 OFFSET $10
+    `)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectClose()
+		err := db.Close()
+		require.NoError(t, err)
+	}(db, mock)
+
+	now := time.Now()
+	mock.ExpectQuery(query).
+		WithArgs("%Developer%", 1, 100000, 200000, "rub", "Москва", "{\"remote\",\"hybrid\"}", "{\"5/2\"}", 10, 0).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 2, "", 0, "", "", time.Time{}, "Go Developer", now, now, false, 150000, "rub", "{remote}"))
+
+	repo := &ResumeRepository{DB: db}
+	result, err := repo.SearchResumesByProfession(context.Background(), 1, "Developer", entity.ResumeFilter{
+		SalaryFrom:      100000,
+		SalaryTo:        200000,
+		Currency:        entity.CurrencyRUB,
+		City:            "Москва",
+		ReadyToRelocate: true,
+		WorkFormats:     []string{"remote", "hybrid"},
+		Schedules:       []string{"5/2"},
+	}, 10, 0)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, 150000, result[0].DesiredSalary)
+	require.Equal(t, entity.CurrencyRUB, result[0].SalaryCurrency)
+	require.Equal(t, []string{"remote"}, result[0].WorkFormats)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumeRepository_GetCitiesByResumeID(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT c.id, c.name
+		FROM city c
+		JOIN resume_city rc ON c.id = rc.city_id
+		WHERE rc.resume_id = $1
+		ORDER BY c.name
+	`)
+
+	testCases := []struct {
+		name           string
+		expectedResult []entity.City
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "Успешное получение городов",
+			expectedResult: []entity.City{{ID: 1, Name: "Москва"}, {ID: 2, Name: "Санкт-Петербург"}},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+						AddRow(1, "Москва").
+						AddRow(2, "Санкт-Петербург"))
+			},
+		},
+		{
+			name: "Ошибка - внутренняя ошибка базы данных",
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при получении городов резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.GetCitiesByResumeID(context.Background(), 1)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_FindCityIDsByNames(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`
+		SELECT name, id
+		FROM city
+		WHERE name = ANY($1)
+	`)
+
+	testCases := []struct {
+		name           string
+		cityNames      []string
+		expectedResult []int
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "Все города найдены",
+			cityNames:      []string{"Казань", "Москва"},
+			expectedResult: []int{5, 1},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs("{\"Казань\",\"Москва\"}").
+					WillReturnRows(sqlmock.NewRows([]string{"name", "id"}).
+						AddRow("Москва", 1).
+						AddRow("Казань", 5))
+			},
+		},
+		{
+			name:           "Пустой список",
+			cityNames:      nil,
+			expectedResult: []int{},
+			setupMock:      func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:      "Ошибка - город не найден",
+			cityNames: []string{"Москва", "Атлантида"},
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("город %s не найден", "Атлантида"),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs("{\"Москва\",\"Атлантида\"}").
+					WillReturnRows(sqlmock.NewRows([]string{"name", "id"}).
+						AddRow("Москва", 1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.FindCityIDsByNames(context.Background(), tc.cityNames)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeRepository_SearchResumesAdvanced(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{
+		"id", "applicant_id", "about_me", "specialization_id",
+		"education", "educational_institution", "graduation_year",
+		"profession", "created_at", "updated_at", "is_anonymous",
+		"desired_salary", "salary_currency", "work_formats",
+	}
+	now := time.Now()
+	updatedFrom := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		params         entity.ResumeSearchParams
+		expectedResult []entity.Resume
+		expectedErr    error
+		setupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Поиск со всеми фильтрами и ранжированием",
+			params: entity.ResumeSearchParams{
+				ResumeFilter:       entity.ResumeFilter{City: "Москва"},
+				Query:              "микросервисы",
+				SkillsAll:          []string{"Go", "PostgreSQL"},
+				SkillsAny:          []string{"Docker", "Kubernetes"},
+				Specializations:    []string{"Backend"},
+				ExperienceFrom:     3,
+				ExperienceTo:       5,
+				Education:          []entity.EducationType{entity.Higher, entity.Master},
+				GraduationYearFrom: 2015,
+				ApplicantStatuses:  []entity.ApplicantStatus{entity.StatusActivelySearching},
+				UpdatedFrom:        updatedFrom,
+			},
+			expectedResult: []entity.Resume{
+				{
+					ID:             1,
+					ApplicantID:    2,
+					AboutMe:        "Пишу микросервисы",
+					Education:      entity.Higher,
+					Profession:     "Go Developer",
+					CreatedAt:      now,
+					UpdatedAt:      now,
+					SalaryCurrency: entity.CurrencyRUB,
+					WorkFormats:    []string{"remote"},
+				},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`(?s)FROM resume\s+WHERE visibility IN \('public', 'employers_only'\)`+
+					`.*b\.employer_id = \$1`+
+					`.*c\.name = \$2`+
+					`.*to_tsvector\('russian', COALESCE\(resume\.about_me, ''\)\) @@ plainto_tsquery\('russian', \$3\)`+
+					`.*@> \$4::text\[\]`+
+					`.*s\.name = ANY\(\$5\)`+
+					`.*sp\.name = ANY\(\$6\)`+
+					`.*>= \$7.*< \$8`+
+					`.*FROM resume_education re\s+WHERE re\.resume_id = resume\.id AND re\.level = ANY\(\$9::education_type\[\]\) AND re\.graduation_year >= \$10`+
+					`.*a\.status = ANY\(\$11::applicant_status_type\[\]\)`+
+					`.*updated_at >= \$12`+
+					`.*ORDER BY \(ts_rank\(.*\) DESC, updated_at DESC\s+LIMIT \$13 OFFSET \$14`).
+					WithArgs(1, "Москва", "микросервисы", "{\"Go\",\"PostgreSQL\"}", "{\"Docker\",\"Kubernetes\"}",
+						"{\"Backend\"}", 3, 6, "{\"higher\",\"master\"}", 2015, "{\"actively_searching\"}", updatedFrom, 10, 0).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(1, 2, "Пишу микросервисы", 0, "higher", "", time.Time{}, "Go Developer", now, now, false, 0, "rub", "{remote}"))
+			},
+		},
+		{
+			name: "Поиск по языку и курсу",
+			params: entity.ResumeSearchParams{
+				Language:      "Английский",
+				LanguageLevel: "B2",
+				Course:        "Kubernetes",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`(?s)FROM resume_language rl\s+WHERE rl\.resume_id = resume\.id AND LOWER\(rl\.language\) = LOWER\(\$2\) AND rl\.level >= \$3::language_level_type`+
+					`.*FROM resume_course rc\s+WHERE rc\.resume_id = resume\.id AND rc\.name ILIKE \$4`+
+					`.*ORDER BY updated_at DESC\s+LIMIT \$5 OFFSET \$6`).
+					WithArgs(1, "Английский", "B2", "%Kubernetes%", 10, 0).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name:   "Без текста и навыков сортировка по дате обновления",
+			params: entity.ResumeSearchParams{Profession: "Developer"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`(?s)AND profession ILIKE \$2\s+ORDER BY updated_at DESC\s+LIMIT \$3 OFFSET \$4`).
+					WithArgs(1, "%Developer%", 10, 0).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name:   "Ошибка - внутренняя ошибка базы данных",
+			params: entity.ResumeSearchParams{Query: "Go"},
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при расширенном поиске резюме: %w", errors.New("database error")),
+			),
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM resume`).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectClose()
+				err := db.Close()
+				require.NoError(t, err)
+			}(db, mock)
+
+			tc.setupMock(mock)
+
+			repo := &ResumeRepository{DB: db}
+			result, err := repo.SearchResumesAdvanced(context.Background(), 1, tc.params, 10, 0)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var repoErr entity.Error
+				require.ErrorAs(t, err, &repoErr)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
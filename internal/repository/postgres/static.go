@@ -11,6 +11,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -18,11 +19,12 @@ import (
 )
 
 type StaticRepository struct {
-	S3        *minio.Client
-	DB        *sql.DB
-	bucket    string
-	cfg       config.MinioConfig
-	publicURL string
+	S3            *minio.Client
+	DB            *sql.DB
+	bucket        string
+	privateBucket string
+	cfg           config.MinioConfig
+	publicURL     string
 }
 
 func NewStaticRepository(db *sql.DB, bucket string, cfg config.MinioConfig) (repository.StaticRepository, error) {
@@ -39,30 +41,34 @@ func NewStaticRepository(db *sql.DB, bucket string, cfg config.MinioConfig) (rep
 		)
 	}
 
-	exists, err := S3.BucketExists(context.Background(), bucket)
-	if err != nil {
-
-		return nil, entity.NewError(
-			entity.ErrInternal,
-			fmt.Errorf("не удалось проверить существование бакета: %w", err),
-		)
-	}
-
-	if !exists {
-		if err := S3.MakeBucket(context.Background(), bucket, minio.MakeBucketOptions{}); err != nil {
+	// Закрытый бакет не раздается напрямую: вложения чатов отдаются через приложение после проверки доступа
+	for _, name := range []string{bucket, cfg.PrivateBucket} {
+		exists, err := S3.BucketExists(context.Background(), name)
+		if err != nil {
 
 			return nil, entity.NewError(
 				entity.ErrInternal,
-				fmt.Errorf("не удалось создать бакет: %w", err),
+				fmt.Errorf("не удалось проверить существование бакета: %w", err),
 			)
 		}
+
+		if !exists {
+			if err := S3.MakeBucket(context.Background(), name, minio.MakeBucketOptions{}); err != nil {
+
+				return nil, entity.NewError(
+					entity.ErrInternal,
+					fmt.Errorf("не удалось создать бакет: %w", err),
+				)
+			}
+		}
 	}
 	return &StaticRepository{
-		DB:        db,
-		S3:        S3,
-		bucket:    bucket,
-		cfg:       cfg,
-		publicURL: fmt.Sprintf("%s://%s", cfg.Scheme, cfg.PublicEndpoint),
+		DB:            db,
+		S3:            S3,
+		bucket:        bucket,
+		privateBucket: cfg.PrivateBucket,
+		cfg:           cfg,
+		publicURL:     fmt.Sprintf("%s://%s", cfg.Scheme, cfg.PublicEndpoint),
 	}, nil
 }
 
@@ -175,6 +181,106 @@ func (r *StaticRepository) DeleteStatic(ctx context.Context, id int) error {
 	return nil
 }
 
+func (r *StaticRepository) UploadPrivateStatic(ctx context.Context, fileName string, contentType string, data []byte) (int, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"fileName":  fileName,
+	}).Info("выполнение sql-запроса сохранения закрытой статики UploadPrivateStatic")
+
+	_, err := r.S3.PutObject(ctx, r.privateBucket, fileName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+
+		return -1, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("не удалось загрузить файл в закрытый бакет: %w", err),
+		)
+	}
+
+	var id int
+	err = r.DB.QueryRowContext(
+		ctx,
+		`INSERT INTO static (file_path, file_name) VALUES ($1, $2) RETURNING id`,
+		r.privateBucket,
+		fileName,
+	).Scan(&id)
+	if err != nil {
+
+		return -1, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("внутренная ошибка при выполнении sql-запроса UploadPrivateStatic: %w", err),
+		)
+	}
+
+	return id, nil
+}
+
+func (r *StaticRepository) DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"id":        id,
+	}).Info("выполнение sql-запроса получения содержимого статики DownloadStatic")
+
+	var bucket, fileName string
+	err := r.DB.QueryRowContext(ctx, `SELECT file_path, file_name FROM static WHERE id = $1`, id).Scan(&bucket, &fileName)
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("файл с id=%d не найден", id),
+			)
+		}
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при выполнении запроса DownloadStatic: %w", err),
+		)
+	}
+
+	object, err := r.S3.GetObject(ctx, bucket, fileName, minio.GetObjectOptions{})
+	if err != nil {
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("не удалось получить файл из minio: %w", err),
+		)
+	}
+	defer func() {
+		if err := object.Close(); err != nil {
+			l.Log.Warnf("Ошибка при закрытии файла minio: %v", err)
+		}
+	}()
+
+	info, err := object.Stat()
+	if err != nil {
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("не удалось получить сведения о файле из minio: %w", err),
+		)
+	}
+
+	data, err := io.ReadAll(object)
+	if err != nil {
+
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("не удалось прочитать файл из minio: %w", err),
+		)
+	}
+
+	return &entity.StaticContent{
+		Data:        data,
+		ContentType: info.ContentType,
+	}, nil
+}
+
 func (r *StaticRepository) getStaticURL(bucket, fileName string) string {
 	return fmt.Sprintf("%s/%s/%s", r.publicURL, bucket, fileName)
 }
@@ -561,7 +561,8 @@ func (r *VacancyRepository) GetByID(ctx context.Context, id int) (*entity.Vacanc
 	return &vacancy, nil
 }
 
-func (r *VacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy) (*entity.Vacancy, error) {
+// Update сохраняет вакансию и событие об изменении в одной транзакции
+func (r *VacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy, event *entity.OutboxEvent) (*entity.Vacancy, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
@@ -592,8 +593,26 @@ func (r *VacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy)
 		 employment, schedule, working_hours, salary_from, salary_to, taxes_included,
 		 experience, description, tasks, requirements, optional_requirements, city, created_at, updated_at
     `
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции обновления вакансии: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции обновления вакансии")
+			}
+		}
+	}()
+
 	var updatedVacancy entity.Vacancy
-	err := r.DB.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		vacancy.Title,
 		vacancy.SpecializationID,
 		vacancy.WorkFormat,
@@ -677,6 +696,20 @@ func (r *VacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy)
 			fmt.Errorf("не удалось обновить вакансию с id=%d", vacancy.ID),
 		)
 	}
+
+	if err = insertOutboxEvent(ctx, tx, event); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события об изменении вакансии: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции обновления вакансии: %w", err),
+		)
+	}
 	return &updatedVacancy, nil
 }
 
@@ -1189,16 +1222,24 @@ func (r *VacancyRepository) VacancyBelongsToEmployer(ctx context.Context, vacanc
 func (r *VacancyRepository) GetVacancyResponses(ctx context.Context, vacancyID int, limit, offset int) ([]*entity.VacancyResponses, error) {
 	requestID := utils.GetRequestID(ctx)
 
+	// Скрытые резюме и резюме соискателей, заблокировавших работодателя, не показываются
 	query := `
         SELECT 
-            id, 
-            vacancy_id, 
-            applicant_id,
-            resume_id, 
-            applied_at
-        FROM vacancy_response
-        WHERE vacancy_id = $1
-        ORDER BY applied_at DESC
+            vr.id, 
+            vr.vacancy_id, 
+            vr.applicant_id,
+            vr.resume_id, 
+            vr.applied_at
+        FROM vacancy_response vr
+        JOIN resume r ON r.id = vr.resume_id
+        JOIN vacancy v ON v.id = vr.vacancy_id
+        WHERE vr.vacancy_id = $1
+          AND r.visibility <> 'hidden'
+          AND NOT EXISTS (
+              SELECT 1 FROM applicant_blocked_employer b
+              WHERE b.applicant_id = vr.applicant_id AND b.employer_id = v.employer_id
+          )
+        ORDER BY vr.applied_at DESC
         LIMIT $2 OFFSET $3  
     `
 	rows, err := r.DB.QueryContext(ctx, query, vacancyID, limit, offset)
@@ -1245,7 +1286,8 @@ func (r *VacancyRepository) ResponseExists(ctx context.Context, vacancyID, appli
 	return exists, err
 }
 
-func (r *VacancyRepository) CreateResponse(ctx context.Context, vacancyID, applicantID, resumeID int) error {
+// CreateResponse сохраняет отклик и событие о нем в одной транзакции
+func (r *VacancyRepository) CreateResponse(ctx context.Context, vacancyID, applicantID, resumeID int, event *entity.OutboxEvent) error {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
@@ -1280,7 +1322,25 @@ func (r *VacancyRepository) CreateResponse(ctx context.Context, vacancyID, appli
         ) VALUES ($1, $2, $3, NOW())
     `
 
-	_, err := r.DB.ExecContext(ctx, query, vacancyID, applicantID, resumeID)
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции создания отклика: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции создания отклика")
+			}
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, query, vacancyID, applicantID, resumeID)
 	if err != nil {
 
 		var pqErr *pq.Error
@@ -1297,10 +1357,25 @@ func (r *VacancyRepository) CreateResponse(ctx context.Context, vacancyID, appli
 		return fmt.Errorf("failed to create vacancy response: %w", err)
 	}
 
+	if err = insertOutboxEvent(ctx, tx, event); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события об отклике: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции создания отклика: %w", err),
+		)
+	}
+
 	return nil
 }
 
-func (r *VacancyRepository) DeleteResponse(ctx context.Context, vacancyID, applicantID, resumeID int) error {
+// DeleteResponse отзывает отклик и записывает событие об отзыве в одной транзакции
+func (r *VacancyRepository) DeleteResponse(ctx context.Context, vacancyID, applicantID, resumeID int, event *entity.OutboxEvent) error {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
@@ -1314,7 +1389,26 @@ func (r *VacancyRepository) DeleteResponse(ctx context.Context, vacancyID, appli
         DELETE FROM vacancy_response 
         WHERE vacancy_id = $1 AND applicant_id = $2
     `
-	result, err := r.DB.ExecContext(ctx, query, vacancyID, applicantID)
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при начале транзакции отзыва отклика: %w", err),
+		)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				l.Log.WithFields(logrus.Fields{
+					"requestID": requestID,
+					"error":     rollbackErr,
+				}).Error("ошибка при откате транзакции отзыва отклика")
+			}
+		}
+	}()
+
+	result, err := tx.ExecContext(ctx, query, vacancyID, applicantID)
 	if err != nil {
 
 		l.Log.WithFields(logrus.Fields{
@@ -1335,8 +1429,23 @@ func (r *VacancyRepository) DeleteResponse(ctx context.Context, vacancyID, appli
 	}
 
 	if rowsAffected == 0 {
-		return entity.NewError(entity.ErrNotFound,
+		err = entity.NewError(entity.ErrNotFound,
 			fmt.Errorf("response not found for vacancy %d and applicant %d with resume %d", vacancyID, applicantID, resumeID))
+		return err
+	}
+
+	if err = insertOutboxEvent(ctx, tx, event); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при записи события об отзыве отклика: %w", err),
+		)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("ошибка при фиксации транзакции отзыва отклика: %w", err),
+		)
 	}
 
 	return nil
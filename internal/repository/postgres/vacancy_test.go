@@ -1492,6 +1492,7 @@ func TestVacancyRepository_Update(t *testing.T) {
          employment, schedule, working_hours, salary_from, salary_to, taxes_included,
          experience, description, tasks, requirements, optional_requirements, city, created_at, updated_at
     `)
+	outboxQuery := regexp.QuoteMeta(`INSERT INTO outbox_event`)
 
 	testCases := []struct {
 		name           string
@@ -1544,6 +1545,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 			},
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1588,6 +1590,9 @@ func TestVacancyRepository_Update(t *testing.T) {
 								now,
 							),
 					)
+				mock.ExpectQuery(outboxQuery).WithArgs(entity.VacancyUpdatedEvent, []byte(nil), []byte(`{"vacancy_id":1}`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, now))
+				mock.ExpectCommit()
 			},
 		},
 		{
@@ -1617,6 +1622,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("конфликт уникальных данных вакансии"),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1638,6 +1644,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(&pq.Error{Code: "23505"})
+				mock.ExpectRollback()
 			},
 		},
 		{
@@ -1667,6 +1674,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("работодатель или специализация с указанным ID не существует"),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1688,6 +1696,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(&pq.Error{Code: "23503"})
+				mock.ExpectRollback()
 			},
 		},
 		{
@@ -1717,6 +1726,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("обязательное поле отсутствует"),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1738,6 +1748,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(&pq.Error{Code: "23502"})
+				mock.ExpectRollback()
 			},
 		},
 		{
@@ -1767,6 +1778,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("неправильный формат данных"),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1788,6 +1800,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(&pq.Error{Code: "22P02"})
+				mock.ExpectRollback()
 			},
 		},
 		{
@@ -1817,6 +1830,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("неправильные данные (например, salary_from > salary_to)"),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1838,6 +1852,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(&pq.Error{Code: "23514"})
+				mock.ExpectRollback()
 			},
 		},
 		{
@@ -1867,6 +1882,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("не удалось обновить вакансию с id=%d", 1),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1888,6 +1904,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
 			},
 		},
 		{
@@ -1917,6 +1934,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 				fmt.Errorf("не удалось обновить вакансию с id=%d", 1),
 			),
 			setupMock: func(mock sqlmock.Sqlmock, vacancy *entity.Vacancy) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(query).
 					WithArgs(
 						vacancy.Title,
@@ -1938,6 +1956,7 @@ func TestVacancyRepository_Update(t *testing.T) {
 						vacancy.EmployerID,
 					).
 					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
 		},
 	}
@@ -1960,7 +1979,10 @@ func TestVacancyRepository_Update(t *testing.T) {
 			repo := &VacancyRepository{DB: db}
 			ctx := context.Background()
 
-			result, err := repo.Update(ctx, tc.inputVacancy)
+			result, err := repo.Update(ctx, tc.inputVacancy, &entity.OutboxEvent{
+				Type:    entity.VacancyUpdatedEvent,
+				Objects: map[string]int{"vacancy_id": 1},
+			})
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"time"
+)
+
+type PresenceRepository interface {
+	Connect(ctx context.Context, userID int, role, connID string, ttl time.Duration) (bool, error)
+	Disconnect(ctx context.Context, userID int, role, connID string) (bool, error)
+	GetPresence(ctx context.Context, userID int, role string) (*entity.Presence, error)
+}
@@ -0,0 +1,188 @@
+package redis
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/metrics"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/utils"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gomodule/redigo/redis"
+	"github.com/sirupsen/logrus"
+	"strconv"
+	"time"
+)
+
+const (
+	presencePrefix = "presence:"
+	lastSeenPrefix = "last_seen:"
+)
+
+// PresenceRepository хранит соединения пользователя в sorted set, где score - момент, после
+// которого соединение считается потерянным. Так статус общий для всех экземпляров приложения,
+// а соединения упавшего экземпляра перестают учитываться сами по истечении срока
+type PresenceRepository struct {
+	pool *redis.Pool
+}
+
+func NewPresenceRepository(pool *redis.Pool) repository.PresenceRepository {
+	return &PresenceRepository{pool: pool}
+}
+
+// Connect регистрирует или продлевает соединение и сообщает, появился ли пользователь в сети
+func (r *PresenceRepository) Connect(ctx context.Context, userID int, role, connID string, ttl time.Duration) (bool, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"id":        userID,
+		"role":      role,
+	}).Debug("продление соединения в Redis Connect")
+
+	conn := r.pool.Get()
+	defer func() {
+		if err := conn.Close(); err != nil {
+			l.Log.Warnf("Ошибка при закрытии соединения redis: %v", err)
+		}
+	}()
+
+	key := presenceKey(userID, role)
+	now := time.Now()
+
+	if err := conn.Send("MULTI"); err != nil {
+		return false, r.presenceError("Connect", userID, role, err)
+	}
+	_ = conn.Send("ZREMRANGEBYSCORE", key, "-inf", now.UnixMilli())
+	_ = conn.Send("ZCARD", key)
+	_ = conn.Send("ZADD", key, now.Add(ttl).UnixMilli(), connID)
+	_ = conn.Send("PEXPIRE", key, ttl.Milliseconds())
+
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return false, r.presenceError("Connect", userID, role, err)
+	}
+
+	before, err := redis.Int(replies[1], nil)
+	if err != nil {
+		return false, r.presenceError("Connect", userID, role, err)
+	}
+	return before == 0, nil
+}
+
+// Disconnect удаляет соединение и сообщает, ушел ли пользователь из сети.
+// Время последнего визита запоминается, когда закрывается последнее соединение
+func (r *PresenceRepository) Disconnect(ctx context.Context, userID int, role, connID string) (bool, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"id":        userID,
+		"role":      role,
+	}).Debug("удаление соединения в Redis Disconnect")
+
+	conn := r.pool.Get()
+	defer func() {
+		if err := conn.Close(); err != nil {
+			l.Log.Warnf("Ошибка при закрытии соединения redis: %v", err)
+		}
+	}()
+
+	key := presenceKey(userID, role)
+	now := time.Now()
+
+	if err := conn.Send("MULTI"); err != nil {
+		return false, r.presenceError("Disconnect", userID, role, err)
+	}
+	_ = conn.Send("ZREM", key, connID)
+	_ = conn.Send("ZREMRANGEBYSCORE", key, "-inf", now.UnixMilli())
+	_ = conn.Send("ZCARD", key)
+
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return false, r.presenceError("Disconnect", userID, role, err)
+	}
+
+	left, err := redis.Int(replies[2], nil)
+	if err != nil {
+		return false, r.presenceError("Disconnect", userID, role, err)
+	}
+	if left > 0 {
+		return false, nil
+	}
+
+	if _, err = conn.Do("SET", lastSeenKey(userID, role), now.Unix()); err != nil {
+		return false, r.presenceError("Disconnect", userID, role, err)
+	}
+	return true, nil
+}
+
+func (r *PresenceRepository) GetPresence(ctx context.Context, userID int, role string) (*entity.Presence, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+		"id":        userID,
+		"role":      role,
+	}).Info("получение статуса пользователя в Redis GetPresence")
+
+	conn := r.pool.Get()
+	defer func() {
+		if err := conn.Close(); err != nil {
+			l.Log.Warnf("Ошибка при закрытии соединения redis: %v", err)
+		}
+	}()
+
+	key := presenceKey(userID, role)
+
+	if err := conn.Send("MULTI"); err != nil {
+		return nil, r.presenceError("GetPresence", userID, role, err)
+	}
+	_ = conn.Send("ZREMRANGEBYSCORE", key, "-inf", time.Now().UnixMilli())
+	_ = conn.Send("ZCARD", key)
+	_ = conn.Send("GET", lastSeenKey(userID, role))
+
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return nil, r.presenceError("GetPresence", userID, role, err)
+	}
+
+	connections, err := redis.Int(replies[1], nil)
+	if err != nil {
+		return nil, r.presenceError("GetPresence", userID, role, err)
+	}
+
+	presence := &entity.Presence{
+		UserID: userID,
+		Role:   entity.UserRole(role),
+		Online: connections > 0,
+	}
+
+	lastSeen, err := redis.Int64(replies[2], nil)
+	switch {
+	case errors.Is(err, redis.ErrNil):
+	case err != nil:
+		return nil, r.presenceError("GetPresence", userID, role, err)
+	default:
+		seenAt := time.Unix(lastSeen, 0)
+		presence.LastSeen = &seenAt
+	}
+	return presence, nil
+}
+
+func (r *PresenceRepository) presenceError(operation string, userID int, role string, err error) error {
+	metrics.LayerErrorCounter.WithLabelValues("Presence Repository", operation).Inc()
+	return entity.NewError(
+		entity.ErrInternal,
+		fmt.Errorf("ошибка при работе со статусом пользователя с id=%d, role=%s :%w", userID, role, err),
+	)
+}
+
+func presenceKey(userID int, role string) string {
+	return presencePrefix + role + ":" + strconv.Itoa(userID)
+}
+
+func lastSeenKey(userID int, role string) string {
+	return lastSeenPrefix + role + ":" + strconv.Itoa(userID)
+}
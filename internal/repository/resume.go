@@ -9,25 +9,50 @@ type ResumeRepository interface {
 	Create(ctx context.Context, resume *entity.Resume) (*entity.Resume, error)
 	AddSkills(ctx context.Context, resumeID int, skillIDs []int) error
 	AddSpecializations(ctx context.Context, resumeID int, specializationIDs []int) error
+	AddCities(ctx context.Context, resumeID int, cityIDs []int) error
 	AddWorkExperience(ctx context.Context, workExperience *entity.WorkExperience) (*entity.WorkExperience, error)
 	GetByID(ctx context.Context, id int) (*entity.Resume, error)
 	GetSkillsByResumeID(ctx context.Context, resumeID int) ([]entity.Skill, error)
 	GetSpecializationsByResumeID(ctx context.Context, resumeID int) ([]entity.Specialization, error)
+	GetCitiesByResumeID(ctx context.Context, resumeID int) ([]entity.City, error)
 	GetWorkExperienceByResumeID(ctx context.Context, resumeID int) ([]entity.WorkExperience, error)
 	Update(ctx context.Context, resume *entity.Resume) (*entity.Resume, error)
 	Delete(ctx context.Context, id int) error
 	DeleteSkills(ctx context.Context, resumeID int) error
 	DeleteSpecializations(ctx context.Context, resumeID int) error
+	DeleteCities(ctx context.Context, resumeID int) error
 	DeleteWorkExperiences(ctx context.Context, resumeID int) error
 	UpdateWorkExperience(ctx context.Context, workExperience *entity.WorkExperience) (*entity.WorkExperience, error)
 	DeleteWorkExperience(ctx context.Context, id int) error
-	GetAll(ctx context.Context, limit, offset int) ([]entity.Resume, error)
+	GetAll(ctx context.Context, employerID, limit, offset int) ([]entity.Resume, error)
 	GetAllResumesByApplicantID(ctx context.Context, applicantID int, limit int, offset int) ([]entity.Resume, error)
 	FindSkillIDsByNames(ctx context.Context, skillNames []string) ([]int, error)
+	FindCityIDsByNames(ctx context.Context, cityNames []string) ([]int, error)
+	AddEducations(ctx context.Context, resumeID int, educations []entity.ResumeEducation) error
+	GetEducationsByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeEducation, error)
+	DeleteEducations(ctx context.Context, resumeID int) error
+	AddCourses(ctx context.Context, resumeID int, courses []entity.ResumeCourse) error
+	GetCoursesByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeCourse, error)
+	DeleteCourses(ctx context.Context, resumeID int) error
+	AddLanguages(ctx context.Context, resumeID int, languages []entity.ResumeLanguage) error
+	GetLanguagesByResumeID(ctx context.Context, resumeID int) ([]entity.ResumeLanguage, error)
+	DeleteLanguages(ctx context.Context, resumeID int) error
 	FindSpecializationIDByName(ctx context.Context, specializationName string) (int, error)
 	FindSpecializationIDsByNames(ctx context.Context, specializationNames []string) ([]int, error)
 	CreateSkillIfNotExists(ctx context.Context, skillName string) (int, error)
 	CreateSpecializationIfNotExists(ctx context.Context, specializationName string) (int, error)
-	SearchResumesByProfession(ctx context.Context, profession string, limit int, offset int) ([]entity.Resume, error)
+	SearchResumesByProfession(ctx context.Context, employerID int, profession string, filter entity.ResumeFilter, limit int, offset int) ([]entity.Resume, error)
+	SearchResumesAdvanced(ctx context.Context, employerID int, params entity.ResumeSearchParams, limit int, offset int) ([]entity.Resume, error)
 	SearchResumesByProfessionForApplicant(ctx context.Context, applicantID int, profession string, limit int, offset int) ([]entity.Resume, error)
+	UpdateVisibility(ctx context.Context, resumeID int, visibility entity.ResumeVisibility, accessToken string, isAnonymous bool) error
+	ResumeSentToEmployer(ctx context.Context, resumeID, employerID int) (bool, error)
+	IsEmployerBlocked(ctx context.Context, applicantID, employerID int) (bool, error)
+	BlockEmployer(ctx context.Context, applicantID, employerID int) error
+	UnblockEmployer(ctx context.Context, applicantID, employerID int) error
+	GetBlockedEmployers(ctx context.Context, applicantID int) ([]entity.BlockedEmployer, error)
+	ContactsDisclosed(ctx context.Context, applicantID, employerID int) (bool, error)
+	HasAnonymousResume(ctx context.Context, applicantID int) (bool, error)
+	CreateContactRequest(ctx context.Context, request *entity.ContactRequest, event *entity.OutboxEvent) (*entity.ContactRequest, error)
+	GetContactRequestByID(ctx context.Context, id int) (*entity.ContactRequest, error)
+	UpdateContactRequestStatus(ctx context.Context, id int, status entity.ContactRequestStatus) (*entity.ContactRequest, error)
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"ResuMatch/internal/entity"
 	"context"
 )
 
@@ -8,4 +9,6 @@ type StaticRepository interface {
 	UploadStatic(ctx context.Context, fileName string, contentType string, data []byte) (int, string, error)
 	GetStatic(ctx context.Context, id int) (string, error)
 	DeleteStatic(ctx context.Context, id int) error
+	UploadPrivateStatic(ctx context.Context, fileName string, contentType string, data []byte) (int, error)
+	DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error)
 }
@@ -10,7 +10,7 @@ type VacancyRepository interface {
 	AddSkills(ctx context.Context, vacancyID int, skillIDs []int) error
 	AddCity(ctx context.Context, vacancyID int, cityIDs []int) error
 	GetByID(ctx context.Context, id int) (*entity.Vacancy, error)
-	Update(ctx context.Context, vacancy *entity.Vacancy) (*entity.Vacancy, error)
+	Update(ctx context.Context, vacancy *entity.Vacancy, event *entity.OutboxEvent) (*entity.Vacancy, error)
 	GetAll(ctx context.Context, limit int, offset int) ([]*entity.Vacancy, error)
 	Delete(ctx context.Context, vacancyID int) error
 	GetSkillsByVacancyID(ctx context.Context, vacancyID int) ([]entity.Skill, error)
@@ -20,7 +20,7 @@ type VacancyRepository interface {
 	FindSkillIDsByNames(ctx context.Context, skillNames []string) ([]int, error)
 	FindCityIDsByNames(ctx context.Context, cityNames []string) ([]int, error)
 	ResponseExists(ctx context.Context, vacancyID, applicantID int) (bool, error)
-	CreateResponse(ctx context.Context, vacancyID, applicantID, resumeID int) error
+	CreateResponse(ctx context.Context, vacancyID, applicantID, resumeID int, event *entity.OutboxEvent) error
 	FindSpecializationIDByName(ctx context.Context, specializationName string) (int, error)
 	CreateSkillIfNotExists(ctx context.Context, skillName string) (int, error)
 	CreateSpecializationIfNotExists(ctx context.Context, specializationName string) (int, error)
@@ -35,6 +35,6 @@ type VacancyRepository interface {
 	DeleteLike(ctx context.Context, vacancyID, applicantID int) error
 	GetlikedVacancies(ctx context.Context, applicantID int, limit, offset int) ([]*entity.Vacancy, error)
 	LikeExists(ctx context.Context, vacancyID, applicantID int) (bool, error)
-	DeleteResponse(ctx context.Context, vacancyID, applicantID, resumeID int) error
+	DeleteResponse(ctx context.Context, vacancyID, applicantID, resumeID int, event *entity.OutboxEvent) error
 	GetVacancyResponses(ctx context.Context, vacancyID int, limit, offset int) ([]*entity.VacancyResponses, error)
 }
@@ -1,6 +1,7 @@
 package static
 
 import (
+	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/metrics"
 	"ResuMatch/internal/transport/grpc/interceptors"
@@ -12,6 +13,10 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// MaxMessageSize покрывает самое большое вложение чата вместе с накладными расходами protobuf.
+// Стандартного лимита gRPC в 4MB не хватает даже для изображений
+const MaxMessageSize = 16 << 20
+
 type Gateway struct {
 	staticClient staticPROTO.StaticServiceClient
 }
@@ -21,6 +26,10 @@ func NewGateway(connectAddr string) (*Gateway, error) {
 		connectAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithUnaryInterceptor(interceptors.RequestIDClientInterceptor()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(MaxMessageSize),
+			grpc.MaxCallSendMsgSize(MaxMessageSize),
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -77,3 +86,38 @@ func (gw *Gateway) DeleteStatic(ctx context.Context, id int) error {
 	metrics.StaticServiceCallCounter.WithLabelValues("DeleteStatic", "200").Inc()
 	return nil
 }
+
+func (gw *Gateway) UploadAttachment(ctx context.Context, data []byte) (*dto.UploadAttachmentResponse, error) {
+	timer := prometheus.NewTimer(metrics.StaticServiceCallDuration.WithLabelValues("UploadAttachment"))
+	defer timer.ObserveDuration()
+
+	resp, err := gw.staticClient.UploadAttachment(ctx, &staticPROTO.UploadAttachmentRequest{Data: data})
+	if err != nil {
+		metrics.StaticServiceCallCounter.WithLabelValues("UploadAttachment", "500").Inc()
+		return nil, utils.FromGRPCError(err)
+	}
+
+	metrics.StaticServiceCallCounter.WithLabelValues("UploadAttachment", "200").Inc()
+	return &dto.UploadAttachmentResponse{
+		ID:          int(resp.Id),
+		ContentType: resp.ContentType,
+		Size:        int(resp.Size),
+	}, nil
+}
+
+func (gw *Gateway) DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error) {
+	timer := prometheus.NewTimer(metrics.StaticServiceCallDuration.WithLabelValues("DownloadStatic"))
+	defer timer.ObserveDuration()
+
+	resp, err := gw.staticClient.DownloadStatic(ctx, &staticPROTO.FileID{Id: uint64(id)})
+	if err != nil {
+		metrics.StaticServiceCallCounter.WithLabelValues("DownloadStatic", "500").Inc()
+		return nil, utils.FromGRPCError(err)
+	}
+
+	metrics.StaticServiceCallCounter.WithLabelValues("DownloadStatic", "200").Inc()
+	return &entity.StaticContent{
+		Data:        resp.Data,
+		ContentType: resp.ContentType,
+	}, nil
+}
@@ -206,6 +206,162 @@ func (x *UploadStaticResponse) GetPath() string {
 	return ""
 }
 
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_static_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_static_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_static_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UploadAttachmentRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_static_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_static_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_static_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadAttachmentResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UploadAttachmentResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadAttachmentResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type StaticContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaticContent) Reset() {
+	*x = StaticContent{}
+	mi := &file_static_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticContent) ProtoMessage() {}
+
+func (x *StaticContent) ProtoReflect() protoreflect.Message {
+	mi := &file_static_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticContent.ProtoReflect.Descriptor instead.
+func (*StaticContent) Descriptor() ([]byte, []int) {
+	return file_static_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StaticContent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StaticContent) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 var File_static_proto protoreflect.FileDescriptor
 
 const file_static_proto_rawDesc = "" +
@@ -219,11 +375,22 @@ const file_static_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x01(\fR\x04data\":\n" +
 	"\x14UploadStaticResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path2\xc2\x01\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"-\n" +
+	"\x17UploadAttachmentRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"a\n" +
+	"\x18UploadAttachmentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\"F\n" +
+	"\rStaticContent\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType2\xd2\x02\n" +
 	"\rStaticService\x12I\n" +
 	"\fUploadStatic\x12\x1b.static.UploadStaticRequest\x1a\x1c.static.UploadStaticResponse\x12.\n" +
 	"\tGetStatic\x12\x0e.static.FileID\x1a\x11.static.StaticURL\x126\n" +
-	"\fDeleteStatic\x12\x0e.static.FileID\x1a\x16.google.protobuf.EmptyB\vZ\t./;staticb\x06proto3"
+	"\fDeleteStatic\x12\x0e.static.FileID\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x10UploadAttachment\x12\x1f.static.UploadAttachmentRequest\x1a .static.UploadAttachmentResponse\x127\n" +
+	"\x0eDownloadStatic\x12\x0e.static.FileID\x1a\x15.static.StaticContentB\vZ\t./;staticb\x06proto3"
 
 var (
 	file_static_proto_rawDescOnce sync.Once
@@ -237,23 +404,30 @@ func file_static_proto_rawDescGZIP() []byte {
 	return file_static_proto_rawDescData
 }
 
-var file_static_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_static_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_static_proto_goTypes = []any{
-	(*FileID)(nil),               // 0: static.FileID
-	(*StaticURL)(nil),            // 1: static.StaticURL
-	(*UploadStaticRequest)(nil),  // 2: static.UploadStaticRequest
-	(*UploadStaticResponse)(nil), // 3: static.UploadStaticResponse
-	(*emptypb.Empty)(nil),        // 4: google.protobuf.Empty
+	(*FileID)(nil),                   // 0: static.FileID
+	(*StaticURL)(nil),                // 1: static.StaticURL
+	(*UploadStaticRequest)(nil),      // 2: static.UploadStaticRequest
+	(*UploadStaticResponse)(nil),     // 3: static.UploadStaticResponse
+	(*UploadAttachmentRequest)(nil),  // 4: static.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil), // 5: static.UploadAttachmentResponse
+	(*StaticContent)(nil),            // 6: static.StaticContent
+	(*emptypb.Empty)(nil),            // 7: google.protobuf.Empty
 }
 var file_static_proto_depIdxs = []int32{
 	2, // 0: static.StaticService.UploadStatic:input_type -> static.UploadStaticRequest
 	0, // 1: static.StaticService.GetStatic:input_type -> static.FileID
 	0, // 2: static.StaticService.DeleteStatic:input_type -> static.FileID
-	3, // 3: static.StaticService.UploadStatic:output_type -> static.UploadStaticResponse
-	1, // 4: static.StaticService.GetStatic:output_type -> static.StaticURL
-	4, // 5: static.StaticService.DeleteStatic:output_type -> google.protobuf.Empty
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	4, // 3: static.StaticService.UploadAttachment:input_type -> static.UploadAttachmentRequest
+	0, // 4: static.StaticService.DownloadStatic:input_type -> static.FileID
+	3, // 5: static.StaticService.UploadStatic:output_type -> static.UploadStaticResponse
+	1, // 6: static.StaticService.GetStatic:output_type -> static.StaticURL
+	7, // 7: static.StaticService.DeleteStatic:output_type -> google.protobuf.Empty
+	5, // 8: static.StaticService.UploadAttachment:output_type -> static.UploadAttachmentResponse
+	6, // 9: static.StaticService.DownloadStatic:output_type -> static.StaticContent
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -270,7 +444,7 @@ func file_static_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_static_proto_rawDesc), len(file_static_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StaticService_UploadStatic_FullMethodName = "/static.StaticService/UploadStatic"
-	StaticService_GetStatic_FullMethodName    = "/static.StaticService/GetStatic"
-	StaticService_DeleteStatic_FullMethodName = "/static.StaticService/DeleteStatic"
+	StaticService_UploadStatic_FullMethodName     = "/static.StaticService/UploadStatic"
+	StaticService_GetStatic_FullMethodName        = "/static.StaticService/GetStatic"
+	StaticService_DeleteStatic_FullMethodName     = "/static.StaticService/DeleteStatic"
+	StaticService_UploadAttachment_FullMethodName = "/static.StaticService/UploadAttachment"
+	StaticService_DownloadStatic_FullMethodName   = "/static.StaticService/DownloadStatic"
 )
 
 // StaticServiceClient is the client API for StaticService service.
@@ -32,6 +34,8 @@ type StaticServiceClient interface {
 	UploadStatic(ctx context.Context, in *UploadStaticRequest, opts ...grpc.CallOption) (*UploadStaticResponse, error)
 	GetStatic(ctx context.Context, in *FileID, opts ...grpc.CallOption) (*StaticURL, error)
 	DeleteStatic(ctx context.Context, in *FileID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error)
+	DownloadStatic(ctx context.Context, in *FileID, opts ...grpc.CallOption) (*StaticContent, error)
 }
 
 type staticServiceClient struct {
@@ -72,6 +76,26 @@ func (c *staticServiceClient) DeleteStatic(ctx context.Context, in *FileID, opts
 	return out, nil
 }
 
+func (c *staticServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadAttachmentResponse)
+	err := c.cc.Invoke(ctx, StaticService_UploadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticServiceClient) DownloadStatic(ctx context.Context, in *FileID, opts ...grpc.CallOption) (*StaticContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StaticContent)
+	err := c.cc.Invoke(ctx, StaticService_DownloadStatic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StaticServiceServer is the server API for StaticService service.
 // All implementations must embed UnimplementedStaticServiceServer
 // for forward compatibility.
@@ -79,6 +103,8 @@ type StaticServiceServer interface {
 	UploadStatic(context.Context, *UploadStaticRequest) (*UploadStaticResponse, error)
 	GetStatic(context.Context, *FileID) (*StaticURL, error)
 	DeleteStatic(context.Context, *FileID) (*emptypb.Empty, error)
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+	DownloadStatic(context.Context, *FileID) (*StaticContent, error)
 	mustEmbedUnimplementedStaticServiceServer()
 }
 
@@ -98,6 +124,12 @@ func (UnimplementedStaticServiceServer) GetStatic(context.Context, *FileID) (*St
 func (UnimplementedStaticServiceServer) DeleteStatic(context.Context, *FileID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatic not implemented")
 }
+func (UnimplementedStaticServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedStaticServiceServer) DownloadStatic(context.Context, *FileID) (*StaticContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadStatic not implemented")
+}
 func (UnimplementedStaticServiceServer) mustEmbedUnimplementedStaticServiceServer() {}
 func (UnimplementedStaticServiceServer) testEmbeddedByValue()                       {}
 
@@ -173,6 +205,42 @@ func _StaticService_DeleteStatic_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaticService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticService_DownloadStatic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticServiceServer).DownloadStatic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticService_DownloadStatic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticServiceServer).DownloadStatic(ctx, req.(*FileID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StaticService_ServiceDesc is the grpc.ServiceDesc for StaticService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +260,14 @@ var StaticService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStatic",
 			Handler:    _StaticService_DeleteStatic_Handler,
 		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _StaticService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "DownloadStatic",
+			Handler:    _StaticService_DownloadStatic_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "static.proto",
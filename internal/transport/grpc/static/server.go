@@ -50,3 +50,28 @@ func (service *GRPC) DeleteStatic(ctx context.Context, req *staticPROTO.FileID)
 
 	return &emptypb.Empty{}, nil
 }
+
+func (service *GRPC) UploadAttachment(ctx context.Context, req *staticPROTO.UploadAttachmentRequest) (*staticPROTO.UploadAttachmentResponse, error) {
+	attachment, err := service.staticUC.UploadAttachment(ctx, req.Data)
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+
+	return &staticPROTO.UploadAttachmentResponse{
+		Id:          uint64(attachment.ID),
+		ContentType: attachment.ContentType,
+		Size:        uint64(attachment.Size),
+	}, nil
+}
+
+func (service *GRPC) DownloadStatic(ctx context.Context, req *staticPROTO.FileID) (*staticPROTO.StaticContent, error) {
+	content, err := service.staticUC.DownloadStatic(ctx, int(req.Id))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+
+	return &staticPROTO.StaticContent{
+		Data:        content.Data,
+		ContentType: content.ContentType,
+	}, nil
+}
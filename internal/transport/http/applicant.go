@@ -128,7 +128,7 @@ func (h *ApplicantHandler) Login(w http.ResponseWriter, r *http.Request) {
 // GetProfile godoc
 // @Tags Applicant
 // @Summary Получить профиль соискателя
-// @Description Возвращает профиль соискателя по ID. Требует авторизации. Доступен только для владельца профиля.
+// @Description Возвращает профиль соискателя по ID. Требует авторизации. Работодателю профиль владельца анонимного резюме недоступен до раскрытия контактов.
 // @Produce json
 // @Param id path int true "ID соискателя"
 // @Success 200 {object} dto.ApplicantProfileResponse "Профиль соискателя"
@@ -148,7 +148,7 @@ func (h *ApplicantHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, _, err = h.auth.GetUserIDBySession(ctx, cookie.Value)
+	currentUserID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
@@ -166,7 +166,7 @@ func (h *ApplicantHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	//	return
 	//}
 
-	applicant, err := h.applicant.GetUser(ctx, applicantID)
+	applicant, err := h.applicant.GetProfile(ctx, applicantID, currentUserID, role)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
@@ -388,7 +388,7 @@ func TestApplicantHandler_GetProfile(t *testing.T) {
 					Return(1, "applicant", nil)
 
 				applicant.EXPECT().
-					GetUser(gomock.Any(), 1).
+					GetProfile(gomock.Any(), 1, 1, "applicant").
 					Return(testProfile, nil)
 			},
 			expectedStatus:   http.StatusOK,
@@ -463,7 +463,7 @@ func TestApplicantHandler_GetProfile(t *testing.T) {
 					Return(1, "applicant", nil)
 
 				applicant.EXPECT().
-					GetUser(gomock.Any(), 999).
+					GetProfile(gomock.Any(), 999, 1, "applicant").
 					Return(nil, entity.NewError(
 						entity.ErrNotFound,
 						fmt.Errorf("профиль не найден"),
@@ -475,6 +475,32 @@ func TestApplicantHandler_GetProfile(t *testing.T) {
 				Message: "профиль не найден",
 			},
 		},
+		{
+			name:   "профиль анонимного соискателя скрыт от работодателя",
+			pathID: "1",
+			setupRequest: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/applicant/1", nil)
+				req.AddCookie(&http.Cookie{Name: "session_id", Value: "valid-session"})
+				return req
+			},
+			mockSetup: func(applicant *mock.MockApplicant, auth *mock.MockAuth) {
+				auth.EXPECT().
+					GetUserIDBySession(gomock.Any(), "valid-session").
+					Return(7, "employer", nil)
+
+				applicant.EXPECT().
+					GetProfile(gomock.Any(), 1, 7, "employer").
+					Return(nil, entity.NewError(
+						entity.ErrForbidden,
+						fmt.Errorf("профиль соискателя скрыт до раскрытия контактов"),
+					))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: utils.APIError{
+				Status:  http.StatusForbidden,
+				Message: "профиль соискателя скрыт до раскрытия контактов",
+			},
+		},
 		{
 			name:   "ошибка при кодировании ответа",
 			pathID: "1",
@@ -489,7 +515,7 @@ func TestApplicantHandler_GetProfile(t *testing.T) {
 					Return(1, "applicant", nil)
 
 				applicant.EXPECT().
-					GetUser(gomock.Any(), 1).
+					GetProfile(gomock.Any(), 1, 1, "applicant").
 					Return(testProfile, nil)
 			},
 			expectedStatus:   http.StatusOK,
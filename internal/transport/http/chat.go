@@ -2,12 +2,19 @@ package http
 
 import (
 	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/transport/http/utils"
 	"ResuMatch/internal/usecase"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
+// maxAttachmentRequestSize оставляет запас на заголовки multipart сверх лимита вложения в 10MB
+const maxAttachmentRequestSize = 11 << 20
+
 type ChatHandler struct {
 	auth usecase.Auth
 	chat usecase.Chat
@@ -22,8 +29,20 @@ func (h *ChatHandler) Configure(r *http.ServeMux) {
 
 	chatMux.HandleFunc("GET /{id}", h.GetChatByID)
 	chatMux.HandleFunc("GET /user", h.GetUserChats)
+	chatMux.HandleFunc("GET /unread", h.GetUnreadCount)
+	chatMux.HandleFunc("GET /search", h.SearchMessages)
 	chatMux.HandleFunc("POST /vacancy/{id}", h.GetVacancyChat)
+	chatMux.HandleFunc("POST /invitation", h.InviteToVacancy)
+	chatMux.HandleFunc("PUT /invitation/{id}/accept", h.AcceptInvitation)
+	chatMux.HandleFunc("PUT /invitation/{id}/decline", h.DeclineInvitation)
+	chatMux.HandleFunc("PUT /{id}/settings", h.UpdateChatSettings)
 	chatMux.HandleFunc("GET /{id}/messages", h.GetChatMessages)
+	chatMux.HandleFunc("PUT /{id}/messages/{messageID}", h.EditMessage)
+	chatMux.HandleFunc("DELETE /{id}/messages/{messageID}", h.DeleteMessage)
+	chatMux.HandleFunc("GET /{id}/messages/{messageID}/history", h.GetMessageHistory)
+	chatMux.HandleFunc("POST /{id}/attachments", h.UploadAttachment)
+	chatMux.HandleFunc("GET /{id}/attachments/{attachmentID}", h.GetAttachment)
+	chatMux.HandleFunc("GET /{id}/export", h.ExportChat)
 
 	r.Handle("/chat/", http.StripPrefix("/chat", chatMux))
 }
@@ -130,9 +149,11 @@ func (h *ChatHandler) GetChatByID(w http.ResponseWriter, r *http.Request) {
 // GetUserChats godoc
 // @Tags Chat
 // @Summary Получить чаты пользователя
-// @Description Получить все чаты текущего пользователя. Требует авторизации.
+// @Description Получить чаты текущего пользователя. Архивные чаты возвращаются отдельно, пока в них не появится новое сообщение. Требует авторизации.
 // @Produce json
+// @Param archived query bool false "Только архивные чаты"
 // @Success 200 {object} dto.ChatResponseList "Список чатов"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
 // @Failure 401 {object} utils.APIError "Не авторизован"
 // @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
 // @Router /chat/user [get]
@@ -152,7 +173,16 @@ func (h *ChatHandler) GetUserChats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	chats, getErr := h.chat.GetUserChats(ctx, userID, role)
+	archived := false
+	if value := r.URL.Query().Get("archived"); value != "" {
+		archived, err = strconv.ParseBool(value)
+		if err != nil {
+			utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+			return
+		}
+	}
+
+	chats, getErr := h.chat.GetUserChats(ctx, userID, role, archived)
 	if getErr != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(getErr))
 		return
@@ -164,13 +194,55 @@ func (h *ChatHandler) GetUserChats(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetUnreadCount godoc
+// @Tags Chat
+// @Summary Получить количество непрочитанных сообщений
+// @Description Получить общее число непрочитанных сообщений и чатов с ними для бейджа. Требует авторизации.
+// @Produce json
+// @Success 200 {object} dto.UnreadCountResponse "Непрочитанные сообщения"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/unread [get]
+// @Security session_cookie
+func (h *ChatHandler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	count, getErr := h.chat.GetUnreadCount(ctx, userID, role)
+	if getErr != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(getErr))
+		return
+	}
+
+	if marshalErr := utils.WriteJSON(w, count); marshalErr != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(marshalErr))
+		return
+	}
+}
+
 // GetChatMessages godoc
 // @Tags Chat
 // @Summary Получить сообщения чата
-// @Description Получить все сообщения из указанного чата. Требует авторизации и доступа к чату.
+// @Description Получить страницу сообщений чата в хронологическом порядке. Без курсора возвращается последняя страница,
+// @Description before - сообщения старше указанного, after - новее указанного. Требует авторизации и доступа к чату.
 // @Param id path int true "ID чата"
+// @Param before query int false "ID сообщения, до которого загрузить историю"
+// @Param after query int false "ID сообщения, после которого загрузить историю"
+// @Param limit query int false "Количество сообщений на странице (по умолчанию 50, не больше 100)"
 // @Produce json
 // @Success 200 {array} dto.MessageResponse "Список сообщений"
+// @Failure 400 {object} utils.APIError "Некорректные параметры пагинации"
 // @Failure 401 {object} utils.APIError "Не авторизован"
 // @Failure 403 {object} utils.APIError "Доступ запрещён"
 // @Failure 404 {object} utils.APIError "Чат не найден"
@@ -223,7 +295,80 @@ func (h *ChatHandler) GetChatMessages(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	messages, getErr := h.chat.GetChatMessages(ctx, chatID)
+	page, err := parseMessagePage(r.URL.Query())
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	messages, getErr := h.chat.GetChatMessages(ctx, chatID, role, page)
+	if getErr != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(getErr))
+		return
+	}
+
+	if marshalErr := utils.WriteJSON(w, messages); marshalErr != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(marshalErr))
+		return
+	}
+}
+
+// SearchMessages godoc
+// @Tags Chat
+// @Summary Поиск по сообщениям
+// @Description Полнотекстовый поиск по сообщениям во всех чатах текущего пользователя. Каждое совпадение возвращается вместе с кратким описанием чата. Требует авторизации.
+// @Param query query string true "Поисковый запрос"
+// @Param limit query int false "Количество результатов на странице"
+// @Param offset query int false "Смещение от начала списка"
+// @Produce json
+// @Success 200 {array} dto.MessageSearchResponse "Найденные сообщения"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/search [get]
+// @Security session_cookie
+func (h *ChatHandler) SearchMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	query := r.URL.Query()
+
+	searchQuery := query.Get("query")
+	if searchQuery == "" {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	limit := 20
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > entity.MaxMessagePageSize {
+			utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+			return
+		}
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		offset, err = strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+			return
+		}
+	}
+
+	messages, getErr := h.chat.SearchMessages(ctx, userID, role, searchQuery, limit, offset)
 	if getErr != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(getErr))
 		return
@@ -234,3 +379,571 @@ func (h *ChatHandler) GetChatMessages(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func parseMessagePage(query url.Values) (entity.MessagePage, error) {
+	var page entity.MessagePage
+
+	params := map[string]*int{
+		"before": &page.BeforeID,
+		"after":  &page.AfterID,
+		"limit":  &page.Limit,
+	}
+	for name, dst := range params {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return page, err
+		}
+		*dst = parsed
+	}
+
+	return page, nil
+}
+
+// UploadAttachment godoc
+// @Tags Chat
+// @Summary Загрузить вложение в чат
+// @Description Загружает файл для отправки в чате. Полученный id передается в attachment_ids сообщения по websocket.
+// @Description До отправки сообщения вложение доступно только загрузившему его участнику. Требует авторизации и CSRF-токена.
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "ID чата"
+// @Param file formData file true "Файл (JPEG/PNG/PDF/DOCX, макс. 10MB)"
+// @Success 200 {object} dto.AttachmentResponse "Информация о вложении"
+// @Failure 400 {object} utils.APIError "Неверный формат или размер файла"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Нет доступа к чату"
+// @Failure 404 {object} utils.APIError "Чат не найден"
+// @Failure 500 {object} utils.APIError "Ошибка загрузки файла"
+// @Router /chat/{id}/attachments [post]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentRequestSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
+		return
+	}
+	if err = file.Close(); err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
+		return
+	}
+
+	attachment, err := h.chat.UploadAttachment(ctx, chatID, userID, role, header.Filename, data)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, attachment); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// GetAttachment godoc
+// @Tags Chat
+// @Summary Скачать вложение из чата
+// @Description Отдает файл вложения. Доступно только участникам чата. Требует авторизации.
+// @Param id path int true "ID чата"
+// @Param attachmentID path int true "ID вложения"
+// @Produce octet-stream
+// @Success 200 {file} byte "Файл вложения"
+// @Header 200 {string} Content-Disposition "attachment; filename=<имя файла>"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Нет доступа к чату"
+// @Failure 404 {object} utils.APIError "Вложение не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/{id}/attachments/{attachmentID} [get]
+// @Security session_cookie
+func (h *ChatHandler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	attachmentID, err := strconv.Atoi(r.PathValue("attachmentID"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	file, err := h.chat.GetAttachmentFile(ctx, chatID, attachmentID, userID, role)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := w.Write(file.Data); err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
+		return
+	}
+}
+
+// ExportChat godoc
+// @Tags Chat
+// @Summary Выгрузить переписку
+// @Description Отдает всю переписку чата с именами участников, названием вакансии, профессией из резюме и временем сообщений.
+// @Description По умолчанию выгружается PDF. Доступно только участникам чата. Требует авторизации.
+// @Param id path int true "ID чата"
+// @Param format query string false "Формат выгрузки" Enums(pdf, html, json)
+// @Produce application/pdf
+// @Produce html
+// @Produce json
+// @Success 200 {file} byte "Файл с перепиской"
+// @Header 200 {string} Content-Disposition "attachment; filename=chat_<id>.<format>"
+// @Failure 400 {object} utils.APIError "Некорректный запрос или формат"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Нет доступа к чату"
+// @Failure 404 {object} utils.APIError "Чат не найден"
+// @Failure 500 {object} utils.APIError "Ошибка формирования выгрузки"
+// @Router /chat/{id}/export [get]
+// @Security session_cookie
+func (h *ChatHandler) ExportChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	format := entity.ExportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = entity.ExportFormatPDF
+	}
+
+	file, err := h.chat.ExportChat(ctx, chatID, userID, role, format)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := w.Write(file.Data); err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
+		return
+	}
+}
+
+// EditMessage godoc
+// @Tags Chat
+// @Summary Исправить сообщение
+// @Description Заменяет текст своего сообщения. Исправить можно в течение суток после отправки, прежняя редакция
+// @Description сохраняется в истории правок. Собеседник получает кадр edit по websocket. Требует авторизации и CSRF-токена.
+// @Accept json
+// @Produce json
+// @Param id path int true "ID чата"
+// @Param messageID path int true "ID сообщения"
+// @Param request body dto.MessageEditRequest true "Новый текст сообщения (используется поле payload)"
+// @Success 200 {object} dto.MessageResponse "Исправленное сообщение"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Чужое сообщение или время на исправление истекло"
+// @Failure 404 {object} utils.APIError "Сообщение не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/{id}/messages/{messageID} [put]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) EditMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, messageID, err := parseMessagePath(r)
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	var req dto.MessageEditRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	message, err := h.chat.EditMessage(ctx, chatID, messageID, userID, role, req.Payload)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, message); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// DeleteMessage godoc
+// @Tags Chat
+// @Summary Удалить сообщение для всех
+// @Description Удаляет свое сообщение вместе с вложениями и историей правок. В переписке остается отметка об удалении,
+// @Description собеседник получает кадр delete по websocket. Требует авторизации и CSRF-токена.
+// @Produce json
+// @Param id path int true "ID чата"
+// @Param messageID path int true "ID сообщения"
+// @Success 200 {object} dto.MessageResponse "Удаленное сообщение"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Чужое сообщение"
+// @Failure 404 {object} utils.APIError "Сообщение не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/{id}/messages/{messageID} [delete]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) DeleteMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, messageID, err := parseMessagePath(r)
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	message, err := h.chat.DeleteMessage(ctx, chatID, messageID, userID, role)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, message); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// GetMessageHistory godoc
+// @Tags Chat
+// @Summary История правок сообщения
+// @Description Прежние редакции сообщения от старых к новым. Доступно участникам чата. Требует авторизации.
+// @Produce json
+// @Param id path int true "ID чата"
+// @Param messageID path int true "ID сообщения"
+// @Success 200 {array} dto.MessageEditResponse "Прежние редакции"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Нет доступа к чату"
+// @Failure 404 {object} utils.APIError "Сообщение не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/{id}/messages/{messageID}/history [get]
+// @Security session_cookie
+func (h *ChatHandler) GetMessageHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, messageID, err := parseMessagePath(r)
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	history, err := h.chat.GetMessageEdits(ctx, chatID, messageID, userID, role)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, history); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// UpdateChatSettings godoc
+// @Tags Chat
+// @Summary Изменить настройки чата
+// @Description Архивирует чат, отключает уведомления о нем или блокирует собеседника. Настройки видны только
+// @Description их автору. В заблокированном чате нельзя отправлять и исправлять сообщения. Требует авторизации.
+// @Accept json
+// @Produce json
+// @Param id path int true "ID чата"
+// @Param request body dto.ChatSettingsRequest true "Изменяемые настройки"
+// @Success 200 {object} dto.ChatSettingsResponse "Настройки чата"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Нет доступа к чату"
+// @Failure 404 {object} utils.APIError "Чат не найден"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/{id}/settings [put]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) UpdateChatSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	chatID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	var req dto.ChatSettingsRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	settings, err := h.chat.UpdateChatSettings(ctx, chatID, userID, role, &req)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, settings); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+func parseMessagePath(r *http.Request) (int, int, error) {
+	chatID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return 0, 0, err
+	}
+	messageID, err := strconv.Atoi(r.PathValue("messageID"))
+	if err != nil {
+		return 0, 0, err
+	}
+	return chatID, messageID, nil
+}
+
+// InviteToVacancy godoc
+// @Tags Chat
+// @Summary Пригласить соискателя на вакансию
+// @Description Работодатель приглашает владельца резюме на свою активную вакансию. Создается чат с приглашением,
+// @Description соискатель получает уведомление через WebSocket и может принять или отклонить приглашение.
+// @Accept json
+// @Produce json
+// @Param request body dto.InvitationRequest true "Вакансия и резюме"
+// @Success 201 {object} dto.InvitationResponse "Созданное приглашение"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для работодателей)"
+// @Failure 404 {object} utils.APIError "Резюме или активная вакансия не найдены"
+// @Failure 409 {object} utils.APIError "Соискатель уже приглашен или откликнулся"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/invitation [post]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) InviteToVacancy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "employer" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	var req dto.InvitationRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	invitation, err := h.chat.InviteToVacancy(ctx, userID, req.VacancyID, req.ResumeID)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := utils.WriteJSON(w, invitation); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// AcceptInvitation godoc
+// @Tags Chat
+// @Summary Принять приглашение на вакансию
+// @Description Записывает отклик соискателя на вакансию резюме из приглашения. Работодатель получает уведомление об отклике.
+// @Produce json
+// @Param id path int true "ID приглашения"
+// @Success 200 {object} dto.InvitationResponse "Обновленное приглашение"
+// @Failure 400 {object} utils.APIError "Приглашение уже обработано"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Приглашение адресовано другому соискателю"
+// @Failure 404 {object} utils.APIError "Приглашение не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/invitation/{id}/accept [put]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	h.answerInvitation(w, r, true)
+}
+
+// DeclineInvitation godoc
+// @Tags Chat
+// @Summary Отклонить приглашение на вакансию
+// @Description Отклоняет приглашение. Чат с работодателем сохраняется.
+// @Produce json
+// @Param id path int true "ID приглашения"
+// @Success 200 {object} dto.InvitationResponse "Обновленное приглашение"
+// @Failure 400 {object} utils.APIError "Приглашение уже обработано"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Приглашение адресовано другому соискателю"
+// @Failure 404 {object} utils.APIError "Приглашение не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /chat/invitation/{id}/decline [put]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ChatHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	h.answerInvitation(w, r, false)
+}
+
+func (h *ChatHandler) answerInvitation(w http.ResponseWriter, r *http.Request, accept bool) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "applicant" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	invitationID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	invitation, err := h.chat.AnswerInvitation(ctx, invitationID, userID, accept)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, invitation); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
@@ -2,24 +2,30 @@ package http
 
 import (
 	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/transport/http/utils"
 	"ResuMatch/internal/usecase"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 type NotificationHandler struct {
 	notification usecase.Notification
 	auth         usecase.Auth
+	digest       usecase.Digest
 }
 
 func NewNotificationHandler(
 	notification usecase.Notification,
 	auth usecase.Auth,
+	digest usecase.Digest,
 ) NotificationHandler {
 	return NotificationHandler{
 		notification: notification,
 		auth:         auth,
+		digest:       digest,
 	}
 }
 
@@ -27,18 +33,33 @@ func (h *NotificationHandler) Configure(r *http.ServeMux) {
 	notificationMux := http.NewServeMux()
 
 	notificationMux.HandleFunc("GET /user", h.GetNotificationsForUser)
+	notificationMux.HandleFunc("GET /unread", h.GetUnreadNotifications)
+	notificationMux.HandleFunc("GET /group/{id}", h.GetNotificationGroup)
 	notificationMux.HandleFunc("PUT /read/{id}", h.ReadNotification)
+	notificationMux.HandleFunc("PUT /group/{id}/read", h.ReadNotificationGroup)
 	notificationMux.HandleFunc("PUT /readAll", h.ReadAllNotifications)
 	notificationMux.HandleFunc("DELETE /clear", h.DeleteAllNotifications)
+	notificationMux.HandleFunc("DELETE /{id}", h.DeleteNotification)
+	notificationMux.HandleFunc("GET /settings", h.GetNotificationSettings)
+	notificationMux.HandleFunc("PUT /settings", h.UpdateNotificationSettings)
+	notificationMux.HandleFunc("GET /unsubscribe", h.Unsubscribe)
+	notificationMux.HandleFunc("POST /unsubscribe", h.Unsubscribe)
 	r.Handle("/notification/", http.StripPrefix("/notification", notificationMux))
 }
 
 // GetNotificationsForUser godoc
 // @Tags Notification
-// @Summary Получить все уведомления пользователя
-// @Description Получаем список уведомлений для соискателя или работодателя. Требует авторизации.
+// @Summary Получить уведомления пользователя
+// @Description Получаем страницу уведомлений соискателя или работодателя от новых к старым. Требует авторизации.
+// @Description Однотипные уведомления об одном объекте за час приходят одной группой: group_size - число уведомлений в ней,
+// @Description отдельные уведомления группы возвращает /notification/group/{group_id}.
 // @Produce json
+// @Param type query string false "Типы уведомлений через запятую"
+// @Param is_viewed query bool false "Только прочитанные (true) или непрочитанные (false)"
+// @Param limit query int false "Количество уведомлений на странице (по умолчанию 20, не больше 100)"
+// @Param offset query int false "Смещение от начала списка"
 // @Success 200 {object} entity.NotificationsList "Список уведомлений"
+// @Failure 400 {object} utils.APIError "Некорректные параметры выборки"
 // @Failure 401 {object} utils.APIError "Не авторизован"
 // @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
 // @Router /notification/user [get]
@@ -57,7 +78,13 @@ func (h *NotificationHandler) GetNotificationsForUser(w http.ResponseWriter, r *
 		return
 	}
 
-	notifications, err := h.notification.GetNotificationsForUser(ctx, userID, role)
+	filter, err := parseNotificationFilter(r.URL.Query())
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	notifications, err := h.notification.GetNotificationsForUser(ctx, userID, role, filter)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
@@ -70,6 +97,175 @@ func (h *NotificationHandler) GetNotificationsForUser(w http.ResponseWriter, r *
 	w.WriteHeader(http.StatusOK)
 }
 
+func parseNotificationFilter(query url.Values) (entity.NotificationFilter, error) {
+	var filter entity.NotificationFilter
+
+	for _, notificationType := range splitList(query.Get("type")) {
+		filter.Types = append(filter.Types, entity.NotificationType(notificationType))
+	}
+
+	if value := query.Get("is_viewed"); value != "" {
+		isViewed, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение is_viewed: %s", value),
+			)
+		}
+		filter.IsViewed = &isViewed
+	}
+
+	for param, target := range map[string]*int{
+		"limit":  &filter.Limit,
+		"offset": &filter.Offset,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return filter, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение %s: %s", param, value),
+			)
+		}
+		*target = parsed
+	}
+
+	return filter, nil
+}
+
+// GetUnreadNotifications godoc
+// @Tags Notification
+// @Summary Число непрочитанных уведомлений
+// @Description Возвращает число непрочитанных уведомлений всего и по каждому типу, который может получить пользователь. Требует авторизации.
+// @Produce json
+// @Success 200 {object} dto.UnreadNotificationsResponse "Число непрочитанных уведомлений"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/unread [get]
+// @Security session_cookie
+func (h *NotificationHandler) GetUnreadNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	unread, err := h.notification.GetUnreadNotifications(ctx, userID, role)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, unread); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// GetNotificationGroup godoc
+// @Tags Notification
+// @Summary Раскрыть группу уведомлений
+// @Description Возвращает уведомления группы по отдельности от новых к старым. Требует авторизации.
+// @Produce json
+// @Param id path int true "ID группы (group_id из превью)"
+// @Param limit query int false "Количество уведомлений на странице (по умолчанию 20, не больше 100)"
+// @Param offset query int false "Смещение от начала группы"
+// @Success 200 {object} entity.NotificationsList "Уведомления группы"
+// @Failure 400 {object} utils.APIError "Некорректные параметры выборки"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Группа адресована другому пользователю"
+// @Failure 404 {object} utils.APIError "Группа не найдена"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/group/{id} [get]
+// @Security session_cookie
+func (h *NotificationHandler) GetNotificationGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	groupID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	filter, err := parseNotificationFilter(r.URL.Query())
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	notifications, err := h.notification.GetNotificationGroup(ctx, groupID, userID, role, filter.Limit, filter.Offset)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+	if err := utils.WriteJSON(w, entity.NotificationsList(notifications)); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// ReadNotificationGroup godoc
+// @Tags Notification
+// @Summary Прочитать группу уведомлений
+// @Description Отмечает прочитанными все уведомления группы. Требует авторизации.
+// @Param id path int true "ID группы (group_id из превью)"
+// @Success 200
+// @Failure 400 {object} utils.APIError "Некорректный id"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Группа адресована другому пользователю"
+// @Failure 404 {object} utils.APIError "Группа не найдена"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/group/{id}/read [put]
+// @Security csrf_token
+// @Security session_cookie
+func (h *NotificationHandler) ReadNotificationGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	groupID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	if err := h.notification.ReadNotificationGroup(ctx, groupID, userID, role); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
 // ReadNotification godoc
 // @Tags Notification
 // @Summary Прочитать уведомление
@@ -172,3 +368,157 @@ func (h *NotificationHandler) DeleteAllNotifications(w http.ResponseWriter, r *h
 	}
 	w.WriteHeader(http.StatusOK)
 }
+
+// DeleteNotification godoc
+// @Tags Notification
+// @Summary Удалить уведомление
+// @Description Удаляет одно уведомление пользователя. Требует авторизации.
+// @Param id path int true "ID уведомления"
+// @Success 200
+// @Failure 400 {object} utils.APIError "Некорректный id"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Уведомление адресовано другому пользователю"
+// @Failure 404 {object} utils.APIError "Уведомление не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/{id} [delete]
+// @Security csrf_token
+// @Security session_cookie
+func (h *NotificationHandler) DeleteNotification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	notificationID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	if err := h.notification.DeleteNotification(ctx, notificationID, userID, role); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Unsubscribe godoc
+// @Tags Notification
+// @Summary Отписаться от писем о непрочитанных сообщениях
+// @Description Отключает письма-дайджесты по подписанной ссылке из письма. Авторизация не нужна,
+// @Description POST-запрос поддерживает отписку в один клик из почтового клиента (RFC 8058).
+// @Param token query string true "Токен отписки из письма"
+// @Success 200
+// @Failure 400 {object} utils.APIError "Неверная ссылка отписки"
+// @Failure 404 {object} utils.APIError "Дайджесты отключены: не задан DIGEST_SECRET"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/unsubscribe [get]
+// @Router /notification/unsubscribe [post]
+func (h *NotificationHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	if err := h.digest.Unsubscribe(ctx, token); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetNotificationSettings godoc
+// @Tags Notification
+// @Summary Получить настройки уведомлений
+// @Description Возвращает каналы доставки (in_app, websocket, email) для каждого типа уведомлений, которые может
+// @Description получить пользователь, и тихие часы. Требует авторизации.
+// @Produce json
+// @Success 200 {object} dto.NotificationSettings "Настройки уведомлений"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/settings [get]
+// @Security session_cookie
+func (h *NotificationHandler) GetNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	settings, err := h.notification.GetNotificationSettings(ctx, userID, role)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, settings); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// UpdateNotificationSettings godoc
+// @Tags Notification
+// @Summary Изменить настройки уведомлений
+// @Description Сохраняет каналы перечисленных типов, остальные типы не меняются. Тихие часы заменяются целиком:
+// @Description без quiet_hours они выключаются. Каналы независимы: без in_app уведомление не сохраняется на сайте,
+// @Description но приходит по websocket и письмом, если они включены. Требует авторизации и CSRF-токена.
+// @Accept json
+// @Produce json
+// @Param request body dto.NotificationSettings true "Изменяемые настройки"
+// @Success 200 {object} dto.NotificationSettings "Настройки уведомлений"
+// @Failure 400 {object} utils.APIError "Некорректный запрос"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /notification/settings [put]
+// @Security csrf_token
+// @Security session_cookie
+func (h *NotificationHandler) UpdateNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	var req dto.NotificationSettings
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	settings, err := h.notification.UpdateNotificationSettings(ctx, userID, role, &req)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, settings); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
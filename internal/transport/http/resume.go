@@ -5,37 +5,35 @@ import (
 	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/transport/http/utils"
-	"ResuMatch/internal/transport/ws"
 	"ResuMatch/internal/usecase"
 	"ResuMatch/pkg/sanitizer"
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 )
 
 type ResumeHandler struct {
-	auth         usecase.Auth
-	resume       usecase.ResumeUsecase
-	cfg          config.CSRFConfig
-	notification usecase.Notification
-	wsHub        *ws.Hub
+	auth   usecase.Auth
+	resume usecase.ResumeUsecase
+	cfg    config.CSRFConfig
 }
 
 func NewResumeHandler(
 	auth usecase.Auth,
 	resume usecase.ResumeUsecase,
 	cfg config.CSRFConfig,
-	wsHub *ws.Hub,
-	notification usecase.Notification,
 ) ResumeHandler {
 	return ResumeHandler{
-		auth:         auth,
-		resume:       resume,
-		cfg:          cfg,
-		wsHub:        wsHub,
-		notification: notification,
+		auth:   auth,
+		resume: resume,
+		cfg:    cfg,
 	}
 }
 
@@ -48,7 +46,16 @@ func (h *ResumeHandler) Configure(r *http.ServeMux) {
 	resumeMux.HandleFunc("DELETE /{id}", h.DeleteResume)
 	resumeMux.HandleFunc("GET /all", h.GetAllResumes)
 	resumeMux.HandleFunc("GET /search", h.SearchResumes)
+	resumeMux.HandleFunc("GET /search/advanced", h.AdvancedSearchResumes)
 	resumeMux.HandleFunc("GET /pdf/{id}", h.GetResumePDF)
+	resumeMux.HandleFunc("PUT /{id}/visibility", h.UpdateResumeVisibility)
+	resumeMux.HandleFunc("GET /blocked", h.GetBlockedEmployers)
+	resumeMux.HandleFunc("POST /blocked/{employer_id}", h.BlockEmployer)
+	resumeMux.HandleFunc("DELETE /blocked/{employer_id}", h.UnblockEmployer)
+	resumeMux.HandleFunc("POST /contact-request/{resume_id}", h.RequestContacts)
+	resumeMux.HandleFunc("PUT /contact-request/{id}/accept", h.AcceptContactRequest)
+	resumeMux.HandleFunc("PUT /contact-request/{id}/decline", h.DeclineContactRequest)
+	resumeMux.HandleFunc("POST /course/file", h.UploadCourseFile)
 
 	r.Handle("/resume/", http.StripPrefix("/resume", resumeMux))
 }
@@ -131,6 +138,8 @@ func (h *ResumeHandler) CreateResume(w http.ResponseWriter, r *http.Request) {
 		createResumeRequest.WorkExperiences[i].Achievements = sanitizer.StrictPolicy.Sanitize(we.Achievements)
 	}
 
+	sanitizeResumeDetails(createResumeRequest.Educations, createResumeRequest.Courses, createResumeRequest.Languages)
+
 	resume, err := h.resume.Create(ctx, userID, &createResumeRequest)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
@@ -145,15 +154,35 @@ func (h *ResumeHandler) CreateResume(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// sanitizeResumeDetails очищает текстовые поля образования, курсов и языков
+func sanitizeResumeDetails(educations []dto.EducationDTO, courses []dto.CourseDTO, languages []dto.LanguageDTO) {
+	for i, education := range educations {
+		educations[i].Institution = sanitizer.StrictPolicy.Sanitize(education.Institution)
+		educations[i].Faculty = sanitizer.StrictPolicy.Sanitize(education.Faculty)
+		educations[i].Specialty = sanitizer.StrictPolicy.Sanitize(education.Specialty)
+	}
+
+	for i, course := range courses {
+		courses[i].Name = sanitizer.StrictPolicy.Sanitize(course.Name)
+		courses[i].Organization = sanitizer.StrictPolicy.Sanitize(course.Organization)
+	}
+
+	for i, language := range languages {
+		languages[i].Language = sanitizer.StrictPolicy.Sanitize(language.Language)
+	}
+}
+
 // GetResume godoc
 // @Tags Resume
 // @Summary Получение резюме по ID
-// @Description Возвращает полную информацию о резюме по его ID. Доступно всем авторизованным пользователям.
+// @Description Возвращает полную информацию о резюме по его ID с учетом настроек видимости.
+// @Description Резюме с видимостью link_only доступно по токену из параметра token.
 // @Produce json
 // @Param id path int true "ID резюме"
+// @Param token query string false "Токен доступа к резюме по ссылке"
 // @Success 200 {object} dto.ResumeResponse "Информация о резюме"
 // @Failure 400 {object} utils.APIError "Неверный ID"
-// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Нет доступа к резюме"
 // @Failure 404 {object} utils.APIError "Резюме не найдено"
 // @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
 // @Router /resume/{id} [get]
@@ -169,8 +198,19 @@ func (h *ResumeHandler) GetResume(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Авторизация необязательна, но от нее зависит доступ к скрытым резюме
+	userID := 0
+	role := ""
+	cookie, err := r.Cookie("session_id")
+	if err == nil && cookie != nil {
+		userID, role, err = h.auth.GetUserIDBySession(ctx, cookie.Value)
+		if err != nil {
+			userID, role = 0, ""
+		}
+	}
+
 	// Получаем резюме
-	resume, err := h.resume.GetByID(ctx, resumeID)
+	resume, err := h.resume.GetByID(ctx, resumeID, userID, role, r.URL.Query().Get("token"))
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
@@ -270,6 +310,8 @@ func (h *ResumeHandler) UpdateResume(w http.ResponseWriter, r *http.Request) {
 		updateResumeRequest.WorkExperiences[i].Achievements = sanitizer.StrictPolicy.Sanitize(we.Achievements)
 	}
 
+	sanitizeResumeDetails(updateResumeRequest.Educations, updateResumeRequest.Courses, updateResumeRequest.Languages)
+
 	resume, err := h.resume.Update(ctx, resumeID, userID, &updateResumeRequest)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
@@ -420,7 +462,7 @@ func (h *ResumeHandler) GetAllResumes(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Получаем список всех резюме
-		resumes, err = h.resume.GetAll(ctx, limit, offset)
+		resumes, err = h.resume.GetAll(ctx, userID, limit, offset)
 		if err != nil {
 			utils.WriteAPIError(w, utils.ToAPIError(err))
 			return
@@ -441,6 +483,15 @@ func (h *ResumeHandler) GetAllResumes(w http.ResponseWriter, r *http.Request) {
 // @Description Ищет резюме по профессии. Для соискателей возвращает только их собственные резюме. Для других ролей - все резюме. Требует авторизации.
 // @Produce json
 // @Param profession query string true "Строка поиска по профессии"
+// @Param salary_from query int false "Минимальная желаемая зарплата"
+// @Param salary_to query int false "Максимальная желаемая зарплата"
+// @Param currency query string false "Валюта зарплаты (rub, usd, eur)"
+// @Param city query string false "Город, в котором соискатель готов работать"
+// @Param relocation query bool false "Только готовые к переезду"
+// @Param business_trips query bool false "Только готовые к командировкам"
+// @Param work_format query string false "Форматы работы через запятую"
+// @Param employment query string false "Типы занятости через запятую"
+// @Param schedule query string false "Графики работы через запятую"
 // @Param limit query int false "Количество резюме на странице"
 // @Param offset query int false "Смещение от начала списка"
 // @Success 200 {array} dto.ResumeShortResponse "Список найденных резюме"
@@ -500,8 +551,14 @@ func (h *ResumeHandler) SearchResumes(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	filter, err := parseResumeFilter(r.URL.Query())
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
 	// Ищем резюме
-	resumes, err := h.resume.SearchResumesByProfession(ctx, userID, role, profession, limit, offset)
+	resumes, err := h.resume.SearchResumesByProfession(ctx, userID, role, profession, filter, limit, offset)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
@@ -515,12 +572,110 @@ func (h *ResumeHandler) SearchResumes(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AdvancedSearchResumes godoc
+// @Tags Resume
+// @Summary Расширенный поиск резюме
+// @Description Ищет резюме по навыкам, специализациям, опыту, образованию, статусу соискателя и тексту. Результаты отсортированы по релевантности. Доступно только работодателям.
+// @Produce json
+// @Param query query string false "Текст для поиска по разделу о себе, обязанностям и достижениям"
+// @Param profession query string false "Строка поиска по профессии"
+// @Param skills_all query string false "Навыки через запятую, все должны присутствовать в резюме"
+// @Param skills_any query string false "Навыки через запятую, хотя бы один должен присутствовать в резюме"
+// @Param specializations query string false "Специализации через запятую"
+// @Param experience_from query int false "Минимальный опыт работы в годах"
+// @Param experience_to query int false "Максимальный опыт работы в годах"
+// @Param education query string false "Уровни образования через запятую"
+// @Param graduation_from query int false "Год окончания учебы не раньше"
+// @Param graduation_to query int false "Год окончания учебы не позже"
+// @Param language query string false "Язык, которым владеет соискатель"
+// @Param language_level query string false "Минимальный уровень владения языком (A1-C2, native)"
+// @Param course query string false "Название пройденного курса или сертификата"
+// @Param applicant_status query string false "Статусы поиска работы соискателя через запятую"
+// @Param updated_from query string false "Резюме обновлено не раньше даты (YYYY-MM-DD)"
+// @Param salary_from query int false "Минимальная желаемая зарплата"
+// @Param salary_to query int false "Максимальная желаемая зарплата"
+// @Param currency query string false "Валюта зарплаты (rub, usd, eur)"
+// @Param city query string false "Город, в котором соискатель готов работать"
+// @Param relocation query bool false "Только готовые к переезду"
+// @Param business_trips query bool false "Только готовые к командировкам"
+// @Param work_format query string false "Форматы работы через запятую"
+// @Param employment query string false "Типы занятости через запятую"
+// @Param schedule query string false "Графики работы через запятую"
+// @Param limit query int false "Количество резюме на странице"
+// @Param offset query int false "Смещение от начала списка"
+// @Success 200 {array} dto.ResumeShortResponse "Список найденных резюме"
+// @Failure 400 {object} utils.APIError "Неверные параметры запроса"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для работодателей)"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/search/advanced [get]
+// @Security session_cookie
+func (h *ResumeHandler) AdvancedSearchResumes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "employer" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := 10
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+			return
+		}
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		offset, err = strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+			return
+		}
+	}
+
+	params, err := parseResumeSearchParams(query)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	resumes, err := h.resume.SearchResumesAdvanced(ctx, userID, params, limit, offset)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	resp := dto.ResumeShortResponseList(resumes)
+	if err := utils.WriteJSON(w, resp); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
 // GetResumePDF godoc
 // @Tags Resume
 // @Summary Получить резюме в формате PDF
 // @Description Скачивает резюме по ID в формате PDF. Требует авторизации.
 // @Description При успешном скачивании отправляет уведомление владельцу резюме через WebSocket.
 // @Param id path int true "ID резюме"
+// @Param token query string false "Токен доступа к резюме по ссылке"
 // @Produce application/pdf
 // @Success 200 {file} byte "PDF-файл резюме"
 // @Header 200 {string} Content-Disposition "attachment; filename=resume.pdf"
@@ -554,29 +709,538 @@ func (h *ResumeHandler) GetResumePDF(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pdfBytes, notification, err := h.resume.GetResumePDF(ctx, resumeID, userID, role)
+	pdfBytes, err := h.resume.GetResumePDF(ctx, resumeID, userID, role, r.URL.Query().Get("token"))
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", "attachment; filename=resume.pdf")
+	if _, err := w.Write(pdfBytes); err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
+		return
+	}
+}
+
+// UpdateResumeVisibility godoc
+// @Tags Resume
+// @Summary Изменение видимости резюме
+// @Description Устанавливает видимость резюме: public, employers_only, link_only или hidden.
+// @Description Для link_only возвращается токен для доступа по ссылке. Доступно только владельцу резюме.
+// @Accept json
+// @Produce json
+// @Param id path int true "ID резюме"
+// @Param visibility body dto.ResumeVisibilityRequest true "Настройки видимости"
+// @Success 200 {object} dto.ResumeVisibilityResponse "Новые настройки видимости"
+// @Failure 400 {object} utils.APIError "Неверный формат запроса"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (не владелец)"
+// @Failure 404 {object} utils.APIError "Резюме не найдено"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/{id}/visibility [put]
+// @Security session_cookie
+// @Security csrf_token
+func (h *ResumeHandler) UpdateResumeVisibility(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "applicant" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	resumeID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	var request dto.ResumeVisibilityRequest
+	if err := utils.ReadJSON(r, &request); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if valid, err := govalidator.ValidateStruct(request); !valid {
+		utils.WriteError(w, http.StatusBadRequest, fmt.Errorf("неверные данные: %v", err))
+		return
+	}
+
+	response, err := h.resume.UpdateVisibility(ctx, resumeID, userID, &request)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, response); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// GetBlockedEmployers godoc
+// @Tags Resume
+// @Summary Список заблокированных работодателей
+// @Description Возвращает работодателей, которым соискатель запретил просматривать свои резюме.
+// @Produce json
+// @Success 200 {object} dto.BlockedEmployerResponseList "Список заблокированных работодателей"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для соискателей)"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/blocked [get]
+// @Security session_cookie
+func (h *ResumeHandler) GetBlockedEmployers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "applicant" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	blocked, err := h.resume.GetBlockedEmployers(ctx, userID)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, blocked); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// BlockEmployer godoc
+// @Tags Resume
+// @Summary Заблокировать работодателя
+// @Description Запрещает работодателю находить и просматривать резюме соискателя.
+// @Param employer_id path int true "ID работодателя"
+// @Success 204 "Работодатель заблокирован"
+// @Failure 400 {object} utils.APIError "Неверный ID работодателя"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для соискателей)"
+// @Failure 404 {object} utils.APIError "Работодатель не найден"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/blocked/{employer_id} [post]
+// @Security session_cookie
+// @Security csrf_token
+func (h *ResumeHandler) BlockEmployer(w http.ResponseWriter, r *http.Request) {
+	h.changeEmployerBlock(w, r, h.resume.BlockEmployer)
+}
+
+// UnblockEmployer godoc
+// @Tags Resume
+// @Summary Разблокировать работодателя
+// @Description Снимает запрет на просмотр резюме соискателя работодателем.
+// @Param employer_id path int true "ID работодателя"
+// @Success 204 "Работодатель разблокирован"
+// @Failure 400 {object} utils.APIError "Неверный ID работодателя"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для соискателей)"
+// @Failure 404 {object} utils.APIError "Работодатель не заблокирован"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/blocked/{employer_id} [delete]
+// @Security session_cookie
+// @Security csrf_token
+func (h *ResumeHandler) UnblockEmployer(w http.ResponseWriter, r *http.Request) {
+	h.changeEmployerBlock(w, r, h.resume.UnblockEmployer)
+}
+
+func (h *ResumeHandler) changeEmployerBlock(
+	w http.ResponseWriter,
+	r *http.Request,
+	action func(ctx context.Context, applicantID, employerID int) error,
+) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "applicant" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	employerID, err := strconv.Atoi(r.PathValue("employer_id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	if err := action(ctx, userID, employerID); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RequestContacts godoc
+// @Tags Resume
+// @Summary Запросить контакты анонимного соискателя
+// @Description Отправляет владельцу анонимного резюме запрос на раскрытие имени, фото и контактов.
+// @Description Соискатель получает уведомление через WebSocket и может принять или отклонить запрос.
+// @Produce json
+// @Param resume_id path int true "ID резюме"
+// @Success 201 {object} dto.ContactRequestResponse "Созданный запрос контактов"
+// @Failure 400 {object} utils.APIError "Контакты уже доступны"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для работодателей)"
+// @Failure 404 {object} utils.APIError "Резюме не найдено"
+// @Failure 409 {object} utils.APIError "Запрос уже отправлен"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/contact-request/{resume_id} [post]
+// @Security session_cookie
+// @Security csrf_token
+func (h *ResumeHandler) RequestContacts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "employer" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	resumeID, err := strconv.Atoi(r.PathValue("resume_id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	request, err := h.resume.RequestContacts(ctx, resumeID, userID)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
 	}
 
-	notificationPreview, err := h.notification.CreateNotification(ctx, &notification)
+	w.WriteHeader(http.StatusCreated)
+	if err := utils.WriteJSON(w, request); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// AcceptContactRequest godoc
+// @Tags Resume
+// @Summary Принять запрос контактов
+// @Description Раскрывает работодателю имя, фото и контакты соискателя во всех его анонимных резюме.
+// @Produce json
+// @Param id path int true "ID запроса контактов"
+// @Success 200 {object} dto.ContactRequestResponse "Обновленный запрос контактов"
+// @Failure 400 {object} utils.APIError "Запрос уже обработан"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Запрос адресован другому соискателю"
+// @Failure 404 {object} utils.APIError "Запрос не найден"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/contact-request/{id}/accept [put]
+// @Security session_cookie
+// @Security csrf_token
+func (h *ResumeHandler) AcceptContactRequest(w http.ResponseWriter, r *http.Request) {
+	h.answerContactRequest(w, r, true)
+}
+
+// DeclineContactRequest godoc
+// @Tags Resume
+// @Summary Отклонить запрос контактов
+// @Description Отклоняет запрос, контакты соискателя остаются скрытыми. Работодатель может запросить их повторно.
+// @Produce json
+// @Param id path int true "ID запроса контактов"
+// @Success 200 {object} dto.ContactRequestResponse "Обновленный запрос контактов"
+// @Failure 400 {object} utils.APIError "Запрос уже обработан"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Запрос адресован другому соискателю"
+// @Failure 404 {object} utils.APIError "Запрос не найден"
+// @Failure 500 {object} utils.APIError "Внутренняя ошибка сервера"
+// @Router /resume/contact-request/{id}/decline [put]
+// @Security session_cookie
+// @Security csrf_token
+func (h *ResumeHandler) DeclineContactRequest(w http.ResponseWriter, r *http.Request) {
+	h.answerContactRequest(w, r, false)
+}
+
+func (h *ResumeHandler) answerContactRequest(w http.ResponseWriter, r *http.Request, accept bool) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
 	if err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
 	}
 
-	if notificationPreview != nil {
-		h.wsHub.Broadcast <- ws.Message{
-			Type:    ws.MessageTypeNotification,
-			Payload: notificationPreview,
+	if role != "applicant" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	contactRequestID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	response, err := h.resume.AnswerContactRequest(ctx, contactRequestID, userID, accept)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, response); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+}
+
+// parseResumeFilter разбирает параметры фильтрации поиска резюме
+func parseResumeFilter(query url.Values) (entity.ResumeFilter, error) {
+	var filter entity.ResumeFilter
+
+	for param, target := range map[string]*int{
+		"salary_from": &filter.SalaryFrom,
+		"salary_to":   &filter.SalaryTo,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		salary, err := strconv.Atoi(value)
+		if err != nil || salary < 0 {
+			return filter, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение %s: %s", param, value),
+			)
 		}
+		*target = salary
 	}
 
-	w.Header().Set("Content-Type", "application/pdf")
-	w.Header().Set("Content-Disposition", "attachment; filename=resume.pdf")
-	if _, err := w.Write(pdfBytes); err != nil {
+	if currency := query.Get("currency"); currency != "" {
+		allowed, ok := entity.AllowedCurrencies[currency]
+		if !ok {
+			return filter, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение currency: %s", currency),
+			)
+		}
+		filter.Currency = allowed
+	}
+
+	filter.City = strings.TrimSpace(query.Get("city"))
+	filter.ReadyToRelocate = query.Get("relocation") == "true"
+	filter.ReadyForBusinessTrips = query.Get("business_trips") == "true"
+
+	var err error
+	if filter.WorkFormats, err = parseEnumList(query, "work_format", entity.WorkFormatRu); err != nil {
+		return filter, err
+	}
+	if filter.EmploymentTypes, err = parseEnumList(query, "employment", entity.EmploymentTypeRu); err != nil {
+		return filter, err
+	}
+	if filter.Schedules, err = parseEnumList(query, "schedule", entity.ScheduleRu); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// parseResumeSearchParams разбирает параметры расширенного поиска резюме
+func parseResumeSearchParams(query url.Values) (entity.ResumeSearchParams, error) {
+	params := entity.ResumeSearchParams{
+		Query:           strings.TrimSpace(query.Get("query")),
+		Profession:      strings.TrimSpace(query.Get("profession")),
+		SkillsAll:       splitList(query.Get("skills_all")),
+		SkillsAny:       splitList(query.Get("skills_any")),
+		Specializations: splitList(query.Get("specializations")),
+		Language:        strings.TrimSpace(query.Get("language")),
+		LanguageLevel:   entity.LanguageLevel(query.Get("language_level")),
+		Course:          strings.TrimSpace(query.Get("course")),
+	}
+
+	var err error
+	if params.ResumeFilter, err = parseResumeFilter(query); err != nil {
+		return params, err
+	}
+
+	for param, target := range map[string]*int{
+		"experience_from": &params.ExperienceFrom,
+		"experience_to":   &params.ExperienceTo,
+		"graduation_from": &params.GraduationYearFrom,
+		"graduation_to":   &params.GraduationYearTo,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			return params, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение %s: %s", param, value),
+			)
+		}
+		*target = number
+	}
+
+	for _, level := range splitList(query.Get("education")) {
+		if _, ok := entity.EducationTypeRu[entity.EducationType(level)]; !ok {
+			return params, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение education: %s", level),
+			)
+		}
+		params.Education = append(params.Education, entity.EducationType(level))
+	}
+
+	for _, status := range splitList(query.Get("applicant_status")) {
+		if err := entity.ValidateStatus(status); err != nil {
+			return params, err
+		}
+		params.ApplicantStatuses = append(params.ApplicantStatuses, entity.ApplicantStatus(status))
+	}
+
+	if updatedFrom := query.Get("updated_from"); updatedFrom != "" {
+		if params.UpdatedFrom, err = time.Parse("2006-01-02", updatedFrom); err != nil {
+			return params, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение updated_from: %s", updatedFrom),
+			)
+		}
+	}
+
+	return params, nil
+}
+
+// splitList разбирает список значений через запятую, пропуская пустые
+func splitList(raw string) []string {
+	var values []string
+	for _, value := range strings.Split(raw, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// parseEnumList разбирает список значений через запятую и проверяет каждое по справочнику
+func parseEnumList(query url.Values, param string, allowed map[string]string) ([]string, error) {
+	values := splitList(query.Get(param))
+	for _, value := range values {
+		if _, ok := allowed[value]; !ok {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректное значение %s: %s", param, value),
+			)
+		}
+	}
+	return values, nil
+}
+
+// UploadCourseFile godoc
+// @Tags Resume
+// @Summary Загрузить файл курса или сертификата
+// @Description Загружает скан сертификата или диплома о прохождении курса. Полученный id передается в поле file_id курса при создании или обновлении резюме. Требует авторизации и CSRF-токена.
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Файл изображения (JPEG/PNG, макс. 5MB)"
+// @Success 200 {object} dto.UploadStaticResponse "Информация о файле"
+// @Failure 400 {object} utils.APIError "Неверный формат файла"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Доступ запрещен (только для соискателей)"
+// @Failure 500 {object} utils.APIError "Ошибка загрузки файла"
+// @Router /resume/course/file [post]
+// @Security csrf_token
+// @Security session_cookie
+func (h *ResumeHandler) UploadCourseFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil || cookie == nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+
+	_, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if role != "applicant" {
+		utils.WriteError(w, http.StatusForbidden, entity.ErrForbidden)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		utils.WriteError(w, http.StatusBadRequest, entity.ErrBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
 		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
 		return
 	}
+	if err = file.Close(); err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, entity.ErrInternal)
+		return
+	}
+
+	uploaded, err := h.resume.UploadCourseFile(ctx, data)
+	if err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
+
+	if err := utils.WriteJSON(w, uploaded); err != nil {
+		utils.WriteAPIError(w, utils.ToAPIError(err))
+		return
+	}
 }
@@ -9,9 +9,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -235,30 +237,54 @@ func TestResumeHandler_GetResume(t *testing.T) {
 	tests := []struct {
 		name           string
 		resumeID       string
-		setupMock      func(resume *mock.MockResumeUsecase)
+		token          string
+		cookie         *http.Cookie
+		setupMock      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase)
 		expectedStatus int
 		expectedError  error
 	}{
 		{
 			name:     "Success",
 			resumeID: "1",
-			setupMock: func(resume *mock.MockResumeUsecase) {
-				resume.EXPECT().GetByID(gomock.Any(), 1).Return(validResumeResponse(), nil)
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				resume.EXPECT().GetByID(gomock.Any(), 1, 0, "", "").Return(validResumeResponse(), nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:     "Success - employer with link token",
+			resumeID: "1",
+			token:    "secret",
+			cookie:   &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(5, "employer", nil)
+				resume.EXPECT().GetByID(gomock.Any(), 1, 5, "employer", "secret").Return(validResumeResponse(), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Invalid session - treated as anonymous",
+			resumeID: "1",
+			cookie:   &http.Cookie{Name: "session_id", Value: "expired"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "expired").Return(0, "", entity.NewError(entity.ErrUnauthorized, fmt.Errorf("session expired")))
+				resume.EXPECT().GetByID(gomock.Any(), 1, 0, "", "").Return(nil, entity.NewError(entity.ErrForbidden, fmt.Errorf("access denied")))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  fmt.Errorf("access denied"),
+		},
 		{
 			name:           "Invalid resume ID - bad request",
 			resumeID:       "invalid",
-			setupMock:      func(resume *mock.MockResumeUsecase) {},
+			setupMock:      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  entity.ErrBadRequest,
 		},
 		{
 			name:     "Resume not found",
 			resumeID: "1",
-			setupMock: func(resume *mock.MockResumeUsecase) {
-				resume.EXPECT().GetByID(gomock.Any(), 1).Return(nil, entity.NewError(entity.ErrNotFound, fmt.Errorf("resume not found")))
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				resume.EXPECT().GetByID(gomock.Any(), 1, 0, "", "").Return(nil, entity.NewError(entity.ErrNotFound, fmt.Errorf("resume not found")))
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedError:  fmt.Errorf("resume not found"),
@@ -266,8 +292,8 @@ func TestResumeHandler_GetResume(t *testing.T) {
 		{
 			name:     "Internal server error from usecase",
 			resumeID: "1",
-			setupMock: func(resume *mock.MockResumeUsecase) {
-				resume.EXPECT().GetByID(gomock.Any(), 1).Return(nil, entity.NewError(entity.ErrInternal, fmt.Errorf("database error")))
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				resume.EXPECT().GetByID(gomock.Any(), 1, 0, "", "").Return(nil, entity.NewError(entity.ErrInternal, fmt.Errorf("database error")))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  fmt.Errorf("database error"),
@@ -286,7 +312,7 @@ func TestResumeHandler_GetResume(t *testing.T) {
 			// Create mocks
 			mockAuth := mock.NewMockAuth(ctrl)
 			mockResume := mock.NewMockResumeUsecase(ctrl)
-			tt.setupMock(mockResume)
+			tt.setupMock(mockAuth, mockResume)
 
 			// Create handler
 			handler := &ResumeHandler{
@@ -295,8 +321,15 @@ func TestResumeHandler_GetResume(t *testing.T) {
 			}
 
 			// Create HTTP request
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/resume/%s", tt.resumeID), nil)
+			url := fmt.Sprintf("/resume/%s", tt.resumeID)
+			if tt.token != "" {
+				url += "?token=" + tt.token
+			}
+			req := httptest.NewRequest(http.MethodGet, url, nil)
 			req = req.WithContext(req.Context())
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
 			req.SetPathValue("id", tt.resumeID)
 
 			// Create response recorder
@@ -814,7 +847,7 @@ func TestResumeHandler_GetAllResumes(t *testing.T) {
 			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
 			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
-				resume.EXPECT().GetAll(gomock.Any(), 20, 10).Return(validResumeShortResponse(), nil)
+				resume.EXPECT().GetAll(gomock.Any(), 1, 20, 10).Return(validResumeShortResponse(), nil)
 			},
 			expectedStatus: http.StatusOK,
 			isApplicant:    false,
@@ -875,7 +908,7 @@ func TestResumeHandler_GetAllResumes(t *testing.T) {
 			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
 			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
-				resume.EXPECT().GetAll(gomock.Any(), 10, 0).Return(nil, entity.NewError(entity.ErrInternal, fmt.Errorf("database error")))
+				resume.EXPECT().GetAll(gomock.Any(), 1, 10, 0).Return(nil, entity.NewError(entity.ErrInternal, fmt.Errorf("database error")))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  fmt.Errorf("database error"),
@@ -992,7 +1025,7 @@ func TestResumeHandler_SearchResumes(t *testing.T) {
 			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
 			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
-				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "applicant", "Go Developer", 10, 0).Return(validResumeShortResponse(), nil)
+				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "applicant", "Go Developer", entity.ResumeFilter{}, 10, 0).Return(validResumeShortResponse(), nil)
 			},
 			expectedStatus: http.StatusOK,
 			role:           "applicant",
@@ -1003,7 +1036,7 @@ func TestResumeHandler_SearchResumes(t *testing.T) {
 			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
 			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
-				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "employer", "Go Developer", 20, 10).Return(validResumeShortResponse(), nil)
+				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "employer", "Go Developer", entity.ResumeFilter{}, 20, 10).Return(validResumeShortResponse(), nil)
 			},
 			expectedStatus: http.StatusOK,
 			role:           "employer",
@@ -1062,7 +1095,7 @@ func TestResumeHandler_SearchResumes(t *testing.T) {
 			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
 			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
-				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "applicant", "Go Developer", 10, 0).Return(nil, entity.NewError(entity.ErrInternal, fmt.Errorf("database error")))
+				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "applicant", "Go Developer", entity.ResumeFilter{}, 10, 0).Return(nil, entity.NewError(entity.ErrInternal, fmt.Errorf("database error")))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  fmt.Errorf("database error"),
@@ -1074,11 +1107,51 @@ func TestResumeHandler_SearchResumes(t *testing.T) {
 			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
 			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
-				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "applicant", "Go Developer", 10, 0).Return(validResumeShortResponse(), nil)
+				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "applicant", "Go Developer", entity.ResumeFilter{}, 10, 0).Return(validResumeShortResponse(), nil)
 			},
 			expectedStatus: http.StatusOK,
 			role:           "applicant",
 		},
+		{
+			name:        "Success - with filters",
+			queryParams: "profession=Go+Developer&salary_from=100000&salary_to=200000&currency=rub&city=%D0%9C%D0%BE%D1%81%D0%BA%D0%B2%D0%B0&relocation=true&work_format=remote,hybrid&employment=full_time&schedule=5/2",
+			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
+				resume.EXPECT().SearchResumesByProfession(gomock.Any(), 1, "employer", "Go Developer", entity.ResumeFilter{
+					SalaryFrom:      100000,
+					SalaryTo:        200000,
+					Currency:        entity.CurrencyRUB,
+					City:            "Москва",
+					ReadyToRelocate: true,
+					WorkFormats:     []string{"remote", "hybrid"},
+					EmploymentTypes: []string{"full_time"},
+					Schedules:       []string{"5/2"},
+				}, 10, 0).Return(validResumeShortResponse(), nil)
+			},
+			expectedStatus: http.StatusOK,
+			role:           "employer",
+		},
+		{
+			name:        "Invalid salary filter - bad request",
+			queryParams: "profession=Go+Developer&salary_from=-5",
+			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  fmt.Errorf("некорректное значение salary_from: -5"),
+		},
+		{
+			name:        "Invalid work format filter - bad request",
+			queryParams: "profession=Go+Developer&work_format=remote,moon",
+			cookie:      &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  fmt.Errorf("некорректное значение work_format: moon"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1136,3 +1209,503 @@ func TestResumeHandler_SearchResumes(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeHandler_AdvancedSearchResumes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		role           string
+		setupMock      func(resume *mock.MockResumeUsecase)
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:        "Success",
+			queryParams: "query=%D0%BC%D0%B8%D0%BA%D1%80%D0%BE%D1%81%D0%B5%D1%80%D0%B2%D0%B8%D1%81%D1%8B&skills_all=Go,+PostgreSQL&skills_any=Docker&specializations=Backend&experience_from=3&education=higher,master&graduation_from=2015&applicant_status=actively_searching&updated_from=2025-01-01&work_format=remote&limit=20&offset=20",
+			role:        "employer",
+			setupMock: func(resume *mock.MockResumeUsecase) {
+				resume.EXPECT().SearchResumesAdvanced(gomock.Any(), 1, entity.ResumeSearchParams{
+					ResumeFilter:       entity.ResumeFilter{WorkFormats: []string{"remote"}},
+					Query:              "микросервисы",
+					SkillsAll:          []string{"Go", "PostgreSQL"},
+					SkillsAny:          []string{"Docker"},
+					Specializations:    []string{"Backend"},
+					ExperienceFrom:     3,
+					Education:          []entity.EducationType{entity.Higher, entity.Master},
+					GraduationYearFrom: 2015,
+					ApplicantStatuses:  []entity.ApplicantStatus{entity.StatusActivelySearching},
+					UpdatedFrom:        time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+				}, 20, 20).Return([]dto.ResumeShortResponse{{ID: 1, Profession: "Go Developer"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Success - with language and course",
+			queryParams: "language=English&language_level=B2&course=Kubernetes",
+			role:        "employer",
+			setupMock: func(resume *mock.MockResumeUsecase) {
+				resume.EXPECT().SearchResumesAdvanced(gomock.Any(), 1, entity.ResumeSearchParams{
+					Language:      "English",
+					LanguageLevel: "B2",
+					Course:        "Kubernetes",
+				}, 10, 0).Return([]dto.ResumeShortResponse{{ID: 2, Profession: "DevOps"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Applicant - forbidden",
+			queryParams:    "query=Go",
+			role:           "applicant",
+			setupMock:      func(resume *mock.MockResumeUsecase) {},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  entity.ErrForbidden,
+		},
+		{
+			name:           "Invalid education - bad request",
+			queryParams:    "education=higher,kindergarten",
+			role:           "employer",
+			setupMock:      func(resume *mock.MockResumeUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  fmt.Errorf("некорректное значение education: kindergarten"),
+		},
+		{
+			name:           "Invalid applicant status - bad request",
+			queryParams:    "applicant_status=sleeping",
+			role:           "employer",
+			setupMock:      func(resume *mock.MockResumeUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  fmt.Errorf("неверный статус соискателя"),
+		},
+		{
+			name:           "Invalid updated date - bad request",
+			queryParams:    "updated_from=01.01.2025",
+			role:           "employer",
+			setupMock:      func(resume *mock.MockResumeUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  fmt.Errorf("некорректное значение updated_from: 01.01.2025"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuth := mock.NewMockAuth(ctrl)
+			mockResume := mock.NewMockResumeUsecase(ctrl)
+			mockAuth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, tt.role, nil)
+			tt.setupMock(mockResume)
+
+			handler := &ResumeHandler{
+				auth:   mockAuth,
+				resume: mockResume,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/resume/search/advanced?"+tt.queryParams, nil)
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: "session123"})
+			w := httptest.NewRecorder()
+
+			handler.AdvancedSearchResumes(w, req)
+
+			resp := w.Result()
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("Failed to close response body: %v", err)
+				}
+			}()
+
+			require.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedError != nil {
+				var apiError utils.APIError
+				err := json.NewDecoder(resp.Body).Decode(&apiError)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedError.Error(), apiError.Message)
+			} else {
+				var resumes []dto.ResumeShortResponse
+				err := json.NewDecoder(resp.Body).Decode(&resumes)
+				require.NoError(t, err)
+				require.Len(t, resumes, 1)
+			}
+		})
+	}
+}
+
+func TestResumeHandler_UpdateResumeVisibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		resumeID       string
+		body           string
+		cookie         *http.Cookie
+		setupMock      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase)
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:     "Success",
+			resumeID: "1",
+			body:     `{"visibility":"link_only"}`,
+			cookie:   &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+				resume.EXPECT().
+					UpdateVisibility(gomock.Any(), 1, 1, &dto.ResumeVisibilityRequest{Visibility: entity.ResumeVisibilityLinkOnly}).
+					Return(&dto.ResumeVisibilityResponse{ID: 1, Visibility: entity.ResumeVisibilityLinkOnly, AccessToken: "token"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Unknown visibility - bad request",
+			resumeID: "1",
+			body:     `{"visibility":"everyone"}`,
+			cookie:   &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "Not applicant - forbidden",
+			resumeID: "1",
+			body:     `{"visibility":"hidden"}`,
+			cookie:   &http.Cookie{Name: "session_id", Value: "session123"},
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  entity.ErrForbidden,
+		},
+		{
+			name:           "No cookie - unauthorized",
+			resumeID:       "1",
+			body:           `{"visibility":"hidden"}`,
+			setupMock:      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  entity.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuth := mock.NewMockAuth(ctrl)
+			mockResume := mock.NewMockResumeUsecase(ctrl)
+			tt.setupMock(mockAuth, mockResume)
+
+			handler := &ResumeHandler{
+				auth:   mockAuth,
+				resume: mockResume,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/resume/%s/visibility", tt.resumeID), bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.resumeID)
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+
+			w := httptest.NewRecorder()
+			handler.UpdateResumeVisibility(w, req)
+
+			resp := w.Result()
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("Failed to close response body: %v", err)
+				}
+			}()
+
+			require.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedError != nil {
+				var apiError utils.APIError
+				err := json.NewDecoder(resp.Body).Decode(&apiError)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedError.Error(), apiError.Message)
+			} else if resp.StatusCode == http.StatusOK {
+				var response dto.ResumeVisibilityResponse
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				require.NoError(t, err)
+				require.Equal(t, "token", response.AccessToken)
+			}
+		})
+	}
+}
+
+func TestResumeHandler_BlockEmployer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		employerID     string
+		setupMock      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase)
+		expectedStatus int
+	}{
+		{
+			name:       "Success",
+			employerID: "7",
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+				resume.EXPECT().BlockEmployer(gomock.Any(), 1, 7).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Employer not found",
+			employerID: "7",
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+				resume.EXPECT().BlockEmployer(gomock.Any(), 1, 7).Return(entity.NewError(entity.ErrNotFound, fmt.Errorf("employer not found")))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Invalid employer ID - bad request",
+			employerID: "abc",
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Not applicant - forbidden",
+			employerID: "7",
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(7, "employer", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuth := mock.NewMockAuth(ctrl)
+			mockResume := mock.NewMockResumeUsecase(ctrl)
+			tt.setupMock(mockAuth, mockResume)
+
+			handler := &ResumeHandler{
+				auth:   mockAuth,
+				resume: mockResume,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/resume/blocked/%s", tt.employerID), nil)
+			req.SetPathValue("employer_id", tt.employerID)
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: "session123"})
+
+			w := httptest.NewRecorder()
+			handler.BlockEmployer(w, req)
+
+			resp := w.Result()
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("Failed to close response body: %v", err)
+				}
+			}()
+
+			require.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestResumeHandler_AnswerContactRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		requestID      string
+		accept         bool
+		setupMock      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase)
+		expectedStatus int
+	}{
+		{
+			name:      "Accept success",
+			requestID: "5",
+			accept:    true,
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(3, "applicant", nil)
+				resume.EXPECT().AnswerContactRequest(gomock.Any(), 5, 3, true).
+					Return(&dto.ContactRequestResponse{ID: 5, Status: entity.ContactRequestAccepted}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Decline success",
+			requestID: "5",
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(3, "applicant", nil)
+				resume.EXPECT().AnswerContactRequest(gomock.Any(), 5, 3, false).
+					Return(&dto.ContactRequestResponse{ID: 5, Status: entity.ContactRequestDeclined}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Already answered",
+			requestID: "5",
+			accept:    true,
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(3, "applicant", nil)
+				resume.EXPECT().AnswerContactRequest(gomock.Any(), 5, 3, true).
+					Return(nil, entity.NewError(entity.ErrBadRequest, fmt.Errorf("already answered")))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "Not applicant - forbidden",
+			requestID: "5",
+			accept:    true,
+			setupMock: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(2, "employer", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuth := mock.NewMockAuth(ctrl)
+			mockResume := mock.NewMockResumeUsecase(ctrl)
+			tt.setupMock(mockAuth, mockResume)
+
+			handler := &ResumeHandler{
+				auth:   mockAuth,
+				resume: mockResume,
+			}
+
+			action := "decline"
+			if tt.accept {
+				action = "accept"
+			}
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/resume/contact-request/%s/%s", tt.requestID, action), nil)
+			req.SetPathValue("id", tt.requestID)
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: "session123"})
+
+			w := httptest.NewRecorder()
+			if tt.accept {
+				handler.AcceptContactRequest(w, req)
+			} else {
+				handler.DeclineContactRequest(w, req)
+			}
+
+			resp := w.Result()
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("Failed to close response body: %v", err)
+				}
+			}()
+
+			require.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestResumeHandler_UploadCourseFile(t *testing.T) {
+	t.Parallel()
+
+	uploaded := &dto.UploadStaticResponse{ID: 7, Path: "/assets/certificate.png"}
+
+	fileRequest := func() *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "certificate.png")
+		require.NoError(t, err)
+
+		_, err = part.Write([]byte("certificate content"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/resume/course/file", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session123"})
+		return req
+	}
+
+	testCases := []struct {
+		name           string
+		setupRequest   func() *http.Request
+		mockSetup      func(auth *mock.MockAuth, resume *mock.MockResumeUsecase)
+		expectedStatus int
+	}{
+		{
+			name:         "Успешная загрузка файла сертификата",
+			setupRequest: fileRequest,
+			mockSetup: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+				resume.EXPECT().UploadCourseFile(gomock.Any(), []byte("certificate content")).Return(uploaded, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:         "Работодатель не может загружать файлы курсов",
+			setupRequest: fileRequest,
+			mockSetup: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(2, "employer", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Файл не передан",
+			setupRequest: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/resume/course/file", nil)
+				req.AddCookie(&http.Cookie{Name: "session_id", Value: "session123"})
+				return req
+			},
+			mockSetup: func(auth *mock.MockAuth, resume *mock.MockResumeUsecase) {
+				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuth := mock.NewMockAuth(ctrl)
+			mockResume := mock.NewMockResumeUsecase(ctrl)
+			tc.mockSetup(mockAuth, mockResume)
+
+			handler := &ResumeHandler{auth: mockAuth, resume: mockResume}
+
+			w := httptest.NewRecorder()
+			handler.UploadCourseFile(w, tc.setupRequest())
+
+			res := w.Result()
+			defer func() {
+				require.NoError(t, res.Body.Close())
+			}()
+
+			require.Equal(t, tc.expectedStatus, res.StatusCode)
+			if tc.expectedStatus == http.StatusOK {
+				var response dto.UploadStaticResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&response))
+				require.Equal(t, uploaded, &response)
+			}
+		})
+	}
+}
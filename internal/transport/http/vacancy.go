@@ -6,7 +6,6 @@ import (
 	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/metrics"
 	"ResuMatch/internal/transport/http/utils"
-	"ResuMatch/internal/transport/ws"
 	"ResuMatch/internal/usecase"
 	"ResuMatch/pkg/sanitizer"
 	"encoding/json"
@@ -17,26 +16,20 @@ import (
 )
 
 type VacancyHandler struct {
-	auth         usecase.Auth
-	vacancy      usecase.Vacancy
-	cfg          config.CSRFConfig
-	wsHub        *ws.Hub
-	notification usecase.Notification
+	auth    usecase.Auth
+	vacancy usecase.Vacancy
+	cfg     config.CSRFConfig
 }
 
 func NewVacancyHandler(
 	auth usecase.Auth,
 	vac usecase.Vacancy,
 	cfg config.CSRFConfig,
-	wsHub *ws.Hub,
-	notification usecase.Notification,
 ) VacancyHandler {
 	return VacancyHandler{
-		auth:         auth,
-		vacancy:      vac,
-		cfg:          cfg,
-		wsHub:        wsHub,
-		notification: notification,
+		auth:    auth,
+		vacancy: vac,
+		cfg:     cfg,
 	}
 }
 
@@ -461,30 +454,11 @@ func (h *VacancyHandler) ApplyToVacancy(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	notification, err := h.vacancy.ApplyToVacancy(ctx, vacancyID, applicantID, resumeID)
-	if err != nil {
-		utils.WriteAPIError(w, utils.ToAPIError(err))
-		return
-	}
-
-	notificationPreview, err := h.notification.CreateNotification(ctx, &notification)
-	if err != nil {
+	if err := h.vacancy.ApplyToVacancy(ctx, vacancyID, applicantID, resumeID); err != nil {
 		utils.WriteAPIError(w, utils.ToAPIError(err))
 		return
 	}
 
-	if notificationPreview != nil {
-		h.wsHub.Broadcast <- ws.Message{
-			Type:    ws.MessageTypeNotification,
-			Payload: notificationPreview,
-		}
-	}
-
-	//err = h.wsHub.SendNotification(notificationPreview)
-	//if err != nil {
-	//	l.Log.Warnf("Не удалось отправить уведомление: %v", err)
-	//}
-
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -5,7 +5,6 @@ import (
 	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/transport/http/utils"
-	"ResuMatch/internal/transport/ws"
 	"ResuMatch/internal/usecase/mock"
 	"bytes"
 	"encoding/json"
@@ -752,7 +751,7 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 		resumeID       string
 		cookie         *http.Cookie
 		body           interface{}
-		setupMock      func(auth *mock.MockAuth, vacancy *mock.MockVacancy, notif *mock.MockNotification)
+		setupMock      func(auth *mock.MockAuth, vacancy *mock.MockVacancy)
 		expectedStatus int
 	}{
 
@@ -762,7 +761,7 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 			resumeID:       "2",
 			cookie:         nil,
 			body:           nil,
-			setupMock:      func(_ *mock.MockAuth, _ *mock.MockVacancy, _ *mock.MockNotification) {},
+			setupMock:      func(_ *mock.MockAuth, _ *mock.MockVacancy) {},
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
@@ -771,7 +770,7 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 			resumeID:  "2",
 			cookie:    &http.Cookie{Name: "session_id", Value: "session123"},
 			body:      nil,
-			setupMock: func(auth *mock.MockAuth, _ *mock.MockVacancy, _ *mock.MockNotification) {
+			setupMock: func(auth *mock.MockAuth, _ *mock.MockVacancy) {
 				//auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -782,7 +781,7 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 			resumeID:  "xyz",
 			cookie:    &http.Cookie{Name: "session_id", Value: "session123"},
 			body:      nil,
-			setupMock: func(auth *mock.MockAuth, _ *mock.MockVacancy, _ *mock.MockNotification) {
+			setupMock: func(auth *mock.MockAuth, _ *mock.MockVacancy) {
 				//auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -793,7 +792,7 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 			resumeID:  "2",
 			cookie:    &http.Cookie{Name: "session_id", Value: "session123"},
 			body:      nil,
-			setupMock: func(auth *mock.MockAuth, _ *mock.MockVacancy, _ *mock.MockNotification) {
+			setupMock: func(auth *mock.MockAuth, _ *mock.MockVacancy) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "employer", nil)
 			},
 			expectedStatus: http.StatusForbidden,
@@ -804,30 +803,27 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 			resumeID:  "2",
 			cookie:    &http.Cookie{Name: "session_id", Value: "session123"},
 			body:      nil,
-			setupMock: func(auth *mock.MockAuth, vacancy *mock.MockVacancy, _ *mock.MockNotification) {
+			setupMock: func(auth *mock.MockAuth, vacancy *mock.MockVacancy) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
 				vacancy.EXPECT().
 					ApplyToVacancy(gomock.Any(), 1, 1, 2).
-					Return(entity.Notification{}, entity.NewError(entity.ErrNotFound, errors.New("vacancy not found")))
+					Return(entity.NewError(entity.ErrNotFound, errors.New("vacancy not found")))
 			},
 			expectedStatus: http.StatusNotFound,
 		},
 		{
-			name:      "Notification creation error",
+			name:      "Response created - notification is delivered from outbox",
 			vacancyID: "1",
 			resumeID:  "2",
 			cookie:    &http.Cookie{Name: "session_id", Value: "session123"},
 			body:      nil,
-			setupMock: func(auth *mock.MockAuth, vacancy *mock.MockVacancy, notif *mock.MockNotification) {
+			setupMock: func(auth *mock.MockAuth, vacancy *mock.MockVacancy) {
 				auth.EXPECT().GetUserIDBySession(gomock.Any(), "session123").Return(1, "applicant", nil)
 				vacancy.EXPECT().
 					ApplyToVacancy(gomock.Any(), 1, 1, 2).
-					Return(entity.Notification{}, nil)
-				notif.EXPECT().
-					CreateNotification(gomock.Any(), gomock.Any()).
-					Return(nil, entity.NewError(entity.ErrInternal, errors.New("notification error")))
+					Return(nil)
 			},
-			expectedStatus: http.StatusInternalServerError,
+			expectedStatus: http.StatusCreated,
 		},
 	}
 
@@ -841,13 +837,10 @@ func TestVacancyHandler_ApplyToVacancy(t *testing.T) {
 
 			authMock := mock.NewMockAuth(ctrl)
 			vacancyMock := mock.NewMockVacancy(ctrl)
-			chatMock := mock.NewMockChat(ctrl)
-			wsHub := ws.NewHub(chatMock)
-			notificationMock := mock.NewMockNotification(ctrl)
 
-			tt.setupMock(authMock, vacancyMock, notificationMock)
+			tt.setupMock(authMock, vacancyMock)
 
-			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{}, wsHub, notificationMock)
+			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{})
 
 			var body []byte
 			if tt.body != nil {
@@ -1065,13 +1058,10 @@ func TestVacancyHandler_SearchVacanciesBySpecializations(t *testing.T) {
 
 			authMock := mock.NewMockAuth(ctrl)
 			vacancyMock := mock.NewMockVacancy(ctrl)
-			chatMock := mock.NewMockChat(ctrl)
-			wsHub := ws.NewHub(chatMock)
-			notificationMock := mock.NewMockNotification(ctrl)
 
 			tc.setupMocks(authMock, vacancyMock)
 
-			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{}, wsHub, notificationMock)
+			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{})
 
 			var reqBody []byte
 			switch body := tc.requestBody.(type) {
@@ -1192,15 +1182,12 @@ func TestVacancyHandler_GetActiveVacanciesByEmployer(t *testing.T) {
 
 			authMock := mock.NewMockAuth(ctrl)
 			vacancyMock := mock.NewMockVacancy(ctrl)
-			chatMock := mock.NewMockChat(ctrl)
-			wsHub := ws.NewHub(chatMock)
-			notificationMock := mock.NewMockNotification(ctrl)
 
 			if tt.setupMocks != nil {
 				tt.setupMocks(authMock, vacancyMock)
 			}
 
-			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{}, wsHub, notificationMock)
+			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{})
 
 			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/vacancy/employer/%s/active", tt.employerID), nil)
 			if tt.cookie != nil {
@@ -1667,15 +1654,12 @@ func TestVacancyHandler_GetLikedVacancies(t *testing.T) {
 
 			authMock := mock.NewMockAuth(ctrl)
 			vacancyMock := mock.NewMockVacancy(ctrl)
-			chatMock := mock.NewMockChat(ctrl)
-			wsHub := ws.NewHub(chatMock)
-			notificationMock := mock.NewMockNotification(ctrl)
 
 			if tt.setupMocks != nil {
 				tt.setupMocks(authMock, vacancyMock)
 			}
 
-			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{}, wsHub, notificationMock)
+			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{})
 
 			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/vacancy/applicant/%s/liked", tt.pathID), nil)
 			if tt.cookie != nil {
@@ -1785,12 +1769,9 @@ func TestVacancyHandler_LikeVacancy(t *testing.T) {
 
 			authMock := mock.NewMockAuth(ctrl)
 			vacancyMock := mock.NewMockVacancy(ctrl)
-			chatMock := mock.NewMockChat(ctrl)
-			wsHub := ws.NewHub(chatMock)
-			notificationMock := mock.NewMockNotification(ctrl)
 			tc.setupMocks(authMock, vacancyMock)
 
-			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{}, wsHub, notificationMock)
+			handler := NewVacancyHandler(authMock, vacancyMock, config.CSRFConfig{})
 
 			req := httptest.NewRequest(http.MethodPost, "/vacancy/"+tc.vacancyID+"/like", nil)
 			if tc.cookie != nil {
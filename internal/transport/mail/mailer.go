@@ -0,0 +1,82 @@
+package mail
+
+import (
+	"ResuMatch/internal/config"
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/usecase"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"sort"
+	"strings"
+)
+
+// SMTPMailer отправляет письма через SMTP-сервер из конфигурации
+type SMTPMailer struct {
+	cfg config.SMTPConfig
+}
+
+// LogMailer только пишет письма в лог. Используется, когда SMTP-сервер не настроен
+type LogMailer struct{}
+
+// NewMailer выбирает реализацию по конфигурации: без адреса SMTP-сервера письма только логируются
+func NewMailer(cfg config.SMTPConfig) usecase.Mailer {
+	if cfg.Host == "" {
+		return &LogMailer{}
+	}
+	return &SMTPMailer{cfg: cfg}
+}
+
+func (m *SMTPMailer) Send(_ context.Context, message *entity.Mail) error {
+	from, err := mail.ParseAddress(m.cfg.From)
+	if err != nil {
+		return fmt.Errorf("неверный адрес отправителя %q: %w", m.cfg.From, err)
+	}
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	if err := smtp.SendMail(m.cfg.Addr(), auth, from.Address, []string{message.To}, compose(m.cfg.From, message)); err != nil {
+		return fmt.Errorf("ошибка отправки письма: %w", err)
+	}
+	return nil
+}
+
+func (m *LogMailer) Send(_ context.Context, message *entity.Mail) error {
+	l.Log.Infof("Письмо для %s: %s\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
+
+// compose собирает текстовое письмо в UTF-8 с дополнительными заголовками
+func compose(from string, message *entity.Mail) []byte {
+	headers := map[string]string{
+		"From":                      from,
+		"To":                        message.To,
+		"Subject":                   mime.QEncoding.Encode("utf-8", message.Subject),
+		"MIME-Version":              "1.0",
+		"Content-Type":              "text/plain; charset=UTF-8",
+		"Content-Transfer-Encoding": "8bit",
+	}
+	for key, value := range message.Headers {
+		headers[key] = value
+	}
+
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key + ": " + headers[key] + "\r\n")
+	}
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
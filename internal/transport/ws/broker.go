@@ -0,0 +1,77 @@
+package ws
+
+import (
+	"context"
+	"sync"
+)
+
+// Delivery - сообщение, опубликованное для конкретного пользователя
+type Delivery struct {
+	Key     ConnectionKey
+	Message Message
+}
+
+// Broker доставляет сообщения между экземплярами приложения. Каждый пользователь
+// получает свой канал, и его читает тот экземпляр, к которому подключен сокет
+type Broker interface {
+	Publish(ctx context.Context, key ConnectionKey, msg Message) error
+	Subscribe(key ConnectionKey) error
+	Unsubscribe(key ConnectionKey) error
+	Deliveries() <-chan Delivery
+	Close() error
+}
+
+// MemoryBroker работает в пределах одного процесса и используется в тестах
+// и при запуске единственного экземпляра приложения
+type MemoryBroker struct {
+	mu         sync.RWMutex
+	subscribed map[ConnectionKey]struct{}
+	out        chan Delivery
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		subscribed: make(map[ConnectionKey]struct{}),
+		out:        make(chan Delivery, 256),
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, key ConnectionKey, msg Message) error {
+	b.mu.RLock()
+	_, ok := b.subscribed[key]
+	b.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	select {
+	case b.out <- Delivery{Key: key, Message: msg}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *MemoryBroker) Subscribe(key ConnectionKey) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscribed[key] = struct{}{}
+	return nil
+}
+
+func (b *MemoryBroker) Unsubscribe(key ConnectionKey) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribed, key)
+	return nil
+}
+
+func (b *MemoryBroker) Deliveries() <-chan Delivery {
+	return b.out
+}
+
+func (b *MemoryBroker) Close() error {
+	return nil
+}
@@ -0,0 +1,209 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	userChannelPrefix = "ws:user:"
+	reconnectDelay    = time.Second
+)
+
+// RedisBroker публикует сообщения в канал пользователя через Redis pub/sub.
+// Экземпляр подписан только на каналы пользователей, чьи сокеты он держит
+type RedisBroker struct {
+	pool     *redis.Pool
+	mu       sync.Mutex
+	psc      *redis.PubSubConn
+	channels map[string]struct{}
+	out      chan Delivery
+	done     chan struct{}
+}
+
+func NewRedisBroker(pool *redis.Pool) *RedisBroker {
+	b := &RedisBroker{
+		pool:     pool,
+		channels: make(map[string]struct{}),
+		out:      make(chan Delivery, 256),
+		done:     make(chan struct{}),
+	}
+	go b.listen()
+	return b
+}
+
+// Message приходит из Redis уже сериализованным, поэтому payload пересылается клиенту как есть
+type redisMessage struct {
+	Type    MessageType     `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, key ConnectionKey, msg Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("не удалось сериализовать сообщение для %+v: %w", key, err)
+	}
+
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось получить соединение с Redis: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			l.Log.Warnf("Ошибка при закрытии соединения redis: %v", err)
+		}
+	}()
+
+	if _, err = conn.Do("PUBLISH", userChannel(key), data); err != nil {
+		return fmt.Errorf("не удалось опубликовать сообщение для %+v: %w", key, err)
+	}
+	return nil
+}
+
+func (b *RedisBroker) Subscribe(key ConnectionKey) error {
+	channel := userChannel(key)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.channels[channel] = struct{}{}
+	if b.psc == nil {
+		// Подписка будет оформлена при переподключении
+		return nil
+	}
+	return b.psc.Subscribe(channel)
+}
+
+func (b *RedisBroker) Unsubscribe(key ConnectionKey) error {
+	channel := userChannel(key)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.channels, channel)
+	if b.psc == nil {
+		return nil
+	}
+	return b.psc.Unsubscribe(channel)
+}
+
+func (b *RedisBroker) Deliveries() <-chan Delivery {
+	return b.out
+}
+
+func (b *RedisBroker) Close() error {
+	close(b.done)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.psc == nil {
+		return nil
+	}
+	return b.psc.Close()
+}
+
+func (b *RedisBroker) listen() {
+	for {
+		select {
+		case <-b.done:
+			return
+		default:
+		}
+
+		if err := b.receive(); err != nil {
+			l.Log.Warnf("Соединение pub/sub с Redis прервано: %v", err)
+		}
+
+		select {
+		case <-b.done:
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (b *RedisBroker) receive() error {
+	psc := &redis.PubSubConn{Conn: b.pool.Get()}
+
+	b.mu.Lock()
+	for channel := range b.channels {
+		if err := psc.Subscribe(channel); err != nil {
+			b.mu.Unlock()
+			return b.closeConn(psc, err)
+		}
+	}
+	b.psc = psc
+	b.mu.Unlock()
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			delivery, err := decodeDelivery(v)
+			if err != nil {
+				l.Log.Warnf("Не удалось разобрать сообщение из канала %s: %v", v.Channel, err)
+				continue
+			}
+			b.out <- delivery
+		case error:
+			b.mu.Lock()
+			b.psc = nil
+			b.mu.Unlock()
+			return b.closeConn(psc, v)
+		}
+	}
+}
+
+func (b *RedisBroker) closeConn(psc *redis.PubSubConn, cause error) error {
+	if err := psc.Close(); err != nil {
+		l.Log.Warnf("Ошибка при закрытии соединения redis: %v", err)
+	}
+	return cause
+}
+
+func decodeDelivery(msg redis.Message) (Delivery, error) {
+	key, err := parseUserChannel(msg.Channel)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	var decoded redisMessage
+	if err = json.Unmarshal(msg.Data, &decoded); err != nil {
+		return Delivery{}, err
+	}
+
+	return Delivery{
+		Key: key,
+		Message: Message{
+			Type:    decoded.Type,
+			Payload: decoded.Payload,
+		},
+	}, nil
+}
+
+func userChannel(key ConnectionKey) string {
+	return fmt.Sprintf("%s%s:%d", userChannelPrefix, key.Type, key.UserID)
+}
+
+func parseUserChannel(channel string) (ConnectionKey, error) {
+	parts := strings.Split(strings.TrimPrefix(channel, userChannelPrefix), ":")
+	if len(parts) != 2 {
+		return ConnectionKey{}, fmt.Errorf("неизвестный канал %s", channel)
+	}
+
+	userID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return ConnectionKey{}, fmt.Errorf("некорректный id пользователя в канале %s: %w", channel, err)
+	}
+
+	return ConnectionKey{UserID: userID, Type: entity.UserRole(parts[0])}, nil
+}
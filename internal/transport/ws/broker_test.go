@@ -0,0 +1,95 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBroker_DeliversOnlySubscribed(t *testing.T) {
+	t.Parallel()
+
+	broker := NewMemoryBroker()
+	online := ConnectionKey{UserID: 1, Type: entity.ApplicantRole}
+	offline := ConnectionKey{UserID: 2, Type: entity.ApplicantRole}
+
+	require.NoError(t, broker.Subscribe(online))
+	require.NoError(t, broker.Publish(context.Background(), offline, Message{Type: MessageTypeChat}))
+	require.NoError(t, broker.Publish(context.Background(), online, Message{Type: MessageTypeChat, Payload: "hi"}))
+
+	delivery := <-broker.Deliveries()
+	require.Equal(t, online, delivery.Key)
+	require.Equal(t, "hi", delivery.Message.Payload)
+	require.Empty(t, broker.Deliveries())
+
+	require.NoError(t, broker.Unsubscribe(online))
+	require.NoError(t, broker.Publish(context.Background(), online, Message{Type: MessageTypeChat}))
+	require.Empty(t, broker.Deliveries())
+}
+
+func TestRedisBroker_DecodeDelivery(t *testing.T) {
+	t.Parallel()
+
+	key := ConnectionKey{UserID: 42, Type: entity.EmployerRole}
+	data, err := json.Marshal(Message{
+		Type:    MessageTypeNotification,
+		Payload: &entity.NotificationPreview{ID: 7, ReceiverID: 42},
+	})
+	require.NoError(t, err)
+
+	delivery, err := decodeDelivery(redis.Message{Channel: userChannel(key), Data: data})
+	require.NoError(t, err)
+	require.Equal(t, key, delivery.Key)
+	require.Equal(t, MessageTypeNotification, delivery.Message.Type)
+
+	// Клиент должен получить тот же JSON, что был бы отправлен без Redis
+	forwarded, err := json.Marshal(delivery.Message)
+	require.NoError(t, err)
+	require.JSONEq(t, string(data), string(forwarded))
+}
+
+func TestRedisBroker_ParseUserChannel(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		channel  string
+		expected ConnectionKey
+		wantErr  bool
+	}{
+		{
+			name:     "applicant",
+			channel:  "ws:user:applicant:5",
+			expected: ConnectionKey{UserID: 5, Type: entity.ApplicantRole},
+		},
+		{
+			name:    "missing id",
+			channel: "ws:user:employer",
+			wantErr: true,
+		},
+		{
+			name:    "invalid id",
+			channel: "ws:user:employer:abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := parseUserChannel(tc.channel)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, key)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	l "ResuMatch/pkg/logger"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"net/http"
 	"time"
@@ -22,6 +24,13 @@ type Client struct {
 	conn *websocket.Conn
 	send chan Message
 	Key  ConnectionKey
+	// id отличает соединение от других вкладок пользователя в статусе присутствия
+	id string
+	// resume - догрузка, которую хаб запускает сразу после регистрации соединения
+	resume *dto.SyncRequest
+
+	lastTypingChat int
+	lastTypingAt   time.Time
 }
 
 func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request, userID int, role string) {
@@ -44,11 +53,12 @@ func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request, userID int, role
 	client := &Client{
 		hub:  hub,
 		conn: conn,
-		send: make(chan Message, 256),
+		send: make(chan Message, clientSendBuffer),
 		Key: ConnectionKey{
 			UserID: userID,
 			Type:   userRole,
 		},
+		id: uuid.NewString(),
 	}
 
 	hub.register <- client
@@ -72,14 +82,21 @@ func (c *Client) readPump() {
 		if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
 			l.Log.Errorf("set read deadline (pong) error: %v", err)
 		}
+		c.hub.trackPresence(c, true)
 		return nil
 	})
 
 	for {
 		var msg struct {
-			Type    MessageType `json:"type"`
-			ChatID  int         `json:"chat_id"`
-			Payload string      `json:"payload"`
+			Type               MessageType `json:"type"`
+			ChatID             int         `json:"chat_id"`
+			Payload            string      `json:"payload"`
+			MessageID          int         `json:"message_id"`
+			ClientID           string      `json:"client_id"`
+			AttachmentIDs      []int       `json:"attachment_ids"`
+			LastMessageID      int         `json:"last_message_id"`
+			LastNotificationID int         `json:"last_notification_id"`
+			Typing             bool        `json:"typing"`
 		}
 
 		l.Log.Infof("Чтение сообщения: %v", msg)
@@ -88,21 +105,102 @@ func (c *Client) readPump() {
 			break
 		}
 
-		if msg.Type == MessageTypeChat {
-			newMsg := Message{
+		switch msg.Type {
+		case MessageTypeChat:
+			c.hub.Broadcast <- Message{
 				Type: MessageTypeChat,
 				Payload: dto.MessageRequest{
+					ChatID:        msg.ChatID,
+					SenderID:      c.Key.UserID,
+					SenderRole:    c.Key.Type,
+					Payload:       msg.Payload,
+					ClientID:      msg.ClientID,
+					AttachmentIDs: msg.AttachmentIDs,
+				},
+				origin: c,
+			}
+		case MessageTypeRead:
+			c.hub.Broadcast <- Message{
+				Type: MessageTypeRead,
+				Payload: dto.ReadRequest{
 					ChatID:     msg.ChatID,
-					SenderID:   c.Key.UserID,
-					SenderRole: c.Key.Type,
-					Payload:    msg.Payload,
+					ReaderID:   c.Key.UserID,
+					ReaderRole: c.Key.Type,
+					MessageID:  msg.MessageID,
+					ClientID:   msg.ClientID,
+				},
+				origin: c,
+			}
+		case MessageTypeSync:
+			c.hub.Broadcast <- Message{
+				Type: MessageTypeSync,
+				Payload: dto.SyncRequest{
+					UserID:             c.Key.UserID,
+					Role:               c.Key.Type,
+					LastMessageID:      msg.LastMessageID,
+					LastNotificationID: msg.LastNotificationID,
 				},
+				origin: c,
+			}
+		case MessageTypeEdit, MessageTypeDelete:
+			c.hub.Broadcast <- Message{
+				Type: msg.Type,
+				Payload: dto.MessageEditRequest{
+					ChatID:    msg.ChatID,
+					MessageID: msg.MessageID,
+					UserID:    c.Key.UserID,
+					Role:      c.Key.Type,
+					Payload:   msg.Payload,
+					Delete:    msg.Type == MessageTypeDelete,
+					ClientID:  msg.ClientID,
+				},
+				origin: c,
+			}
+		case MessageTypeTyping:
+			if !c.allowTyping(msg.ChatID, msg.Typing) {
+				continue
+			}
+			c.hub.Broadcast <- Message{
+				Type: MessageTypeTyping,
+				Payload: dto.TypingRequest{
+					ChatID: msg.ChatID,
+					UserID: c.Key.UserID,
+					Role:   c.Key.Type,
+					Typing: msg.Typing,
+				},
+				origin: c,
+			}
+		default:
+			c.hub.Broadcast <- Message{
+				Type: MessageTypeError,
+				Payload: dto.MessageError{
+					ClientID: msg.ClientID,
+					ChatID:   msg.ChatID,
+					Status:   http.StatusBadRequest,
+					Message:  fmt.Sprintf("неизвестный тип сообщения: %s", msg.Type),
+				},
+				origin: c,
 			}
-			c.hub.Broadcast <- newMsg
 		}
 	}
 }
 
+// allowTyping пропускает не больше одного сигнала о наборе в чат за typingThrottle.
+// Окончание набора передается всегда, чтобы индикатор у собеседника не завис
+func (c *Client) allowTyping(chatID int, typing bool) bool {
+	now := time.Now()
+	if typing && c.lastTypingChat == chatID && now.Sub(c.lastTypingAt) < typingThrottle {
+		return false
+	}
+
+	if typing {
+		c.lastTypingChat, c.lastTypingAt = chatID, now
+	} else {
+		c.lastTypingChat = 0
+	}
+	return true
+}
+
 func (c *Client) writePump() {
 	ticker := time.NewTicker(pingPeriod)
 
@@ -3,109 +3,459 @@ package ws
 import (
 	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/metrics"
+	"ResuMatch/internal/transport/http/utils"
 	"ResuMatch/internal/usecase"
 	l "ResuMatch/pkg/logger"
 	"context"
 	"sync"
 )
 
+// Hub хранит все соединения пользователя: каждая вкладка и каждое устройство
+// получают сообщения независимо друг от друга. Исходящие сообщения идут через Broker,
+// чтобы дойти до сокетов, подключенных к другим экземплярам приложения.
+// Сохранение сообщений выполняют отдельные обработчики, поэтому медленный запрос к базе
+// не задерживает подключение клиентов и доставку уже сохраненных сообщений.
+// Если presence не передан, статус присутствия не отслеживается
 type Hub struct {
-	clients    map[ConnectionKey]*Client
+	clients    map[ConnectionKey]map[*Client]struct{}
 	register   chan *Client
 	unregister chan *Client
 	Broadcast  chan Message
 	mu         sync.Mutex
 	chatUC     usecase.Chat
+	notifyUC   usecase.Notification
+	presenceUC usecase.Presence
+	broker     Broker
+	queues     []chan Message
 }
 
-func NewHub(chat usecase.Chat) *Hub {
+func NewHub(chat usecase.Chat, notification usecase.Notification, presence usecase.Presence, broker Broker) *Hub {
+	queues := make([]chan Message, hubWorkers)
+	for i := range queues {
+		queues[i] = make(chan Message, workerQueueSize)
+	}
+
 	return &Hub{
-		clients:    make(map[ConnectionKey]*Client),
+		clients:    make(map[ConnectionKey]map[*Client]struct{}),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 		Broadcast:  make(chan Message),
 		chatUC:     chat,
+		notifyUC:   notification,
+		presenceUC: presence,
+		broker:     broker,
+		queues:     queues,
 	}
 }
 
 func (h *Hub) Run() {
+	for _, queue := range h.queues {
+		go h.work(queue)
+	}
+	go h.dispatch()
+
 	for {
 		select {
 		case client := <-h.register:
 			h.mu.Lock()
-			h.clients[client.Key] = client
+			h.addClient(client)
 			h.mu.Unlock()
 			l.Log.Infof("Client connected: %+v", client.Key)
+			// Запрос догрузки, отправленный до регистрации, мог бы ответить в еще неизвестное хабу соединение
+			if client.resume != nil {
+				go func(client *Client) {
+					h.Broadcast <- Message{Type: MessageTypeSync, Payload: *client.resume, origin: client}
+				}(client)
+			}
 
 		case client := <-h.unregister:
 			h.mu.Lock()
-			if _, ok := h.clients[client.Key]; ok {
-				close(client.send)
-				delete(h.clients, client.Key)
+			if h.removeClient(client) {
 				l.Log.Infof("Client disconnected: %+v", client.Key)
 			}
 			h.mu.Unlock()
 
-		case message := <-h.Broadcast:
+		case delivery := <-h.broker.Deliveries():
 			h.mu.Lock()
-			switch msgType := message.Type; msgType {
-			case MessageTypeChat:
-				req := message.Payload.(dto.MessageRequest)
-
-				resp, err := h.chatUC.SendMessage(context.Background(), req.ChatID, req.SenderID, string(req.SenderRole), req.Payload)
-				if err != nil {
-					l.Log.Warnf("Не удалось сохранить сообщение: %v", err)
-					h.mu.Unlock()
-					continue
-				}
-
-				receiverKey := ConnectionKey{
-					UserID: resp.ReceiverID,
-					Type:   h.getReceiverRole(resp.FromApplicant),
-				}
-
-				if receiver, ok := h.clients[receiverKey]; ok {
-					receiver.send <- Message{
-						Type:    MessageTypeChat,
-						Payload: resp,
-					}
-				}
-
-				senderKey := ConnectionKey{
-					UserID: req.SenderID,
-					Type:   req.SenderRole,
-				}
-
-				if sender, ok := h.clients[senderKey]; ok {
-					sender.send <- Message{
-						Type:    MessageTypeChat,
-						Payload: resp,
-					}
-				}
-			case MessageTypeNotification:
-				notificationMsg := message.Payload.(*entity.NotificationPreview)
-
-				var receiverRole entity.UserRole
-				switch notificationMsg.Type {
-				case entity.DownloadResumeType:
-					receiverRole = entity.ApplicantRole
-				case entity.ApplyNotificationType:
-					receiverRole = entity.EmployerRole
-				}
-
-				key := ConnectionKey{
-					UserID: notificationMsg.ReceiverID,
-					Type:   receiverRole,
-				}
-				if client, ok := h.clients[key]; ok {
-					client.send <- message
-				}
-			}
+			h.sendTo(delivery.Key, delivery.Message)
 			h.mu.Unlock()
 		}
 	}
 }
 
+// dispatch раскладывает входящие сообщения по очередям обработчиков. Сообщения одного чата
+// всегда попадают в одну очередь, поэтому сохраняются в порядке отправки
+func (h *Hub) dispatch() {
+	for message := range h.Broadcast {
+		h.queues[h.queueIndex(message)] <- message
+	}
+}
+
+func (h *Hub) queueIndex(message Message) int {
+	var shardKey int
+	switch payload := message.Payload.(type) {
+	case dto.MessageRequest:
+		shardKey = payload.ChatID
+	case *dto.ChatEvent:
+		shardKey = payload.Message.ChatID
+	case dto.ReadRequest:
+		shardKey = payload.ChatID
+	case dto.SyncRequest:
+		shardKey = payload.UserID
+	case dto.TypingRequest:
+		shardKey = payload.ChatID
+	case dto.MessageEditRequest:
+		shardKey = payload.ChatID
+	case presenceChange:
+		shardKey = payload.key.UserID
+	case *entity.NotificationPreview:
+		shardKey = payload.ReceiverID
+	}
+	if shardKey < 0 {
+		shardKey = -shardKey
+	}
+	return shardKey % len(h.queues)
+}
+
+func (h *Hub) work(queue <-chan Message) {
+	for message := range queue {
+		h.handle(message)
+	}
+}
+
+func (h *Hub) handle(message Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+	defer cancel()
+
+	switch msgType := message.Type; msgType {
+	case MessageTypeChat:
+		// Системные сообщения уже сохранены сервисом чатов и только рассылаются участникам
+		if event, ok := message.Payload.(*dto.ChatEvent); ok {
+			h.publishEvent(ctx, MessageTypeChat, event)
+			return
+		}
+
+		req := message.Payload.(dto.MessageRequest)
+
+		event, err := h.chatUC.SendMessage(ctx, req.ChatID, req.SenderID, string(req.SenderRole), req.Payload, req.ClientID, req.AttachmentIDs)
+		if err != nil {
+			l.Log.Warnf("Не удалось сохранить сообщение: %v", err)
+			h.replyError(message.origin, req.ClientID, req.ChatID, err)
+			return
+		}
+
+		h.reply(message.origin, Message{
+			Type: MessageTypeAck,
+			Payload: dto.MessageAck{
+				ClientID:  req.ClientID,
+				ChatID:    event.Message.ChatID,
+				MessageID: event.Message.ID,
+				SentAt:    event.Message.SentAt,
+			},
+		})
+
+		h.publishEvent(ctx, MessageTypeChat, event)
+	case MessageTypeEdit, MessageTypeDelete:
+		// Сохраненные правки приходят из outbox и только рассылаются участникам
+		if event, ok := message.Payload.(*dto.ChatEvent); ok {
+			h.publishEvent(ctx, message.Type, event)
+			return
+		}
+
+		// Правка из websocket только сохраняется: участникам ее разошлет outbox вместе с правками через HTTP
+		var err error
+		req := message.Payload.(dto.MessageEditRequest)
+		if req.Delete {
+			_, err = h.chatUC.DeleteMessage(ctx, req.ChatID, req.MessageID, req.UserID, string(req.Role))
+		} else {
+			_, err = h.chatUC.EditMessage(ctx, req.ChatID, req.MessageID, req.UserID, string(req.Role), req.Payload)
+		}
+		if err != nil {
+			l.Log.Warnf("Не удалось изменить сообщение: %v", err)
+			h.replyError(message.origin, req.ClientID, req.ChatID, err)
+		}
+	case MessageTypeRead:
+		req := message.Payload.(dto.ReadRequest)
+
+		event, err := h.chatUC.MarkRead(ctx, req.ChatID, req.ReaderID, string(req.ReaderRole), req.MessageID)
+		if err != nil {
+			l.Log.Warnf("Не удалось отметить сообщения прочитанными: %v", err)
+			h.replyError(message.origin, req.ClientID, req.ChatID, err)
+			return
+		}
+
+		// Отметка уходит собеседнику и возвращается во все вкладки читателя, чтобы счетчики в них совпадали
+		h.publish(ctx, ConnectionKey{UserID: event.ApplicantID, Type: entity.ApplicantRole}, Message{
+			Type:    MessageTypeRead,
+			Payload: event.Receipt,
+		})
+		h.publish(ctx, ConnectionKey{UserID: event.EmployerID, Type: entity.EmployerRole}, Message{
+			Type:    MessageTypeRead,
+			Payload: event.EmployerReceipt,
+		})
+	case MessageTypeNotification:
+		notificationMsg := message.Payload.(*entity.NotificationPreview)
+
+		spec, ok := entity.LookupNotificationType(notificationMsg.Type)
+		if !ok {
+			l.Log.Warnf("Уведомление %d неизвестного типа %q не доставлено", notificationMsg.ID, notificationMsg.Type)
+			return
+		}
+		key := ConnectionKey{
+			UserID: notificationMsg.ReceiverID,
+			Type:   spec.Receiver,
+		}
+		h.publish(ctx, key, message)
+	case MessageTypeSync:
+		h.sync(ctx, message.origin, message.Payload.(dto.SyncRequest))
+	case MessageTypeTyping:
+		req := message.Payload.(dto.TypingRequest)
+
+		peer, err := h.chatUC.GetChatPeer(ctx, req.ChatID, req.UserID, string(req.Role))
+		if err != nil {
+			l.Log.Warnf("Не удалось переслать набор текста: %v", err)
+			h.replyError(message.origin, "", req.ChatID, err)
+			return
+		}
+
+		peerKey := ConnectionKey{
+			UserID: peer.ID,
+			Type:   h.getReceiverRole(req.Role == entity.ApplicantRole),
+		}
+
+		event := dto.TypingEvent{
+			ChatID: req.ChatID,
+			UserID: req.UserID,
+			Typing: req.Typing,
+		}
+		if peer.UserHidden {
+			event.UserID = 0
+		}
+		h.publish(ctx, peerKey, Message{
+			Type:    MessageTypeTyping,
+			Payload: event,
+		})
+	case MessageTypePresence:
+		h.updatePresence(ctx, message.Payload.(presenceChange))
+	case MessageTypeError:
+		h.reply(message.origin, message)
+	}
+}
+
+// sync догружает в соединение события, пропущенные клиентом, и завершает догрузку кадром sync
+func (h *Hub) sync(ctx context.Context, origin *Client, req dto.SyncRequest) {
+	role := string(req.Role)
+
+	messages, err := h.chatUC.GetMessagesAfter(ctx, req.UserID, role, req.LastMessageID, syncLimit)
+	if err != nil {
+		l.Log.Warnf("Не удалось получить пропущенные сообщения: %v", err)
+		h.replyError(origin, "", 0, err)
+		return
+	}
+
+	notifications, err := h.notifyUC.GetNotificationsAfter(ctx, req.UserID, role, req.LastNotificationID, syncLimit)
+	if err != nil {
+		l.Log.Warnf("Не удалось получить пропущенные уведомления: %v", err)
+		h.replyError(origin, "", 0, err)
+		return
+	}
+
+	resp := dto.SyncResponse{
+		LastMessageID:      req.LastMessageID,
+		LastNotificationID: req.LastNotificationID,
+		Complete:           len(messages) < syncLimit && len(notifications) < syncLimit,
+	}
+
+	for _, msg := range messages {
+		h.reply(origin, Message{Type: MessageTypeChat, Payload: msg})
+		resp.LastMessageID = msg.ID
+	}
+	for _, notification := range notifications {
+		h.reply(origin, Message{Type: MessageTypeNotification, Payload: notification})
+		resp.LastNotificationID = notification.ID
+	}
+
+	h.reply(origin, Message{Type: MessageTypeSync, Payload: resp})
+}
+
+// updatePresence учитывает соединение в общем статусе пользователя и, если пользователь
+// появился в сети или ушел из нее, сообщает об этом всем его собеседникам
+func (h *Hub) updatePresence(ctx context.Context, change presenceChange) {
+	role := string(change.key.Type)
+
+	var changed bool
+	var err error
+	if change.connected {
+		changed, err = h.presenceUC.Connect(ctx, change.key.UserID, role, change.connID)
+	} else {
+		changed, err = h.presenceUC.Disconnect(ctx, change.key.UserID, role, change.connID)
+	}
+	if err != nil {
+		l.Log.Warnf("Не удалось обновить статус пользователя %+v: %v", change.key, err)
+		return
+	}
+	if !changed {
+		return
+	}
+
+	presence, err := h.presenceUC.GetPresence(ctx, change.key.UserID, role)
+	if err != nil {
+		l.Log.Warnf("Не удалось получить статус пользователя %+v: %v", change.key, err)
+		return
+	}
+
+	peers, err := h.chatUC.GetChatPeers(ctx, change.key.UserID, role)
+	if err != nil {
+		l.Log.Warnf("Не удалось получить собеседников пользователя %+v: %v", change.key, err)
+		return
+	}
+
+	// Работодатель, которому владелец анонимного резюме не раскрыт, получает статус без id пользователя
+	hidden := *presence
+	hidden.UserID = 0
+
+	peerRole := h.getReceiverRole(change.key.Type == entity.ApplicantRole)
+	for _, peer := range peers {
+		payload := presence
+		if peer.UserHidden {
+			payload = &hidden
+		}
+		h.publish(ctx, ConnectionKey{UserID: peer.ID, Type: peerRole}, Message{
+			Type:    MessageTypePresence,
+			Payload: payload,
+		})
+	}
+}
+
+// trackPresence только кладет событие в очередь обработчика, поэтому мьютекс хаба ему не нужен.
+// Места в очереди он не ждет: из addClient и removeClient вызывается под мьютексом хаба, а из pong
+// и keep-alive потока не должен задерживать соединение. Потерянное подключение восстановится
+// на следующем pong, а потерянное отключение - по истечении срока соединения
+func (h *Hub) trackPresence(client *Client, connected bool) {
+	if h.presenceUC == nil {
+		return
+	}
+
+	message := Message{
+		Type: MessageTypePresence,
+		Payload: presenceChange{
+			key:       client.Key,
+			connID:    client.id,
+			connected: connected,
+		},
+	}
+
+	select {
+	case h.queues[h.queueIndex(message)] <- message:
+	default:
+		l.Log.Warnf("Очередь хаба переполнена, статус пользователя %+v обновится позже", client.Key)
+	}
+}
+
+func (h *Hub) addClient(client *Client) {
+	conns, ok := h.clients[client.Key]
+	if !ok {
+		conns = make(map[*Client]struct{})
+		h.clients[client.Key] = conns
+	}
+	conns[client] = struct{}{}
+	if len(conns) == 1 {
+		if err := h.broker.Subscribe(client.Key); err != nil {
+			l.Log.Errorf("Не удалось подписаться на канал пользователя %+v: %v", client.Key, err)
+		}
+	}
+	metrics.WebsocketConnections.WithLabelValues(string(client.Key.Type)).Inc()
+	h.trackPresence(client, true)
+}
+
+// removeClient закрывает только переданное соединение, остальные вкладки пользователя продолжают работать
+func (h *Hub) removeClient(client *Client) bool {
+	conns, ok := h.clients[client.Key]
+	if !ok {
+		return false
+	}
+	if _, ok = conns[client]; !ok {
+		return false
+	}
+
+	close(client.send)
+	delete(conns, client)
+	if len(conns) == 0 {
+		delete(h.clients, client.Key)
+		if err := h.broker.Unsubscribe(client.Key); err != nil {
+			l.Log.Errorf("Не удалось отписаться от канала пользователя %+v: %v", client.Key, err)
+		}
+	}
+	metrics.WebsocketConnections.WithLabelValues(string(client.Key.Type)).Dec()
+	h.trackPresence(client, false)
+	return true
+}
+
+// publishEvent доставляет сообщение чата кадром messageType обоим участникам. Работодатель получает
+// свою копию сообщения, в которой может быть скрыт владелец анонимного резюме
+func (h *Hub) publishEvent(ctx context.Context, messageType MessageType, event *dto.ChatEvent) {
+	h.publish(ctx, ConnectionKey{UserID: event.ApplicantID, Type: entity.ApplicantRole}, Message{
+		Type:    messageType,
+		Payload: event.Message,
+	})
+	h.publish(ctx, ConnectionKey{UserID: event.EmployerID, Type: entity.EmployerRole}, Message{
+		Type:    messageType,
+		Payload: event.EmployerMessage,
+	})
+}
+
+func (h *Hub) publish(ctx context.Context, key ConnectionKey, message Message) {
+	if err := h.broker.Publish(ctx, key, message); err != nil {
+		l.Log.Errorf("Не удалось опубликовать сообщение для %+v: %v", key, err)
+	}
+}
+
+// sendTo никогда не ждет клиента: если его буфер переполнен, соединение закрывается,
+// а клиент после переподключения догружает пропущенное через историю чата
+func (h *Hub) sendTo(key ConnectionKey, message Message) {
+	for client := range h.clients[key] {
+		h.deliver(client, message)
+	}
+}
+
+// reply отправляет ответ только в соединение, из которого пришел запрос, если оно еще открыто
+func (h *Hub) reply(client *Client, message Message) {
+	if client == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[client.Key][client]; ok {
+		h.deliver(client, message)
+	}
+}
+
+func (h *Hub) replyError(client *Client, clientID string, chatID int, err error) {
+	apiErr := utils.ToAPIError(err)
+	h.reply(client, Message{
+		Type: MessageTypeError,
+		Payload: dto.MessageError{
+			ClientID: clientID,
+			ChatID:   chatID,
+			Status:   apiErr.Status,
+			Message:  apiErr.Message,
+		},
+	})
+}
+
+func (h *Hub) deliver(client *Client, message Message) {
+	select {
+	case client.send <- message:
+	default:
+		l.Log.Warnf("Клиент %+v не успевает читать сообщения, соединение закрыто", client.Key)
+		metrics.WebsocketSlowConsumers.WithLabelValues(string(client.Key.Type)).Inc()
+		h.removeClient(client)
+	}
+}
+
 func (h *Hub) getReceiverRole(fromApplicant bool) entity.UserRole {
 	if fromApplicant {
 		return entity.EmployerRole
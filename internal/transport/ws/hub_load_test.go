@@ -0,0 +1,176 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/usecase"
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stubChat сохраняет сообщения без базы, имитируя задержку запроса
+type stubChat struct {
+	usecase.Chat
+	nextID  atomic.Int64
+	latency time.Duration
+}
+
+func (s *stubChat) SendMessage(ctx context.Context, chatID, senderID int, role string, payload, clientID string, attachmentIDs []int) (*dto.ChatEvent, error) {
+	if s.latency > 0 {
+		time.Sleep(s.latency)
+	}
+	fromApplicant := role == string(entity.ApplicantRole)
+	message := &dto.MessageResponse{
+		ID:            int(s.nextID.Add(1)),
+		ChatID:        chatID,
+		SenderID:      senderID,
+		ReceiverID:    chatID,
+		FromApplicant: fromApplicant,
+		Payload:       payload,
+	}
+	applicantID, employerID := senderID, chatID
+	if !fromApplicant {
+		applicantID, employerID = chatID, senderID
+	}
+	return &dto.ChatEvent{Message: message, EmployerMessage: message, ApplicantID: applicantID, EmployerID: employerID}, nil
+}
+
+// loadPair - соискатель и работодатель с общим чатом, id чата совпадает с id работодателя
+type loadPair struct {
+	applicant *Client
+	employer  *Client
+	echoed    *atomic.Int64
+}
+
+// sendWindow ограничивает число неподтвержденных сообщений отправителя, как это делает
+// реальный клиент, дожидающийся эха. Иначе хаб закрыл бы получателей как медленных
+const sendWindow = clientSendBuffer / 4
+
+func startLoadHub(t testing.TB, pairs int, latency time.Duration) (*Hub, []loadPair, *atomic.Int64, func()) {
+	t.Helper()
+
+	hub := NewHub(&stubChat{latency: latency}, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	var received atomic.Int64
+	var wg sync.WaitGroup
+	drain := func(c *Client, echoed *atomic.Int64) {
+		defer wg.Done()
+		for range c.send {
+			received.Add(1)
+			if echoed != nil {
+				echoed.Add(1)
+			}
+		}
+	}
+
+	clients := make([]loadPair, pairs)
+	for i := range clients {
+		clients[i] = loadPair{
+			applicant: &Client{hub: hub, send: make(chan Message, clientSendBuffer), Key: ConnectionKey{UserID: i + 1, Type: entity.ApplicantRole}},
+			employer:  &Client{hub: hub, send: make(chan Message, clientSendBuffer), Key: ConnectionKey{UserID: i + 1, Type: entity.EmployerRole}},
+			echoed:    &atomic.Int64{},
+		}
+		hub.register <- clients[i].applicant
+		hub.register <- clients[i].employer
+		waitRegistered(t, hub, clients[i].applicant)
+		waitRegistered(t, hub, clients[i].employer)
+		wg.Add(2)
+		go drain(clients[i].applicant, clients[i].echoed)
+		go drain(clients[i].employer, nil)
+	}
+
+	stop := func() {
+		for _, pair := range clients {
+			hub.unregister <- pair.applicant
+			hub.unregister <- pair.employer
+		}
+		wg.Wait()
+	}
+	return hub, clients, &received, stop
+}
+
+func sendLoad(hub *Hub, clients []loadPair, perClient int) {
+	var wg sync.WaitGroup
+	for _, pair := range clients {
+		wg.Add(1)
+		go func(sender *Client, echoed *atomic.Int64) {
+			defer wg.Done()
+			for i := 0; i < perClient; i++ {
+				for int64(i)-echoed.Load() >= sendWindow {
+					time.Sleep(50 * time.Microsecond)
+				}
+				hub.Broadcast <- Message{
+					Type: MessageTypeChat,
+					Payload: dto.MessageRequest{
+						ChatID:     sender.Key.UserID,
+						SenderID:   sender.Key.UserID,
+						SenderRole: sender.Key.Type,
+						Payload:    "нагрузка",
+					},
+				}
+			}
+		}(pair.applicant, pair.echoed)
+	}
+	wg.Wait()
+}
+
+func waitReceived(t testing.TB, received *atomic.Int64, expected int64, timeout time.Duration) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for received.Load() < expected {
+		if time.Now().After(deadline) {
+			t.Fatalf("доставлено %d из %d сообщений", received.Load(), expected)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHub_LoadManyClients(t *testing.T) {
+	if testing.Short() {
+		t.Skip("нагрузочный тест пропускается в режиме -short")
+	}
+
+	const (
+		pairs     = 500
+		perClient = 20
+	)
+
+	// Каждое сохранение занимает миллисекунду, последовательный хаб обработал бы нагрузку за 10 секунд
+	hub, clients, received, stop := startLoadHub(t, pairs, time.Millisecond)
+
+	start := time.Now()
+	sendLoad(hub, clients, perClient)
+
+	// Каждое сообщение доставляется получателю и возвращается отправителю
+	expected := int64(pairs * perClient * 2)
+	waitReceived(t, received, expected, 30*time.Second)
+	elapsed := time.Since(start)
+	stop()
+
+	require.Equal(t, expected, received.Load())
+	t.Logf("%d клиентов, %d сообщений за %v (%.0f сообщений/с)",
+		pairs*2, pairs*perClient, elapsed, float64(pairs*perClient)/elapsed.Seconds())
+}
+
+func BenchmarkHub_ChatThroughput(b *testing.B) {
+	const pairs = 200
+
+	hub, clients, received, stop := startLoadHub(b, pairs, 0)
+	defer stop()
+
+	perClient := b.N/pairs + 1
+	b.ResetTimer()
+
+	sendLoad(hub, clients, perClient)
+	waitReceived(b, received, int64(pairs*perClient*2), time.Minute)
+
+	b.StopTimer()
+	b.ReportMetric(float64(pairs*perClient)/b.Elapsed().Seconds(), "msgs/s")
+}
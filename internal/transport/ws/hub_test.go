@@ -0,0 +1,545 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/metrics"
+	"ResuMatch/internal/usecase/mock"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	metrics.Init("ws_test")
+	os.Exit(m.Run())
+}
+
+func newTestClient(t testing.TB, hub *Hub, userID int, role entity.UserRole) *Client {
+	client := &Client{
+		hub:  hub,
+		send: make(chan Message, 8),
+		Key:  ConnectionKey{UserID: userID, Type: role},
+	}
+	hub.register <- client
+	waitRegistered(t, hub, client)
+	return client
+}
+
+// waitRegistered дожидается, пока хаб подпишет клиента: регистрация завершается уже после чтения из канала
+func waitRegistered(t testing.TB, hub *Hub, client *Client) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		hub.mu.Lock()
+		_, ok := hub.clients[client.Key][client]
+		hub.mu.Unlock()
+		if ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("клиент %+v не зарегистрирован", client.Key)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func receive(t *testing.T, client *Client) Message {
+	t.Helper()
+
+	select {
+	case msg, ok := <-client.send:
+		require.True(t, ok, "канал клиента закрыт")
+		return msg
+	case <-time.After(time.Second):
+		t.Fatalf("клиент %+v не получил сообщение", client.Key)
+	}
+	return Message{}
+}
+
+func requireNothingReceived(t *testing.T, client *Client) {
+	t.Helper()
+
+	select {
+	case msg := <-client.send:
+		t.Fatalf("клиент %+v получил лишнее сообщение: %+v", client.Key, msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHub_NotificationFanOut(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub(nil, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	firstTab := newTestClient(t, hub, 1, entity.EmployerRole)
+	secondTab := newTestClient(t, hub, 1, entity.EmployerRole)
+	applicantSameID := newTestClient(t, hub, 1, entity.ApplicantRole)
+
+	notification := &entity.NotificationPreview{ID: 10, Type: entity.ApplyNotificationType, ReceiverID: 1}
+	hub.Broadcast <- Message{Type: MessageTypeNotification, Payload: notification}
+
+	require.Equal(t, notification, receive(t, firstTab).Payload)
+	require.Equal(t, notification, receive(t, secondTab).Payload)
+	requireNothingReceived(t, applicantSameID)
+}
+
+func TestHub_UnregisterKeepsOtherTabs(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub(nil, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	closedTab := newTestClient(t, hub, 2, entity.EmployerRole)
+	openTab := newTestClient(t, hub, 2, entity.EmployerRole)
+
+	hub.unregister <- closedTab
+	// Повторное отключение того же соединения не должно паниковать на закрытом канале
+	hub.unregister <- closedTab
+
+	_, ok := <-closedTab.send
+	require.False(t, ok)
+
+	notification := &entity.NotificationPreview{ID: 11, Type: entity.ApplyNotificationType, ReceiverID: 2}
+	hub.Broadcast <- Message{Type: MessageTypeNotification, Payload: notification}
+
+	require.Equal(t, notification, receive(t, openTab).Payload)
+}
+
+func TestHub_ChatMessageFanOut(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	senderBrowser := newTestClient(t, hub, 3, entity.ApplicantRole)
+	senderPhone := newTestClient(t, hub, 3, entity.ApplicantRole)
+	receiver := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	resp := &dto.MessageResponse{ID: 100, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Payload: "привет"}
+	chatUC.EXPECT().
+		SendMessage(gomock.Any(), 7, 3, "applicant", "привет", "", nil).
+		Return(&dto.ChatEvent{Message: resp, EmployerMessage: resp, ApplicantID: 3, EmployerID: 4}, nil)
+
+	hub.Broadcast <- Message{
+		Type: MessageTypeChat,
+		Payload: dto.MessageRequest{
+			ChatID:     7,
+			SenderID:   3,
+			SenderRole: entity.ApplicantRole,
+			Payload:    "привет",
+		},
+	}
+
+	require.Equal(t, resp, receive(t, receiver).Payload)
+	require.Equal(t, resp, receive(t, senderBrowser).Payload)
+	require.Equal(t, resp, receive(t, senderPhone).Payload)
+}
+
+func TestHub_SlowConsumerDisconnected(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub(nil, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	slowTab := &Client{
+		hub:  hub,
+		send: make(chan Message, 1),
+		Key:  ConnectionKey{UserID: 5, Type: entity.EmployerRole},
+	}
+	hub.register <- slowTab
+	waitRegistered(t, hub, slowTab)
+	healthyTab := newTestClient(t, hub, 5, entity.EmployerRole)
+
+	for i := 1; i <= 3; i++ {
+		hub.Broadcast <- Message{
+			Type:    MessageTypeNotification,
+			Payload: &entity.NotificationPreview{ID: i, Type: entity.ApplyNotificationType, ReceiverID: 5},
+		}
+		receive(t, healthyTab)
+	}
+
+	_, ok := <-slowTab.send
+	require.True(t, ok, "первое сообщение помещается в буфер")
+	_, ok = <-slowTab.send
+	require.False(t, ok, "переполненное соединение должно быть закрыто")
+}
+
+func TestHub_SlowPersistenceDoesNotBlockOthers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	release := make(chan struct{})
+	defer close(release)
+	chatUC.EXPECT().
+		SendMessage(gomock.Any(), 1, 3, "applicant", "медленно", "", nil).
+		DoAndReturn(func(ctx context.Context, _, _ int, _, _, _ string, _ []int) (*dto.ChatEvent, error) {
+			select {
+			case <-release:
+				return nil, errors.New("тест завершен")
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		})
+
+	receiver := newTestClient(t, hub, 2, entity.EmployerRole)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeChat,
+		Payload: dto.MessageRequest{ChatID: 1, SenderID: 3, SenderRole: entity.ApplicantRole, Payload: "медленно"},
+	}
+	notification := &entity.NotificationPreview{ID: 1, Type: entity.ApplyNotificationType, ReceiverID: 2}
+	hub.Broadcast <- Message{Type: MessageTypeNotification, Payload: notification}
+
+	require.Equal(t, notification, receive(t, receiver).Payload)
+}
+
+func TestHub_AckGoesToOriginOnly(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	originTab := newTestClient(t, hub, 3, entity.ApplicantRole)
+	otherTab := newTestClient(t, hub, 3, entity.ApplicantRole)
+
+	sentAt := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	resp := &dto.MessageResponse{ID: 101, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Payload: "привет", ClientID: "c-1", SentAt: sentAt}
+	chatUC.EXPECT().
+		SendMessage(gomock.Any(), 7, 3, "applicant", "привет", "c-1", nil).
+		Return(&dto.ChatEvent{Message: resp, EmployerMessage: resp, ApplicantID: 3, EmployerID: 4}, nil)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeChat,
+		Payload: dto.MessageRequest{ChatID: 7, SenderID: 3, SenderRole: entity.ApplicantRole, Payload: "привет", ClientID: "c-1"},
+		origin:  originTab,
+	}
+
+	ack := receive(t, originTab)
+	require.Equal(t, MessageTypeAck, ack.Type)
+	require.Equal(t, dto.MessageAck{ClientID: "c-1", ChatID: 7, MessageID: 101, SentAt: sentAt}, ack.Payload)
+	require.Equal(t, resp, receive(t, originTab).Payload)
+
+	require.Equal(t, resp, receive(t, otherTab).Payload)
+	requireNothingReceived(t, otherTab)
+}
+
+func TestHub_ErrorFrameOnFailedSend(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	origin := newTestClient(t, hub, 3, entity.ApplicantRole)
+
+	chatUC.EXPECT().
+		SendMessage(gomock.Any(), 8, 3, "applicant", "чужой чат", "c-2", nil).
+		Return(nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату")))
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeChat,
+		Payload: dto.MessageRequest{ChatID: 8, SenderID: 3, SenderRole: entity.ApplicantRole, Payload: "чужой чат", ClientID: "c-2"},
+		origin:  origin,
+	}
+
+	frame := receive(t, origin)
+	require.Equal(t, MessageTypeError, frame.Type)
+	require.Equal(t, dto.MessageError{
+		ClientID: "c-2",
+		ChatID:   8,
+		Status:   http.StatusForbidden,
+		Message:  "у вас нет доступа к этому чату",
+	}, frame.Payload)
+}
+
+func TestHub_SyncReplaysMissedEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+	notificationUC := mock.NewMockNotification(ctrl)
+
+	hub := NewHub(chatUC, notificationUC, nil, NewMemoryBroker())
+	go hub.Run()
+
+	origin := newTestClient(t, hub, 4, entity.EmployerRole)
+	otherTab := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	missed := dto.MessagesResponseList{
+		{ID: 51, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Payload: "первое"},
+		{ID: 52, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Payload: "второе"},
+	}
+	notification := &entity.NotificationPreview{ID: 9, Type: entity.ApplyNotificationType, ReceiverID: 4}
+
+	chatUC.EXPECT().GetMessagesAfter(gomock.Any(), 4, "employer", 50, syncLimit).Return(missed, nil)
+	notificationUC.EXPECT().GetNotificationsAfter(gomock.Any(), 4, "employer", 8, syncLimit).Return([]*entity.NotificationPreview{notification}, nil)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeSync,
+		Payload: dto.SyncRequest{UserID: 4, Role: entity.EmployerRole, LastMessageID: 50, LastNotificationID: 8},
+		origin:  origin,
+	}
+
+	require.Equal(t, missed[0], receive(t, origin).Payload)
+	require.Equal(t, missed[1], receive(t, origin).Payload)
+	require.Equal(t, notification, receive(t, origin).Payload)
+
+	done := receive(t, origin)
+	require.Equal(t, MessageTypeSync, done.Type)
+	require.Equal(t, dto.SyncResponse{LastMessageID: 52, LastNotificationID: 9, Complete: true}, done.Payload)
+
+	requireNothingReceived(t, otherTab)
+}
+
+func TestHub_TypingRelayedToPeer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	typist := newTestClient(t, hub, 3, entity.ApplicantRole)
+	peer := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	chatUC.EXPECT().GetChatPeer(gomock.Any(), 7, 3, "applicant").Return(&dto.ChatPeer{ID: 4}, nil)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeTyping,
+		Payload: dto.TypingRequest{ChatID: 7, UserID: 3, Role: entity.ApplicantRole, Typing: true},
+		origin:  typist,
+	}
+
+	frame := receive(t, peer)
+	require.Equal(t, MessageTypeTyping, frame.Type)
+	require.Equal(t, dto.TypingEvent{ChatID: 7, UserID: 3, Typing: true}, frame.Payload)
+	requireNothingReceived(t, typist)
+}
+
+func TestHub_HiddenApplicantMaskedForEmployer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	applicant := newTestClient(t, hub, 3, entity.ApplicantRole)
+	employer := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	message := &dto.MessageResponse{ID: 100, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Avatar: "avatar.png", Payload: "привет"}
+	masked := &dto.MessageResponse{ID: 100, ChatID: 7, ReceiverID: 4, FromApplicant: true, Payload: "привет"}
+	chatUC.EXPECT().
+		SendMessage(gomock.Any(), 7, 3, "applicant", "привет", "", nil).
+		Return(&dto.ChatEvent{Message: message, EmployerMessage: masked, ApplicantID: 3, EmployerID: 4}, nil)
+	chatUC.EXPECT().GetChatPeer(gomock.Any(), 7, 3, "applicant").Return(&dto.ChatPeer{ID: 4, UserHidden: true}, nil)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeChat,
+		Payload: dto.MessageRequest{ChatID: 7, SenderID: 3, SenderRole: entity.ApplicantRole, Payload: "привет"},
+	}
+
+	require.Equal(t, message, receive(t, applicant).Payload)
+	require.Equal(t, masked, receive(t, employer).Payload)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeTyping,
+		Payload: dto.TypingRequest{ChatID: 7, UserID: 3, Role: entity.ApplicantRole, Typing: true},
+		origin:  applicant,
+	}
+
+	frame := receive(t, employer)
+	require.Equal(t, MessageTypeTyping, frame.Type)
+	require.Equal(t, dto.TypingEvent{ChatID: 7, Typing: true}, frame.Payload)
+}
+
+func TestHub_EditAndDeleteUpdateBothParticipants(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	author := newTestClient(t, hub, 3, entity.ApplicantRole)
+	peer := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	now := time.Now()
+	edited := &dto.MessageResponse{ID: 9, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Payload: "fixed", EditedAt: &now}
+	chatUC.EXPECT().EditMessage(gomock.Any(), 7, 9, 3, "applicant", "fixed").Return(edited, nil)
+	chatUC.EXPECT().GetChatEvent(gomock.Any(), 9).Return(&dto.ChatEvent{Message: edited, EmployerMessage: edited, ApplicantID: 3, EmployerID: 4}, nil)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeEdit,
+		Payload: dto.MessageEditRequest{ChatID: 7, MessageID: 9, UserID: 3, Role: entity.ApplicantRole, Payload: "fixed"},
+		origin:  author,
+	}
+
+	// Правка из websocket рассылается так же, как правка через HTTP: по событию outbox
+	sink := NewChatSink(hub, chatUC)
+	require.NoError(t, sink.Deliver(context.Background(), &entity.OutboxEvent{
+		ID:      1,
+		Type:    entity.MessageEditedEvent,
+		Objects: map[string]int{"chat_id": 7, "message_id": 9},
+	}))
+
+	for _, client := range []*Client{peer, author} {
+		frame := receive(t, client)
+		require.Equal(t, MessageTypeEdit, frame.Type)
+		require.Equal(t, edited, frame.Payload)
+	}
+
+	// Удаление уже сохранено, хаб его только рассылает
+	deleted := &dto.MessageResponse{ID: 9, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Deleted: true}
+	hub.Broadcast <- Message{Type: MessageTypeDelete, Payload: &dto.ChatEvent{Message: deleted, EmployerMessage: deleted, ApplicantID: 3, EmployerID: 4}}
+
+	for _, client := range []*Client{peer, author} {
+		frame := receive(t, client)
+		require.Equal(t, MessageTypeDelete, frame.Type)
+		require.Equal(t, deleted, frame.Payload)
+	}
+}
+
+func TestHub_SystemMessageReachesBothParticipants(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub(nil, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	applicant := newTestClient(t, hub, 3, entity.ApplicantRole)
+	employer := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	system := &dto.MessageResponse{
+		ID: 10, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: "Работодатель скачал резюме",
+	}
+	hub.Broadcast <- Message{
+		Type:    MessageTypeChat,
+		Payload: &dto.ChatEvent{Message: system, EmployerMessage: system, ApplicantID: 3, EmployerID: 4},
+	}
+
+	for _, client := range []*Client{applicant, employer} {
+		frame := receive(t, client)
+		require.Equal(t, MessageTypeChat, frame.Type)
+		require.Equal(t, system, frame.Payload)
+	}
+}
+
+func TestHub_ErrorFrameOnForbiddenEdit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(chatUC, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	intruder := newTestClient(t, hub, 4, entity.EmployerRole)
+
+	chatUC.EXPECT().DeleteMessage(gomock.Any(), 7, 9, 4, "employer").
+		Return(nil, entity.NewError(entity.ErrForbidden, errors.New("можно изменять только свои сообщения")))
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeDelete,
+		Payload: dto.MessageEditRequest{ChatID: 7, MessageID: 9, UserID: 4, Role: entity.EmployerRole, Delete: true, ClientID: "d-1"},
+		origin:  intruder,
+	}
+
+	frame := receive(t, intruder)
+	require.Equal(t, MessageTypeError, frame.Type)
+	require.Equal(t, dto.MessageError{
+		ClientID: "d-1",
+		ChatID:   7,
+		Status:   http.StatusForbidden,
+		Message:  "можно изменять только свои сообщения",
+	}, frame.Payload)
+}
+
+func TestHub_PresenceChangesPushedToPeers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+	presenceUC := mock.NewMockPresence(ctrl)
+
+	hub := NewHub(chatUC, nil, presenceUC, NewMemoryBroker())
+	go hub.Run()
+
+	presenceUC.EXPECT().Connect(gomock.Any(), 6, "employer", "peer").Return(true, nil)
+	presenceUC.EXPECT().GetPresence(gomock.Any(), 6, "employer").Return(&dto.PresenceResponse{UserID: 6, Role: entity.EmployerRole, Online: true}, nil)
+	chatUC.EXPECT().GetChatPeers(gomock.Any(), 6, "employer").Return([]*dto.ChatPeer{}, nil)
+
+	peer := &Client{hub: hub, send: make(chan Message, 8), Key: ConnectionKey{UserID: 6, Type: entity.EmployerRole}, id: "peer"}
+	hub.register <- peer
+	waitRegistered(t, hub, peer)
+
+	lastSeen := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	online := &dto.PresenceResponse{UserID: 5, Role: entity.ApplicantRole, Online: true}
+	offline := &dto.PresenceResponse{UserID: 5, Role: entity.ApplicantRole, LastSeen: &lastSeen}
+
+	gomock.InOrder(
+		presenceUC.EXPECT().Connect(gomock.Any(), 5, "applicant", "browser").Return(true, nil),
+		presenceUC.EXPECT().GetPresence(gomock.Any(), 5, "applicant").Return(online, nil),
+		chatUC.EXPECT().GetChatPeers(gomock.Any(), 5, "applicant").Return([]*dto.ChatPeer{{ID: 6}}, nil),
+		presenceUC.EXPECT().Connect(gomock.Any(), 5, "applicant", "phone").Return(false, nil),
+		presenceUC.EXPECT().Disconnect(gomock.Any(), 5, "applicant", "browser").Return(false, nil),
+		presenceUC.EXPECT().Disconnect(gomock.Any(), 5, "applicant", "phone").Return(true, nil),
+		presenceUC.EXPECT().GetPresence(gomock.Any(), 5, "applicant").Return(offline, nil),
+		chatUC.EXPECT().GetChatPeers(gomock.Any(), 5, "applicant").Return([]*dto.ChatPeer{{ID: 6}}, nil),
+	)
+
+	browser := &Client{hub: hub, send: make(chan Message, 8), Key: ConnectionKey{UserID: 5, Type: entity.ApplicantRole}, id: "browser"}
+	phone := &Client{hub: hub, send: make(chan Message, 8), Key: ConnectionKey{UserID: 5, Type: entity.ApplicantRole}, id: "phone"}
+	hub.register <- browser
+	waitRegistered(t, hub, browser)
+
+	frame := receive(t, peer)
+	require.Equal(t, MessageTypePresence, frame.Type)
+	require.Equal(t, online, frame.Payload)
+
+	hub.register <- phone
+	waitRegistered(t, hub, phone)
+	hub.unregister <- browser
+	requireNothingReceived(t, peer)
+
+	hub.unregister <- phone
+	frame = receive(t, peer)
+	require.Equal(t, MessageTypePresence, frame.Type)
+	require.Equal(t, offline, frame.Payload)
+}
+
+func TestClient_TypingThrottled(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{}
+
+	require.True(t, client.allowTyping(7, true))
+	require.False(t, client.allowTyping(7, true))
+	require.True(t, client.allowTyping(8, true), "набор в другом чате не должен подавляться")
+	require.True(t, client.allowTyping(8, false), "окончание набора передается всегда")
+	require.True(t, client.allowTyping(8, true))
+}
@@ -0,0 +1,114 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/usecase"
+	"context"
+)
+
+// NotificationSink создает уведомление по событию из outbox и отправляет его превью получателю.
+// Превью уходит через хаб уже после сохранения: клиент, который был не в сети, получит
+// уведомление при догрузке после переподключения
+type NotificationSink struct {
+	hub      *Hub
+	notifyUC usecase.Notification
+}
+
+func NewNotificationSink(hub *Hub, notification usecase.Notification) *NotificationSink {
+	return &NotificationSink{
+		hub:      hub,
+		notifyUC: notification,
+	}
+}
+
+func (s *NotificationSink) Name() string {
+	return "notification"
+}
+
+func (s *NotificationSink) Deliver(ctx context.Context, event *entity.OutboxEvent) error {
+	if event.Notification == nil {
+		return nil
+	}
+
+	// Уведомление помечается событием, чтобы повтор после сбоя отправки не создал его еще раз
+	event.Notification.OutboxEventID = event.ID
+	preview, err := s.notifyUC.CreateNotification(ctx, event.Notification)
+	if err != nil {
+		return err
+	}
+	if preview == nil {
+		return nil
+	}
+	return s.hub.send(ctx, Message{
+		Type:    MessageTypeNotification,
+		Payload: preview,
+	})
+}
+
+// ChatSink добавляет системные сообщения о событии в чаты, которых оно касается, и рассылает их участникам.
+// Если сообщение уже сохранено вместе с событием, как правка или приглашение, оно только рассылается
+type ChatSink struct {
+	hub    *Hub
+	chatUC usecase.Chat
+}
+
+func NewChatSink(hub *Hub, chat usecase.Chat) *ChatSink {
+	return &ChatSink{
+		hub:    hub,
+		chatUC: chat,
+	}
+}
+
+func (s *ChatSink) Name() string {
+	return "chat"
+}
+
+func (s *ChatSink) Deliver(ctx context.Context, event *entity.OutboxEvent) error {
+	if messageID, ok := event.Objects["message_id"]; ok {
+		return s.deliverMessage(ctx, event.Type, messageID)
+	}
+	if event.Notification == nil {
+		return nil
+	}
+
+	events, err := s.chatUC.RecordEvent(ctx, event.ID, event.Notification)
+	if err != nil {
+		return err
+	}
+	for _, chatEvent := range events {
+		if err := s.hub.send(ctx, Message{
+			Type:    MessageTypeChat,
+			Payload: chatEvent,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliverMessage рассылает участникам сохраненное сообщение чата тем кадром, который соответствует событию
+func (s *ChatSink) deliverMessage(ctx context.Context, eventType entity.EventType, messageID int) error {
+	chatEvent, err := s.chatUC.GetChatEvent(ctx, messageID)
+	if err != nil {
+		return err
+	}
+
+	switch eventType {
+	case entity.MessageEditedEvent:
+		return s.hub.send(ctx, Message{Type: MessageTypeEdit, Payload: chatEvent})
+	case entity.MessageDeletedEvent:
+		return s.hub.send(ctx, Message{Type: MessageTypeDelete, Payload: chatEvent})
+	default:
+		return s.hub.send(ctx, Message{Type: MessageTypeChat, Payload: chatEvent})
+	}
+}
+
+// send передает сообщение хабу, не блокируя отправителя дольше, чем живет его контекст
+func (h *Hub) send(ctx context.Context, message Message) error {
+	select {
+	case h.Broadcast <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,70 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/usecase/mock"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSinks_KeyByOutboxEvent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	notifyUC := mock.NewMockNotification(ctrl)
+	chatUC := mock.NewMockChat(ctrl)
+
+	notification := &entity.Notification{Type: entity.DownloadResumeType, SenderID: 10, ReceiverID: 20, ObjectID: 5}
+	event := &entity.OutboxEvent{ID: 7, Type: entity.ResumeDownloadedEvent, Notification: notification}
+
+	// Повторная доставка того же события приходит с тем же id, и репозитории не создают записи заново
+	notifyUC.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, n *entity.Notification) (*entity.NotificationPreview, error) {
+			require.Equal(t, 7, n.OutboxEventID)
+			return nil, nil
+		}).Times(2)
+	chatUC.EXPECT().RecordEvent(gomock.Any(), 7, notification).Return(nil, nil).Times(2)
+
+	notificationSink := NewNotificationSink(nil, notifyUC)
+	chatSink := NewChatSink(nil, chatUC)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, notificationSink.Deliver(context.Background(), event))
+		require.NoError(t, chatSink.Deliver(context.Background(), event))
+	}
+}
+
+func TestChatSink_BroadcastsSavedMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+
+	hub := NewHub(nil, nil, nil, NewMemoryBroker())
+	go hub.Run()
+
+	applicant := newTestClient(t, hub, 20, entity.ApplicantRole)
+	employer := newTestClient(t, hub, 10, entity.EmployerRole)
+
+	// Сообщение о принятом приглашении уже сохранено вместе с событием: повторно оно не записывается
+	// через RecordEvent, хотя событие несет уведомление об отклике
+	message := &dto.MessageResponse{ID: 100, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventInvitationAccepted}
+	chatUC.EXPECT().GetChatEvent(gomock.Any(), 100).Return(&dto.ChatEvent{Message: message, EmployerMessage: message, ApplicantID: 20, EmployerID: 10}, nil)
+
+	sink := NewChatSink(hub, chatUC)
+	require.NoError(t, sink.Deliver(context.Background(), &entity.OutboxEvent{
+		ID:           3,
+		Type:         entity.InvitationAnsweredEvent,
+		Notification: &entity.Notification{Type: entity.ApplyNotificationType, SenderID: 20, ReceiverID: 10, ObjectID: 5},
+		Objects:      map[string]int{"invitation_id": 1, "chat_id": 7, "message_id": 100},
+	}))
+
+	for _, client := range []*Client{applicant, employer} {
+		frame := receive(t, client)
+		require.Equal(t, MessageTypeChat, frame.Type)
+		require.Equal(t, message, frame.Payload)
+	}
+}
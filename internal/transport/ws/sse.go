@@ -0,0 +1,219 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// streamCursor - последние сообщение и уведомление, отправленные в поток.
+// Передается браузеру в поле id, и EventSource возвращает его в Last-Event-ID при переподключении
+type streamCursor struct {
+	messageID      int
+	notificationID int
+}
+
+func (c streamCursor) String() string {
+	return fmt.Sprintf("%d:%d", c.messageID, c.notificationID)
+}
+
+func parseStreamCursor(value string) (streamCursor, bool) {
+	messageID, notificationID, ok := strings.Cut(value, ":")
+	if !ok {
+		return streamCursor{}, false
+	}
+
+	var cursor streamCursor
+	var err error
+	if cursor.messageID, err = strconv.Atoi(messageID); err != nil || cursor.messageID < 0 {
+		return streamCursor{}, false
+	}
+	if cursor.notificationID, err = strconv.Atoi(notificationID); err != nil || cursor.notificationID < 0 {
+		return streamCursor{}, false
+	}
+	return cursor, true
+}
+
+// advance сдвигает курсор по кадру. Кадры, пришедшие через Redis, содержат сырой JSON,
+// поэтому id в них читается из payload
+func (c *streamCursor) advance(message Message) {
+	switch message.Type {
+	case MessageTypeChat:
+		c.messageID = max(c.messageID, payloadID(message.Payload))
+	case MessageTypeNotification:
+		c.notificationID = max(c.notificationID, payloadID(message.Payload))
+	case MessageTypeSync:
+		if resp, ok := message.Payload.(dto.SyncResponse); ok {
+			c.messageID = max(c.messageID, resp.LastMessageID)
+			c.notificationID = max(c.notificationID, resp.LastNotificationID)
+		}
+	}
+}
+
+func payloadID(payload interface{}) int {
+	switch p := payload.(type) {
+	case *dto.MessageResponse:
+		return p.ID
+	case *entity.NotificationPreview:
+		return p.ID
+	case json.RawMessage:
+		var decoded struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(p, &decoded); err != nil {
+			return 0
+		}
+		return decoded.ID
+	}
+	return 0
+}
+
+// ServeSSE отдает те же кадры, что и websocket, потоком Server-Sent Events для клиентов,
+// у которых websocket заблокирован прокси. Поток только принимает события: сообщения
+// отправляются через HTTP. Пропущенное во время обрыва догружается по Last-Event-ID
+func ServeSSE(hub *Hub, w http.ResponseWriter, r *http.Request, userID int, role string) {
+	var userRole entity.UserRole
+	switch role {
+	case "employer":
+		userRole = entity.EmployerRole
+	case "applicant":
+		userRole = entity.ApplicantRole
+	default:
+		http.Error(w, "неверная роль пользователя", http.StatusForbidden)
+		return
+	}
+
+	cursor, resume := parseStreamCursor(r.Header.Get("Last-Event-ID"))
+	if !resume {
+		// EventSource не умеет передавать заголовки при первом подключении,
+		// поэтому курсор из websocket-соединения можно передать в запросе
+		cursor, resume = parseStreamCursor(r.URL.Query().Get("last_event_id"))
+	}
+	if !resume {
+		// Новый поток начинается с последних событий пользователя. Иначе первый кадр одного типа
+		// оставил бы в курсоре ноль для другого, и переподключение догрузило бы всю историю
+		head, err := hub.streamHead(r.Context(), userID, role)
+		if err != nil {
+			l.Log.Errorf("Не удалось получить последние события пользователя %d: %v", userID, err)
+			http.Error(w, "не удалось открыть поток событий", http.StatusInternalServerError)
+			return
+		}
+		cursor = head
+	}
+
+	rc := http.NewResponseController(w)
+	// Поток живет дольше таймаута записи сервера, поэтому таймаут задается на каждый кадр отдельно
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		l.Log.Warnf("Не удалось снять таймаут записи для потока событий: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Отключает буферизацию ответа в nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	client := &Client{
+		hub:  hub,
+		send: make(chan Message, clientSendBuffer),
+		Key: ConnectionKey{
+			UserID: userID,
+			Type:   userRole,
+		},
+		id: uuid.NewString(),
+	}
+
+	if resume {
+		client.resume = &dto.SyncRequest{
+			UserID:             userID,
+			Role:               userRole,
+			LastMessageID:      cursor.messageID,
+			LastNotificationID: cursor.notificationID,
+		}
+	}
+
+	hub.register <- client
+
+	client.streamPump(w, rc, r, cursor)
+}
+
+// streamHead возвращает курсор, указывающий на последние сообщение и уведомление пользователя
+func (h *Hub) streamHead(ctx context.Context, userID int, role string) (streamCursor, error) {
+	messageID, err := h.chatUC.GetLastMessageID(ctx, userID, role)
+	if err != nil {
+		return streamCursor{}, err
+	}
+	notificationID, err := h.notifyUC.GetLastNotificationID(ctx, userID, role)
+	if err != nil {
+		return streamCursor{}, err
+	}
+	return streamCursor{messageID: messageID, notificationID: notificationID}, nil
+}
+
+// streamPump пишет кадры в поток до отключения клиента. Комментарий keep-alive не дает
+// прокси закрыть молчащее соединение и продлевает статус присутствия, как pong у websocket
+func (c *Client) streamPump(w http.ResponseWriter, rc *http.ResponseController, r *http.Request, cursor streamCursor) {
+	ticker := time.NewTicker(streamKeepAlive)
+
+	defer func() {
+		ticker.Stop()
+		c.hub.unregister <- c
+	}()
+
+	if err := c.writeStream(w, rc, fmt.Sprintf("retry: %d\n\n", streamRetry.Milliseconds())); err != nil {
+		l.Log.Warnf("Ошибка при открытии потока событий: %v", err)
+		return
+	}
+
+	for {
+		select {
+		case msg, ok := <-c.send:
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(msg)
+			if err != nil {
+				l.Log.Errorf("Ошибка при кодировании события: %v", err)
+				continue
+			}
+			cursor.advance(msg)
+
+			if err = c.writeStream(w, rc, fmt.Sprintf("id: %s\ndata: %s\n\n", cursor, data)); err != nil {
+				l.Log.Warnf("Ошибка при отправке события: %v", err)
+				return
+			}
+
+		case <-ticker.C:
+			if err := c.writeStream(w, rc, ": keep-alive\n\n"); err != nil {
+				l.Log.Warnf("Ошибка при отправке keep-alive: %v", err)
+				return
+			}
+			c.hub.trackPresence(c, true)
+
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func (c *Client) writeStream(w http.ResponseWriter, rc *http.ResponseController, frame string) error {
+	if err := rc.SetWriteDeadline(time.Now().Add(writeWait)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err := w.Write([]byte(frame)); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
@@ -0,0 +1,168 @@
+package ws
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/usecase/mock"
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type streamEvent struct {
+	id   string
+	data string
+}
+
+func readStreamEvent(t *testing.T, events <-chan streamEvent) streamEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "поток событий закрыт")
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("поток не прислал событие")
+	}
+	return streamEvent{}
+}
+
+// scanStream разбирает text/event-stream на события, пропуская retry и комментарии
+func scanStream(resp *http.Response) <-chan streamEvent {
+	events := make(chan streamEvent, 16)
+	go func() {
+		defer close(events)
+
+		var event streamEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				event.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			case line == "" && event.data != "":
+				events <- event
+				event = streamEvent{}
+			}
+		}
+	}()
+	return events
+}
+
+func TestServeSSE_ReplaysAndStreamsFrames(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+	notificationUC := mock.NewMockNotification(ctrl)
+
+	hub := NewHub(chatUC, notificationUC, nil, NewMemoryBroker())
+	go hub.Run()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeSSE(hub, w, r, 4, "employer")
+	}))
+	defer server.Close()
+
+	missed := dto.MessagesResponseList{
+		{ID: 51, ChatID: 7, SenderID: 3, ReceiverID: 4, FromApplicant: true, Payload: "пропущенное"},
+	}
+	chatUC.EXPECT().GetMessagesAfter(gomock.Any(), 4, "employer", 50, syncLimit).Return(missed, nil)
+	notificationUC.EXPECT().GetNotificationsAfter(gomock.Any(), 4, "employer", 8, syncLimit).Return(nil, nil)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "50:8")
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := scanStream(resp)
+
+	replayed := readStreamEvent(t, events)
+	require.Equal(t, "51:8", replayed.id)
+	var frame struct {
+		Type    MessageType         `json:"type"`
+		Payload dto.MessageResponse `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(replayed.data), &frame))
+	require.Equal(t, MessageTypeChat, frame.Type)
+	require.Equal(t, *missed[0], frame.Payload)
+
+	done := readStreamEvent(t, events)
+	require.Equal(t, "51:8", done.id)
+	require.Contains(t, done.data, `"type":"sync"`)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeNotification,
+		Payload: &entity.NotificationPreview{ID: 12, Type: entity.ApplyNotificationType, ReceiverID: 4},
+	}
+
+	live := readStreamEvent(t, events)
+	require.Equal(t, "51:12", live.id)
+	require.Contains(t, live.data, `"type":"notification"`)
+}
+
+func TestServeSSE_NewStreamStartsFromLatestEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	chatUC := mock.NewMockChat(ctrl)
+	notificationUC := mock.NewMockNotification(ctrl)
+
+	hub := NewHub(chatUC, notificationUC, nil, NewMemoryBroker())
+	go hub.Run()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeSSE(hub, w, r, 4, "employer")
+	}))
+	defer server.Close()
+
+	chatUC.EXPECT().GetLastMessageID(gomock.Any(), 4, "employer").Return(40, nil)
+	notificationUC.EXPECT().GetLastNotificationID(gomock.Any(), 4, "employer").Return(9, nil)
+
+	resp, err := server.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	events := scanStream(resp)
+
+	hub.Broadcast <- Message{
+		Type:    MessageTypeNotification,
+		Payload: &entity.NotificationPreview{ID: 12, Type: entity.ApplyNotificationType, ReceiverID: 4},
+	}
+
+	// Половина курсора для сообщений не обнуляется, и переподключение не догрузит всю переписку
+	live := readStreamEvent(t, events)
+	require.Equal(t, "40:12", live.id)
+}
+
+func TestStreamCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor, ok := parseStreamCursor("10:3")
+	require.True(t, ok)
+	require.Equal(t, streamCursor{messageID: 10, notificationID: 3}, cursor)
+
+	for _, value := range []string{"", "10", "a:3", "10:-1"} {
+		_, ok = parseStreamCursor(value)
+		require.False(t, ok, value)
+	}
+
+	// Кадры из Redis приходят с сырым payload, а правки старых сообщений не сдвигают курсор
+	cursor.advance(Message{Type: MessageTypeChat, Payload: json.RawMessage(`{"id":15}`)})
+	cursor.advance(Message{Type: MessageTypeNotification, Payload: json.RawMessage(`{"id":2}`)})
+	cursor.advance(Message{Type: MessageTypeEdit, Payload: &dto.MessageResponse{ID: 20}})
+	require.Equal(t, "15:3", cursor.String())
+}
@@ -10,12 +10,35 @@ type MessageType string
 const (
 	MessageTypeChat         MessageType = "message"
 	MessageTypeNotification MessageType = "notification"
+	MessageTypeRead         MessageType = "read"
+	MessageTypeAck          MessageType = "ack"
+	MessageTypeError        MessageType = "error"
+	MessageTypeSync         MessageType = "sync"
+	MessageTypeTyping       MessageType = "typing"
+	MessageTypePresence     MessageType = "presence"
+	MessageTypeEdit         MessageType = "edit"
+	MessageTypeDelete       MessageType = "delete"
 )
 
 const (
 	pongWait   = 60 * time.Second
 	pingPeriod = 55 * time.Second
 	writeWait  = 10 * time.Second
+	// streamKeepAlive меньше типичного таймаута простоя у прокси, которые и мешают websocket
+	streamKeepAlive = 25 * time.Second
+	// streamRetry - через сколько EventSource переподключается после обрыва
+	streamRetry = 3 * time.Second
+)
+
+const (
+	clientSendBuffer = 256
+	hubWorkers       = 8
+	workerQueueSize  = 256
+	persistTimeout   = 5 * time.Second
+	// syncLimit меньше буфера клиента, чтобы догрузка не закрыла соединение как медленное
+	syncLimit = 100
+	// typingThrottle - как часто одно соединение может сообщать о наборе текста в один чат
+	typingThrottle = 2 * time.Second
 )
 
 type ConnectionKey struct {
@@ -26,4 +49,13 @@ type ConnectionKey struct {
 type Message struct {
 	Type    MessageType `json:"type"`
 	Payload interface{} `json:"payload"`
+	// origin - соединение, из которого пришел запрос. Ему адресуются ack, ошибки и догрузка
+	origin *Client
+}
+
+// presenceChange - подключение или отключение соединения, которое нужно учесть в статусе пользователя
+type presenceChange struct {
+	key       ConnectionKey
+	connID    string
+	connected bool
 }
@@ -22,6 +22,12 @@ func (h *WebsocketHandler) Configure(r *http.ServeMux) {
 	wsMux.HandleFunc("GET /connect", h.HandleWebsocket)
 
 	r.Handle("/ws/", http.StripPrefix("/ws", wsMux))
+
+	eventsMux := http.NewServeMux()
+
+	eventsMux.HandleFunc("GET /stream", h.HandleEventStream)
+
+	r.Handle("/events/", http.StripPrefix("/events", eventsMux))
 }
 
 func (h *WebsocketHandler) HandleWebsocket(w http.ResponseWriter, r *http.Request) {
@@ -40,6 +46,34 @@ func (h *WebsocketHandler) HandleWebsocket(w http.ResponseWriter, r *http.Reques
 	ServeWs(h.wsHub, w, r, userID, role)
 }
 
+// HandleEventStream godoc
+// @Tags Events
+// @Summary Поток событий (SSE)
+// @Description Запасной канал для клиентов, у которых заблокирован websocket. Отдает те же кадры message и notification,
+// @Description что и /ws/connect, в формате text/event-stream. При переподключении пропущенные события догружаются
+// @Description по заголовку Last-Event-ID или параметру last_event_id. Требует авторизации.
+// @Produce text/event-stream
+// @Param last_event_id query string false "Курсор последнего полученного события"
+// @Success 200 {string} string "Поток событий"
+// @Failure 401 {object} utils.APIError "Не авторизован"
+// @Failure 403 {object} utils.APIError "Неверная роль пользователя"
+// @Router /events/stream [get]
+func (h *WebsocketHandler) HandleEventStream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+	userID, role, err := h.auth.GetUserIDBySession(ctx, cookie.Value)
+	if err != nil {
+		utils.WriteError(w, http.StatusUnauthorized, entity.ErrUnauthorized)
+		return
+	}
+	ServeSSE(h.wsHub, w, r, userID, role)
+}
+
 //
 //import (
 //	"ResuMatch/internal/entity"
@@ -9,6 +9,7 @@ type Applicant interface {
 	Register(context.Context, *dto.ApplicantRegister) (int, error)
 	Login(context.Context, *dto.Login) (int, error)
 	GetUser(context.Context, int) (*dto.ApplicantProfileResponse, error)
+	GetProfile(ctx context.Context, applicantID, userID int, role string) (*dto.ApplicantProfileResponse, error)
 	UpdateProfile(context.Context, int, *dto.ApplicantProfileUpdate) error
 	UpdateAvatar(context.Context, int, []byte) (*dto.UploadStaticResponse, error)
 	EmailExists(context.Context, string) (*dto.EmailExistsResponse, error)
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	"context"
 )
@@ -8,8 +9,26 @@ import (
 type Chat interface {
 	StartChat(ctx context.Context, vacancyID, resumeID, applicantID, employerID int) (int, error)
 	GetChat(ctx context.Context, chatID int, userID int, role string) (*dto.ChatResponse, error)
-	SendMessage(ctx context.Context, chatID, senderID int, role string, payload string) (*dto.MessageResponse, error)
-	GetUserChats(ctx context.Context, userID int, role string) (dto.ChatResponseList, error)
-	GetChatMessages(ctx context.Context, chatID int) (dto.MessagesResponseList, error)
+	SendMessage(ctx context.Context, chatID, senderID int, role string, payload, clientID string, attachmentIDs []int) (*dto.ChatEvent, error)
+	EditMessage(ctx context.Context, chatID, messageID, userID int, role, payload string) (*dto.MessageResponse, error)
+	DeleteMessage(ctx context.Context, chatID, messageID, userID int, role string) (*dto.MessageResponse, error)
+	GetMessageEdits(ctx context.Context, chatID, messageID, userID int, role string) (dto.MessageEditResponseList, error)
+	UploadAttachment(ctx context.Context, chatID, userID int, role, fileName string, data []byte) (*dto.AttachmentResponse, error)
+	GetAttachmentFile(ctx context.Context, chatID, attachmentID, userID int, role string) (*entity.AttachmentFile, error)
+	ExportChat(ctx context.Context, chatID, userID int, role string, format entity.ExportFormat) (*entity.AttachmentFile, error)
+	GetUserChats(ctx context.Context, userID int, role string, archived bool) (dto.ChatResponseList, error)
+	UpdateChatSettings(ctx context.Context, chatID, userID int, role string, req *dto.ChatSettingsRequest) (*dto.ChatSettingsResponse, error)
+	GetChatMessages(ctx context.Context, chatID int, role string, page entity.MessagePage) (dto.MessagesResponseList, error)
+	GetMessagesAfter(ctx context.Context, userID int, role string, afterID, limit int) (dto.MessagesResponseList, error)
+	GetLastMessageID(ctx context.Context, userID int, role string) (int, error)
+	SearchMessages(ctx context.Context, userID int, role string, query string, limit, offset int) (dto.MessageSearchResponseList, error)
+	InviteToVacancy(ctx context.Context, employerID, vacancyID, resumeID int) (*dto.InvitationResponse, error)
+	AnswerInvitation(ctx context.Context, invitationID, applicantID int, accept bool) (*dto.InvitationResponse, error)
+	RecordEvent(ctx context.Context, eventID int, notification *entity.Notification) ([]*dto.ChatEvent, error)
+	GetChatEvent(ctx context.Context, messageID int) (*dto.ChatEvent, error)
 	GetVacancyChat(ctx context.Context, vacancyID, applicantID int, role string) (*dto.ChatResponse, error)
+	MarkRead(ctx context.Context, chatID, userID int, role string, messageID int) (*dto.ReadEvent, error)
+	GetChatPeer(ctx context.Context, chatID, userID int, role string) (*dto.ChatPeer, error)
+	GetChatPeers(ctx context.Context, userID int, role string) ([]*dto.ChatPeer, error)
+	GetUnreadCount(ctx context.Context, userID int, role string) (*dto.UnreadCountResponse, error)
 }
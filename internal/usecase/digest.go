@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+)
+
+type Digest interface {
+	Run(ctx context.Context)
+	SendDigests(ctx context.Context) (int, error)
+	Unsubscribe(ctx context.Context, token string) error
+}
+
+// Mailer отправляет письма. Реализация выбирается при запуске приложения
+type Mailer interface {
+	Send(ctx context.Context, mail *entity.Mail) error
+}
@@ -56,6 +56,21 @@ func (mr *MockApplicantMockRecorder) EmailExists(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmailExists", reflect.TypeOf((*MockApplicant)(nil).EmailExists), arg0, arg1)
 }
 
+// GetProfile mocks base method.
+func (m *MockApplicant) GetProfile(ctx context.Context, applicantID, userID int, role string) (*dto.ApplicantProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, applicantID, userID, role)
+	ret0, _ := ret[0].(*dto.ApplicantProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockApplicantMockRecorder) GetProfile(ctx, applicantID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockApplicant)(nil).GetProfile), ctx, applicantID, userID, role)
+}
+
 // GetUser mocks base method.
 func (m *MockApplicant) GetUser(arg0 context.Context, arg1 int) (*dto.ApplicantProfileResponse, error) {
 	m.ctrl.T.Helper()
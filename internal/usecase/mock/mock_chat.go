@@ -10,6 +10,7 @@
 package mock
 
 import (
+	entity "ResuMatch/internal/entity"
 	dto "ResuMatch/internal/entity/dto"
 	context "context"
 	reflect "reflect"
@@ -41,6 +42,81 @@ func (m *MockChat) EXPECT() *MockChatMockRecorder {
 	return m.recorder
 }
 
+// AnswerInvitation mocks base method.
+func (m *MockChat) AnswerInvitation(ctx context.Context, invitationID, applicantID int, accept bool) (*dto.InvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerInvitation", ctx, invitationID, applicantID, accept)
+	ret0, _ := ret[0].(*dto.InvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnswerInvitation indicates an expected call of AnswerInvitation.
+func (mr *MockChatMockRecorder) AnswerInvitation(ctx, invitationID, applicantID, accept any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerInvitation", reflect.TypeOf((*MockChat)(nil).AnswerInvitation), ctx, invitationID, applicantID, accept)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChat) DeleteMessage(ctx context.Context, chatID, messageID, userID int, role string) (*dto.MessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, chatID, messageID, userID, role)
+	ret0, _ := ret[0].(*dto.MessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatMockRecorder) DeleteMessage(ctx, chatID, messageID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChat)(nil).DeleteMessage), ctx, chatID, messageID, userID, role)
+}
+
+// EditMessage mocks base method.
+func (m *MockChat) EditMessage(ctx context.Context, chatID, messageID, userID int, role, payload string) (*dto.MessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", ctx, chatID, messageID, userID, role, payload)
+	ret0, _ := ret[0].(*dto.MessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockChatMockRecorder) EditMessage(ctx, chatID, messageID, userID, role, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockChat)(nil).EditMessage), ctx, chatID, messageID, userID, role, payload)
+}
+
+// ExportChat mocks base method.
+func (m *MockChat) ExportChat(ctx context.Context, chatID, userID int, role string, format entity.ExportFormat) (*entity.AttachmentFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportChat", ctx, chatID, userID, role, format)
+	ret0, _ := ret[0].(*entity.AttachmentFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportChat indicates an expected call of ExportChat.
+func (mr *MockChatMockRecorder) ExportChat(ctx, chatID, userID, role, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportChat", reflect.TypeOf((*MockChat)(nil).ExportChat), ctx, chatID, userID, role, format)
+}
+
+// GetAttachmentFile mocks base method.
+func (m *MockChat) GetAttachmentFile(ctx context.Context, chatID, attachmentID, userID int, role string) (*entity.AttachmentFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentFile", ctx, chatID, attachmentID, userID, role)
+	ret0, _ := ret[0].(*entity.AttachmentFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentFile indicates an expected call of GetAttachmentFile.
+func (mr *MockChatMockRecorder) GetAttachmentFile(ctx, chatID, attachmentID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentFile", reflect.TypeOf((*MockChat)(nil).GetAttachmentFile), ctx, chatID, attachmentID, userID, role)
+}
+
 // GetChat mocks base method.
 func (m *MockChat) GetChat(ctx context.Context, chatID, userID int, role string) (*dto.ChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,34 +132,139 @@ func (mr *MockChatMockRecorder) GetChat(ctx, chatID, userID, role any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChat)(nil).GetChat), ctx, chatID, userID, role)
 }
 
+// GetChatEvent mocks base method.
+func (m *MockChat) GetChatEvent(ctx context.Context, messageID int) (*dto.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatEvent", ctx, messageID)
+	ret0, _ := ret[0].(*dto.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatEvent indicates an expected call of GetChatEvent.
+func (mr *MockChatMockRecorder) GetChatEvent(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatEvent", reflect.TypeOf((*MockChat)(nil).GetChatEvent), ctx, messageID)
+}
+
 // GetChatMessages mocks base method.
-func (m *MockChat) GetChatMessages(ctx context.Context, chatID int) (dto.MessagesResponseList, error) {
+func (m *MockChat) GetChatMessages(ctx context.Context, chatID int, role string, page entity.MessagePage) (dto.MessagesResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChatMessages", ctx, chatID)
+	ret := m.ctrl.Call(m, "GetChatMessages", ctx, chatID, role, page)
 	ret0, _ := ret[0].(dto.MessagesResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetChatMessages indicates an expected call of GetChatMessages.
-func (mr *MockChatMockRecorder) GetChatMessages(ctx, chatID any) *gomock.Call {
+func (mr *MockChatMockRecorder) GetChatMessages(ctx, chatID, role, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMessages", reflect.TypeOf((*MockChat)(nil).GetChatMessages), ctx, chatID, role, page)
+}
+
+// GetChatPeer mocks base method.
+func (m *MockChat) GetChatPeer(ctx context.Context, chatID, userID int, role string) (*dto.ChatPeer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatPeer", ctx, chatID, userID, role)
+	ret0, _ := ret[0].(*dto.ChatPeer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatPeer indicates an expected call of GetChatPeer.
+func (mr *MockChatMockRecorder) GetChatPeer(ctx, chatID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatPeer", reflect.TypeOf((*MockChat)(nil).GetChatPeer), ctx, chatID, userID, role)
+}
+
+// GetChatPeers mocks base method.
+func (m *MockChat) GetChatPeers(ctx context.Context, userID int, role string) ([]*dto.ChatPeer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatPeers", ctx, userID, role)
+	ret0, _ := ret[0].([]*dto.ChatPeer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatPeers indicates an expected call of GetChatPeers.
+func (mr *MockChatMockRecorder) GetChatPeers(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatPeers", reflect.TypeOf((*MockChat)(nil).GetChatPeers), ctx, userID, role)
+}
+
+// GetLastMessageID mocks base method.
+func (m *MockChat) GetLastMessageID(ctx context.Context, userID int, role string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessageID", ctx, userID, role)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessageID indicates an expected call of GetLastMessageID.
+func (mr *MockChatMockRecorder) GetLastMessageID(ctx, userID, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMessages", reflect.TypeOf((*MockChat)(nil).GetChatMessages), ctx, chatID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessageID", reflect.TypeOf((*MockChat)(nil).GetLastMessageID), ctx, userID, role)
+}
+
+// GetMessageEdits mocks base method.
+func (m *MockChat) GetMessageEdits(ctx context.Context, chatID, messageID, userID int, role string) (dto.MessageEditResponseList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageEdits", ctx, chatID, messageID, userID, role)
+	ret0, _ := ret[0].(dto.MessageEditResponseList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageEdits indicates an expected call of GetMessageEdits.
+func (mr *MockChatMockRecorder) GetMessageEdits(ctx, chatID, messageID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageEdits", reflect.TypeOf((*MockChat)(nil).GetMessageEdits), ctx, chatID, messageID, userID, role)
+}
+
+// GetMessagesAfter mocks base method.
+func (m *MockChat) GetMessagesAfter(ctx context.Context, userID int, role string, afterID, limit int) (dto.MessagesResponseList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesAfter", ctx, userID, role, afterID, limit)
+	ret0, _ := ret[0].(dto.MessagesResponseList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesAfter indicates an expected call of GetMessagesAfter.
+func (mr *MockChatMockRecorder) GetMessagesAfter(ctx, userID, role, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesAfter", reflect.TypeOf((*MockChat)(nil).GetMessagesAfter), ctx, userID, role, afterID, limit)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockChat) GetUnreadCount(ctx context.Context, userID int, role string) (*dto.UnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID, role)
+	ret0, _ := ret[0].(*dto.UnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockChatMockRecorder) GetUnreadCount(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockChat)(nil).GetUnreadCount), ctx, userID, role)
 }
 
 // GetUserChats mocks base method.
-func (m *MockChat) GetUserChats(ctx context.Context, userID int, role string) (dto.ChatResponseList, error) {
+func (m *MockChat) GetUserChats(ctx context.Context, userID int, role string, archived bool) (dto.ChatResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserChats", ctx, userID, role)
+	ret := m.ctrl.Call(m, "GetUserChats", ctx, userID, role, archived)
 	ret0, _ := ret[0].(dto.ChatResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserChats indicates an expected call of GetUserChats.
-func (mr *MockChatMockRecorder) GetUserChats(ctx, userID, role any) *gomock.Call {
+func (mr *MockChatMockRecorder) GetUserChats(ctx, userID, role, archived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserChats", reflect.TypeOf((*MockChat)(nil).GetUserChats), ctx, userID, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserChats", reflect.TypeOf((*MockChat)(nil).GetUserChats), ctx, userID, role, archived)
 }
 
 // GetVacancyChat mocks base method.
@@ -101,19 +282,79 @@ func (mr *MockChatMockRecorder) GetVacancyChat(ctx, vacancyID, applicantID, role
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVacancyChat", reflect.TypeOf((*MockChat)(nil).GetVacancyChat), ctx, vacancyID, applicantID, role)
 }
 
+// InviteToVacancy mocks base method.
+func (m *MockChat) InviteToVacancy(ctx context.Context, employerID, vacancyID, resumeID int) (*dto.InvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteToVacancy", ctx, employerID, vacancyID, resumeID)
+	ret0, _ := ret[0].(*dto.InvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteToVacancy indicates an expected call of InviteToVacancy.
+func (mr *MockChatMockRecorder) InviteToVacancy(ctx, employerID, vacancyID, resumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToVacancy", reflect.TypeOf((*MockChat)(nil).InviteToVacancy), ctx, employerID, vacancyID, resumeID)
+}
+
+// MarkRead mocks base method.
+func (m *MockChat) MarkRead(ctx context.Context, chatID, userID int, role string, messageID int) (*dto.ReadEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, chatID, userID, role, messageID)
+	ret0, _ := ret[0].(*dto.ReadEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatMockRecorder) MarkRead(ctx, chatID, userID, role, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChat)(nil).MarkRead), ctx, chatID, userID, role, messageID)
+}
+
+// RecordEvent mocks base method.
+func (m *MockChat) RecordEvent(ctx context.Context, eventID int, notification *entity.Notification) ([]*dto.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEvent", ctx, eventID, notification)
+	ret0, _ := ret[0].([]*dto.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordEvent indicates an expected call of RecordEvent.
+func (mr *MockChatMockRecorder) RecordEvent(ctx, eventID, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEvent", reflect.TypeOf((*MockChat)(nil).RecordEvent), ctx, eventID, notification)
+}
+
+// SearchMessages mocks base method.
+func (m *MockChat) SearchMessages(ctx context.Context, userID int, role, query string, limit, offset int) (dto.MessageSearchResponseList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userID, role, query, limit, offset)
+	ret0, _ := ret[0].(dto.MessageSearchResponseList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockChatMockRecorder) SearchMessages(ctx, userID, role, query, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockChat)(nil).SearchMessages), ctx, userID, role, query, limit, offset)
+}
+
 // SendMessage mocks base method.
-func (m *MockChat) SendMessage(ctx context.Context, chatID, senderID int, role, payload string) (*dto.MessageResponse, error) {
+func (m *MockChat) SendMessage(ctx context.Context, chatID, senderID int, role, payload, clientID string, attachmentIDs []int) (*dto.ChatEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", ctx, chatID, senderID, role, payload)
-	ret0, _ := ret[0].(*dto.MessageResponse)
+	ret := m.ctrl.Call(m, "SendMessage", ctx, chatID, senderID, role, payload, clientID, attachmentIDs)
+	ret0, _ := ret[0].(*dto.ChatEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockChatMockRecorder) SendMessage(ctx, chatID, senderID, role, payload any) *gomock.Call {
+func (mr *MockChatMockRecorder) SendMessage(ctx, chatID, senderID, role, payload, clientID, attachmentIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockChat)(nil).SendMessage), ctx, chatID, senderID, role, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockChat)(nil).SendMessage), ctx, chatID, senderID, role, payload, clientID, attachmentIDs)
 }
 
 // StartChat mocks base method.
@@ -130,3 +371,33 @@ func (mr *MockChatMockRecorder) StartChat(ctx, vacancyID, resumeID, applicantID,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartChat", reflect.TypeOf((*MockChat)(nil).StartChat), ctx, vacancyID, resumeID, applicantID, employerID)
 }
+
+// UpdateChatSettings mocks base method.
+func (m *MockChat) UpdateChatSettings(ctx context.Context, chatID, userID int, role string, req *dto.ChatSettingsRequest) (*dto.ChatSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChatSettings", ctx, chatID, userID, role, req)
+	ret0, _ := ret[0].(*dto.ChatSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChatSettings indicates an expected call of UpdateChatSettings.
+func (mr *MockChatMockRecorder) UpdateChatSettings(ctx, chatID, userID, role, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatSettings", reflect.TypeOf((*MockChat)(nil).UpdateChatSettings), ctx, chatID, userID, role, req)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockChat) UploadAttachment(ctx context.Context, chatID, userID int, role, fileName string, data []byte) (*dto.AttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, chatID, userID, role, fileName, data)
+	ret0, _ := ret[0].(*dto.AttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockChatMockRecorder) UploadAttachment(ctx, chatID, userID, role, fileName, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockChat)(nil).UploadAttachment), ctx, chatID, userID, role, fileName, data)
+}
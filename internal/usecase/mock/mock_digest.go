@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ResuMatch/internal/usecase (interfaces: Digest,Mailer)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/usecase/mock/mock_digest.go ResuMatch/internal/usecase Digest,Mailer
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	entity "ResuMatch/internal/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDigest is a mock of Digest interface.
+type MockDigest struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestMockRecorder is the mock recorder for MockDigest.
+type MockDigestMockRecorder struct {
+	mock *MockDigest
+}
+
+// NewMockDigest creates a new mock instance.
+func NewMockDigest(ctrl *gomock.Controller) *MockDigest {
+	mock := &MockDigest{ctrl: ctrl}
+	mock.recorder = &MockDigestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigest) EXPECT() *MockDigestMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockDigest) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockDigestMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockDigest)(nil).Run), ctx)
+}
+
+// SendDigests mocks base method.
+func (m *MockDigest) SendDigests(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDigests", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendDigests indicates an expected call of SendDigests.
+func (mr *MockDigestMockRecorder) SendDigests(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDigests", reflect.TypeOf((*MockDigest)(nil).SendDigests), ctx)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockDigest) Unsubscribe(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockDigestMockRecorder) Unsubscribe(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockDigest)(nil).Unsubscribe), ctx, token)
+}
+
+// MockMailer is a mock of Mailer interface.
+type MockMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockMailerMockRecorder is the mock recorder for MockMailer.
+type MockMailerMockRecorder struct {
+	mock *MockMailer
+}
+
+// NewMockMailer creates a new mock instance.
+func NewMockMailer(ctrl *gomock.Controller) *MockMailer {
+	mock := &MockMailer{ctrl: ctrl}
+	mock.recorder = &MockMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailer) EXPECT() *MockMailerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailer) Send(ctx context.Context, mail *entity.Mail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, mail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailerMockRecorder) Send(ctx, mail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), ctx, mail)
+}
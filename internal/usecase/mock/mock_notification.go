@@ -11,6 +11,7 @@ package mock
 
 import (
 	entity "ResuMatch/internal/entity"
+	dto "ResuMatch/internal/entity/dto"
 	context "context"
 	reflect "reflect"
 
@@ -70,19 +71,108 @@ func (mr *MockNotificationMockRecorder) DeleteAllNotifications(ctx, userID, role
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllNotifications", reflect.TypeOf((*MockNotification)(nil).DeleteAllNotifications), ctx, userID, role)
 }
 
+// DeleteNotification mocks base method.
+func (m *MockNotification) DeleteNotification(ctx context.Context, notificationID, userID int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotification", ctx, notificationID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockNotificationMockRecorder) DeleteNotification(ctx, notificationID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockNotification)(nil).DeleteNotification), ctx, notificationID, userID, role)
+}
+
+// GetLastNotificationID mocks base method.
+func (m *MockNotification) GetLastNotificationID(ctx context.Context, userID int, role string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastNotificationID", ctx, userID, role)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastNotificationID indicates an expected call of GetLastNotificationID.
+func (mr *MockNotificationMockRecorder) GetLastNotificationID(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastNotificationID", reflect.TypeOf((*MockNotification)(nil).GetLastNotificationID), ctx, userID, role)
+}
+
+// GetNotificationGroup mocks base method.
+func (m *MockNotification) GetNotificationGroup(ctx context.Context, groupID, userID int, role string, limit, offset int) ([]*entity.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationGroup", ctx, groupID, userID, role, limit, offset)
+	ret0, _ := ret[0].([]*entity.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationGroup indicates an expected call of GetNotificationGroup.
+func (mr *MockNotificationMockRecorder) GetNotificationGroup(ctx, groupID, userID, role, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationGroup", reflect.TypeOf((*MockNotification)(nil).GetNotificationGroup), ctx, groupID, userID, role, limit, offset)
+}
+
+// GetNotificationSettings mocks base method.
+func (m *MockNotification) GetNotificationSettings(ctx context.Context, userID int, role string) (*dto.NotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSettings", ctx, userID, role)
+	ret0, _ := ret[0].(*dto.NotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationSettings indicates an expected call of GetNotificationSettings.
+func (mr *MockNotificationMockRecorder) GetNotificationSettings(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSettings", reflect.TypeOf((*MockNotification)(nil).GetNotificationSettings), ctx, userID, role)
+}
+
+// GetNotificationsAfter mocks base method.
+func (m *MockNotification) GetNotificationsAfter(ctx context.Context, userID int, role string, afterID, limit int) ([]*entity.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsAfter", ctx, userID, role, afterID, limit)
+	ret0, _ := ret[0].([]*entity.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationsAfter indicates an expected call of GetNotificationsAfter.
+func (mr *MockNotificationMockRecorder) GetNotificationsAfter(ctx, userID, role, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsAfter", reflect.TypeOf((*MockNotification)(nil).GetNotificationsAfter), ctx, userID, role, afterID, limit)
+}
+
 // GetNotificationsForUser mocks base method.
-func (m *MockNotification) GetNotificationsForUser(ctx context.Context, userID int, role string) ([]*entity.NotificationPreview, error) {
+func (m *MockNotification) GetNotificationsForUser(ctx context.Context, userID int, role string, filter entity.NotificationFilter) ([]*entity.NotificationPreview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNotificationsForUser", ctx, userID, role)
+	ret := m.ctrl.Call(m, "GetNotificationsForUser", ctx, userID, role, filter)
 	ret0, _ := ret[0].([]*entity.NotificationPreview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNotificationsForUser indicates an expected call of GetNotificationsForUser.
-func (mr *MockNotificationMockRecorder) GetNotificationsForUser(ctx, userID, role any) *gomock.Call {
+func (mr *MockNotificationMockRecorder) GetNotificationsForUser(ctx, userID, role, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsForUser", reflect.TypeOf((*MockNotification)(nil).GetNotificationsForUser), ctx, userID, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsForUser", reflect.TypeOf((*MockNotification)(nil).GetNotificationsForUser), ctx, userID, role, filter)
+}
+
+// GetUnreadNotifications mocks base method.
+func (m *MockNotification) GetUnreadNotifications(ctx context.Context, userID int, role string) (*dto.UnreadNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadNotifications", ctx, userID, role)
+	ret0, _ := ret[0].(*dto.UnreadNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadNotifications indicates an expected call of GetUnreadNotifications.
+func (mr *MockNotificationMockRecorder) GetUnreadNotifications(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadNotifications", reflect.TypeOf((*MockNotification)(nil).GetUnreadNotifications), ctx, userID, role)
 }
 
 // ReadAllNotifications mocks base method.
@@ -112,3 +202,46 @@ func (mr *MockNotificationMockRecorder) ReadNotification(ctx, notificationID, us
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNotification", reflect.TypeOf((*MockNotification)(nil).ReadNotification), ctx, notificationID, userID)
 }
+
+// ReadNotificationGroup mocks base method.
+func (m *MockNotification) ReadNotificationGroup(ctx context.Context, groupID, userID int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadNotificationGroup", ctx, groupID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadNotificationGroup indicates an expected call of ReadNotificationGroup.
+func (mr *MockNotificationMockRecorder) ReadNotificationGroup(ctx, groupID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNotificationGroup", reflect.TypeOf((*MockNotification)(nil).ReadNotificationGroup), ctx, groupID, userID, role)
+}
+
+// SendNotificationMail mocks base method.
+func (m *MockNotification) SendNotificationMail(ctx context.Context, notification *entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNotificationMail", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendNotificationMail indicates an expected call of SendNotificationMail.
+func (mr *MockNotificationMockRecorder) SendNotificationMail(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNotificationMail", reflect.TypeOf((*MockNotification)(nil).SendNotificationMail), ctx, notification)
+}
+
+// UpdateNotificationSettings mocks base method.
+func (m *MockNotification) UpdateNotificationSettings(ctx context.Context, userID int, role string, req *dto.NotificationSettings) (*dto.NotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSettings", ctx, userID, role, req)
+	ret0, _ := ret[0].(*dto.NotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationSettings indicates an expected call of UpdateNotificationSettings.
+func (mr *MockNotificationMockRecorder) UpdateNotificationSettings(ctx, userID, role, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSettings", reflect.TypeOf((*MockNotification)(nil).UpdateNotificationSettings), ctx, userID, role, req)
+}
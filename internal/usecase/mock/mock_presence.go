@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ResuMatch/internal/usecase (interfaces: Presence)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/usecase/mock/mock_presence.go ResuMatch/internal/usecase Presence
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	dto "ResuMatch/internal/entity/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPresence is a mock of Presence interface.
+type MockPresence struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresenceMockRecorder
+	isgomock struct{}
+}
+
+// MockPresenceMockRecorder is the mock recorder for MockPresence.
+type MockPresenceMockRecorder struct {
+	mock *MockPresence
+}
+
+// NewMockPresence creates a new mock instance.
+func NewMockPresence(ctrl *gomock.Controller) *MockPresence {
+	mock := &MockPresence{ctrl: ctrl}
+	mock.recorder = &MockPresenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresence) EXPECT() *MockPresenceMockRecorder {
+	return m.recorder
+}
+
+// Connect mocks base method.
+func (m *MockPresence) Connect(ctx context.Context, userID int, role, connID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", ctx, userID, role, connID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockPresenceMockRecorder) Connect(ctx, userID, role, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockPresence)(nil).Connect), ctx, userID, role, connID)
+}
+
+// Disconnect mocks base method.
+func (m *MockPresence) Disconnect(ctx context.Context, userID int, role, connID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disconnect", ctx, userID, role, connID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Disconnect indicates an expected call of Disconnect.
+func (mr *MockPresenceMockRecorder) Disconnect(ctx, userID, role, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockPresence)(nil).Disconnect), ctx, userID, role, connID)
+}
+
+// GetPresence mocks base method.
+func (m *MockPresence) GetPresence(ctx context.Context, userID int, role string) (*dto.PresenceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", ctx, userID, role)
+	ret0, _ := ret[0].(*dto.PresenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockPresenceMockRecorder) GetPresence(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockPresence)(nil).GetPresence), ctx, userID, role)
+}
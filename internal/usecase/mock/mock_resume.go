@@ -42,6 +42,35 @@ func (m *MockResumeUsecase) EXPECT() *MockResumeUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AnswerContactRequest mocks base method.
+func (m *MockResumeUsecase) AnswerContactRequest(ctx context.Context, contactRequestID, applicantID int, accept bool) (*dto.ContactRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerContactRequest", ctx, contactRequestID, applicantID, accept)
+	ret0, _ := ret[0].(*dto.ContactRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnswerContactRequest indicates an expected call of AnswerContactRequest.
+func (mr *MockResumeUsecaseMockRecorder) AnswerContactRequest(ctx, contactRequestID, applicantID, accept any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerContactRequest", reflect.TypeOf((*MockResumeUsecase)(nil).AnswerContactRequest), ctx, contactRequestID, applicantID, accept)
+}
+
+// BlockEmployer mocks base method.
+func (m *MockResumeUsecase) BlockEmployer(ctx context.Context, applicantID, employerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockEmployer", ctx, applicantID, employerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockEmployer indicates an expected call of BlockEmployer.
+func (mr *MockResumeUsecaseMockRecorder) BlockEmployer(ctx, applicantID, employerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockEmployer", reflect.TypeOf((*MockResumeUsecase)(nil).BlockEmployer), ctx, applicantID, employerID)
+}
+
 // Create mocks base method.
 func (m *MockResumeUsecase) Create(ctx context.Context, applicantID int, request *dto.CreateResumeRequest) (*dto.ResumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -73,18 +102,18 @@ func (mr *MockResumeUsecaseMockRecorder) Delete(ctx, id, applicantID any) *gomoc
 }
 
 // GetAll mocks base method.
-func (m *MockResumeUsecase) GetAll(ctx context.Context, limit, offset int) ([]dto.ResumeShortResponse, error) {
+func (m *MockResumeUsecase) GetAll(ctx context.Context, employerID, limit, offset int) ([]dto.ResumeShortResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "GetAll", ctx, employerID, limit, offset)
 	ret0, _ := ret[0].([]dto.ResumeShortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockResumeUsecaseMockRecorder) GetAll(ctx, limit, offset any) *gomock.Call {
+func (mr *MockResumeUsecaseMockRecorder) GetAll(ctx, employerID, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockResumeUsecase)(nil).GetAll), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockResumeUsecase)(nil).GetAll), ctx, employerID, limit, offset)
 }
 
 // GetAllResumesByApplicantID mocks base method.
@@ -102,50 +131,108 @@ func (mr *MockResumeUsecaseMockRecorder) GetAllResumesByApplicantID(ctx, applica
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResumesByApplicantID", reflect.TypeOf((*MockResumeUsecase)(nil).GetAllResumesByApplicantID), ctx, applicantID, limit, offset)
 }
 
+// GetBlockedEmployers mocks base method.
+func (m *MockResumeUsecase) GetBlockedEmployers(ctx context.Context, applicantID int) (dto.BlockedEmployerResponseList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedEmployers", ctx, applicantID)
+	ret0, _ := ret[0].(dto.BlockedEmployerResponseList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedEmployers indicates an expected call of GetBlockedEmployers.
+func (mr *MockResumeUsecaseMockRecorder) GetBlockedEmployers(ctx, applicantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedEmployers", reflect.TypeOf((*MockResumeUsecase)(nil).GetBlockedEmployers), ctx, applicantID)
+}
+
 // GetByID mocks base method.
-func (m *MockResumeUsecase) GetByID(ctx context.Context, id int) (*dto.ResumeResponse, error) {
+func (m *MockResumeUsecase) GetByID(ctx context.Context, id, userID int, role, accessToken string) (*dto.ResumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID, role, accessToken)
 	ret0, _ := ret[0].(*dto.ResumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockResumeUsecaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+func (mr *MockResumeUsecaseMockRecorder) GetByID(ctx, id, userID, role, accessToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockResumeUsecase)(nil).GetByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockResumeUsecase)(nil).GetByID), ctx, id, userID, role, accessToken)
 }
 
 // GetResumePDF mocks base method.
-func (m *MockResumeUsecase) GetResumePDF(ctx context.Context, resumeID, userID int, role string) ([]byte, entity.Notification, error) {
+func (m *MockResumeUsecase) GetResumePDF(ctx context.Context, resumeID, userID int, role, accessToken string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetResumePDF", ctx, resumeID, userID, role)
+	ret := m.ctrl.Call(m, "GetResumePDF", ctx, resumeID, userID, role, accessToken)
 	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(entity.Notification)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetResumePDF indicates an expected call of GetResumePDF.
-func (mr *MockResumeUsecaseMockRecorder) GetResumePDF(ctx, resumeID, userID, role any) *gomock.Call {
+func (mr *MockResumeUsecaseMockRecorder) GetResumePDF(ctx, resumeID, userID, role, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResumePDF", reflect.TypeOf((*MockResumeUsecase)(nil).GetResumePDF), ctx, resumeID, userID, role, accessToken)
+}
+
+// RequestContacts mocks base method.
+func (m *MockResumeUsecase) RequestContacts(ctx context.Context, resumeID, employerID int) (*dto.ContactRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestContacts", ctx, resumeID, employerID)
+	ret0, _ := ret[0].(*dto.ContactRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestContacts indicates an expected call of RequestContacts.
+func (mr *MockResumeUsecaseMockRecorder) RequestContacts(ctx, resumeID, employerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResumePDF", reflect.TypeOf((*MockResumeUsecase)(nil).GetResumePDF), ctx, resumeID, userID, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestContacts", reflect.TypeOf((*MockResumeUsecase)(nil).RequestContacts), ctx, resumeID, employerID)
+}
+
+// SearchResumesAdvanced mocks base method.
+func (m *MockResumeUsecase) SearchResumesAdvanced(ctx context.Context, employerID int, params entity.ResumeSearchParams, limit, offset int) ([]dto.ResumeShortResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchResumesAdvanced", ctx, employerID, params, limit, offset)
+	ret0, _ := ret[0].([]dto.ResumeShortResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchResumesAdvanced indicates an expected call of SearchResumesAdvanced.
+func (mr *MockResumeUsecaseMockRecorder) SearchResumesAdvanced(ctx, employerID, params, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesAdvanced", reflect.TypeOf((*MockResumeUsecase)(nil).SearchResumesAdvanced), ctx, employerID, params, limit, offset)
 }
 
 // SearchResumesByProfession mocks base method.
-func (m *MockResumeUsecase) SearchResumesByProfession(ctx context.Context, userID int, role, profession string, limit, offset int) ([]dto.ResumeShortResponse, error) {
+func (m *MockResumeUsecase) SearchResumesByProfession(ctx context.Context, userID int, role, profession string, filter entity.ResumeFilter, limit, offset int) ([]dto.ResumeShortResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchResumesByProfession", ctx, userID, role, profession, limit, offset)
+	ret := m.ctrl.Call(m, "SearchResumesByProfession", ctx, userID, role, profession, filter, limit, offset)
 	ret0, _ := ret[0].([]dto.ResumeShortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchResumesByProfession indicates an expected call of SearchResumesByProfession.
-func (mr *MockResumeUsecaseMockRecorder) SearchResumesByProfession(ctx, userID, role, profession, limit, offset any) *gomock.Call {
+func (mr *MockResumeUsecaseMockRecorder) SearchResumesByProfession(ctx, userID, role, profession, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesByProfession", reflect.TypeOf((*MockResumeUsecase)(nil).SearchResumesByProfession), ctx, userID, role, profession, filter, limit, offset)
+}
+
+// UnblockEmployer mocks base method.
+func (m *MockResumeUsecase) UnblockEmployer(ctx context.Context, applicantID, employerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockEmployer", ctx, applicantID, employerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockEmployer indicates an expected call of UnblockEmployer.
+func (mr *MockResumeUsecaseMockRecorder) UnblockEmployer(ctx, applicantID, employerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResumesByProfession", reflect.TypeOf((*MockResumeUsecase)(nil).SearchResumesByProfession), ctx, userID, role, profession, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockEmployer", reflect.TypeOf((*MockResumeUsecase)(nil).UnblockEmployer), ctx, applicantID, employerID)
 }
 
 // Update mocks base method.
@@ -162,3 +249,33 @@ func (mr *MockResumeUsecaseMockRecorder) Update(ctx, id, applicantID, request an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockResumeUsecase)(nil).Update), ctx, id, applicantID, request)
 }
+
+// UpdateVisibility mocks base method.
+func (m *MockResumeUsecase) UpdateVisibility(ctx context.Context, resumeID, applicantID int, request *dto.ResumeVisibilityRequest) (*dto.ResumeVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", ctx, resumeID, applicantID, request)
+	ret0, _ := ret[0].(*dto.ResumeVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockResumeUsecaseMockRecorder) UpdateVisibility(ctx, resumeID, applicantID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockResumeUsecase)(nil).UpdateVisibility), ctx, resumeID, applicantID, request)
+}
+
+// UploadCourseFile mocks base method.
+func (m *MockResumeUsecase) UploadCourseFile(ctx context.Context, data []byte) (*dto.UploadStaticResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCourseFile", ctx, data)
+	ret0, _ := ret[0].(*dto.UploadStaticResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCourseFile indicates an expected call of UploadCourseFile.
+func (mr *MockResumeUsecaseMockRecorder) UploadCourseFile(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCourseFile", reflect.TypeOf((*MockResumeUsecase)(nil).UploadCourseFile), ctx, data)
+}
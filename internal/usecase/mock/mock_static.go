@@ -10,6 +10,7 @@
 package mock
 
 import (
+	entity "ResuMatch/internal/entity"
 	dto "ResuMatch/internal/entity/dto"
 	context "context"
 	reflect "reflect"
@@ -55,6 +56,21 @@ func (mr *MockStaticMockRecorder) DeleteStatic(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStatic", reflect.TypeOf((*MockStatic)(nil).DeleteStatic), ctx, id)
 }
 
+// DownloadStatic mocks base method.
+func (m *MockStatic) DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadStatic", ctx, id)
+	ret0, _ := ret[0].(*entity.StaticContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadStatic indicates an expected call of DownloadStatic.
+func (mr *MockStaticMockRecorder) DownloadStatic(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStatic", reflect.TypeOf((*MockStatic)(nil).DownloadStatic), ctx, id)
+}
+
 // GetStatic mocks base method.
 func (m *MockStatic) GetStatic(ctx context.Context, id int) (string, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +86,21 @@ func (mr *MockStaticMockRecorder) GetStatic(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatic", reflect.TypeOf((*MockStatic)(nil).GetStatic), ctx, id)
 }
 
+// UploadAttachment mocks base method.
+func (m *MockStatic) UploadAttachment(ctx context.Context, data []byte) (*dto.UploadAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, data)
+	ret0, _ := ret[0].(*dto.UploadAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockStaticMockRecorder) UploadAttachment(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockStatic)(nil).UploadAttachment), ctx, data)
+}
+
 // UploadStatic mocks base method.
 func (m *MockStatic) UploadStatic(ctx context.Context, data []byte) (*dto.UploadStaticResponse, error) {
 	m.ctrl.T.Helper()
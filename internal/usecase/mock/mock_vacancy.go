@@ -10,7 +10,6 @@
 package mock
 
 import (
-	entity "ResuMatch/internal/entity"
 	dto "ResuMatch/internal/entity/dto"
 	context "context"
 	reflect "reflect"
@@ -43,12 +42,11 @@ func (m *MockVacancy) EXPECT() *MockVacancyMockRecorder {
 }
 
 // ApplyToVacancy mocks base method.
-func (m *MockVacancy) ApplyToVacancy(ctx context.Context, vacancyID, applicantID, resumeID int) (entity.Notification, error) {
+func (m *MockVacancy) ApplyToVacancy(ctx context.Context, vacancyID, applicantID, resumeID int) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ApplyToVacancy", ctx, vacancyID, applicantID, resumeID)
-	ret0, _ := ret[0].(entity.Notification)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // ApplyToVacancy indicates an expected call of ApplyToVacancy.
@@ -2,13 +2,23 @@ package usecase
 
 import (
 	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
 	"context"
 )
 
 type Notification interface {
 	CreateNotification(ctx context.Context, notification *entity.Notification) (*entity.NotificationPreview, error)
+	SendNotificationMail(ctx context.Context, notification *entity.Notification) error
 	ReadNotification(ctx context.Context, notificationID, userID int) error
+	ReadNotificationGroup(ctx context.Context, groupID, userID int, role string) error
 	ReadAllNotifications(ctx context.Context, userID int, role string) error
+	DeleteNotification(ctx context.Context, notificationID, userID int, role string) error
 	DeleteAllNotifications(ctx context.Context, userID int, role string) error
-	GetNotificationsForUser(ctx context.Context, userID int, role string) ([]*entity.NotificationPreview, error)
+	GetNotificationsForUser(ctx context.Context, userID int, role string, filter entity.NotificationFilter) ([]*entity.NotificationPreview, error)
+	GetNotificationGroup(ctx context.Context, groupID, userID int, role string, limit, offset int) ([]*entity.NotificationPreview, error)
+	GetNotificationsAfter(ctx context.Context, userID int, role string, afterID, limit int) ([]*entity.NotificationPreview, error)
+	GetLastNotificationID(ctx context.Context, userID int, role string) (int, error)
+	GetUnreadNotifications(ctx context.Context, userID int, role string) (*dto.UnreadNotificationsResponse, error)
+	GetNotificationSettings(ctx context.Context, userID int, role string) (*dto.NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, userID int, role string, req *dto.NotificationSettings) (*dto.NotificationSettings, error)
 }
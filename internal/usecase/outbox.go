@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"ResuMatch/internal/entity"
+	"context"
+)
+
+type Outbox interface {
+	Run(ctx context.Context)
+	Dispatch(ctx context.Context) (int, error)
+}
+
+// EventSink - получатель событий из outbox: уведомления, websocket, вебхуки. Событие может прийти
+// повторно, если диспетчер упал до отметки о доставке. Событие, которое получателя не касается,
+// он пропускает без ошибки
+type EventSink interface {
+	Name() string
+	Deliver(ctx context.Context, event *entity.OutboxEvent) error
+}
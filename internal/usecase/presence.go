@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"ResuMatch/internal/entity/dto"
+	"context"
+)
+
+type Presence interface {
+	Connect(ctx context.Context, userID int, role, connID string) (bool, error)
+	Disconnect(ctx context.Context, userID int, role, connID string) (bool, error)
+	GetPresence(ctx context.Context, userID int, role string) (*dto.PresenceResponse, error)
+}
@@ -8,11 +8,19 @@ import (
 
 type ResumeUsecase interface {
 	Create(ctx context.Context, applicantID int, request *dto.CreateResumeRequest) (*dto.ResumeResponse, error)
-	GetByID(ctx context.Context, id int) (*dto.ResumeResponse, error)
+	GetByID(ctx context.Context, id int, userID int, role string, accessToken string) (*dto.ResumeResponse, error)
 	Update(ctx context.Context, id int, applicantID int, request *dto.UpdateResumeRequest) (*dto.ResumeResponse, error)
 	Delete(ctx context.Context, id int, applicantID int) (*dto.DeleteResumeResponse, error)
-	GetAll(ctx context.Context, limit int, offset int) ([]dto.ResumeShortResponse, error)
-	GetResumePDF(ctx context.Context, resumeID, userID int, role string) ([]byte, entity.Notification, error)
+	GetAll(ctx context.Context, employerID int, limit int, offset int) ([]dto.ResumeShortResponse, error)
+	GetResumePDF(ctx context.Context, resumeID, userID int, role string, accessToken string) ([]byte, error)
 	GetAllResumesByApplicantID(ctx context.Context, applicantID int, limit int, offset int) ([]dto.ResumeApplicantShortResponse, error)
-	SearchResumesByProfession(ctx context.Context, userID int, role string, profession string, limit int, offset int) ([]dto.ResumeShortResponse, error)
+	SearchResumesByProfession(ctx context.Context, userID int, role string, profession string, filter entity.ResumeFilter, limit int, offset int) ([]dto.ResumeShortResponse, error)
+	SearchResumesAdvanced(ctx context.Context, employerID int, params entity.ResumeSearchParams, limit int, offset int) ([]dto.ResumeShortResponse, error)
+	UpdateVisibility(ctx context.Context, resumeID, applicantID int, request *dto.ResumeVisibilityRequest) (*dto.ResumeVisibilityResponse, error)
+	GetBlockedEmployers(ctx context.Context, applicantID int) (dto.BlockedEmployerResponseList, error)
+	BlockEmployer(ctx context.Context, applicantID, employerID int) error
+	UnblockEmployer(ctx context.Context, applicantID, employerID int) error
+	RequestContacts(ctx context.Context, resumeID, employerID int) (*dto.ContactRequestResponse, error)
+	AnswerContactRequest(ctx context.Context, contactRequestID, applicantID int, accept bool) (*dto.ContactRequestResponse, error)
+	UploadCourseFile(ctx context.Context, data []byte) (*dto.UploadStaticResponse, error)
 }
@@ -15,17 +15,20 @@ import (
 type ApplicantService struct {
 	applicantRepository repository.ApplicantRepository
 	cityRepository      repository.CityRepository
+	resumeRepository    repository.ResumeRepository
 	staticGateway       usecase.Static
 }
 
 func NewApplicantService(
 	applicantRepository repository.ApplicantRepository,
 	cityRepository repository.CityRepository,
+	resumeRepository repository.ResumeRepository,
 	staticGateway usecase.Static,
 ) usecase.Applicant {
 	return &ApplicantService{
 		applicantRepository: applicantRepository,
 		cityRepository:      cityRepository,
+		resumeRepository:    resumeRepository,
 		staticGateway:       staticGateway,
 	}
 }
@@ -129,6 +132,31 @@ func (a *ApplicantService) GetUser(ctx context.Context, applicantID int) (*dto.A
 	return a.applicantEntityToDTO(ctx, applicant)
 }
 
+// GetProfile возвращает профиль соискателя пользователю userID с ролью role. Работодателю профиль владельца
+// анонимного резюме недоступен, пока соискатель не раскрыл ему контакты
+func (a *ApplicantService) GetProfile(ctx context.Context, applicantID, userID int, role string) (*dto.ApplicantProfileResponse, error) {
+	if role == string(entity.EmployerRole) {
+		anonymous, err := a.resumeRepository.HasAnonymousResume(ctx, applicantID)
+		if err != nil {
+			return nil, err
+		}
+		if anonymous {
+			disclosed, err := a.resumeRepository.ContactsDisclosed(ctx, applicantID, userID)
+			if err != nil {
+				return nil, err
+			}
+			if !disclosed {
+				return nil, entity.NewError(
+					entity.ErrForbidden,
+					fmt.Errorf("профиль соискателя скрыт до раскрытия контактов"),
+				)
+			}
+		}
+	}
+
+	return a.GetUser(ctx, applicantID)
+}
+
 func (a *ApplicantService) UpdateProfile(ctx context.Context, userID int, applicantDTO *dto.ApplicantProfileUpdate) error {
 	if isValid, err := govalidator.ValidateStruct(applicantDTO); !isValid {
 		return entity.NewError(
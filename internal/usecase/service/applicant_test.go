@@ -161,7 +161,7 @@ func TestApplicantService_Register(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockRepo := mock.NewMockApplicantRepository(ctrl)
-			applicantService := NewApplicantService(mockRepo, nil, nil)
+			applicantService := NewApplicantService(mockRepo, nil, nil, nil)
 
 			tc.mockSetup(mockRepo)
 
@@ -311,7 +311,7 @@ func TestApplicantService_Login(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockRepo := mock.NewMockApplicantRepository(ctrl)
-			applicantService := NewApplicantService(mockRepo, nil, nil)
+			applicantService := NewApplicantService(mockRepo, nil, nil, nil)
 
 			tc.mockSetup(mockRepo)
 
@@ -510,7 +510,7 @@ func TestApplicantService_UpdateProfile(t *testing.T) {
 
 			mockApplicantRepo := mock.NewMockApplicantRepository(ctrl)
 			mockCityRepo := mock.NewMockCityRepository(ctrl)
-			applicantService := NewApplicantService(mockApplicantRepo, mockCityRepo, nil)
+			applicantService := NewApplicantService(mockApplicantRepo, mockCityRepo, nil, nil)
 
 			tc.mockSetup(mockApplicantRepo, mockCityRepo)
 
@@ -610,7 +610,7 @@ func TestApplicantService_GetUser(t *testing.T) {
 
 			mockAppRepo := mock.NewMockApplicantRepository(ctrl)
 			mockCityRepo := mock.NewMockCityRepository(ctrl)
-			applicantService := NewApplicantService(mockAppRepo, mockCityRepo, nil)
+			applicantService := NewApplicantService(mockAppRepo, mockCityRepo, nil, nil)
 
 			tc.mockSetup(mockAppRepo, mockCityRepo)
 
@@ -629,6 +629,102 @@ func TestApplicantService_GetUser(t *testing.T) {
 	}
 }
 
+func TestApplicantService_GetProfile(t *testing.T) {
+	t.Parallel()
+
+	applicant := &entity.Applicant{ID: 1, FirstName: "Иван", LastName: "Иванов", Status: "actively_searching"}
+	profile := &dto.ApplicantProfileResponse{ID: 1, FirstName: "Иван", LastName: "Иванов", Status: "actively_searching"}
+
+	testCases := []struct {
+		name           string
+		userID         int
+		role           string
+		mockSetup      func(*mock.MockApplicantRepository, *mock.MockResumeRepository)
+		expectedResult *dto.ApplicantProfileResponse
+		expectedErr    error
+	}{
+		{
+			name:   "Соискатель видит профиль без проверки анонимности",
+			userID: 2,
+			role:   "applicant",
+			mockSetup: func(appRepo *mock.MockApplicantRepository, resumeRepo *mock.MockResumeRepository) {
+				appRepo.EXPECT().GetApplicantByID(gomock.Any(), 1).Return(applicant, nil)
+			},
+			expectedResult: profile,
+		},
+		{
+			name:   "Работодатель видит профиль соискателя без анонимных резюме",
+			userID: 7,
+			role:   "employer",
+			mockSetup: func(appRepo *mock.MockApplicantRepository, resumeRepo *mock.MockResumeRepository) {
+				resumeRepo.EXPECT().HasAnonymousResume(gomock.Any(), 1).Return(false, nil)
+				appRepo.EXPECT().GetApplicantByID(gomock.Any(), 1).Return(applicant, nil)
+			},
+			expectedResult: profile,
+		},
+		{
+			name:   "Работодатель видит профиль после раскрытия контактов",
+			userID: 7,
+			role:   "employer",
+			mockSetup: func(appRepo *mock.MockApplicantRepository, resumeRepo *mock.MockResumeRepository) {
+				resumeRepo.EXPECT().HasAnonymousResume(gomock.Any(), 1).Return(true, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 1, 7).Return(true, nil)
+				appRepo.EXPECT().GetApplicantByID(gomock.Any(), 1).Return(applicant, nil)
+			},
+			expectedResult: profile,
+		},
+		{
+			name:   "Профиль анонимного соискателя скрыт от работодателя",
+			userID: 7,
+			role:   "employer",
+			mockSetup: func(appRepo *mock.MockApplicantRepository, resumeRepo *mock.MockResumeRepository) {
+				resumeRepo.EXPECT().HasAnonymousResume(gomock.Any(), 1).Return(true, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 1, 7).Return(false, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:   "Ошибка при проверке анонимных резюме",
+			userID: 7,
+			role:   "employer",
+			mockSetup: func(appRepo *mock.MockApplicantRepository, resumeRepo *mock.MockResumeRepository) {
+				resumeRepo.EXPECT().HasAnonymousResume(gomock.Any(), 1).
+					Return(false, entity.NewError(entity.ErrInternal, errors.New("database error")))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppRepo := mock.NewMockApplicantRepository(ctrl)
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			applicantService := NewApplicantService(mockAppRepo, nil, mockResumeRepo, nil)
+
+			tc.mockSetup(mockAppRepo, mockResumeRepo)
+
+			result, err := applicantService.GetProfile(context.Background(), 1, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, result)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
 func TestApplicantService_UpdateAvatar(t *testing.T) {
 	t.Parallel()
 
@@ -712,7 +808,7 @@ func TestApplicantService_UpdateAvatar(t *testing.T) {
 
 			mockAppRepo := mock.NewMockApplicantRepository(ctrl)
 			mockStaticUC := mockUC.NewMockStatic(ctrl)
-			service := NewApplicantService(mockAppRepo, nil, mockStaticUC)
+			service := NewApplicantService(mockAppRepo, nil, nil, mockStaticUC)
 
 			tc.mockSetup(mockAppRepo, mockStaticUC)
 
@@ -790,7 +886,7 @@ func TestApplicantService_EmailExists(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockAppRepo := mock.NewMockApplicantRepository(ctrl)
-			service := NewApplicantService(mockAppRepo, nil, nil)
+			service := NewApplicantService(mockAppRepo, nil, nil, nil)
 
 			tc.mockSetup(mockAppRepo)
 
@@ -1,44 +1,91 @@
 package service
 
 import (
+	"ResuMatch/internal/config"
 	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/repository"
 	"ResuMatch/internal/usecase"
+	"ResuMatch/internal/utils"
+	l "ResuMatch/pkg/logger"
 	"ResuMatch/pkg/sanitizer"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"html/template"
+	"path"
+	"path/filepath"
 	"strings"
+	"time"
+	"unicode"
 )
 
-const ResponseMessage = "Отклик на вакансию"
+// Тексты системных сообщений о событиях отклика
+const (
+	ResponseMessage           = "Отклик на вакансию"
+	InvitationMessage         = "Приглашение на вакансию"
+	InvitationAcceptedMessage = "Приглашение принято"
+	InvitationDeclinedMessage = "Приглашение отклонено"
+	ResumeDownloadMessage     = "Работодатель скачал резюме"
+)
 
 type ChatService struct {
 	ApplicantUC usecase.Applicant
 	EmployerUC  usecase.Employer
 	ResumeUC    usecase.ResumeUsecase
+	ResumeRepo  repository.ResumeRepository
 	VacancyUC   usecase.Vacancy
 	ChatRepo    repository.ChatRepository
 	MessageRepo repository.MessageRepository
+	PresenceUC  usecase.Presence
+	StaticUC    usecase.Static
+	// PDFConfig и ExportTemplate нужны для выгрузки переписки
+	PDFConfig      config.ResumeConfig
+	ExportTemplate *template.Template
 }
 
 func NewChatService(
 	applicantUC usecase.Applicant,
 	employerUC usecase.Employer,
 	resumeUC usecase.ResumeUsecase,
+	resumeRepository repository.ResumeRepository,
 	vacancyUC usecase.Vacancy,
 	chatRepository repository.ChatRepository,
 	messageRepository repository.MessageRepository,
+	presenceUC usecase.Presence,
+	staticUC usecase.Static,
+	cfg config.ChatConfig,
+	pdfCfg config.ResumeConfig,
 ) usecase.Chat {
 	return &ChatService{
-		ApplicantUC: applicantUC,
-		EmployerUC:  employerUC,
-		ResumeUC:    resumeUC,
-		VacancyUC:   vacancyUC,
-		ChatRepo:    chatRepository,
-		MessageRepo: messageRepository,
+		ApplicantUC:    applicantUC,
+		EmployerUC:     employerUC,
+		ResumeUC:       resumeUC,
+		ResumeRepo:     resumeRepository,
+		VacancyUC:      vacancyUC,
+		ChatRepo:       chatRepository,
+		MessageRepo:    messageRepository,
+		PresenceUC:     presenceUC,
+		StaticUC:       staticUC,
+		PDFConfig:      pdfCfg,
+		ExportTemplate: parseExportTemplate(filepath.Join(pdfCfg.StaticPath, cfg.ExportFile)),
+	}
+}
+
+// parseExportTemplate не останавливает запуск приложения: без шаблона недоступна
+// только выгрузка в HTML и PDF
+func parseExportTemplate(templatePath string) *template.Template {
+	tmpl, err := template.New(filepath.Base(templatePath)).Funcs(template.FuncMap{
+		"formatTime": func(t time.Time) string {
+			return t.UTC().Format("02.01.2006 15:04 UTC")
+		},
+	}).ParseFiles(templatePath)
+	if err != nil {
+		l.Log.Errorf("Не удалось распарсить шаблон выгрузки переписки: %v", err)
+		return nil
 	}
+	return tmpl
 }
 
 func (s *ChatService) GetVacancyChat(ctx context.Context, vacancyID, applicantID int, role string) (*dto.ChatResponse, error) {
@@ -73,7 +120,7 @@ func (s *ChatService) StartChat(ctx context.Context, vacancyID, resumeID, applic
 		return -1, err
 	}
 
-	_, err = s.MessageRepo.CreateMessage(ctx, chat.ID, chat.ApplicantID, true, ResponseMessage)
+	_, err = s.MessageRepo.CreateSystemMessage(ctx, chat.ID, entity.SystemEventResponse, ResponseMessage)
 	if err != nil {
 		return -1, err
 	}
@@ -97,7 +144,9 @@ func (s *ChatService) GetChat(ctx context.Context, chatID int, userID int, role
 		return nil, err
 	}
 
-	resume, err := s.ResumeUC.GetByID(ctx, resp.ResumeID)
+	// Доступ к чату уже проверен, поэтому резюме читаем от имени его владельца,
+	// чтобы настройки видимости не скрывали его от собеседника
+	resume, err := s.ResumeUC.GetByID(ctx, resp.ResumeID, resp.ApplicantID, string(entity.ApplicantRole), "")
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +161,20 @@ func (s *ChatService) GetChat(ctx context.Context, chatID int, userID int, role
 		return nil, err
 	}
 
+	peerID, peerRole := resp.ApplicantID, entity.ApplicantRole
+	if isApplicant(role) {
+		peerID, peerRole = resp.EmployerID, entity.EmployerRole
+	}
+	peerPresence, err := s.PresenceUC.GetPresence(ctx, peerID, string(peerRole))
+	if err != nil {
+		return nil, err
+	}
+
+	applicantHidden, err := s.applicantHidden(ctx, resp, role)
+	if err != nil {
+		return nil, err
+	}
+
 	chat := &dto.ChatResponse{
 		ID: resp.ID,
 		Vacancy: &dto.VacancyChatResponse{
@@ -126,23 +189,54 @@ func (s *ChatService) GetChat(ctx context.Context, chatID int, userID int, role
 			AvatarPath:  applicant.AvatarPath,
 			Profession:  resume.Profession,
 		},
-		CreatedAt: resp.CreatedAt,
-		UpdatedAt: resp.UpdatedAt,
+		LastReadMessageID:     resp.LastReadID(isApplicant(role)),
+		PeerLastReadMessageID: resp.LastReadID(!isApplicant(role)),
+		PeerPresence:          peerPresence,
+		Blocked:               resp.BlockedBy(isApplicant(role)),
+		BlockedByPeer:         resp.BlockedBy(!isApplicant(role)),
+		CreatedAt:             resp.CreatedAt,
+		UpdatedAt:             resp.UpdatedAt,
+	}
+	if applicantHidden {
+		chat.Resume.ApplicantID = 0
+		chat.Resume.AvatarPath = ""
+		if peerPresence != nil {
+			presence := *peerPresence
+			presence.UserID = 0
+			chat.PeerPresence = &presence
+		}
 	}
 	return chat, nil
 }
 
-func (s *ChatService) SendMessage(ctx context.Context, chatID, senderID int, role string, payload string) (*dto.MessageResponse, error) {
+// SendMessage сохраняет сообщение и готовит его к рассылке обоим участникам чата
+func (s *ChatService) SendMessage(ctx context.Context, chatID, senderID int, role string, payload, clientID string, attachmentIDs []int) (*dto.ChatEvent, error) {
 	fromApplicant := isApplicant(role)
 
+	attachmentIDs = uniqueIDs(attachmentIDs)
+	if len(attachmentIDs) > entity.MaxMessageAttachments {
+		return nil, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("к сообщению можно прикрепить не больше %d файлов", entity.MaxMessageAttachments),
+		)
+	}
+
 	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
 	if err != nil {
 		return nil, err
 	}
 
+	if !isParticipant(chat, senderID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	if chat.IsBlocked() {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("переписка в чате заблокирована"))
+	}
+
 	sanitizedPayload := sanitizer.StrictPolicy.Sanitize(payload)
 
-	resp, err := s.MessageRepo.CreateMessage(ctx, chatID, senderID, fromApplicant, sanitizedPayload)
+	resp, err := s.MessageRepo.CreateMessage(ctx, chatID, senderID, fromApplicant, sanitizedPayload, clientID, attachmentIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -173,13 +267,16 @@ func (s *ChatService) SendMessage(ctx context.Context, chatID, senderID int, rol
 		Avatar:        avatarPath,
 		FromApplicant: resp.FromApplicant,
 		Payload:       resp.Payload,
+		ClientID:      resp.ClientID,
+		Attachments:   attachmentResponses(resp.Attachments),
 		SentAt:        resp.SentAt,
 	}
 
-	return message, nil
+	return s.chatEvent(ctx, chat, message)
 }
 
-func (s *ChatService) GetUserChats(ctx context.Context, userID int, role string) (dto.ChatResponseList, error) {
+// GetUserChats возвращает общий список чатов пользователя или, если archived, только архивные
+func (s *ChatService) GetUserChats(ctx context.Context, userID int, role string, archived bool) (dto.ChatResponseList, error) {
 	fromApplicant := isApplicant(role)
 	resp, err := s.ChatRepo.GetForUser(ctx, userID, fromApplicant)
 	if err != nil {
@@ -188,92 +285,1061 @@ func (s *ChatService) GetUserChats(ctx context.Context, userID int, role string)
 
 	var chats dto.ChatResponseList
 	for _, chat := range resp {
-		vacancy, err := s.VacancyUC.GetVacancy(ctx, chat.VacancyID, userID, role)
+		if chat.Archived != archived {
+			continue
+		}
+		preview, err := s.chatPreview(ctx, chat, userID, role)
 		if err != nil {
 			return nil, err
 		}
+		chats = append(chats, preview)
+	}
+	return chats, nil
+}
 
-		var otherUser dto.ChatUserPreview
-		switch role {
-		case "applicant":
-			employer, err := s.EmployerUC.GetUser(ctx, chat.EmployerID)
-			if err != nil {
-				return nil, err
-			}
-			otherUser = dto.ChatUserPreview{
-				ID:         employer.ID,
-				Name:       employer.CompanyName,
-				AvatarPath: employer.LogoPath,
-			}
-		case "employer":
-			applicant, err := s.ApplicantUC.GetUser(ctx, chat.ApplicantID)
+func (s *ChatService) GetChatMessages(ctx context.Context, chatID int, role string, page entity.MessagePage) (dto.MessagesResponseList, error) {
+	if err := page.Validate(); err != nil {
+		return nil, err
+	}
+
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.MessageRepo.GetMessagesForChat(ctx, chatID, page)
+	if err != nil {
+		return nil, err
+	}
+
+	applicantHidden, err := s.applicantHidden(ctx, chat, role)
+	if err != nil {
+		return nil, err
+	}
+
+	var chatMessages []*dto.MessageResponse
+	for _, msg := range messages {
+		message, err := s.messageResponse(ctx, chat, msg)
+		if err != nil {
+			return nil, err
+		}
+		if applicantHidden {
+			message = hideApplicant(message)
+		}
+		chatMessages = append(chatMessages, message)
+	}
+	return chatMessages, nil
+}
+
+func (s *ChatService) SearchMessages(ctx context.Context, userID int, role string, query string, limit, offset int) (dto.MessageSearchResponseList, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, entity.NewError(entity.ErrBadRequest, errors.New("поисковый запрос не может быть пустым"))
+	}
+
+	found, err := s.MessageRepo.SearchMessages(ctx, userID, isApplicant(role), query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Несколько совпадений из одного чата не должны повторно запрашивать вакансию и собеседника
+	previews := make(map[int]*dto.ChatShortResponse)
+	hidden := make(map[int]bool)
+	results := make(dto.MessageSearchResponseList, 0, len(found))
+	for _, item := range found {
+		preview, ok := previews[item.Chat.ID]
+		if !ok {
+			preview, err = s.chatPreview(ctx, &item.Chat, userID, role)
 			if err != nil {
 				return nil, err
 			}
-			fullName := strings.TrimSpace(applicant.LastName + " " + applicant.FirstName + " " + applicant.MiddleName)
-			otherUser = dto.ChatUserPreview{
-				ID:         applicant.ID,
-				Name:       fullName,
-				AvatarPath: applicant.AvatarPath,
-			}
-		default:
-			return nil, entity.NewError(entity.ErrBadRequest, fmt.Errorf("неизвестная роль пользователя: %s", role))
+			previews[item.Chat.ID] = preview
+		}
+
+		message, err := s.messageResponseFor(ctx, &item.Chat, &item.Message, role, hidden)
+		if err != nil {
+			return nil, err
 		}
 
-		chats = append(chats, &dto.ChatShortResponse{
-			ID:           chat.ID,
-			VacancyTitle: vacancy.Title,
-			User:         otherUser,
+		results = append(results, &dto.MessageSearchResponse{
+			Message: message,
+			Chat:    preview,
 		})
 	}
-	return chats, nil
+	return results, nil
+}
+
+func (s *ChatService) GetMessagesAfter(ctx context.Context, userID int, role string, afterID, limit int) (dto.MessagesResponseList, error) {
+	missed, err := s.MessageRepo.GetMessagesForUserAfter(ctx, userID, isApplicant(role), afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	hidden := make(map[int]bool)
+	messages := make(dto.MessagesResponseList, 0, len(missed))
+	for _, item := range missed {
+		message, err := s.messageResponseFor(ctx, &item.Chat, &item.Message, role, hidden)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// GetLastMessageID возвращает id последнего сообщения во всех чатах пользователя
+func (s *ChatService) GetLastMessageID(ctx context.Context, userID int, role string) (int, error) {
+	return s.MessageRepo.GetLastMessageIDForUser(ctx, userID, isApplicant(role))
 }
 
-func (s *ChatService) GetChatMessages(ctx context.Context, chatID int) (dto.MessagesResponseList, error) {
+// MarkRead сдвигает курсор прочтения пользователя и готовит отметку к рассылке обоим участникам чата
+func (s *ChatService) MarkRead(ctx context.Context, chatID, userID int, role string, messageID int) (*dto.ReadEvent, error) {
+	fromApplicant := isApplicant(role)
+
 	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
 	if err != nil {
 		return nil, err
 	}
 
-	messages, err := s.MessageRepo.GetMessagesForChat(ctx, chatID)
+	receiverID := chat.ApplicantID
+	if fromApplicant {
+		receiverID = chat.EmployerID
+	}
+	if (fromApplicant && chat.ApplicantID != userID) || (!fromApplicant && chat.EmployerID != userID) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	lastReadID, err := s.ChatRepo.MarkRead(ctx, chatID, fromApplicant, messageID)
 	if err != nil {
 		return nil, err
 	}
 
-	var chatMessages []*dto.MessageResponse
-	for _, msg := range messages {
-		var avatarPath string
-		var receiverID int
-		if msg.FromApplicant {
-			applicant, err := s.ApplicantUC.GetUser(ctx, msg.SenderID)
-			if err != nil {
-				return nil, err
-			}
-			avatarPath = applicant.AvatarPath
-			receiverID = chat.EmployerID
+	receipt := &dto.ReadReceipt{
+		ChatID:            chatID,
+		ReaderID:          userID,
+		ReceiverID:        receiverID,
+		FromApplicant:     fromApplicant,
+		LastReadMessageID: lastReadID,
+	}
+	event := &dto.ReadEvent{
+		Receipt:         receipt,
+		EmployerReceipt: receipt,
+		ApplicantID:     chat.ApplicantID,
+		EmployerID:      chat.EmployerID,
+	}
+
+	applicantHidden, err := s.applicantHidden(ctx, chat, string(entity.EmployerRole))
+	if err != nil {
+		return nil, err
+	}
+	if applicantHidden {
+		hidden := *receipt
+		if fromApplicant {
+			hidden.ReaderID = 0
 		} else {
-			employer, err := s.EmployerUC.GetUser(ctx, msg.SenderID)
-			if err != nil {
-				return nil, err
-			}
-			avatarPath = employer.LogoPath
-			receiverID = chat.ApplicantID
+			hidden.ReceiverID = 0
+		}
+		event.EmployerReceipt = &hidden
+	}
+	return event, nil
+}
+
+// UploadAttachment сохраняет файл в закрытую статику. Вложение становится видно собеседнику,
+// только когда его id передан при отправке сообщения
+func (s *ChatService) UploadAttachment(ctx context.Context, chatID, userID int, role, fileName string, data []byte) (*dto.AttachmentResponse, error) {
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	uploaded, err := s.StaticUC.UploadAttachment(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.MessageRepo.CreateAttachment(ctx, &entity.Attachment{
+		ChatID:        chatID,
+		UploaderID:    userID,
+		FromApplicant: isApplicant(role),
+		StaticID:      uploaded.ID,
+		FileName:      attachmentFileName(fileName),
+		ContentType:   uploaded.ContentType,
+		Size:          uploaded.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AttachmentResponse{
+		ID:          attachment.ID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+	}, nil
+}
+
+// GetAttachmentFile отдает файл только участникам чата. Еще не отправленное вложение видит только тот, кто его загрузил
+func (s *ChatService) GetAttachmentFile(ctx context.Context, chatID, attachmentID, userID int, role string) (*entity.AttachmentFile, error) {
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	attachment, err := s.MessageRepo.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingForeign := attachment.MessageID == 0 &&
+		(attachment.UploaderID != userID || attachment.FromApplicant != isApplicant(role))
+	if attachment.ChatID != chatID || pendingForeign {
+		return nil, entity.NewError(entity.ErrNotFound, fmt.Errorf("вложение с id=%d не найдено", attachmentID))
+	}
+
+	content, err := s.StaticUC.DownloadStatic(ctx, attachment.StaticID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.AttachmentFile{
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Data:        content.Data,
+	}, nil
+}
+
+// ExportChat выгружает всю переписку чата в выбранном формате. Выгрузка доступна обоим участникам чата
+func (s *ChatService) ExportChat(ctx context.Context, chatID, userID int, role string, format entity.ExportFormat) (*entity.AttachmentFile, error) {
+	switch format {
+	case entity.ExportFormatPDF, entity.ExportFormatHTML, entity.ExportFormatJSON:
+	default:
+		return nil, entity.NewError(entity.ErrBadRequest, fmt.Errorf("неподдерживаемый формат выгрузки: %s", format))
+	}
+
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	export, err := s.chatExport(ctx, chat, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &entity.AttachmentFile{FileName: fmt.Sprintf("chat_%d.%s", chat.ID, format)}
+	if format == entity.ExportFormatJSON {
+		file.ContentType = "application/json"
+		if file.Data, err = export.MarshalJSON(); err != nil {
+			return nil, entity.NewError(entity.ErrInternal, fmt.Errorf("не удалось сериализовать переписку: %w", err))
 		}
+		return file, nil
+	}
+
+	if s.ExportTemplate == nil {
+		return nil, entity.NewError(entity.ErrInternal, errors.New("шаблон выгрузки переписки не загружен"))
+	}
+
+	var buf bytes.Buffer
+	if err = s.ExportTemplate.Execute(&buf, export); err != nil {
+		return nil, entity.NewError(entity.ErrInternal, fmt.Errorf("не удалось заполнить шаблон выгрузки: %w", err))
+	}
+
+	if format == entity.ExportFormatHTML {
+		file.ContentType = "text/html; charset=utf-8"
+		file.Data = buf.Bytes()
+		return file, nil
+	}
+
+	file.ContentType = "application/pdf"
+	if file.Data, err = utils.GeneratePDF(buf.String(), s.PDFConfig); err != nil {
+		return nil, entity.NewError(entity.ErrInternal, err)
+	}
+	return file, nil
+}
+
+func (s *ChatService) chatExport(ctx context.Context, chat *entity.Chat, userID int, role string) (*dto.ChatExport, error) {
+	vacancy, err := s.VacancyUC.GetVacancy(ctx, chat.VacancyID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Как и в GetChat, резюме читается от имени владельца, чтобы настройки видимости не мешали выгрузке
+	resume, err := s.ResumeUC.GetByID(ctx, chat.ResumeID, chat.ApplicantID, string(entity.ApplicantRole), "")
+	if err != nil {
+		return nil, err
+	}
+
+	applicant, err := s.ApplicantUC.GetUser(ctx, chat.ApplicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	employer, err := s.EmployerUC.GetUser(ctx, chat.EmployerID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.chatHistory(ctx, chat.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicantHidden, err := s.applicantHidden(ctx, chat, role)
+	if err != nil {
+		return nil, err
+	}
+	applicantName := applicantFullName(applicant)
+	if applicantHidden {
+		applicantName = ""
+	}
 
-		chatMessages = append(chatMessages, &dto.MessageResponse{
+	export := &dto.ChatExport{
+		ChatID:        chat.ID,
+		VacancyID:     vacancy.ID,
+		VacancyTitle:  vacancy.Title,
+		ResumeID:      resume.ID,
+		Profession:    resume.Profession,
+		ApplicantName: applicantName,
+		EmployerName:  employer.CompanyName,
+		CreatedAt:     chat.CreatedAt,
+		ExportedAt:    time.Now(),
+		Messages:      make([]*dto.ChatExportMessage, 0, len(history)),
+	}
+
+	for _, msg := range history {
+		message := &dto.ChatExportMessage{
 			ID:            msg.ID,
-			ChatID:        msg.ChatID,
-			SenderID:      msg.SenderID,
-			ReceiverID:    receiverID,
-			Avatar:        avatarPath,
 			FromApplicant: msg.FromApplicant,
+			Kind:          msg.Kind,
+			Event:         msg.Event,
 			Payload:       msg.Payload,
 			SentAt:        msg.SentAt,
+			EditedAt:      msg.EditedAt,
+			Deleted:       msg.Deleted(),
+		}
+		if !msg.IsSystem() {
+			message.Author = export.EmployerName
+			if msg.FromApplicant {
+				message.Author = export.ApplicantName
+			}
+		}
+		if message.Deleted {
+			message.Payload = ""
+		}
+		for _, attachment := range msg.Attachments {
+			message.Attachments = append(message.Attachments, attachment.FileName)
+		}
+		export.Messages = append(export.Messages, message)
+	}
+	return export, nil
+}
+
+// chatHistory загружает переписку целиком, страница за страницей от последнего сообщения к первому
+func (s *ChatService) chatHistory(ctx context.Context, chatID int) ([]*entity.Message, error) {
+	var history []*entity.Message
+	page := entity.MessagePage{Limit: entity.MaxMessagePageSize}
+	for {
+		messages, err := s.MessageRepo.GetMessagesForChat(ctx, chatID, page)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(messages, history...)
+		if len(messages) < page.Limit {
+			return history, nil
+		}
+		page.BeforeID = messages[0].ID
+	}
+}
+
+// EditMessage исправляет текст сообщения. Править можно только свои неудаленные сообщения
+// в течение entity.MessageEditWindow после отправки. Участникам правка рассылается по событию outbox
+func (s *ChatService) EditMessage(ctx context.Context, chatID, messageID, userID int, role, payload string) (*dto.MessageResponse, error) {
+	chat, msg, err := s.ownMessage(ctx, chatID, messageID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.IsBlocked() {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("переписка в чате заблокирована"))
+	}
+
+	if time.Since(msg.SentAt) > entity.MessageEditWindow {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("время на исправление сообщения истекло"))
+	}
+
+	sanitizedPayload := sanitizer.StrictPolicy.Sanitize(payload)
+	if strings.TrimSpace(sanitizedPayload) == "" && len(msg.Attachments) == 0 {
+		return nil, entity.NewError(entity.ErrBadRequest, errors.New("текст сообщения не может быть пустым"))
+	}
+
+	// Повторная отправка той же правки не должна плодить записи в истории
+	if sanitizedPayload != msg.Payload {
+		msg, err = s.MessageRepo.EditMessage(ctx, messageID, sanitizedPayload, &entity.OutboxEvent{
+			Type:    entity.MessageEditedEvent,
+			Objects: map[string]int{"chat_id": chatID, "message_id": messageID},
 		})
+		if err != nil {
+			return nil, err
+		}
 	}
-	return chatMessages, nil
+
+	return s.messageResponseFor(ctx, chat, msg, role, nil)
 }
 
-func isApplicant(role string) bool {
-	return role == "applicant"
+// DeleteMessage удаляет свое сообщение для обоих участников чата. Участникам удаление рассылается
+// по событию outbox
+func (s *ChatService) DeleteMessage(ctx context.Context, chatID, messageID, userID int, role string) (*dto.MessageResponse, error) {
+	chat, _, err := s.ownMessage(ctx, chatID, messageID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.MessageRepo.DeleteMessage(ctx, messageID, &entity.OutboxEvent{
+		Type:    entity.MessageDeletedEvent,
+		Objects: map[string]int{"chat_id": chatID, "message_id": messageID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.messageResponseFor(ctx, chat, msg, role, nil)
+}
+
+// GetMessageEdits возвращает прежние редакции сообщения любому участнику чата
+func (s *ChatService) GetMessageEdits(ctx context.Context, chatID, messageID, userID int, role string) (dto.MessageEditResponseList, error) {
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	msg, err := s.MessageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ChatID != chatID {
+		return nil, entity.NewError(entity.ErrNotFound, fmt.Errorf("сообщение с id=%d не найдено", messageID))
+	}
+
+	edits, err := s.MessageRepo.GetMessageEdits(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(dto.MessageEditResponseList, 0, len(edits))
+	for _, edit := range edits {
+		history = append(history, &dto.MessageEditResponse{
+			Payload:  edit.Payload,
+			EditedAt: edit.EditedAt,
+		})
+	}
+	return history, nil
+}
+
+// ownMessage находит неудаленное сообщение чата, отправленное пользователем
+func (s *ChatService) ownMessage(ctx context.Context, chatID, messageID, userID int, role string) (*entity.Chat, *entity.Message, error) {
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	msg, err := s.MessageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if msg.ChatID != chatID || msg.Deleted() {
+		return nil, nil, entity.NewError(entity.ErrNotFound, fmt.Errorf("сообщение с id=%d не найдено", messageID))
+	}
+	if msg.SenderID != userID || msg.FromApplicant != isApplicant(role) {
+		return nil, nil, entity.NewError(entity.ErrForbidden, errors.New("можно изменять только свои сообщения"))
+	}
+	return chat, msg, nil
+}
+
+// InviteToVacancy приглашает владельца найденного резюме на активную вакансию работодателя.
+// Сразу создается чат с приглашением, чтобы соискатель мог задать вопросы до отклика.
+// Уведомление и сообщение в чате рассылаются по событию outbox
+func (s *ChatService) InviteToVacancy(ctx context.Context, employerID, vacancyID, resumeID int) (*dto.InvitationResponse, error) {
+	// Через usecase проверяется, что работодатель вообще может видеть резюме. В ответе id владельца
+	// анонимного резюме скрыт, поэтому сам id берется из сохраненного резюме
+	masked, err := s.ResumeUC.GetByID(ctx, resumeID, employerID, string(entity.EmployerRole), "")
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := s.ResumeRepo.GetByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err := s.ChatRepo.GetForVacancy(ctx, vacancyID, resume.ApplicantID)
+	if err != nil {
+		return nil, err
+	}
+	if chat != nil {
+		return nil, entity.NewError(
+			entity.ErrAlreadyExists,
+			errors.New("чат с соискателем по этой вакансии уже существует"),
+		)
+	}
+
+	vacancy, err := s.VacancyUC.GetVacancy(ctx, vacancyID, employerID, string(entity.EmployerRole))
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, message, err := s.ChatRepo.CreateInvitation(ctx, &entity.Invitation{
+		VacancyID:   vacancyID,
+		ResumeID:    resumeID,
+		EmployerID:  employerID,
+		ApplicantID: resume.ApplicantID,
+	}, InvitationMessage, &entity.OutboxEvent{
+		Type: entity.InvitationCreatedEvent,
+		Notification: &entity.Notification{
+			Type:         entity.InvitationType,
+			SenderID:     employerID,
+			SenderRole:   entity.EmployerRole,
+			ReceiverID:   resume.ApplicantID,
+			ReceiverRole: entity.ApplicantRole,
+			ResumeID:     resumeID,
+			Payload:      entity.NotificationPayload{"vacancy_title": vacancy.Title},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := invitationToDTO(invitation)
+	resp.Message = systemMessageResponse(message)
+	if masked.ContactsHidden {
+		resp.ApplicantID = 0
+	}
+	return resp, nil
+}
+
+// AnswerInvitation принимает или отклоняет приглашение. Принятое приглашение становится откликом,
+// о котором работодатель получает обычное уведомление. Уведомление и сообщение в чате рассылаются
+// по событию outbox
+func (s *ChatService) AnswerInvitation(ctx context.Context, invitationID, applicantID int, accept bool) (*dto.InvitationResponse, error) {
+	invitation, err := s.ChatRepo.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.ApplicantID != applicantID {
+		return nil, entity.NewError(
+			entity.ErrForbidden,
+			fmt.Errorf("приглашение с id=%d адресовано другому соискателю", invitationID),
+		)
+	}
+
+	status := entity.InvitationDeclined
+	systemEvent, text := entity.SystemEventInvitationDeclined, InvitationDeclinedMessage
+	event := &entity.OutboxEvent{Type: entity.InvitationAnsweredEvent}
+	if accept {
+		status = entity.InvitationAccepted
+		systemEvent, text = entity.SystemEventInvitationAccepted, InvitationAcceptedMessage
+
+		vacancy, err := s.VacancyUC.GetVacancy(ctx, invitation.VacancyID, applicantID, string(entity.ApplicantRole))
+		if err != nil {
+			return nil, err
+		}
+		event.Notification = &entity.Notification{
+			Type:         entity.ApplyNotificationType,
+			SenderID:     applicantID,
+			SenderRole:   entity.ApplicantRole,
+			ReceiverID:   invitation.EmployerID,
+			ReceiverRole: entity.EmployerRole,
+			ObjectID:     invitation.VacancyID,
+			ResumeID:     invitation.ResumeID,
+			Payload:      entity.NotificationPayload{"vacancy_title": vacancy.Title},
+		}
+	}
+
+	answered, message, err := s.ChatRepo.AnswerInvitation(ctx, invitationID, status, systemEvent, text, event)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := invitationToDTO(answered)
+	if message != nil {
+		resp.Message = systemMessageResponse(message)
+	}
+	return resp, nil
+}
+
+// RecordEvent добавляет системные сообщения о событии outbox eventID в чаты, которых оно касается.
+// Отклик отмечается в уже начатом чате по вакансии, скачивание резюме - во всех чатах работодателя по этому резюме.
+// При повторной доставке события возвращаются уже созданные сообщения
+func (s *ChatService) RecordEvent(ctx context.Context, eventID int, notification *entity.Notification) ([]*dto.ChatEvent, error) {
+	var (
+		chats []*entity.Chat
+		event entity.SystemEvent
+		text  string
+	)
+
+	switch notification.Type {
+	case entity.ApplyNotificationType:
+		chat, err := s.ChatRepo.GetForVacancy(ctx, notification.ObjectID, notification.SenderID)
+		if err != nil {
+			return nil, err
+		}
+		// Чата еще нет: сообщение об отклике появится при его создании
+		if chat == nil {
+			return nil, nil
+		}
+		chats = []*entity.Chat{chat}
+		event, text = entity.SystemEventResponse, ResponseMessage
+	case entity.DownloadResumeType:
+		var err error
+		chats, err = s.ChatRepo.GetForResume(ctx, notification.SenderID, notification.ResumeID)
+		if err != nil {
+			return nil, err
+		}
+		event, text = entity.SystemEventResumeDownload, ResumeDownloadMessage
+	default:
+		return nil, nil
+	}
+
+	events := make([]*dto.ChatEvent, 0, len(chats))
+	for _, chat := range chats {
+		message, err := s.MessageRepo.CreateEventMessage(ctx, chat.ID, eventID, event, text)
+		if err != nil {
+			return nil, err
+		}
+		event, err := s.chatEvent(ctx, chat, systemMessageResponse(message))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// GetChatEvent возвращает сохраненное сообщение вместе с участниками чата, чтобы разослать его по событию outbox.
+// Сообщение читается в текущем состоянии, поэтому при повторной доставке рассылается последняя правка
+func (s *ChatService) GetChatEvent(ctx context.Context, messageID int) (*dto.ChatEvent, error) {
+	msg, err := s.MessageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err := s.ChatRepo.GetChatByID(ctx, msg.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.messageResponse(ctx, chat, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.chatEvent(ctx, chat, message)
+}
+
+// UpdateChatSettings меняет настройки чата пользователя: архив, уведомления и блокировку собеседника
+func (s *ChatService) UpdateChatSettings(ctx context.Context, chatID, userID int, role string, req *dto.ChatSettingsRequest) (*dto.ChatSettingsResponse, error) {
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+
+	settings, err := s.ChatRepo.GetSettings(ctx, chatID, isApplicant(role))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Archived != nil {
+		settings.ArchivedAt = nil
+		if *req.Archived {
+			now := time.Now()
+			settings.ArchivedAt = &now
+		}
+	}
+	if req.Muted != nil {
+		settings.Muted = *req.Muted
+	}
+	if req.Blocked != nil {
+		settings.Blocked = *req.Blocked
+	}
+
+	updated, err := s.ChatRepo.UpdateSettings(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ChatSettingsResponse{
+		ChatID:   updated.ChatID,
+		Archived: updated.Archived,
+		Muted:    updated.Muted,
+		Blocked:  updated.Blocked,
+	}, nil
+}
+
+// GetChatPeer возвращает собеседника пользователя в чате, проверяя, что пользователь в нем участвует
+func (s *ChatService) GetChatPeer(ctx context.Context, chatID, userID int, role string) (*dto.ChatPeer, error) {
+	chat, err := s.ChatRepo.GetChatByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.IsBlocked() {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("переписка в чате заблокирована"))
+	}
+
+	if !isParticipant(chat, userID, role) {
+		return nil, entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату"))
+	}
+	if !isApplicant(role) {
+		return &dto.ChatPeer{ID: chat.ApplicantID}, nil
+	}
+
+	userHidden, err := s.applicantHidden(ctx, chat, string(entity.EmployerRole))
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ChatPeer{ID: chat.EmployerID, UserHidden: userHidden}, nil
+}
+
+// GetChatPeers возвращает всех собеседников пользователя без повторов. Собеседники из заблокированных
+// чатов не возвращаются, чтобы не сообщать им о присутствии пользователя. От работодателя соискатель
+// скрыт, только если скрыт во всех их общих чатах: по любому другому чату его id работодателю уже известен
+func (s *ChatService) GetChatPeers(ctx context.Context, userID int, role string) ([]*dto.ChatPeer, error) {
+	fromApplicant := isApplicant(role)
+	chats, err := s.ChatRepo.GetForUser(ctx, userID, fromApplicant)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]*dto.ChatPeer, len(chats))
+	peers := make([]*dto.ChatPeer, 0, len(chats))
+	for _, chat := range chats {
+		if chat.IsBlocked() {
+			continue
+		}
+		if !fromApplicant {
+			if _, ok := seen[chat.ApplicantID]; !ok {
+				seen[chat.ApplicantID] = &dto.ChatPeer{ID: chat.ApplicantID}
+				peers = append(peers, seen[chat.ApplicantID])
+			}
+			continue
+		}
+
+		userHidden, err := s.applicantHidden(ctx, chat, string(entity.EmployerRole))
+		if err != nil {
+			return nil, err
+		}
+		if peer, ok := seen[chat.EmployerID]; ok {
+			peer.UserHidden = peer.UserHidden && userHidden
+			continue
+		}
+		seen[chat.EmployerID] = &dto.ChatPeer{ID: chat.EmployerID, UserHidden: userHidden}
+		peers = append(peers, seen[chat.EmployerID])
+	}
+	return peers, nil
+}
+
+func (s *ChatService) GetUnreadCount(ctx context.Context, userID int, role string) (*dto.UnreadCountResponse, error) {
+	count, err := s.ChatRepo.GetUnreadCount(ctx, userID, isApplicant(role))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UnreadCountResponse{
+		Messages: count.Messages,
+		Chats:    count.Chats,
+	}, nil
+}
+
+// chatPreview собирает краткое описание чата с точки зрения пользователя: вакансия и собеседник
+func (s *ChatService) chatPreview(ctx context.Context, chat *entity.Chat, userID int, role string) (*dto.ChatShortResponse, error) {
+	vacancy, err := s.VacancyUC.GetVacancy(ctx, chat.VacancyID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	var otherUser dto.ChatUserPreview
+	switch role {
+	case "applicant":
+		employer, err := s.EmployerUC.GetUser(ctx, chat.EmployerID)
+		if err != nil {
+			return nil, err
+		}
+		otherUser = dto.ChatUserPreview{
+			ID:         employer.ID,
+			Name:       employer.CompanyName,
+			AvatarPath: employer.LogoPath,
+		}
+	case "employer":
+		applicantHidden, err := s.applicantHidden(ctx, chat, role)
+		if err != nil {
+			return nil, err
+		}
+		if applicantHidden {
+			break
+		}
+		applicant, err := s.ApplicantUC.GetUser(ctx, chat.ApplicantID)
+		if err != nil {
+			return nil, err
+		}
+		otherUser = dto.ChatUserPreview{
+			ID:         applicant.ID,
+			Name:       applicantFullName(applicant),
+			AvatarPath: applicant.AvatarPath,
+		}
+	default:
+		return nil, entity.NewError(entity.ErrBadRequest, fmt.Errorf("неизвестная роль пользователя: %s", role))
+	}
+
+	return &dto.ChatShortResponse{
+		ID:            chat.ID,
+		VacancyTitle:  vacancy.Title,
+		User:          otherUser,
+		UnreadCount:   chat.UnreadCount,
+		Archived:      chat.Archived,
+		Muted:         chat.Muted,
+		Blocked:       chat.BlockedBy(isApplicant(role)),
+		BlockedByPeer: chat.BlockedBy(!isApplicant(role)),
+	}, nil
+}
+
+func (s *ChatService) messageResponse(ctx context.Context, chat *entity.Chat, msg *entity.Message) (*dto.MessageResponse, error) {
+	if msg.IsSystem() {
+		return systemMessageResponse(msg), nil
+	}
+
+	var avatarPath string
+	var receiverID int
+	if msg.FromApplicant {
+		applicant, err := s.ApplicantUC.GetUser(ctx, msg.SenderID)
+		if err != nil {
+			return nil, err
+		}
+		avatarPath = applicant.AvatarPath
+		receiverID = chat.EmployerID
+	} else {
+		employer, err := s.EmployerUC.GetUser(ctx, msg.SenderID)
+		if err != nil {
+			return nil, err
+		}
+		avatarPath = employer.LogoPath
+		receiverID = chat.ApplicantID
+	}
+
+	return &dto.MessageResponse{
+		ID:            msg.ID,
+		ChatID:        msg.ChatID,
+		SenderID:      msg.SenderID,
+		ReceiverID:    receiverID,
+		Avatar:        avatarPath,
+		FromApplicant: msg.FromApplicant,
+		Kind:          msg.Kind,
+		Payload:       msg.Payload,
+		ClientID:      msg.ClientID,
+		Attachments:   attachmentResponses(msg.Attachments),
+		SentAt:        msg.SentAt,
+		EditedAt:      msg.EditedAt,
+		Deleted:       msg.Deleted(),
+		Read:          msg.ID <= chat.LastReadID(!msg.FromApplicant),
+	}, nil
+}
+
+// systemMessageResponse описывает системное сообщение: у него нет отправителя, аватара и вложений
+func systemMessageResponse(msg *entity.Message) *dto.MessageResponse {
+	return &dto.MessageResponse{
+		ID:      msg.ID,
+		ChatID:  msg.ChatID,
+		Kind:    entity.MessageKindSystem,
+		Event:   msg.Event,
+		Payload: msg.Payload,
+		SentAt:  msg.SentAt,
+	}
+}
+
+func applicantFullName(applicant *dto.ApplicantProfileResponse) string {
+	return strings.TrimSpace(applicant.LastName + " " + applicant.FirstName + " " + applicant.MiddleName)
+}
+
+func isApplicant(role string) bool {
+	return role == "applicant"
+}
+
+// applicantHidden сообщает, нужно ли скрыть от работодателя, кто владелец анонимного резюме в чате.
+// Правило то же, что и для самого резюме: в чате по приглашению соискатель раскрывается, только
+// приняв его, потому что при этом записывается отклик
+func (s *ChatService) applicantHidden(ctx context.Context, chat *entity.Chat, role string) (bool, error) {
+	if isApplicant(role) {
+		return false, nil
+	}
+
+	resume, err := s.ResumeRepo.GetByID(ctx, chat.ResumeID)
+	if err != nil {
+		return false, err
+	}
+	if !resume.IsAnonymous {
+		return false, nil
+	}
+
+	disclosed, err := s.ResumeRepo.ContactsDisclosed(ctx, chat.ApplicantID, chat.EmployerID)
+	if err != nil {
+		return false, err
+	}
+	return !disclosed, nil
+}
+
+// messageResponseFor собирает сообщение для пользователя с ролью role, скрывая от работодателя владельца
+// анонимного резюме. checked запоминает уже проверенные чаты, если сообщения собраны из разных чатов
+func (s *ChatService) messageResponseFor(ctx context.Context, chat *entity.Chat, msg *entity.Message, role string, checked map[int]bool) (*dto.MessageResponse, error) {
+	message, err := s.messageResponse(ctx, chat, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	applicantHidden, ok := checked[chat.ID]
+	if !ok {
+		if applicantHidden, err = s.applicantHidden(ctx, chat, role); err != nil {
+			return nil, err
+		}
+		if checked != nil {
+			checked[chat.ID] = applicantHidden
+		}
+	}
+	if applicantHidden {
+		return hideApplicant(message), nil
+	}
+	return message, nil
+}
+
+// chatEvent готовит сообщение к рассылке обоим участникам чата. В системных сообщениях нет данных
+// участников, поэтому работодатель получает их без изменений
+func (s *ChatService) chatEvent(ctx context.Context, chat *entity.Chat, message *dto.MessageResponse) (*dto.ChatEvent, error) {
+	event := &dto.ChatEvent{
+		Message:         message,
+		EmployerMessage: message,
+		ApplicantID:     chat.ApplicantID,
+		EmployerID:      chat.EmployerID,
+	}
+	if message.Kind == entity.MessageKindSystem {
+		return event, nil
+	}
+
+	applicantHidden, err := s.applicantHidden(ctx, chat, string(entity.EmployerRole))
+	if err != nil {
+		return nil, err
+	}
+	if applicantHidden {
+		event.EmployerMessage = hideApplicant(message)
+	}
+	return event, nil
+}
+
+// hideApplicant возвращает копию сообщения без id и фото соискателя
+func hideApplicant(message *dto.MessageResponse) *dto.MessageResponse {
+	hidden := *message
+	if hidden.FromApplicant {
+		hidden.SenderID = 0
+		hidden.Avatar = ""
+	} else {
+		hidden.ReceiverID = 0
+	}
+	return &hidden
+}
+
+func isParticipant(chat *entity.Chat, userID int, role string) bool {
+	if isApplicant(role) {
+		return chat.ApplicantID == userID
+	}
+	return chat.EmployerID == userID
+}
+
+func uniqueIDs(ids []int) []int {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	seen := make(map[int]struct{}, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// attachmentFileName оставляет от присланного имени только базовое имя файла, чтобы его
+// можно было безопасно подставить в Content-Disposition при скачивании
+func attachmentFileName(name string) string {
+	const maxFileNameLength = 255
+
+	name = strings.TrimSpace(path.Base(strings.ReplaceAll(name, "\\", "/")))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	if runes := []rune(name); len(runes) > maxFileNameLength {
+		name = string(runes[:maxFileNameLength])
+	}
+	return name
+}
+
+func attachmentResponses(attachments []*entity.Attachment) []*dto.AttachmentResponse {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	responses := make([]*dto.AttachmentResponse, 0, len(attachments))
+	for _, attachment := range attachments {
+		responses = append(responses, &dto.AttachmentResponse{
+			ID:          attachment.ID,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			Size:        attachment.Size,
+		})
+	}
+	return responses
+}
+
+func invitationToDTO(invitation *entity.Invitation) *dto.InvitationResponse {
+	return &dto.InvitationResponse{
+		ID:          invitation.ID,
+		VacancyID:   invitation.VacancyID,
+		ResumeID:    invitation.ResumeID,
+		EmployerID:  invitation.EmployerID,
+		ApplicantID: invitation.ApplicantID,
+		ChatID:      invitation.ChatID,
+		Status:      invitation.Status,
+		CreatedAt:   invitation.CreatedAt,
+		UpdatedAt:   invitation.UpdatedAt,
+	}
 }
@@ -39,7 +39,7 @@ func TestChatService_StartChat(t *testing.T) {
 					Return(&entity.Chat{ID: 10, ApplicantID: 3}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 10, 3, true, ResponseMessage).
+					CreateSystemMessage(gomock.Any(), 10, entity.SystemEventResponse, ResponseMessage).
 					Return(&entity.Message{ID: 100}, nil)
 			},
 			expectedChatID: 10,
@@ -55,7 +55,7 @@ func TestChatService_StartChat(t *testing.T) {
 				chatRepo.EXPECT().
 					CreateChat(gomock.Any(), 5, 6, 8, 7).
 					Return(nil, errors.New("failed to create chat"))
-				// CreateMessage НЕ вызывается
+				// CreateSystemMessage НЕ вызывается
 			},
 			expectedChatID: -1,
 			expectedErr:    errors.New("failed to create chat"),
@@ -72,7 +72,7 @@ func TestChatService_StartChat(t *testing.T) {
 					Return(&entity.Chat{ID: 20, ApplicantID: 11}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 20, 11, true, ResponseMessage).
+					CreateSystemMessage(gomock.Any(), 20, entity.SystemEventResponse, ResponseMessage).
 					Return(nil, errors.New("failed to create message"))
 			},
 			expectedChatID: -1,
@@ -123,20 +123,46 @@ func TestChatService_GetChat(t *testing.T) {
 		role      string
 		mockSetup func(
 			chatRepo *mock.MockChatRepository,
+			resumeRepo *mock.MockResumeRepository,
 			vacancyUC *m.MockVacancy,
 			resumeUC *m.MockResumeUsecase,
 			applicantUC *m.MockApplicant,
 			employerUC *m.MockEmployer,
+			presenceUC *m.MockPresence,
 		)
 		expectedResult *dto.ChatResponse
 		expectedErr    error
 	}{
+		{
+			name:   "Success - anonymous applicant hidden from employer",
+			chatID: 1,
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, applicantUC *m.MockApplicant, employerUC *m.MockEmployer, presenceUC *m.MockPresence) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, VacancyID: 2, ResumeID: 3, EmployerID: 10, ApplicantID: 20}, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 2, 10, "employer").Return(&dto.VacancyResponse{ID: 2, EmployerID: 10, Title: "Backend"}, nil)
+				resumeUC.EXPECT().GetByID(gomock.Any(), 3, 20, "applicant", "").Return(&dto.ResumeResponse{ID: 3, ApplicantID: 20, Profession: "Backend"}, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{ID: 20, AvatarPath: "/a.png"}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{ID: 10, LogoPath: "/e.png"}, nil)
+				presenceUC.EXPECT().GetPresence(gomock.Any(), 20, "applicant").Return(&dto.PresenceResponse{UserID: 20, Role: entity.ApplicantRole, Online: true}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 3).Return(&entity.Resume{ID: 3, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+			},
+			expectedResult: &dto.ChatResponse{
+				ID:           1,
+				Vacancy:      &dto.VacancyChatResponse{ID: 2, EmployerID: 10, LogoPath: "/e.png", Title: "Backend"},
+				Resume:       &dto.ResumeChatResponse{ID: 3, Profession: "Backend"},
+				PeerPresence: &dto.PresenceResponse{Role: entity.ApplicantRole, Online: true},
+			},
+		},
 		{
 			name:   "Error - GetChatByID fails",
 			chatID: 2,
 			userID: 20,
 			role:   "employer",
-			mockSetup: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, applicantUC *m.MockApplicant, employerUC *m.MockEmployer, presenceUC *m.MockPresence) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 2).
 					Return(nil, errors.New("chat not found"))
@@ -159,15 +185,19 @@ func TestChatService_GetChat(t *testing.T) {
 			mockResumeUC := m.NewMockResumeUsecase(ctrl)
 			mockApplicantUC := m.NewMockApplicant(ctrl)
 			mockEmployerUC := m.NewMockEmployer(ctrl)
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			mockPresenceUC := m.NewMockPresence(ctrl)
 
-			tc.mockSetup(mockChatRepo, mockVacancyUC, mockResumeUC, mockApplicantUC, mockEmployerUC)
+			tc.mockSetup(mockChatRepo, mockResumeRepo, mockVacancyUC, mockResumeUC, mockApplicantUC, mockEmployerUC, mockPresenceUC)
 
 			service := &ChatService{
 				ChatRepo:    mockChatRepo,
+				ResumeRepo:  mockResumeRepo,
 				VacancyUC:   mockVacancyUC,
 				ResumeUC:    mockResumeUC,
 				ApplicantUC: mockApplicantUC,
 				EmployerUC:  mockEmployerUC,
+				PresenceUC:  mockPresenceUC,
 			}
 
 			ctx := context.Background()
@@ -191,18 +221,22 @@ func TestChatService_SendMessage(t *testing.T) {
 	now := time.Now()
 
 	testCases := []struct {
-		name      string
-		chatID    int
-		senderID  int
-		role      string
-		payload   string
-		mockSetup func(
+		name          string
+		chatID        int
+		senderID      int
+		role          string
+		payload       string
+		clientID      string
+		attachmentIDs []int
+		mockSetup     func(
 			chatRepo *mock.MockChatRepository,
 			messageRepo *mock.MockMessageRepository,
+			resumeRepo *mock.MockResumeRepository,
 			applicantUC *m.MockApplicant,
 			employerUC *m.MockEmployer,
 		)
 		expectedResult *dto.MessageResponse
+		employerResult *dto.MessageResponse
 		expectedErr    error
 	}{
 		{
@@ -211,23 +245,26 @@ func TestChatService_SendMessage(t *testing.T) {
 			senderID: 10,
 			role:     "applicant",
 			payload:  "Hello from applicant",
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			clientID: "c-1",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 1).
 					Return(&entity.Chat{
 						ID:          1,
+						ResumeID:    5,
 						ApplicantID: 10,
 						EmployerID:  20,
 					}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 1, 10, true, "Hello from applicant").
+					CreateMessage(gomock.Any(), 1, 10, true, "Hello from applicant", "c-1", nil).
 					Return(&entity.Message{
 						ID:            100,
 						ChatID:        1,
 						SenderID:      10,
 						FromApplicant: true,
 						Payload:       "Hello from applicant",
+						ClientID:      "c-1",
 						SentAt:        now,
 					}, nil)
 
@@ -236,6 +273,8 @@ func TestChatService_SendMessage(t *testing.T) {
 					Return(&dto.ApplicantProfileResponse{
 						AvatarPath: "/avatars/applicant10.png",
 					}, nil)
+
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&entity.Resume{ID: 5, ApplicantID: 10}, nil)
 			},
 			expectedResult: &dto.MessageResponse{
 				ID:            100,
@@ -245,27 +284,85 @@ func TestChatService_SendMessage(t *testing.T) {
 				Avatar:        "/avatars/applicant10.png",
 				FromApplicant: true,
 				Payload:       "Hello from applicant",
+				ClientID:      "c-1",
 				SentAt:        now,
 			},
 			expectedErr: nil,
 		},
+		{
+			name:          "Success - with attachments, duplicates collapsed",
+			chatID:        1,
+			senderID:      10,
+			role:          "applicant",
+			payload:       "",
+			attachmentIDs: []int{3, 4, 3},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, ResumeID: 5, ApplicantID: 10, EmployerID: 20}, nil)
+
+				messageRepo.EXPECT().
+					CreateMessage(gomock.Any(), 1, 10, true, "", "", []int{3, 4}).
+					Return(&entity.Message{
+						ID:            101,
+						ChatID:        1,
+						SenderID:      10,
+						FromApplicant: true,
+						Attachments: []*entity.Attachment{
+							{ID: 3, FileName: "cv.pdf", ContentType: "application/pdf", Size: 100},
+							{ID: 4, FileName: "photo.png", ContentType: "image/png", Size: 200},
+						},
+						SentAt: now,
+					}, nil)
+
+				applicantUC.EXPECT().
+					GetUser(gomock.Any(), 10).
+					Return(&dto.ApplicantProfileResponse{AvatarPath: "/avatars/applicant10.png"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&entity.Resume{ID: 5, ApplicantID: 10}, nil)
+			},
+			expectedResult: &dto.MessageResponse{
+				ID:            101,
+				ChatID:        1,
+				SenderID:      10,
+				ReceiverID:    20,
+				Avatar:        "/avatars/applicant10.png",
+				FromApplicant: true,
+				Attachments: []*dto.AttachmentResponse{
+					{ID: 3, FileName: "cv.pdf", ContentType: "application/pdf", Size: 100},
+					{ID: 4, FileName: "photo.png", ContentType: "image/png", Size: 200},
+				},
+				SentAt: now,
+			},
+		},
+		{
+			name:          "Error - too many attachments",
+			chatID:        1,
+			senderID:      10,
+			role:          "applicant",
+			payload:       "много файлов",
+			attachmentIDs: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			},
+			expectedErr: entity.NewError(entity.ErrBadRequest, errors.New("к сообщению можно прикрепить не больше 10 файлов")),
+		},
 		{
 			name:     "Success - from employer",
 			chatID:   2,
 			senderID: 20,
 			role:     "employer",
 			payload:  "Hello from employer",
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 2).
 					Return(&entity.Chat{
 						ID:          2,
+						ResumeID:    6,
 						ApplicantID: 30,
 						EmployerID:  20,
 					}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 2, 20, false, "Hello from employer").
+					CreateMessage(gomock.Any(), 2, 20, false, "Hello from employer", "", nil).
 					Return(&entity.Message{
 						ID:            101,
 						ChatID:        2,
@@ -280,6 +377,7 @@ func TestChatService_SendMessage(t *testing.T) {
 					Return(&dto.EmployerProfileResponse{
 						LogoPath: "/logos/employer20.png",
 					}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 6).Return(&entity.Resume{ID: 6, ApplicantID: 30}, nil)
 			},
 			expectedResult: &dto.MessageResponse{
 				ID:            101,
@@ -293,13 +391,60 @@ func TestChatService_SendMessage(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name:     "Success - anonymous applicant hidden from employer",
+			chatID:   2,
+			senderID: 10,
+			role:     "applicant",
+			payload:  "Hello from anonymous applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 2).
+					Return(&entity.Chat{ID: 2, ResumeID: 6, ApplicantID: 10, EmployerID: 20}, nil)
+
+				messageRepo.EXPECT().
+					CreateMessage(gomock.Any(), 2, 10, true, "Hello from anonymous applicant", "", nil).
+					Return(&entity.Message{
+						ID:            104,
+						ChatID:        2,
+						SenderID:      10,
+						FromApplicant: true,
+						Payload:       "Hello from anonymous applicant",
+						SentAt:        now,
+					}, nil)
+
+				applicantUC.EXPECT().
+					GetUser(gomock.Any(), 10).
+					Return(&dto.ApplicantProfileResponse{AvatarPath: "/avatars/applicant10.png"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 6).Return(&entity.Resume{ID: 6, ApplicantID: 10, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 10, 20).Return(false, nil)
+			},
+			expectedResult: &dto.MessageResponse{
+				ID:            104,
+				ChatID:        2,
+				SenderID:      10,
+				ReceiverID:    20,
+				Avatar:        "/avatars/applicant10.png",
+				FromApplicant: true,
+				Payload:       "Hello from anonymous applicant",
+				SentAt:        now,
+			},
+			employerResult: &dto.MessageResponse{
+				ID:            104,
+				ChatID:        2,
+				ReceiverID:    20,
+				FromApplicant: true,
+				Payload:       "Hello from anonymous applicant",
+				SentAt:        now,
+			},
+		},
 		{
 			name:     "Error - GetChatByID fails",
 			chatID:   3,
 			senderID: 30,
 			role:     "applicant",
 			payload:  "Test",
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 3).
 					Return(nil, errors.New("chat not found"))
@@ -307,13 +452,55 @@ func TestChatService_SendMessage(t *testing.T) {
 			expectedResult: nil,
 			expectedErr:    errors.New("chat not found"),
 		},
+		{
+			name:     "Error - sender is not a participant",
+			chatID:   3,
+			senderID: 31,
+			role:     "applicant",
+			payload:  "Test",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 3).
+					Return(&entity.Chat{ID: 3, ApplicantID: 30, EmployerID: 40}, nil)
+			},
+			expectedResult: nil,
+			expectedErr:    entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату")),
+		},
+		{
+			name:     "Error - employer of another chat",
+			chatID:   3,
+			senderID: 41,
+			role:     "employer",
+			payload:  "Test",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 3).
+					Return(&entity.Chat{ID: 3, ApplicantID: 30, EmployerID: 40}, nil)
+			},
+			expectedResult: nil,
+			expectedErr:    entity.NewError(entity.ErrForbidden, errors.New("у вас нет доступа к этому чату")),
+		},
+		{
+			name:     "Error - chat blocked by the peer",
+			chatID:   3,
+			senderID: 30,
+			role:     "applicant",
+			payload:  "Test",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 3).
+					Return(&entity.Chat{ID: 3, ApplicantID: 30, EmployerID: 40, EmployerBlocked: true}, nil)
+			},
+			expectedResult: nil,
+			expectedErr:    entity.NewError(entity.ErrForbidden, errors.New("переписка в чате заблокирована")),
+		},
 		{
 			name:     "Error - CreateMessage fails",
 			chatID:   4,
 			senderID: 40,
 			role:     "employer",
 			payload:  "Test message",
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 4).
 					Return(&entity.Chat{
@@ -323,7 +510,7 @@ func TestChatService_SendMessage(t *testing.T) {
 					}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 4, 40, false, "Test message").
+					CreateMessage(gomock.Any(), 4, 40, false, "Test message", "", nil).
 					Return(nil, errors.New("failed to create message"))
 			},
 			expectedResult: nil,
@@ -335,7 +522,7 @@ func TestChatService_SendMessage(t *testing.T) {
 			senderID: 60,
 			role:     "applicant",
 			payload:  "Hi",
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 5).
 					Return(&entity.Chat{
@@ -345,7 +532,7 @@ func TestChatService_SendMessage(t *testing.T) {
 					}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 5, 60, true, "Hi").
+					CreateMessage(gomock.Any(), 5, 60, true, "Hi", "", nil).
 					Return(&entity.Message{
 						ID:            102,
 						ChatID:        5,
@@ -368,7 +555,7 @@ func TestChatService_SendMessage(t *testing.T) {
 			senderID: 70,
 			role:     "employer",
 			payload:  "Hello",
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 6).
 					Return(&entity.Chat{
@@ -378,7 +565,7 @@ func TestChatService_SendMessage(t *testing.T) {
 					}, nil)
 
 				messageRepo.EXPECT().
-					CreateMessage(gomock.Any(), 6, 70, false, "Hello").
+					CreateMessage(gomock.Any(), 6, 70, false, "Hello", "", nil).
 					Return(&entity.Message{
 						ID:            103,
 						ChatID:        6,
@@ -409,18 +596,20 @@ func TestChatService_SendMessage(t *testing.T) {
 			mockMessageRepo := mock.NewMockMessageRepository(ctrl)
 			mockApplicantUC := m.NewMockApplicant(ctrl)
 			mockEmployerUC := m.NewMockEmployer(ctrl)
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
 
-			tc.mockSetup(mockChatRepo, mockMessageRepo, mockApplicantUC, mockEmployerUC)
+			tc.mockSetup(mockChatRepo, mockMessageRepo, mockResumeRepo, mockApplicantUC, mockEmployerUC)
 
 			service := &ChatService{
 				ChatRepo:    mockChatRepo,
 				MessageRepo: mockMessageRepo,
+				ResumeRepo:  mockResumeRepo,
 				ApplicantUC: mockApplicantUC,
 				EmployerUC:  mockEmployerUC,
 			}
 
 			ctx := context.Background()
-			resp, err := service.SendMessage(ctx, tc.chatID, tc.senderID, tc.role, tc.payload)
+			resp, err := service.SendMessage(ctx, tc.chatID, tc.senderID, tc.role, tc.payload, tc.clientID, tc.attachmentIDs)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -428,7 +617,13 @@ func TestChatService_SendMessage(t *testing.T) {
 				require.Nil(t, resp)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expectedResult, resp)
+				require.Equal(t, tc.expectedResult, resp.Message)
+
+				employerResult := tc.employerResult
+				if employerResult == nil {
+					employerResult = tc.expectedResult
+				}
+				require.Equal(t, employerResult, resp.EmployerMessage)
 			}
 		})
 	}
@@ -441,7 +636,8 @@ func TestChatService_GetUserChats(t *testing.T) {
 		name        string
 		userID      int
 		role        string
-		setupMocks  func(*mock.MockChatRepository, *m.MockVacancy, *m.MockEmployer, *m.MockApplicant)
+		archived    bool
+		setupMocks  func(*mock.MockChatRepository, *mock.MockResumeRepository, *m.MockVacancy, *m.MockEmployer, *m.MockApplicant)
 		want        dto.ChatResponseList
 		expectedErr error
 	}
@@ -451,9 +647,9 @@ func TestChatService_GetUserChats(t *testing.T) {
 			name:   "success for applicant",
 			userID: 1,
 			role:   "applicant",
-			setupMocks: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
 				chatRepo.EXPECT().GetForUser(gomock.Any(), 1, true).Return([]*entity.Chat{
-					{ID: 10, VacancyID: 100, EmployerID: 200, ApplicantID: 1},
+					{ID: 10, VacancyID: 100, EmployerID: 200, ApplicantID: 1, UnreadCount: 3},
 					{ID: 11, VacancyID: 101, EmployerID: 201, ApplicantID: 1},
 				}, nil)
 
@@ -464,7 +660,7 @@ func TestChatService_GetUserChats(t *testing.T) {
 				employerUC.EXPECT().GetUser(gomock.Any(), 201).Return(&dto.EmployerProfileResponse{ID: 201, CompanyName: "Company B", LogoPath: "logoB.png"}, nil)
 			},
 			want: dto.ChatResponseList{
-				{ID: 10, VacancyTitle: "Vacancy 100", User: dto.ChatUserPreview{ID: 200, Name: "Company A", AvatarPath: "logoA.png"}},
+				{ID: 10, VacancyTitle: "Vacancy 100", User: dto.ChatUserPreview{ID: 200, Name: "Company A", AvatarPath: "logoA.png"}, UnreadCount: 3},
 				{ID: 11, VacancyTitle: "Vacancy 101", User: dto.ChatUserPreview{ID: 201, Name: "Company B", AvatarPath: "logoB.png"}},
 			},
 		},
@@ -472,12 +668,13 @@ func TestChatService_GetUserChats(t *testing.T) {
 			name:   "success for employer",
 			userID: 2,
 			role:   "employer",
-			setupMocks: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
 				chatRepo.EXPECT().GetForUser(gomock.Any(), 2, false).Return([]*entity.Chat{
-					{ID: 20, VacancyID: 200, EmployerID: 2, ApplicantID: 300},
+					{ID: 20, VacancyID: 200, ResumeID: 400, EmployerID: 2, ApplicantID: 300},
 				}, nil)
 
 				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 200, 2, "employer").Return(&dto.VacancyResponse{ID: 200, EmployerID: 2, Title: "Vacancy 200"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 400).Return(&entity.Resume{ID: 400, ApplicantID: 300}, nil)
 				applicantUC.EXPECT().GetUser(gomock.Any(), 300).Return(&dto.ApplicantProfileResponse{
 					ID: 300, FirstName: "Ivan", LastName: "Ivanov", MiddleName: "Ivanovich", AvatarPath: "avatar.png",
 				}, nil)
@@ -486,11 +683,49 @@ func TestChatService_GetUserChats(t *testing.T) {
 				{ID: 20, VacancyTitle: "Vacancy 200", User: dto.ChatUserPreview{ID: 300, Name: "Ivanov Ivan Ivanovich", AvatarPath: "avatar.png"}},
 			},
 		},
+		{
+			name:   "employer does not see owner of anonymous resume before disclosure",
+			userID: 2,
+			role:   "employer",
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetForUser(gomock.Any(), 2, false).Return([]*entity.Chat{
+					{ID: 20, VacancyID: 200, ResumeID: 400, EmployerID: 2, ApplicantID: 300},
+				}, nil)
+
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 200, 2, "employer").Return(&dto.VacancyResponse{ID: 200, EmployerID: 2, Title: "Vacancy 200"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 400).Return(&entity.Resume{ID: 400, ApplicantID: 300, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 300, 2).Return(false, nil)
+			},
+			want: dto.ChatResponseList{
+				{ID: 20, VacancyTitle: "Vacancy 200"},
+			},
+		},
+		{
+			name:     "archived view skips active chats",
+			userID:   1,
+			role:     "applicant",
+			archived: true,
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetForUser(gomock.Any(), 1, true).Return([]*entity.Chat{
+					{ID: 10, VacancyID: 100, EmployerID: 200, ApplicantID: 1},
+					{ID: 11, VacancyID: 101, EmployerID: 201, ApplicantID: 1, Archived: true, Muted: true, EmployerBlocked: true},
+				}, nil)
+
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 101, 1, "applicant").Return(&dto.VacancyResponse{ID: 101, EmployerID: 201, Title: "Vacancy 101"}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 201).Return(&dto.EmployerProfileResponse{ID: 201, CompanyName: "Company B", LogoPath: "logoB.png"}, nil)
+			},
+			want: dto.ChatResponseList{
+				{
+					ID: 11, VacancyTitle: "Vacancy 101", User: dto.ChatUserPreview{ID: 201, Name: "Company B", AvatarPath: "logoB.png"},
+					Archived: true, Muted: true, BlockedByPeer: true,
+				},
+			},
+		},
 		{
 			name:   "repo error",
 			userID: 1,
 			role:   "applicant",
-			setupMocks: func(chatRepo *mock.MockChatRepository, _ *m.MockVacancy, _ *m.MockEmployer, _ *m.MockApplicant) {
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, _ *m.MockVacancy, _ *m.MockEmployer, _ *m.MockApplicant) {
 				chatRepo.EXPECT().GetForUser(gomock.Any(), 1, true).Return(nil, errors.New("repo error"))
 			},
 			expectedErr: errors.New("repo error"),
@@ -499,7 +734,7 @@ func TestChatService_GetUserChats(t *testing.T) {
 			name:   "vacancy error",
 			userID: 1,
 			role:   "applicant",
-			setupMocks: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy, _ *m.MockEmployer, _ *m.MockApplicant) {
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, _ *m.MockEmployer, _ *m.MockApplicant) {
 				chatRepo.EXPECT().GetForUser(gomock.Any(), 1, true).Return([]*entity.Chat{
 					{ID: 10, VacancyID: 100, EmployerID: 200, ApplicantID: 1},
 				}, nil)
@@ -512,7 +747,7 @@ func TestChatService_GetUserChats(t *testing.T) {
 			name:   "employer get user error",
 			userID: 1,
 			role:   "applicant",
-			setupMocks: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, _ *m.MockApplicant) {
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, _ *m.MockApplicant) {
 				chatRepo.EXPECT().GetForUser(gomock.Any(), 1, true).Return([]*entity.Chat{
 					{ID: 10, VacancyID: 100, EmployerID: 200, ApplicantID: 1},
 				}, nil)
@@ -526,12 +761,13 @@ func TestChatService_GetUserChats(t *testing.T) {
 			name:   "applicant get user error",
 			userID: 2,
 			role:   "employer",
-			setupMocks: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy, _ *m.MockEmployer, applicantUC *m.MockApplicant) {
+			setupMocks: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy, _ *m.MockEmployer, applicantUC *m.MockApplicant) {
 				chatRepo.EXPECT().GetForUser(gomock.Any(), 2, false).Return([]*entity.Chat{
-					{ID: 20, VacancyID: 200, EmployerID: 2, ApplicantID: 300},
+					{ID: 20, VacancyID: 200, ResumeID: 400, EmployerID: 2, ApplicantID: 300},
 				}, nil)
 
 				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 200, 2, "employer").Return(&dto.VacancyResponse{ID: 200, EmployerID: 2, Title: "Vacancy 200"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 400).Return(&entity.Resume{ID: 400, ApplicantID: 300}, nil)
 				applicantUC.EXPECT().GetUser(gomock.Any(), 300).Return(nil, errors.New("applicant error"))
 			},
 			expectedErr: errors.New("applicant error"),
@@ -547,20 +783,22 @@ func TestChatService_GetUserChats(t *testing.T) {
 			defer ctrl.Finish()
 
 			chatRepo := mock.NewMockChatRepository(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
 			vacancyUC := m.NewMockVacancy(ctrl)
 			employerUC := m.NewMockEmployer(ctrl)
 			applicantUC := m.NewMockApplicant(ctrl)
 
 			service := &ChatService{
 				ChatRepo:    chatRepo,
+				ResumeRepo:  resumeRepo,
 				VacancyUC:   vacancyUC,
 				EmployerUC:  employerUC,
 				ApplicantUC: applicantUC,
 			}
 
-			tc.setupMocks(chatRepo, vacancyUC, employerUC, applicantUC)
+			tc.setupMocks(chatRepo, resumeRepo, vacancyUC, employerUC, applicantUC)
 
-			got, err := service.GetUserChats(context.Background(), tc.userID, tc.role)
+			got, err := service.GetUserChats(context.Background(), tc.userID, tc.role, tc.archived)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -582,25 +820,29 @@ func TestChatService_GetChatMessages(t *testing.T) {
 	testCases := []struct {
 		name      string
 		chatID    int
+		role      string
 		mockSetup func(
 			chatRepo *mock.MockChatRepository,
 			messageRepo *mock.MockMessageRepository,
+			resumeRepo *mock.MockResumeRepository,
 			applicantUC *m.MockApplicant,
 			employerUC *m.MockEmployer,
 		)
+		page           entity.MessagePage
 		expectedResult dto.MessagesResponseList
 		expectedErr    error
 	}{
 		{
 			name:   "Success - messages from applicant and employer",
 			chatID: 1,
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 1).
-					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20, EmployerLastReadID: 100}, nil)
 
 				messageRepo.EXPECT().
-					GetMessagesForChat(gomock.Any(), 1).
+					GetMessagesForChat(gomock.Any(), 1, entity.MessagePage{Limit: entity.DefaultMessagePageSize}).
 					Return([]*entity.Message{
 						{ID: 100, ChatID: 1, SenderID: 20, FromApplicant: true, Payload: "hello", SentAt: time.Now()},
 						{ID: 101, ChatID: 1, SenderID: 10, FromApplicant: false, Payload: "hi", SentAt: time.Now()},
@@ -623,6 +865,7 @@ func TestChatService_GetChatMessages(t *testing.T) {
 					Avatar:        "/avatars/applicant.png",
 					FromApplicant: true,
 					Payload:       "hello",
+					Read:          true,
 				},
 				{
 					ID:            101,
@@ -636,10 +879,95 @@ func TestChatService_GetChatMessages(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name:   "Success - page before message",
+			chatID: 6,
+			role:   "applicant",
+			page:   entity.MessagePage{BeforeID: 300, Limit: 1},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 6).
+					Return(&entity.Chat{ID: 6, EmployerID: 10, ApplicantID: 20}, nil)
+
+				messageRepo.EXPECT().
+					GetMessagesForChat(gomock.Any(), 6, entity.MessagePage{BeforeID: 300, Limit: 1}).
+					Return([]*entity.Message{
+						{ID: 299, ChatID: 6, SenderID: 10, FromApplicant: false, Payload: "older", SentAt: time.Now()},
+					}, nil)
+
+				employerUC.EXPECT().
+					GetUser(gomock.Any(), 10).
+					Return(&dto.EmployerProfileResponse{ID: 10, LogoPath: "/logos/employer.png"}, nil)
+			},
+			expectedResult: dto.MessagesResponseList{
+				{
+					ID:            299,
+					ChatID:        6,
+					SenderID:      10,
+					ReceiverID:    20,
+					Avatar:        "/logos/employer.png",
+					FromApplicant: false,
+					Payload:       "older",
+				},
+			},
+		},
+		{
+			name:   "Success - anonymous applicant hidden from employer",
+			chatID: 8,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 8).
+					Return(&entity.Chat{ID: 8, ResumeID: 30, EmployerID: 10, ApplicantID: 20}, nil)
+
+				messageRepo.EXPECT().
+					GetMessagesForChat(gomock.Any(), 8, entity.MessagePage{Limit: entity.DefaultMessagePageSize}).
+					Return([]*entity.Message{
+						{ID: 400, ChatID: 8, SenderID: 20, FromApplicant: true, Payload: "hello", SentAt: time.Now()},
+						{ID: 401, ChatID: 8, SenderID: 10, FromApplicant: false, Payload: "hi", SentAt: time.Now()},
+					}, nil)
+
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+
+				applicantUC.EXPECT().
+					GetUser(gomock.Any(), 20).
+					Return(&dto.ApplicantProfileResponse{ID: 20, AvatarPath: "/avatars/applicant.png"}, nil)
+
+				employerUC.EXPECT().
+					GetUser(gomock.Any(), 10).
+					Return(&dto.EmployerProfileResponse{ID: 10, LogoPath: "/logos/employer.png"}, nil)
+			},
+			expectedResult: dto.MessagesResponseList{
+				{ID: 400, ChatID: 8, ReceiverID: 10, FromApplicant: true, Payload: "hello"},
+				{ID: 401, ChatID: 8, SenderID: 10, Avatar: "/logos/employer.png", Payload: "hi"},
+			},
+		},
+		{
+			name:   "Error - before and after together",
+			chatID: 7,
+			role:   "applicant",
+			page:   entity.MessagePage{BeforeID: 10, AfterID: 5},
+			mockSetup: func(*mock.MockChatRepository, *mock.MockMessageRepository, *mock.MockResumeRepository, *m.MockApplicant, *m.MockEmployer) {
+			},
+			expectedResult: nil,
+			expectedErr:    entity.ErrBadRequest,
+		},
+		{
+			name:   "Error - page too large",
+			chatID: 7,
+			role:   "applicant",
+			page:   entity.MessagePage{Limit: entity.MaxMessagePageSize + 1},
+			mockSetup: func(*mock.MockChatRepository, *mock.MockMessageRepository, *mock.MockResumeRepository, *m.MockApplicant, *m.MockEmployer) {
+			},
+			expectedResult: nil,
+			expectedErr:    entity.ErrBadRequest,
+		},
 		{
 			name:   "Error - chat not found",
 			chatID: 2,
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 2).
 					Return(nil, errors.New("chat not found"))
@@ -650,13 +978,14 @@ func TestChatService_GetChatMessages(t *testing.T) {
 		{
 			name:   "Error - failed to get messages",
 			chatID: 3,
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 3).
 					Return(&entity.Chat{ID: 3, EmployerID: 10, ApplicantID: 20}, nil)
 
 				messageRepo.EXPECT().
-					GetMessagesForChat(gomock.Any(), 3).
+					GetMessagesForChat(gomock.Any(), 3, entity.MessagePage{Limit: entity.DefaultMessagePageSize}).
 					Return(nil, errors.New("db error"))
 			},
 			expectedResult: nil,
@@ -665,13 +994,14 @@ func TestChatService_GetChatMessages(t *testing.T) {
 		{
 			name:   "Error - failed to get applicant user",
 			chatID: 4,
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 4).
 					Return(&entity.Chat{ID: 4, EmployerID: 10, ApplicantID: 20}, nil)
 
 				messageRepo.EXPECT().
-					GetMessagesForChat(gomock.Any(), 4).
+					GetMessagesForChat(gomock.Any(), 4, entity.MessagePage{Limit: entity.DefaultMessagePageSize}).
 					Return([]*entity.Message{
 						{ID: 200, ChatID: 4, SenderID: 20, FromApplicant: true, Payload: "hey", SentAt: time.Now()},
 					}, nil)
@@ -686,13 +1016,14 @@ func TestChatService_GetChatMessages(t *testing.T) {
 		{
 			name:   "Error - failed to get employer user",
 			chatID: 5,
-			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
 				chatRepo.EXPECT().
 					GetChatByID(gomock.Any(), 5).
 					Return(&entity.Chat{ID: 5, EmployerID: 10, ApplicantID: 20}, nil)
 
 				messageRepo.EXPECT().
-					GetMessagesForChat(gomock.Any(), 5).
+					GetMessagesForChat(gomock.Any(), 5, entity.MessagePage{Limit: entity.DefaultMessagePageSize}).
 					Return([]*entity.Message{
 						{ID: 201, ChatID: 5, SenderID: 10, FromApplicant: false, Payload: "hey", SentAt: time.Now()},
 					}, nil)
@@ -718,17 +1049,19 @@ func TestChatService_GetChatMessages(t *testing.T) {
 			mockMessageRepo := mock.NewMockMessageRepository(ctrl)
 			mockApplicantUC := m.NewMockApplicant(ctrl)
 			mockEmployerUC := m.NewMockEmployer(ctrl)
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
 
-			tc.mockSetup(mockChatRepo, mockMessageRepo, mockApplicantUC, mockEmployerUC)
+			tc.mockSetup(mockChatRepo, mockMessageRepo, mockResumeRepo, mockApplicantUC, mockEmployerUC)
 
 			service := &ChatService{
 				ChatRepo:    mockChatRepo,
 				MessageRepo: mockMessageRepo,
+				ResumeRepo:  mockResumeRepo,
 				ApplicantUC: mockApplicantUC,
 				EmployerUC:  mockEmployerUC,
 			}
 
-			resp, err := service.GetChatMessages(ctx, tc.chatID)
+			resp, err := service.GetChatMessages(ctx, tc.chatID, tc.role, tc.page)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -745,8 +1078,1897 @@ func TestChatService_GetChatMessages(t *testing.T) {
 					require.Equal(t, tc.expectedResult[i].Avatar, resp[i].Avatar)
 					require.Equal(t, tc.expectedResult[i].FromApplicant, resp[i].FromApplicant)
 					require.Equal(t, tc.expectedResult[i].Payload, resp[i].Payload)
+					require.Equal(t, tc.expectedResult[i].Read, resp[i].Read)
 				}
 			}
 		})
 	}
 }
+
+func TestChatService_MarkRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		chatID      int
+		userID      int
+		role        string
+		messageID   int
+		mockSetup   func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository)
+		expected    *dto.ReadEvent
+		expectedErr error
+	}{
+		{
+			name:      "Success - applicant reads employer messages",
+			chatID:    1,
+			userID:    20,
+			role:      "applicant",
+			messageID: 105,
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20}, nil)
+				chatRepo.EXPECT().
+					MarkRead(gomock.Any(), 1, true, 105).
+					Return(105, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20}, nil)
+			},
+			expected: &dto.ReadEvent{
+				Receipt:         &dto.ReadReceipt{ChatID: 1, ReaderID: 20, ReceiverID: 10, FromApplicant: true, LastReadMessageID: 105},
+				EmployerReceipt: &dto.ReadReceipt{ChatID: 1, ReaderID: 20, ReceiverID: 10, FromApplicant: true, LastReadMessageID: 105},
+				ApplicantID:     20,
+				EmployerID:      10,
+			},
+		},
+		{
+			name:      "Success - anonymous applicant hidden from employer",
+			chatID:    1,
+			userID:    20,
+			role:      "applicant",
+			messageID: 105,
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20}, nil)
+				chatRepo.EXPECT().
+					MarkRead(gomock.Any(), 1, true, 105).
+					Return(105, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+			},
+			expected: &dto.ReadEvent{
+				Receipt:         &dto.ReadReceipt{ChatID: 1, ReaderID: 20, ReceiverID: 10, FromApplicant: true, LastReadMessageID: 105},
+				EmployerReceipt: &dto.ReadReceipt{ChatID: 1, ReceiverID: 10, FromApplicant: true, LastReadMessageID: 105},
+				ApplicantID:     20,
+				EmployerID:      10,
+			},
+		},
+		{
+			name:      "Success - stale tab does not move cursor back",
+			chatID:    1,
+			userID:    10,
+			role:      "employer",
+			messageID: 90,
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20, EmployerLastReadID: 100}, nil)
+				chatRepo.EXPECT().
+					MarkRead(gomock.Any(), 1, false, 90).
+					Return(100, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20}, nil)
+			},
+			expected: &dto.ReadEvent{
+				Receipt:         &dto.ReadReceipt{ChatID: 1, ReaderID: 10, ReceiverID: 20, LastReadMessageID: 100},
+				EmployerReceipt: &dto.ReadReceipt{ChatID: 1, ReaderID: 10, ReceiverID: 20, LastReadMessageID: 100},
+				ApplicantID:     20,
+				EmployerID:      10,
+			},
+		},
+		{
+			name:      "Error - not a participant",
+			chatID:    1,
+			userID:    99,
+			role:      "employer",
+			messageID: 105,
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:      "Error - chat not found",
+			chatID:    2,
+			userID:    20,
+			role:      "applicant",
+			messageID: 105,
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 2).
+					Return(nil, entity.NewError(entity.ErrNotFound, errors.New("чат не найден")))
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+		{
+			name:      "Error - message from another chat",
+			chatID:    1,
+			userID:    20,
+			role:      "applicant",
+			messageID: 500,
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+				chatRepo.EXPECT().
+					MarkRead(gomock.Any(), 1, true, 500).
+					Return(0, entity.NewError(entity.ErrNotFound, errors.New("сообщение не найдено")))
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			tc.mockSetup(chatRepo, resumeRepo)
+
+			service := &ChatService{ChatRepo: chatRepo, ResumeRepo: resumeRepo}
+
+			got, err := service.MarkRead(context.Background(), tc.chatID, tc.userID, tc.role, tc.messageID)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetUnreadCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		mockSetup   func(chatRepo *mock.MockChatRepository)
+		expected    *dto.UnreadCountResponse
+		expectedErr error
+	}{
+		{
+			name:   "Success - employer",
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository) {
+				chatRepo.EXPECT().
+					GetUnreadCount(gomock.Any(), 10, false).
+					Return(&entity.UnreadCount{Messages: 7, Chats: 2}, nil)
+			},
+			expected: &dto.UnreadCountResponse{Messages: 7, Chats: 2},
+		},
+		{
+			name:   "Success - nothing unread",
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository) {
+				chatRepo.EXPECT().
+					GetUnreadCount(gomock.Any(), 20, true).
+					Return(&entity.UnreadCount{}, nil)
+			},
+			expected: &dto.UnreadCountResponse{},
+		},
+		{
+			name:   "Error - repository",
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository) {
+				chatRepo.EXPECT().
+					GetUnreadCount(gomock.Any(), 20, true).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			tc.mockSetup(chatRepo)
+
+			service := &ChatService{ChatRepo: chatRepo}
+
+			got, err := service.GetUnreadCount(context.Background(), tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_SearchMessages(t *testing.T) {
+	t.Parallel()
+
+	sentAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		query       string
+		mockSetup   func(*mock.MockMessageRepository, *m.MockVacancy, *m.MockEmployer, *m.MockApplicant)
+		expected    dto.MessageSearchResponseList
+		expectedErr error
+	}{
+		{
+			name:   "Success - chat context loaded once per chat",
+			userID: 20,
+			role:   "applicant",
+			query:  " собеседование ",
+			mockSetup: func(messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, employerUC *m.MockEmployer, applicantUC *m.MockApplicant) {
+				chat := entity.Chat{ID: 1, VacancyID: 100, EmployerID: 10, ApplicantID: 20}
+				messageRepo.EXPECT().
+					SearchMessages(gomock.Any(), 20, true, "собеседование", 20, 0).
+					Return([]*entity.MessageWithChat{
+						{Message: entity.Message{ID: 5, ChatID: 1, SenderID: 10, Payload: "Ждем на собеседование", SentAt: sentAt}, Chat: chat},
+						{Message: entity.Message{ID: 3, ChatID: 1, SenderID: 20, FromApplicant: true, Payload: "Когда собеседование?", SentAt: sentAt}, Chat: chat},
+					}, nil)
+
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 100, 20, "applicant").Return(&dto.VacancyResponse{ID: 100, Title: "Go developer"}, nil).Times(1)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{ID: 10, CompanyName: "Orange", LogoPath: "logo.png"}, nil).Times(2)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{ID: 20, AvatarPath: "avatar.png"}, nil)
+			},
+			expected: dto.MessageSearchResponseList{
+				{
+					Message: &dto.MessageResponse{ID: 5, ChatID: 1, SenderID: 10, ReceiverID: 20, Avatar: "logo.png", Payload: "Ждем на собеседование", SentAt: sentAt},
+					Chat:    &dto.ChatShortResponse{ID: 1, VacancyTitle: "Go developer", User: dto.ChatUserPreview{ID: 10, Name: "Orange", AvatarPath: "logo.png"}},
+				},
+				{
+					Message: &dto.MessageResponse{ID: 3, ChatID: 1, SenderID: 20, ReceiverID: 10, Avatar: "avatar.png", FromApplicant: true, Payload: "Когда собеседование?", SentAt: sentAt},
+					Chat:    &dto.ChatShortResponse{ID: 1, VacancyTitle: "Go developer", User: dto.ChatUserPreview{ID: 10, Name: "Orange", AvatarPath: "logo.png"}},
+				},
+			},
+		},
+		{
+			name:   "Success - nothing found",
+			userID: 10,
+			role:   "employer",
+			query:  "оффер",
+			mockSetup: func(messageRepo *mock.MockMessageRepository, _ *m.MockVacancy, _ *m.MockEmployer, _ *m.MockApplicant) {
+				messageRepo.EXPECT().
+					SearchMessages(gomock.Any(), 10, false, "оффер", 20, 0).
+					Return(nil, nil)
+			},
+			expected: dto.MessageSearchResponseList{},
+		},
+		{
+			name:        "Error - empty query",
+			userID:      10,
+			role:        "employer",
+			query:       "   ",
+			mockSetup:   func(*mock.MockMessageRepository, *m.MockVacancy, *m.MockEmployer, *m.MockApplicant) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:   "Error - repository",
+			userID: 10,
+			role:   "employer",
+			query:  "оффер",
+			mockSetup: func(messageRepo *mock.MockMessageRepository, _ *m.MockVacancy, _ *m.MockEmployer, _ *m.MockApplicant) {
+				messageRepo.EXPECT().
+					SearchMessages(gomock.Any(), 10, false, "оффер", 20, 0).
+					Return(nil, entity.NewError(entity.ErrInternal, errors.New("db error")))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			vacancyUC := m.NewMockVacancy(ctrl)
+			employerUC := m.NewMockEmployer(ctrl)
+			applicantUC := m.NewMockApplicant(ctrl)
+			tc.mockSetup(messageRepo, vacancyUC, employerUC, applicantUC)
+
+			service := &ChatService{
+				MessageRepo: messageRepo,
+				VacancyUC:   vacancyUC,
+				EmployerUC:  employerUC,
+				ApplicantUC: applicantUC,
+			}
+
+			got, err := service.SearchMessages(context.Background(), tc.userID, tc.role, tc.query, 20, 0)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetMessagesAfter(t *testing.T) {
+	t.Parallel()
+
+	sentAt := time.Date(2025, 5, 2, 9, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		mockSetup   func(*mock.MockMessageRepository, *m.MockApplicant, *m.MockEmployer)
+		expected    dto.MessagesResponseList
+		expectedErr error
+	}{
+		{
+			name: "Success - missed messages with read state",
+			mockSetup: func(messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chat := entity.Chat{ID: 3, ApplicantID: 20, EmployerID: 10, ApplicantLastReadID: 41}
+				messageRepo.EXPECT().
+					GetMessagesForUserAfter(gomock.Any(), 20, true, 40, 100).
+					Return([]*entity.MessageWithChat{
+						{Message: entity.Message{ID: 41, ChatID: 3, SenderID: 10, Payload: "Добрый день", SentAt: sentAt}, Chat: chat},
+						{Message: entity.Message{ID: 42, ChatID: 3, SenderID: 20, FromApplicant: true, Payload: "Здравствуйте", ClientID: "tab-1", SentAt: sentAt}, Chat: chat},
+					}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{ID: 10, LogoPath: "logo.png"}, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{ID: 20, AvatarPath: "avatar.png"}, nil)
+			},
+			expected: dto.MessagesResponseList{
+				{ID: 41, ChatID: 3, SenderID: 10, ReceiverID: 20, Avatar: "logo.png", Payload: "Добрый день", SentAt: sentAt, Read: true},
+				{ID: 42, ChatID: 3, SenderID: 20, ReceiverID: 10, Avatar: "avatar.png", FromApplicant: true, Payload: "Здравствуйте", ClientID: "tab-1", SentAt: sentAt},
+			},
+		},
+		{
+			name: "Success - nothing missed",
+			mockSetup: func(messageRepo *mock.MockMessageRepository, _ *m.MockApplicant, _ *m.MockEmployer) {
+				messageRepo.EXPECT().
+					GetMessagesForUserAfter(gomock.Any(), 20, true, 40, 100).
+					Return(nil, nil)
+			},
+			expected: dto.MessagesResponseList{},
+		},
+		{
+			name: "Error - repository",
+			mockSetup: func(messageRepo *mock.MockMessageRepository, _ *m.MockApplicant, _ *m.MockEmployer) {
+				messageRepo.EXPECT().
+					GetMessagesForUserAfter(gomock.Any(), 20, true, 40, 100).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			applicantUC := m.NewMockApplicant(ctrl)
+			employerUC := m.NewMockEmployer(ctrl)
+			tc.mockSetup(messageRepo, applicantUC, employerUC)
+
+			service := &ChatService{
+				MessageRepo: messageRepo,
+				ApplicantUC: applicantUC,
+				EmployerUC:  employerUC,
+			}
+
+			got, err := service.GetMessagesAfter(context.Background(), 20, "applicant", 40, 100)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetChatPeer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		chatID      int
+		userID      int
+		role        string
+		mockSetup   func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository)
+		expected    *dto.ChatPeer
+		expectedErr error
+	}{
+		{
+			name:   "Success - applicant gets employer",
+			chatID: 1,
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20}, nil)
+			},
+			expected: &dto.ChatPeer{ID: 10},
+		},
+		{
+			name:   "Success - anonymous applicant hidden from employer",
+			chatID: 1,
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+			},
+			expected: &dto.ChatPeer{ID: 10, UserHidden: true},
+		},
+		{
+			name:   "Success - employer gets applicant",
+			chatID: 1,
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+			},
+			expected: &dto.ChatPeer{ID: 20},
+		},
+		{
+			name:   "Error - chat is blocked",
+			chatID: 1,
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20, EmployerBlocked: true}, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:   "Error - not a participant",
+			chatID: 1,
+			userID: 10,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:   "Error - chat not found",
+			chatID: 2,
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 2).
+					Return(nil, entity.NewError(entity.ErrNotFound, errors.New("чат не найден")))
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			tc.mockSetup(chatRepo, resumeRepo)
+
+			service := &ChatService{ChatRepo: chatRepo, ResumeRepo: resumeRepo}
+
+			got, err := service.GetChatPeer(context.Background(), tc.chatID, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetChatPeers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		mockSetup   func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository)
+		expected    []*dto.ChatPeer
+		expectedErr error
+	}{
+		{
+			name:   "Success - peers without duplicates",
+			userID: 20,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetForUser(gomock.Any(), 20, true).
+					Return([]*entity.Chat{
+						{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20},
+						{ID: 2, ResumeID: 31, EmployerID: 11, ApplicantID: 20},
+						{ID: 3, ResumeID: 32, EmployerID: 10, ApplicantID: 20},
+						{ID: 4, ResumeID: 30, EmployerID: 12, ApplicantID: 20, ApplicantBlocked: true},
+					}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 31).Return(&entity.Resume{ID: 31, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 11).Return(false, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 32).Return(&entity.Resume{ID: 32, ApplicantID: 20}, nil)
+			},
+			// С работодателем 10 есть чат по открытому резюме, поэтому от него соискатель не скрыт
+			expected: []*dto.ChatPeer{{ID: 10}, {ID: 11, UserHidden: true}},
+		},
+		{
+			name:   "Success - no chats",
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetForUser(gomock.Any(), 10, false).
+					Return(nil, nil)
+			},
+			expected: []*dto.ChatPeer{},
+		},
+		{
+			name:   "Error - repository",
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeRepo *mock.MockResumeRepository) {
+				chatRepo.EXPECT().
+					GetForUser(gomock.Any(), 10, false).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			tc.mockSetup(chatRepo, resumeRepo)
+
+			service := &ChatService{ChatRepo: chatRepo, ResumeRepo: resumeRepo}
+
+			got, err := service.GetChatPeers(context.Background(), tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_UploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("%PDF-1.4")
+
+	testCases := []struct {
+		name        string
+		chatID      int
+		userID      int
+		role        string
+		fileName    string
+		mockSetup   func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic)
+		expected    *dto.AttachmentResponse
+		expectedErr error
+	}{
+		{
+			name:     "Success - file name is reduced to base name",
+			chatID:   1,
+			userID:   20,
+			role:     "applicant",
+			fileName: `C:\Users\me\портфолио.pdf`,
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+				staticUC.EXPECT().
+					UploadAttachment(gomock.Any(), data).
+					Return(&dto.UploadAttachmentResponse{ID: 5, ContentType: "application/pdf", Size: len(data)}, nil)
+				messageRepo.EXPECT().
+					CreateAttachment(gomock.Any(), &entity.Attachment{
+						ChatID:        1,
+						UploaderID:    20,
+						FromApplicant: true,
+						StaticID:      5,
+						FileName:      "портфолио.pdf",
+						ContentType:   "application/pdf",
+						Size:          len(data),
+					}).
+					Return(&entity.Attachment{ID: 3, FileName: "портфолио.pdf", ContentType: "application/pdf", Size: len(data)}, nil)
+			},
+			expected: &dto.AttachmentResponse{ID: 3, FileName: "портфолио.pdf", ContentType: "application/pdf", Size: len(data)},
+		},
+		{
+			name:     "Error - not a participant",
+			chatID:   1,
+			userID:   99,
+			role:     "employer",
+			fileName: "task.pdf",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:     "Error - static rejects file",
+			chatID:   1,
+			userID:   10,
+			role:     "employer",
+			fileName: "task.exe",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().
+					GetChatByID(gomock.Any(), 1).
+					Return(&entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}, nil)
+				staticUC.EXPECT().
+					UploadAttachment(gomock.Any(), data).
+					Return(nil, entity.NewError(entity.ErrBadRequest, errors.New("недопустимый формат вложения")))
+			},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			staticUC := m.NewMockStatic(ctrl)
+			tc.mockSetup(chatRepo, messageRepo, staticUC)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo, StaticUC: staticUC}
+
+			got, err := service.UploadAttachment(context.Background(), tc.chatID, tc.userID, tc.role, tc.fileName, data)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetAttachmentFile(t *testing.T) {
+	t.Parallel()
+
+	chat := &entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}
+	sent := &entity.Attachment{ID: 3, ChatID: 1, MessageID: 100, UploaderID: 20, FromApplicant: true, StaticID: 5, FileName: "cv.pdf", ContentType: "application/pdf"}
+	pending := &entity.Attachment{ID: 4, ChatID: 1, UploaderID: 20, FromApplicant: true, StaticID: 6, FileName: "draft.pdf", ContentType: "application/pdf"}
+
+	testCases := []struct {
+		name         string
+		chatID       int
+		attachmentID int
+		userID       int
+		role         string
+		mockSetup    func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic)
+		expected     *entity.AttachmentFile
+		expectedErr  error
+	}{
+		{
+			name:         "Success - peer downloads sent attachment",
+			chatID:       1,
+			attachmentID: 3,
+			userID:       10,
+			role:         "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetAttachment(gomock.Any(), 3).Return(sent, nil)
+				staticUC.EXPECT().DownloadStatic(gomock.Any(), 5).Return(&entity.StaticContent{Data: []byte("pdf")}, nil)
+			},
+			expected: &entity.AttachmentFile{FileName: "cv.pdf", ContentType: "application/pdf", Data: []byte("pdf")},
+		},
+		{
+			name:         "Success - uploader downloads pending attachment",
+			chatID:       1,
+			attachmentID: 4,
+			userID:       20,
+			role:         "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetAttachment(gomock.Any(), 4).Return(pending, nil)
+				staticUC.EXPECT().DownloadStatic(gomock.Any(), 6).Return(&entity.StaticContent{Data: []byte("draft")}, nil)
+			},
+			expected: &entity.AttachmentFile{FileName: "draft.pdf", ContentType: "application/pdf", Data: []byte("draft")},
+		},
+		{
+			name:         "Error - peer cannot see pending attachment",
+			chatID:       1,
+			attachmentID: 4,
+			userID:       10,
+			role:         "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetAttachment(gomock.Any(), 4).Return(pending, nil)
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+		{
+			name:         "Error - attachment from another chat",
+			chatID:       2,
+			attachmentID: 3,
+			userID:       10,
+			role:         "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 2).Return(&entity.Chat{ID: 2, EmployerID: 10, ApplicantID: 21}, nil)
+				messageRepo.EXPECT().GetAttachment(gomock.Any(), 3).Return(sent, nil)
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+		{
+			name:         "Error - not a participant",
+			chatID:       1,
+			attachmentID: 3,
+			userID:       99,
+			role:         "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, staticUC *m.MockStatic) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			staticUC := m.NewMockStatic(ctrl)
+			tc.mockSetup(chatRepo, messageRepo, staticUC)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo, StaticUC: staticUC}
+
+			got, err := service.GetAttachmentFile(context.Background(), tc.chatID, tc.attachmentID, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_ExportChat(t *testing.T) {
+	t.Parallel()
+
+	chat := &entity.Chat{ID: 1, VacancyID: 2, ResumeID: 3, EmployerID: 10, ApplicantID: 20}
+	sentAt := time.Date(2025, 5, 10, 12, 30, 0, 0, time.UTC)
+	history := []*entity.Message{
+		{ID: 5, ChatID: 1, Kind: entity.MessageKindSystem, Event: entity.SystemEventResponse, Payload: ResponseMessage, SentAt: sentAt},
+		{ID: 6, ChatID: 1, SenderID: 20, FromApplicant: true, Kind: entity.MessageKindUser, Payload: "Добрый день <b>!</b>", SentAt: sentAt,
+			Attachments: []*entity.Attachment{{ID: 1, FileName: "cv.pdf"}}},
+		{ID: 7, ChatID: 1, SenderID: 10, Kind: entity.MessageKindUser, Payload: "", SentAt: sentAt, DeletedAt: &sentAt},
+	}
+
+	participantsSetup := func(vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+		vacancyUC.EXPECT().GetVacancy(gomock.Any(), 2, 10, "employer").Return(&dto.VacancyResponse{ID: 2, Title: "Go-разработчик"}, nil)
+		resumeUC.EXPECT().GetByID(gomock.Any(), 3, 20, "applicant", "").Return(&dto.ResumeResponse{ID: 3, Profession: "Backend"}, nil)
+		applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{ID: 20, FirstName: "Иван", LastName: "Петров"}, nil)
+		employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{ID: 10, CompanyName: "Орион"}, nil)
+		resumeRepo.EXPECT().GetByID(gomock.Any(), 3).Return(&entity.Resume{ID: 3, ApplicantID: 20}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		format      entity.ExportFormat
+		mockSetup   func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer)
+		check       func(t *testing.T, file *entity.AttachmentFile)
+		expectedErr error
+	}{
+		{
+			name:   "Success - json with participants and system messages",
+			userID: 10,
+			role:   "employer",
+			format: entity.ExportFormatJSON,
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				participantsSetup(vacancyUC, resumeUC, resumeRepo, applicantUC, employerUC)
+				messageRepo.EXPECT().GetMessagesForChat(gomock.Any(), 1, entity.MessagePage{Limit: entity.MaxMessagePageSize}).Return(history, nil)
+			},
+			check: func(t *testing.T, file *entity.AttachmentFile) {
+				require.Equal(t, "chat_1.json", file.FileName)
+				require.Equal(t, "application/json", file.ContentType)
+
+				var export dto.ChatExport
+				require.NoError(t, export.UnmarshalJSON(file.Data))
+				require.Equal(t, "Go-разработчик", export.VacancyTitle)
+				require.Equal(t, "Backend", export.Profession)
+				require.Equal(t, "Петров Иван", export.ApplicantName)
+				require.Equal(t, "Орион", export.EmployerName)
+				require.Equal(t, []*dto.ChatExportMessage{
+					{ID: 5, Kind: entity.MessageKindSystem, Event: entity.SystemEventResponse, Payload: ResponseMessage, SentAt: sentAt},
+					{ID: 6, Author: "Петров Иван", FromApplicant: true, Kind: entity.MessageKindUser, Payload: "Добрый день <b>!</b>", Attachments: []string{"cv.pdf"}, SentAt: sentAt},
+					{ID: 7, Author: "Орион", Kind: entity.MessageKindUser, SentAt: sentAt, Deleted: true},
+				}, export.Messages)
+			},
+		},
+		{
+			name:   "Success - html rendered through template",
+			userID: 10,
+			role:   "employer",
+			format: entity.ExportFormatHTML,
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				participantsSetup(vacancyUC, resumeUC, resumeRepo, applicantUC, employerUC)
+				messageRepo.EXPECT().GetMessagesForChat(gomock.Any(), 1, entity.MessagePage{Limit: entity.MaxMessagePageSize}).Return(history, nil)
+			},
+			check: func(t *testing.T, file *entity.AttachmentFile) {
+				require.Equal(t, "chat_1.html", file.FileName)
+				require.Equal(t, "text/html; charset=utf-8", file.ContentType)
+
+				html := string(file.Data)
+				require.Contains(t, html, "Соискатель: Петров Иван, Backend")
+				require.Contains(t, html, "Работодатель: Орион")
+				require.Contains(t, html, "10.05.2025 12:30 UTC")
+				require.Contains(t, html, "Добрый день &lt;b&gt;!&lt;/b&gt;")
+				require.Contains(t, html, "Вложения: cv.pdf")
+				require.Contains(t, html, "Сообщение удалено")
+			},
+		},
+		{
+			name:   "Success - owner of anonymous resume is not named before disclosure",
+			userID: 10,
+			role:   "employer",
+			format: entity.ExportFormatHTML,
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 2, 10, "employer").Return(&dto.VacancyResponse{ID: 2, Title: "Go-разработчик"}, nil)
+				resumeUC.EXPECT().GetByID(gomock.Any(), 3, 20, "applicant", "").Return(&dto.ResumeResponse{ID: 3, Profession: "Backend"}, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{ID: 20, FirstName: "Иван", LastName: "Петров"}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{ID: 10, CompanyName: "Орион"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 3).Return(&entity.Resume{ID: 3, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+				messageRepo.EXPECT().GetMessagesForChat(gomock.Any(), 1, entity.MessagePage{Limit: entity.MaxMessagePageSize}).Return(history, nil)
+			},
+			check: func(t *testing.T, file *entity.AttachmentFile) {
+				html := string(file.Data)
+				require.Contains(t, html, "Соискатель: имя скрыто, Backend")
+				require.NotContains(t, html, "Петров")
+			},
+		},
+		{
+			name:   "Success - long history loaded page by page",
+			userID: 20,
+			role:   "applicant",
+			format: entity.ExportFormatJSON,
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 2, 20, "applicant").Return(&dto.VacancyResponse{ID: 2}, nil)
+				resumeUC.EXPECT().GetByID(gomock.Any(), 3, 20, "applicant", "").Return(&dto.ResumeResponse{ID: 3}, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{ID: 20}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{ID: 10}, nil)
+
+				latest := make([]*entity.Message, 0, entity.MaxMessagePageSize)
+				for id := 101; id <= 100+entity.MaxMessagePageSize; id++ {
+					latest = append(latest, &entity.Message{ID: id, ChatID: 1, Kind: entity.MessageKindUser})
+				}
+				messageRepo.EXPECT().GetMessagesForChat(gomock.Any(), 1, entity.MessagePage{Limit: entity.MaxMessagePageSize}).Return(latest, nil)
+				messageRepo.EXPECT().GetMessagesForChat(gomock.Any(), 1, entity.MessagePage{BeforeID: 101, Limit: entity.MaxMessagePageSize}).
+					Return([]*entity.Message{{ID: 50, ChatID: 1, Kind: entity.MessageKindUser}}, nil)
+			},
+			check: func(t *testing.T, file *entity.AttachmentFile) {
+				var export dto.ChatExport
+				require.NoError(t, export.UnmarshalJSON(file.Data))
+				require.Len(t, export.Messages, entity.MaxMessagePageSize+1)
+				require.Equal(t, 50, export.Messages[0].ID)
+				require.Equal(t, 100+entity.MaxMessagePageSize, export.Messages[len(export.Messages)-1].ID)
+			},
+		},
+		{
+			name:   "Error - unsupported format",
+			userID: 10,
+			role:   "employer",
+			format: "docx",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+			},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:   "Error - not a participant",
+			userID: 11,
+			role:   "employer",
+			format: entity.ExportFormatPDF,
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, vacancyUC *m.MockVacancy, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+	}
+
+	exportTemplate := parseExportTemplate("../../../static/templates/chat_export.html")
+	require.NotNil(t, exportTemplate)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			vacancyUC := m.NewMockVacancy(ctrl)
+			resumeUC := m.NewMockResumeUsecase(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			applicantUC := m.NewMockApplicant(ctrl)
+			employerUC := m.NewMockEmployer(ctrl)
+			tc.mockSetup(chatRepo, messageRepo, vacancyUC, resumeUC, resumeRepo, applicantUC, employerUC)
+
+			service := &ChatService{
+				ChatRepo:       chatRepo,
+				MessageRepo:    messageRepo,
+				VacancyUC:      vacancyUC,
+				ResumeUC:       resumeUC,
+				ResumeRepo:     resumeRepo,
+				ApplicantUC:    applicantUC,
+				EmployerUC:     employerUC,
+				ExportTemplate: exportTemplate,
+			}
+
+			got, err := service.ExportChat(context.Background(), 1, tc.userID, tc.role, tc.format)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				tc.check(t, got)
+			}
+		})
+	}
+}
+
+func TestChatService_EditMessage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	editedAt := now.Add(time.Minute)
+	chat := &entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}
+	own := &entity.Message{ID: 100, ChatID: 1, SenderID: 20, FromApplicant: true, Payload: "Привет", SentAt: now}
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		payload     string
+		mockSetup   func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant)
+		expected    *dto.MessageResponse
+		expectedErr error
+	}{
+		{
+			name:    "Success",
+			userID:  20,
+			role:    "applicant",
+			payload: "Здравствуйте",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(own, nil)
+				messageRepo.EXPECT().EditMessage(gomock.Any(), 100, "Здравствуйте", &entity.OutboxEvent{
+					Type:    entity.MessageEditedEvent,
+					Objects: map[string]int{"chat_id": 1, "message_id": 100},
+				}).Return(&entity.Message{
+					ID: 100, ChatID: 1, SenderID: 20, FromApplicant: true, Payload: "Здравствуйте", SentAt: now, EditedAt: &editedAt,
+				}, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{AvatarPath: "/a.png"}, nil)
+			},
+			expected: &dto.MessageResponse{
+				ID: 100, ChatID: 1, SenderID: 20, ReceiverID: 10, Avatar: "/a.png", FromApplicant: true,
+				Payload: "Здравствуйте", SentAt: now, EditedAt: &editedAt,
+			},
+		},
+		{
+			name:    "Success - same text is not recorded as an edit",
+			userID:  20,
+			role:    "applicant",
+			payload: "Привет",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(own, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{AvatarPath: "/a.png"}, nil)
+			},
+			expected: &dto.MessageResponse{
+				ID: 100, ChatID: 1, SenderID: 20, ReceiverID: 10, Avatar: "/a.png", FromApplicant: true,
+				Payload: "Привет", SentAt: now,
+			},
+		},
+		{
+			name:    "Error - message of the peer",
+			userID:  10,
+			role:    "employer",
+			payload: "Исправлено",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(own, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:    "Error - edit window expired",
+			userID:  20,
+			role:    "applicant",
+			payload: "Исправлено",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(&entity.Message{
+					ID: 100, ChatID: 1, SenderID: 20, FromApplicant: true, Payload: "Привет",
+					SentAt: now.Add(-entity.MessageEditWindow - time.Minute),
+				}, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:    "Error - deleted message",
+			userID:  20,
+			role:    "applicant",
+			payload: "Исправлено",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(&entity.Message{
+					ID: 100, ChatID: 1, SenderID: 20, FromApplicant: true, SentAt: now, DeletedAt: &now,
+				}, nil)
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+		{
+			name:    "Error - empty text",
+			userID:  20,
+			role:    "applicant",
+			payload: "  ",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, applicantUC *m.MockApplicant) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(own, nil)
+			},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			applicantUC := m.NewMockApplicant(ctrl)
+			tc.mockSetup(chatRepo, messageRepo, applicantUC)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo, ApplicantUC: applicantUC}
+
+			got, err := service.EditMessage(context.Background(), 1, 100, tc.userID, tc.role, tc.payload)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_DeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	chat := &entity.Chat{ID: 1, ResumeID: 30, EmployerID: 10, ApplicantID: 20}
+	own := &entity.Message{ID: 100, ChatID: 1, SenderID: 10, FromApplicant: false, Payload: "Приходите на собеседование", SentAt: now}
+
+	testCases := []struct {
+		name        string
+		chatID      int
+		userID      int
+		role        string
+		mockSetup   func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, employerUC *m.MockEmployer)
+		expected    *dto.MessageResponse
+		expectedErr error
+	}{
+		{
+			name:   "Success - message becomes a tombstone",
+			chatID: 1,
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(own, nil)
+				messageRepo.EXPECT().DeleteMessage(gomock.Any(), 100, &entity.OutboxEvent{
+					Type:    entity.MessageDeletedEvent,
+					Objects: map[string]int{"chat_id": 1, "message_id": 100},
+				}).Return(&entity.Message{
+					ID: 100, ChatID: 1, SenderID: 10, FromApplicant: false, SentAt: now, DeletedAt: &now,
+				}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{LogoPath: "/e.png"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20}, nil)
+			},
+			expected: &dto.MessageResponse{
+				ID: 100, ChatID: 1, SenderID: 10, ReceiverID: 20, Avatar: "/e.png", SentAt: now, Deleted: true,
+			},
+		},
+		{
+			name:   "Success - anonymous applicant hidden from employer",
+			chatID: 1,
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(own, nil)
+				messageRepo.EXPECT().DeleteMessage(gomock.Any(), 100, gomock.Any()).Return(&entity.Message{
+					ID: 100, ChatID: 1, SenderID: 10, FromApplicant: false, SentAt: now, DeletedAt: &now,
+				}, nil)
+				employerUC.EXPECT().GetUser(gomock.Any(), 10).Return(&dto.EmployerProfileResponse{LogoPath: "/e.png"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+			},
+			expected: &dto.MessageResponse{
+				ID: 100, ChatID: 1, SenderID: 10, Avatar: "/e.png", SentAt: now, Deleted: true,
+			},
+		},
+		{
+			name:   "Error - message from another chat",
+			chatID: 1,
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(&entity.Message{ID: 100, ChatID: 2, SenderID: 10}, nil)
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+		{
+			name:   "Error - not a participant",
+			chatID: 1,
+			userID: 11,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, employerUC *m.MockEmployer) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			employerUC := m.NewMockEmployer(ctrl)
+			tc.mockSetup(chatRepo, messageRepo, resumeRepo, employerUC)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo, ResumeRepo: resumeRepo, EmployerUC: employerUC}
+
+			got, err := service.DeleteMessage(context.Background(), tc.chatID, 100, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetMessageEdits(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	chat := &entity.Chat{ID: 1, EmployerID: 10, ApplicantID: 20}
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		mockSetup   func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository)
+		expected    dto.MessageEditResponseList
+		expectedErr error
+	}{
+		{
+			name:   "Success - peer reads the history",
+			userID: 10,
+			role:   "employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(&entity.Message{ID: 100, ChatID: 1, SenderID: 20, FromApplicant: true}, nil)
+				messageRepo.EXPECT().GetMessageEdits(gomock.Any(), 100).Return([]*entity.MessageEdit{
+					{ID: 1, MessageID: 100, Payload: "Првиет", EditedAt: now},
+				}, nil)
+			},
+			expected: dto.MessageEditResponseList{{Payload: "Првиет", EditedAt: now}},
+		},
+		{
+			name:   "Error - not a participant",
+			userID: 30,
+			role:   "applicant",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 1).Return(chat, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			tc.mockSetup(chatRepo, messageRepo)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo}
+
+			got, err := service.GetMessageEdits(context.Background(), 1, 100, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_InviteToVacancy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	resume := &dto.ResumeResponse{ID: 5, ApplicantID: 20}
+	invitation := &entity.Invitation{
+		ID: 1, VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20, ChatID: 7,
+		Status: entity.InvitationPending, CreatedAt: now, UpdatedAt: now,
+	}
+	message := &entity.Message{
+		ID: 100, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventInvitation,
+		Payload: InvitationMessage, SentAt: now,
+	}
+	// Id приглашения и сообщения дописывает в событие репозиторий
+	event := &entity.OutboxEvent{
+		Type: entity.InvitationCreatedEvent,
+		Notification: &entity.Notification{
+			Type:         entity.InvitationType,
+			SenderID:     10,
+			SenderRole:   entity.EmployerRole,
+			ReceiverID:   20,
+			ReceiverRole: entity.ApplicantRole,
+			ResumeID:     5,
+			Payload:      entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		mockSetup   func(chatRepo *mock.MockChatRepository, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy)
+		expected    *dto.InvitationResponse
+		expectedErr error
+	}{
+		{
+			name: "Success - chat with invitation created",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy) {
+				resumeUC.EXPECT().GetByID(gomock.Any(), 5, 10, "employer", "").Return(resume, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&entity.Resume{ID: 5, ApplicantID: 20}, nil)
+				chatRepo.EXPECT().GetForVacancy(gomock.Any(), 3, 20).Return(nil, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 3, 10, "employer").Return(&dto.VacancyResponse{ID: 3, EmployerID: 10, Title: "Backend Developer"}, nil)
+				chatRepo.EXPECT().CreateInvitation(gomock.Any(), &entity.Invitation{
+					VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20,
+				}, InvitationMessage, event).Return(invitation, message, nil)
+			},
+			expected: &dto.InvitationResponse{
+				ID: 1, VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20, ChatID: 7,
+				Status: entity.InvitationPending, CreatedAt: now, UpdatedAt: now,
+				Message: &dto.MessageResponse{
+					ID: 100, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventInvitation,
+					Payload: InvitationMessage, SentAt: now,
+				},
+			},
+		},
+		{
+			name: "Success - anonymous resume is invited without disclosing its owner",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy) {
+				resumeUC.EXPECT().GetByID(gomock.Any(), 5, 10, "employer", "").
+					Return(&dto.ResumeResponse{ID: 5, IsAnonymous: true, ContactsHidden: true}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&entity.Resume{ID: 5, ApplicantID: 20, IsAnonymous: true}, nil)
+				chatRepo.EXPECT().GetForVacancy(gomock.Any(), 3, 20).Return(nil, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 3, 10, "employer").Return(&dto.VacancyResponse{ID: 3, EmployerID: 10, Title: "Backend Developer"}, nil)
+				chatRepo.EXPECT().CreateInvitation(gomock.Any(), &entity.Invitation{
+					VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20,
+				}, InvitationMessage, event).Return(invitation, message, nil)
+			},
+			expected: &dto.InvitationResponse{
+				ID: 1, VacancyID: 3, ResumeID: 5, EmployerID: 10, ChatID: 7,
+				Status: entity.InvitationPending, CreatedAt: now, UpdatedAt: now,
+				Message: &dto.MessageResponse{
+					ID: 100, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventInvitation,
+					Payload: InvitationMessage, SentAt: now,
+				},
+			},
+		},
+		{
+			name: "Error - applicant already has a chat for the vacancy",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy) {
+				resumeUC.EXPECT().GetByID(gomock.Any(), 5, 10, "employer", "").Return(resume, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&entity.Resume{ID: 5, ApplicantID: 20}, nil)
+				chatRepo.EXPECT().GetForVacancy(gomock.Any(), 3, 20).Return(&entity.Chat{ID: 4}, nil)
+			},
+			expectedErr: entity.ErrAlreadyExists,
+		},
+		{
+			name: "Error - vacancy is not active",
+			mockSetup: func(chatRepo *mock.MockChatRepository, resumeUC *m.MockResumeUsecase, resumeRepo *mock.MockResumeRepository, vacancyUC *m.MockVacancy) {
+				resumeUC.EXPECT().GetByID(gomock.Any(), 5, 10, "employer", "").Return(resume, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&entity.Resume{ID: 5, ApplicantID: 20}, nil)
+				chatRepo.EXPECT().GetForVacancy(gomock.Any(), 3, 20).Return(nil, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 3, 10, "employer").Return(&dto.VacancyResponse{ID: 3, EmployerID: 10, Title: "Backend Developer"}, nil)
+				chatRepo.EXPECT().CreateInvitation(gomock.Any(), gomock.Any(), InvitationMessage, gomock.Any()).
+					Return(nil, nil, entity.NewError(entity.ErrNotFound, errors.New("активная вакансия с id=3 не найдена")))
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			resumeUC := m.NewMockResumeUsecase(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			vacancyUC := m.NewMockVacancy(ctrl)
+			tc.mockSetup(chatRepo, resumeUC, resumeRepo, vacancyUC)
+
+			service := &ChatService{ChatRepo: chatRepo, ResumeUC: resumeUC, ResumeRepo: resumeRepo, VacancyUC: vacancyUC}
+
+			got, err := service.InviteToVacancy(context.Background(), 10, 3, 5)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_AnswerInvitation(t *testing.T) {
+	t.Parallel()
+
+	pending := &entity.Invitation{ID: 1, VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20, ChatID: 7, Status: entity.InvitationPending}
+
+	testCases := []struct {
+		name           string
+		applicantID    int
+		accept         bool
+		mockSetup      func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy)
+		expectedStatus entity.InvitationStatus
+		expectedErr    error
+	}{
+		{
+			name:        "Success - accepted invitation notifies employer about the response",
+			applicantID: 20,
+			accept:      true,
+			mockSetup: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy) {
+				chatRepo.EXPECT().GetInvitationByID(gomock.Any(), 1).Return(pending, nil)
+				vacancyUC.EXPECT().GetVacancy(gomock.Any(), 3, 20, "applicant").Return(&dto.VacancyResponse{ID: 3, EmployerID: 10, Title: "Backend Developer"}, nil)
+				chatRepo.EXPECT().AnswerInvitation(gomock.Any(), 1, entity.InvitationAccepted,
+					entity.SystemEventInvitationAccepted, InvitationAcceptedMessage, &entity.OutboxEvent{
+						Type: entity.InvitationAnsweredEvent,
+						Notification: &entity.Notification{
+							Type:         entity.ApplyNotificationType,
+							SenderID:     20,
+							SenderRole:   entity.ApplicantRole,
+							ReceiverID:   10,
+							ReceiverRole: entity.EmployerRole,
+							ObjectID:     3,
+							ResumeID:     5,
+							Payload:      entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+						},
+					}).Return(&entity.Invitation{
+					ID: 1, VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20, ChatID: 7, Status: entity.InvitationAccepted,
+				}, &entity.Message{ID: 101, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventInvitationAccepted}, nil)
+			},
+			expectedStatus: entity.InvitationAccepted,
+		},
+		{
+			name:        "Success - declined invitation without notification",
+			applicantID: 20,
+			accept:      false,
+			mockSetup: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy) {
+				chatRepo.EXPECT().GetInvitationByID(gomock.Any(), 1).Return(pending, nil)
+				chatRepo.EXPECT().AnswerInvitation(gomock.Any(), 1, entity.InvitationDeclined,
+					entity.SystemEventInvitationDeclined, InvitationDeclinedMessage, &entity.OutboxEvent{Type: entity.InvitationAnsweredEvent}).
+					Return(&entity.Invitation{
+						ID: 1, VacancyID: 3, ResumeID: 5, EmployerID: 10, ApplicantID: 20, ChatID: 7, Status: entity.InvitationDeclined,
+					}, &entity.Message{ID: 101, ChatID: 7, Kind: entity.MessageKindSystem, Event: entity.SystemEventInvitationDeclined}, nil)
+			},
+			expectedStatus: entity.InvitationDeclined,
+		},
+		{
+			name:        "Error - invitation addressed to another applicant",
+			applicantID: 21,
+			accept:      true,
+			mockSetup: func(chatRepo *mock.MockChatRepository, vacancyUC *m.MockVacancy) {
+				chatRepo.EXPECT().GetInvitationByID(gomock.Any(), 1).Return(pending, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			vacancyUC := m.NewMockVacancy(ctrl)
+			tc.mockSetup(chatRepo, vacancyUC)
+
+			service := &ChatService{ChatRepo: chatRepo, VacancyUC: vacancyUC}
+
+			got, err := service.AnswerInvitation(context.Background(), 1, tc.applicantID, tc.accept)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedStatus, got.Status)
+				require.Equal(t, entity.MessageKindSystem, got.Message.Kind)
+			}
+		})
+	}
+}
+
+func TestChatService_RecordEvent(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		name         string
+		notification *entity.Notification
+		mockSetup    func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository)
+		expected     []*dto.ChatEvent
+		expectedErr  error
+	}{
+		{
+			name: "Success - resume download is recorded in every chat about the resume",
+			notification: &entity.Notification{
+				Type: entity.DownloadResumeType, SenderID: 10, ReceiverID: 20, ObjectID: 5, ResumeID: 5,
+			},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository) {
+				chatRepo.EXPECT().GetForResume(gomock.Any(), 10, 5).Return([]*entity.Chat{
+					{ID: 1, ApplicantID: 20, EmployerID: 10},
+					{ID: 2, ApplicantID: 20, EmployerID: 10},
+				}, nil)
+				messageRepo.EXPECT().
+					CreateEventMessage(gomock.Any(), 1, 7, entity.SystemEventResumeDownload, ResumeDownloadMessage).
+					Return(&entity.Message{ID: 11, ChatID: 1, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: ResumeDownloadMessage, SentAt: now}, nil)
+				messageRepo.EXPECT().
+					CreateEventMessage(gomock.Any(), 2, 7, entity.SystemEventResumeDownload, ResumeDownloadMessage).
+					Return(&entity.Message{ID: 12, ChatID: 2, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: ResumeDownloadMessage, SentAt: now}, nil)
+			},
+			expected: []*dto.ChatEvent{
+				{
+					Message:         &dto.MessageResponse{ID: 11, ChatID: 1, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: ResumeDownloadMessage, SentAt: now},
+					EmployerMessage: &dto.MessageResponse{ID: 11, ChatID: 1, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: ResumeDownloadMessage, SentAt: now},
+					ApplicantID:     20,
+					EmployerID:      10,
+				},
+				{
+					Message:         &dto.MessageResponse{ID: 12, ChatID: 2, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: ResumeDownloadMessage, SentAt: now},
+					EmployerMessage: &dto.MessageResponse{ID: 12, ChatID: 2, Kind: entity.MessageKindSystem, Event: entity.SystemEventResumeDownload, Payload: ResumeDownloadMessage, SentAt: now},
+					ApplicantID:     20,
+					EmployerID:      10,
+				},
+			},
+		},
+		{
+			name: "Success - response without a chat is recorded when the chat is started",
+			notification: &entity.Notification{
+				Type: entity.ApplyNotificationType, SenderID: 20, ReceiverID: 10, ObjectID: 3, ResumeID: 5,
+			},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository) {
+				chatRepo.EXPECT().GetForVacancy(gomock.Any(), 3, 20).Return(nil, nil)
+			},
+			expected: nil,
+		},
+		{
+			name: "Success - response is recorded in the existing chat",
+			notification: &entity.Notification{
+				Type: entity.ApplyNotificationType, SenderID: 20, ReceiverID: 10, ObjectID: 3, ResumeID: 5,
+			},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository) {
+				chatRepo.EXPECT().GetForVacancy(gomock.Any(), 3, 20).Return(&entity.Chat{ID: 4, ApplicantID: 20, EmployerID: 10}, nil)
+				messageRepo.EXPECT().
+					CreateEventMessage(gomock.Any(), 4, 7, entity.SystemEventResponse, ResponseMessage).
+					Return(&entity.Message{ID: 13, ChatID: 4, Kind: entity.MessageKindSystem, Event: entity.SystemEventResponse, Payload: ResponseMessage, SentAt: now}, nil)
+			},
+			expected: []*dto.ChatEvent{
+				{
+					Message:         &dto.MessageResponse{ID: 13, ChatID: 4, Kind: entity.MessageKindSystem, Event: entity.SystemEventResponse, Payload: ResponseMessage, SentAt: now},
+					EmployerMessage: &dto.MessageResponse{ID: 13, ChatID: 4, Kind: entity.MessageKindSystem, Event: entity.SystemEventResponse, Payload: ResponseMessage, SentAt: now},
+					ApplicantID:     20,
+					EmployerID:      10,
+				},
+			},
+		},
+		{
+			name:         "Success - withdrawn response is not recorded",
+			notification: &entity.Notification{},
+			mockSetup:    func(_ *mock.MockChatRepository, _ *mock.MockMessageRepository) {},
+			expected:     nil,
+		},
+		{
+			name: "Error - system message is not saved",
+			notification: &entity.Notification{
+				Type: entity.DownloadResumeType, SenderID: 10, ReceiverID: 20, ObjectID: 5, ResumeID: 5,
+			},
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository) {
+				chatRepo.EXPECT().GetForResume(gomock.Any(), 10, 5).Return([]*entity.Chat{{ID: 1}}, nil)
+				messageRepo.EXPECT().
+					CreateEventMessage(gomock.Any(), 1, 7, entity.SystemEventResumeDownload, ResumeDownloadMessage).
+					Return(nil, entity.NewError(entity.ErrInternal, errors.New("db error")))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			tc.mockSetup(chatRepo, messageRepo)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo}
+
+			got, err := service.RecordEvent(context.Background(), 7, tc.notification)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_GetChatEvent(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	chat := &entity.Chat{ID: 7, ResumeID: 30, ApplicantID: 20, EmployerID: 10}
+
+	testCases := []struct {
+		name        string
+		mockSetup   func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant)
+		expected    *dto.ChatEvent
+		expectedErr error
+	}{
+		{
+			name: "Success - edited message with both participants",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant) {
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(&entity.Message{
+					ID: 100, ChatID: 7, SenderID: 20, FromApplicant: true, Payload: "Здравствуйте", SentAt: now, EditedAt: &now,
+				}, nil)
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 7).Return(chat, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{AvatarPath: "/a.png"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20}, nil)
+			},
+			expected: &dto.ChatEvent{
+				Message: &dto.MessageResponse{
+					ID: 100, ChatID: 7, SenderID: 20, ReceiverID: 10, Avatar: "/a.png", FromApplicant: true,
+					Payload: "Здравствуйте", SentAt: now, EditedAt: &now,
+				},
+				EmployerMessage: &dto.MessageResponse{
+					ID: 100, ChatID: 7, SenderID: 20, ReceiverID: 10, Avatar: "/a.png", FromApplicant: true,
+					Payload: "Здравствуйте", SentAt: now, EditedAt: &now,
+				},
+				ApplicantID: 20,
+				EmployerID:  10,
+			},
+		},
+		{
+			name: "Success - anonymous applicant hidden from employer",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant) {
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).Return(&entity.Message{
+					ID: 100, ChatID: 7, SenderID: 20, FromApplicant: true, Payload: "Здравствуйте", SentAt: now, EditedAt: &now,
+				}, nil)
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 7).Return(chat, nil)
+				applicantUC.EXPECT().GetUser(gomock.Any(), 20).Return(&dto.ApplicantProfileResponse{AvatarPath: "/a.png"}, nil)
+				resumeRepo.EXPECT().GetByID(gomock.Any(), 30).Return(&entity.Resume{ID: 30, ApplicantID: 20, IsAnonymous: true}, nil)
+				resumeRepo.EXPECT().ContactsDisclosed(gomock.Any(), 20, 10).Return(false, nil)
+			},
+			expected: &dto.ChatEvent{
+				Message: &dto.MessageResponse{
+					ID: 100, ChatID: 7, SenderID: 20, ReceiverID: 10, Avatar: "/a.png", FromApplicant: true,
+					Payload: "Здравствуйте", SentAt: now, EditedAt: &now,
+				},
+				EmployerMessage: &dto.MessageResponse{
+					ID: 100, ChatID: 7, ReceiverID: 10, FromApplicant: true,
+					Payload: "Здравствуйте", SentAt: now, EditedAt: &now,
+				},
+				ApplicantID: 20,
+				EmployerID:  10,
+			},
+		},
+		{
+			name: "Error - message not found",
+			mockSetup: func(chatRepo *mock.MockChatRepository, messageRepo *mock.MockMessageRepository, resumeRepo *mock.MockResumeRepository, applicantUC *m.MockApplicant) {
+				messageRepo.EXPECT().GetMessage(gomock.Any(), 100).
+					Return(nil, entity.NewError(entity.ErrNotFound, errors.New("сообщение с id=100 не найдено")))
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			messageRepo := mock.NewMockMessageRepository(ctrl)
+			resumeRepo := mock.NewMockResumeRepository(ctrl)
+			applicantUC := m.NewMockApplicant(ctrl)
+			tc.mockSetup(chatRepo, messageRepo, resumeRepo, applicantUC)
+
+			service := &ChatService{ChatRepo: chatRepo, MessageRepo: messageRepo, ResumeRepo: resumeRepo, ApplicantUC: applicantUC}
+
+			got, err := service.GetChatEvent(context.Background(), 100)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChatService_UpdateChatSettings(t *testing.T) {
+	t.Parallel()
+
+	archived, muted, blocked := true, true, false
+	chat := &entity.Chat{ID: 7, ApplicantID: 20, EmployerID: 10}
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		req         *dto.ChatSettingsRequest
+		mockSetup   func(chatRepo *mock.MockChatRepository)
+		expected    *dto.ChatSettingsResponse
+		expectedErr error
+	}{
+		{
+			name:   "Success - applicant archives and mutes the chat",
+			userID: 20,
+			role:   "applicant",
+			req:    &dto.ChatSettingsRequest{Archived: &archived, Muted: &muted},
+			mockSetup: func(chatRepo *mock.MockChatRepository) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 7).Return(chat, nil)
+				chatRepo.EXPECT().GetSettings(gomock.Any(), 7, true).Return(&entity.ChatSettings{ChatID: 7, IsApplicant: true}, nil)
+				chatRepo.EXPECT().UpdateSettings(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, settings *entity.ChatSettings) (*entity.ChatSettings, error) {
+						require.NotNil(t, settings.ArchivedAt)
+						require.True(t, settings.Muted)
+						require.False(t, settings.Blocked)
+						return &entity.ChatSettings{ChatID: 7, IsApplicant: true, ArchivedAt: settings.ArchivedAt, Archived: true, Muted: true}, nil
+					})
+			},
+			expected: &dto.ChatSettingsResponse{ChatID: 7, Archived: true, Muted: true},
+		},
+		{
+			name:   "Success - employer unblocks and keeps other settings",
+			userID: 10,
+			role:   "employer",
+			req:    &dto.ChatSettingsRequest{Blocked: &blocked},
+			mockSetup: func(chatRepo *mock.MockChatRepository) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 7).Return(chat, nil)
+				chatRepo.EXPECT().GetSettings(gomock.Any(), 7, false).
+					Return(&entity.ChatSettings{ChatID: 7, Muted: true, Blocked: true}, nil)
+				chatRepo.EXPECT().UpdateSettings(gomock.Any(), &entity.ChatSettings{ChatID: 7, Muted: true}).
+					Return(&entity.ChatSettings{ChatID: 7, Muted: true}, nil)
+			},
+			expected: &dto.ChatSettingsResponse{ChatID: 7, Muted: true},
+		},
+		{
+			name:   "Error - user is not a participant",
+			userID: 11,
+			role:   "employer",
+			req:    &dto.ChatSettingsRequest{Blocked: &blocked},
+			mockSetup: func(chatRepo *mock.MockChatRepository) {
+				chatRepo.EXPECT().GetChatByID(gomock.Any(), 7).Return(chat, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mock.NewMockChatRepository(ctrl)
+			tc.mockSetup(chatRepo)
+
+			service := &ChatService{ChatRepo: chatRepo}
+
+			got, err := service.UpdateChatSettings(context.Background(), 7, tc.userID, tc.role, tc.req)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"ResuMatch/internal/config"
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/usecase"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const digestSubject = "Непрочитанные сообщения на ResuMatch"
+
+type DigestService struct {
+	DigestRepo   repository.DigestRepository
+	SettingsRepo repository.NotificationSettingsRepository
+	PresenceUC   usecase.Presence
+	Mailer       usecase.Mailer
+	cfg          config.DigestConfig
+	now          func() time.Time
+}
+
+func NewDigestService(
+	digestRepository repository.DigestRepository,
+	settingsRepository repository.NotificationSettingsRepository,
+	presenceUC usecase.Presence,
+	mailer usecase.Mailer,
+	cfg config.DigestConfig,
+) usecase.Digest {
+	return &DigestService{
+		DigestRepo:   digestRepository,
+		SettingsRepo: settingsRepository,
+		PresenceUC:   presenceUC,
+		Mailer:       mailer,
+		cfg:          cfg,
+		now:          time.Now,
+	}
+}
+
+// Run рассылает дайджесты раз в cfg.Interval, пока не отменен контекст
+func (s *DigestService) Run(ctx context.Context) {
+	if s.cfg.Interval <= 0 {
+		l.Log.Warn("Интервал рассылки дайджестов не задан, дайджесты отключены")
+		return
+	}
+	if s.cfg.Secret == "" {
+		l.Log.Warn("DIGEST_SECRET не задан, дайджесты отключены")
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := s.SendDigests(ctx)
+			if err != nil {
+				l.Log.Errorf("Не удалось разослать дайджесты непрочитанных сообщений: %v", err)
+				continue
+			}
+			if sent > 0 {
+				l.Log.Infof("Отправлено дайджестов непрочитанных сообщений: %d", sent)
+			}
+		}
+	}
+}
+
+// SendDigests отправляет по одному письму каждому пользователю не на сайте, у которого сообщения
+// не прочитаны дольше cfg.UnreadAfter. Ошибка отправки одного письма не мешает остальным:
+// неотправленное письмо попадет в следующий запуск
+func (s *DigestService) SendDigests(ctx context.Context) (int, error) {
+	digests, err := s.DigestRepo.GetPendingDigests(ctx, s.now().Add(-s.cfg.UnreadAfter))
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+	for _, digest := range digests {
+		presence, err := s.PresenceUC.GetPresence(ctx, digest.UserID, string(digest.Role))
+		if err != nil {
+			l.Log.Warnf("Не удалось проверить присутствие пользователя %d: %v", digest.UserID, err)
+			continue
+		}
+		// Пользователь на сайте и увидит сообщения сам
+		if presence.Online {
+			continue
+		}
+
+		settings, err := s.SettingsRepo.GetNotificationSettings(ctx, digest.UserID, digest.Role)
+		if err != nil {
+			l.Log.Warnf("Не удалось получить настройки уведомлений пользователя %d: %v", digest.UserID, err)
+			continue
+		}
+		// Письмо уйдет первым запуском после окончания тихих часов
+		if settings.QuietHours.Active(s.now()) {
+			continue
+		}
+
+		if err := s.Mailer.Send(ctx, s.digestMail(digest)); err != nil {
+			l.Log.Warnf("Не удалось отправить дайджест пользователю %d: %v", digest.UserID, err)
+			continue
+		}
+
+		if err := s.DigestRepo.MarkDigestSent(ctx, digest.UserID, digest.Role, digest.LastMessageID()); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// Unsubscribe отключает дайджесты по токену из ссылки в письме
+func (s *DigestService) Unsubscribe(ctx context.Context, token string) error {
+	// С пустым ключом подпись может подделать кто угодно, а писем со ссылками без секрета не бывает
+	if s.cfg.Secret == "" {
+		return entity.NewError(entity.ErrNotFound, errors.New("отписка от дайджестов отключена"))
+	}
+
+	userID, role, err := s.parseUnsubscribeToken(token)
+	if err != nil {
+		return entity.NewError(entity.ErrBadRequest, err)
+	}
+	return s.DigestRepo.Unsubscribe(ctx, userID, role)
+}
+
+func (s *DigestService) digestMail(digest *entity.Digest) *entity.Mail {
+	unsubscribeURL := s.cfg.BaseURL + "/api/v1/notification/unsubscribe?token=" + url.QueryEscape(s.unsubscribeToken(digest.UserID, digest.Role))
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Здравствуйте!\n\nУ вас %d непрочитанных сообщений:\n\n", digest.Unread())
+	for _, chat := range digest.Chats {
+		fmt.Fprintf(&body, "- «%s»: %d\n", chat.VacancyTitle, chat.Unread)
+	}
+	fmt.Fprintf(&body, "\nПрочитать их можно на сайте: %s\n", s.cfg.BaseURL)
+	fmt.Fprintf(&body, "\nОтписаться от этих писем: %s\n", unsubscribeURL)
+
+	return &entity.Mail{
+		To:      digest.Email,
+		Subject: digestSubject,
+		Body:    body.String(),
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}
+}
+
+// unsubscribeToken подписывает пользователя и роль, чтобы по ссылке из письма нельзя было отписать другого
+func (s *DigestService) unsubscribeToken(userID int, role entity.UserRole) string {
+	payload := strconv.Itoa(userID) + ":" + string(role)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(s.sign(payload))
+}
+
+func (s *DigestService) parseUnsubscribeToken(token string) (int, entity.UserRole, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, "", errors.New("неверный формат ссылки отписки")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return 0, "", fmt.Errorf("неверный формат ссылки отписки: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return 0, "", fmt.Errorf("неверный формат ссылки отписки: %w", err)
+	}
+	if !hmac.Equal(signature, s.sign(string(payload))) {
+		return 0, "", errors.New("неверная подпись ссылки отписки")
+	}
+
+	rawID, rawRole, _ := strings.Cut(string(payload), ":")
+	userID, err := strconv.Atoi(rawID)
+	if err != nil {
+		return 0, "", fmt.Errorf("неверный пользователь в ссылке отписки: %w", err)
+	}
+	role, ok := entity.AllowedUserRoles[rawRole]
+	if !ok {
+		return 0, "", fmt.Errorf("неизвестная роль в ссылке отписки: %s", rawRole)
+	}
+	return userID, role, nil
+}
+
+func (s *DigestService) sign(payload string) []byte {
+	h := hmac.New(sha256.New, []byte(s.cfg.Secret))
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"ResuMatch/internal/config"
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/repository/mock"
+	m "ResuMatch/internal/usecase/mock"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDigestService_SendDigests(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	cfg := config.DigestConfig{UnreadAfter: 30 * time.Minute, BaseURL: "https://resumatch.tech", Secret: "secret"}
+
+	offline := &entity.Digest{
+		UserID: 1, Role: entity.ApplicantRole, Email: "applicant@mail.ru",
+		Chats: []*entity.DigestChat{
+			{ChatID: 10, VacancyTitle: "Go-разработчик", Unread: 2, LastMessageID: 105},
+			{ChatID: 11, VacancyTitle: "Тестировщик", Unread: 1, LastMessageID: 120},
+		},
+	}
+	online := &entity.Digest{
+		UserID: 2, Role: entity.EmployerRole, Email: "employer@mail.ru",
+		Chats: []*entity.DigestChat{{ChatID: 10, VacancyTitle: "Go-разработчик", Unread: 1, LastMessageID: 104}},
+	}
+
+	testCases := []struct {
+		name         string
+		mockSetup    func(digestRepo *mock.MockDigestRepository, settingsRepo *mock.MockNotificationSettingsRepository, presenceUC *m.MockPresence, mailer *m.MockMailer)
+		expectedSent int
+		expectedErr  error
+	}{
+		{
+			name: "Success - only offline users get one mail each",
+			mockSetup: func(digestRepo *mock.MockDigestRepository, settingsRepo *mock.MockNotificationSettingsRepository, presenceUC *m.MockPresence, mailer *m.MockMailer) {
+				digestRepo.EXPECT().GetPendingDigests(gomock.Any(), now.Add(-30*time.Minute)).
+					Return([]*entity.Digest{offline, online}, nil)
+				presenceUC.EXPECT().GetPresence(gomock.Any(), 1, "applicant").Return(&dto.PresenceResponse{UserID: 1}, nil)
+				settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 1, entity.ApplicantRole).Return(&entity.NotificationSettings{UserID: 1}, nil)
+				presenceUC.EXPECT().GetPresence(gomock.Any(), 2, "employer").Return(&dto.PresenceResponse{UserID: 2, Online: true}, nil)
+				mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, mail *entity.Mail) error {
+					require.Equal(t, "applicant@mail.ru", mail.To)
+					require.Contains(t, mail.Body, "«Go-разработчик»: 2")
+					require.Contains(t, mail.Body, "«Тестировщик»: 1")
+					require.True(t, strings.HasPrefix(mail.Headers["List-Unsubscribe"], "<https://resumatch.tech/api/v1/notification/unsubscribe?token="))
+					require.Equal(t, "List-Unsubscribe=One-Click", mail.Headers["List-Unsubscribe-Post"])
+					return nil
+				})
+				digestRepo.EXPECT().MarkDigestSent(gomock.Any(), 1, entity.ApplicantRole, 120).Return(nil)
+			},
+			expectedSent: 1,
+		},
+		{
+			name: "Success - failed mail is retried on the next run",
+			mockSetup: func(digestRepo *mock.MockDigestRepository, settingsRepo *mock.MockNotificationSettingsRepository, presenceUC *m.MockPresence, mailer *m.MockMailer) {
+				digestRepo.EXPECT().GetPendingDigests(gomock.Any(), gomock.Any()).Return([]*entity.Digest{offline}, nil)
+				presenceUC.EXPECT().GetPresence(gomock.Any(), 1, "applicant").Return(&dto.PresenceResponse{UserID: 1}, nil)
+				settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 1, entity.ApplicantRole).Return(&entity.NotificationSettings{UserID: 1}, nil)
+				mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp unavailable"))
+			},
+			expectedSent: 0,
+		},
+		{
+			name: "Success - mail waits for the end of quiet hours",
+			mockSetup: func(digestRepo *mock.MockDigestRepository, settingsRepo *mock.MockNotificationSettingsRepository, presenceUC *m.MockPresence, mailer *m.MockMailer) {
+				digestRepo.EXPECT().GetPendingDigests(gomock.Any(), gomock.Any()).Return([]*entity.Digest{offline}, nil)
+				presenceUC.EXPECT().GetPresence(gomock.Any(), 1, "applicant").Return(&dto.PresenceResponse{UserID: 1}, nil)
+				settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 1, entity.ApplicantRole).Return(&entity.NotificationSettings{
+					UserID:     1,
+					QuietHours: &entity.QuietHours{Start: "11:00", End: "13:00", TimeZone: "UTC"},
+				}, nil)
+			},
+			expectedSent: 0,
+		},
+		{
+			name: "Error - pending digests are not loaded",
+			mockSetup: func(digestRepo *mock.MockDigestRepository, _ *mock.MockNotificationSettingsRepository, _ *m.MockPresence, _ *m.MockMailer) {
+				digestRepo.EXPECT().GetPendingDigests(gomock.Any(), gomock.Any()).
+					Return(nil, entity.NewError(entity.ErrInternal, errors.New("db error")))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			digestRepo := mock.NewMockDigestRepository(ctrl)
+			settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+			presenceUC := m.NewMockPresence(ctrl)
+			mailer := m.NewMockMailer(ctrl)
+			tc.mockSetup(digestRepo, settingsRepo, presenceUC, mailer)
+
+			service := &DigestService{
+				DigestRepo:   digestRepo,
+				SettingsRepo: settingsRepo,
+				PresenceUC:   presenceUC,
+				Mailer:       mailer,
+				cfg:          cfg,
+				now:          func() time.Time { return now },
+			}
+
+			sent, err := service.SendDigests(context.Background())
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedSent, sent)
+			}
+		})
+	}
+}
+
+func TestDigestService_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.DigestConfig{Secret: "secret"}
+	signer := &DigestService{cfg: cfg}
+	valid := signer.unsubscribeToken(7, entity.EmployerRole)
+	foreign := (&DigestService{cfg: config.DigestConfig{Secret: "other"}}).unsubscribeToken(7, entity.EmployerRole)
+
+	testCases := []struct {
+		name        string
+		token       string
+		mockSetup   func(digestRepo *mock.MockDigestRepository)
+		expectedErr error
+	}{
+		{
+			name:  "Success - signed link unsubscribes its owner",
+			token: valid,
+			mockSetup: func(digestRepo *mock.MockDigestRepository) {
+				digestRepo.EXPECT().Unsubscribe(gomock.Any(), 7, entity.EmployerRole).Return(nil)
+			},
+		},
+		{
+			name:        "Error - link signed with another secret",
+			token:       foreign,
+			mockSetup:   func(_ *mock.MockDigestRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - malformed link",
+			token:       "not-a-token",
+			mockSetup:   func(_ *mock.MockDigestRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			digestRepo := mock.NewMockDigestRepository(ctrl)
+			tc.mockSetup(digestRepo)
+
+			service := &DigestService{DigestRepo: digestRepo, cfg: cfg}
+
+			err := service.Unsubscribe(context.Background(), tc.token)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDigestService_UnsubscribeWithoutSecret(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := &DigestService{DigestRepo: mock.NewMockDigestRepository(ctrl)}
+	forged := service.unsubscribeToken(7, entity.EmployerRole)
+
+	err := service.Unsubscribe(context.Background(), forged)
+
+	var serviceErr entity.Error
+	require.ErrorAs(t, err, &serviceErr)
+	require.ErrorIs(t, serviceErr.ClientErr(), entity.ErrNotFound)
+}
@@ -2,46 +2,239 @@ package service
 
 import (
 	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
 	"ResuMatch/internal/repository"
 	"ResuMatch/internal/usecase"
 	"context"
 	"fmt"
+	"time"
 )
 
+// notificationMailTimeout ограничивает отправку письма, чтобы недоступный SMTP-сервер не задерживал
+// доставку остальных событий outbox
+const notificationMailTimeout = 30 * time.Second
+
 type NotificationService struct {
 	notificationRepo repository.NotificationRepository
+	settingsRepo     repository.NotificationSettingsRepository
+	mailer           usecase.Mailer
+	now              func() time.Time
 }
 
-func NewNotificationService(notificationRepo repository.NotificationRepository) usecase.Notification {
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	settingsRepo repository.NotificationSettingsRepository,
+	mailer usecase.Mailer,
+) usecase.Notification {
 	return &NotificationService{
 		notificationRepo: notificationRepo,
+		settingsRepo:     settingsRepo,
+		mailer:           mailer,
+		now:              time.Now,
 	}
 }
 
+// CreateNotification сохраняет уведомление, если у получателя включены уведомления на сайте.
+// Превью возвращается, только если уведомление нужно сразу отправить через websocket: в тихие часы
+// оно не отправляется. Каналы не зависят друг от друга, поэтому без уведомлений на сайте превью
+// собирается по самому уведомлению. Письмо отправляет SendNotificationMail
 func (s NotificationService) CreateNotification(ctx context.Context, notification *entity.Notification) (*entity.NotificationPreview, error) {
 	if notification == nil || notification.SenderID == 0 {
 		return nil, nil
 	}
 
-	if _, ok := entity.AllowedNotificationTypes[string(notification.Type)]; !ok {
+	spec, ok := entity.LookupNotificationType(notification.Type)
+	if !ok {
 		return nil, entity.NewError(
 			entity.ErrBadRequest,
 			fmt.Errorf("неверный тип уведомления"),
 		)
 	}
+	if err := spec.ValidatePayload(notification.Payload); err != nil {
+		return nil, err
+	}
 
 	if notification.SenderID == notification.ReceiverID && notification.SenderRole == notification.ReceiverRole {
 		return nil, nil
 	}
 
-	if err := s.notificationRepo.CreateNotification(ctx, notification); err != nil {
+	settings, err := s.settingsRepo.GetNotificationSettings(ctx, notification.ReceiverID, notification.ReceiverRole)
+	if err != nil {
+		return nil, err
+	}
+	push := settings.Enabled(notification.Type, entity.WebsocketChannel) && !settings.QuietHours.Active(s.now())
+
+	var preview *entity.NotificationPreview
+	switch {
+	case settings.Enabled(notification.Type, entity.InAppChannel):
+		if err = s.notificationRepo.CreateNotification(ctx, notification); err != nil {
+			return nil, err
+		}
+		if preview, err = s.notificationRepo.GetNotificationPreview(ctx, notification.ID); err != nil {
+			return nil, err
+		}
+	case push:
+		if preview, err = s.notificationRepo.GetEventPreview(ctx, notification); err != nil {
+			return nil, err
+		}
+		if preview.CreatedAt.IsZero() {
+			preview.CreatedAt = s.now()
+		}
+	}
+
+	if !push {
+		return nil, nil
+	}
+	entity.RenderNotification(preview)
+	return preview, nil
+}
+
+// SendNotificationMail отправляет письмо об уведомлении, если у получателя включен канал email.
+// Письмо отправляется только о первом уведомлении группы, остальные видны в ней на сайте.
+// Ошибка отправки возвращается, чтобы outbox повторил доставку
+func (s NotificationService) SendNotificationMail(ctx context.Context, notification *entity.Notification) error {
+	if notification == nil || notification.SenderID == 0 {
+		return nil
+	}
+	if _, ok := entity.LookupNotificationType(notification.Type); !ok {
+		return entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("неверный тип уведомления"),
+		)
+	}
+	if notification.SenderID == notification.ReceiverID && notification.SenderRole == notification.ReceiverRole {
+		return nil
+	}
+
+	settings, err := s.settingsRepo.GetNotificationSettings(ctx, notification.ReceiverID, notification.ReceiverRole)
+	if err != nil {
+		return err
+	}
+	if !settings.Enabled(notification.Type, entity.EmailChannel) || settings.Email == "" || settings.QuietHours.Active(s.now()) {
+		return nil
+	}
+
+	preview, err := s.notificationRepo.GetEventPreview(ctx, notification)
+	if err != nil {
+		return err
+	}
+	if preview.GroupSize > 1 {
+		return nil
+	}
+	entity.RenderNotification(preview)
+
+	mailCtx, cancel := context.WithTimeout(ctx, notificationMailTimeout)
+	defer cancel()
+	if err = s.mailer.Send(mailCtx, notificationMail(settings.Email, preview)); err != nil {
+		return entity.NewError(
+			entity.ErrInternal,
+			fmt.Errorf("не удалось отправить письмо об уведомлении: %w", err),
+		)
+	}
+	return nil
+}
+
+// NotificationMailSink отправляет письма об уведомлениях из событий outbox. Письмо - отдельный получатель,
+// поэтому сбой SMTP-сервера повторяет только отправку письма, а не создание уведомления
+type NotificationMailSink struct {
+	notifyUC usecase.Notification
+}
+
+func NewNotificationMailSink(notification usecase.Notification) usecase.EventSink {
+	return &NotificationMailSink{notifyUC: notification}
+}
+
+func (s *NotificationMailSink) Name() string {
+	return "email"
+}
+
+func (s *NotificationMailSink) Deliver(ctx context.Context, event *entity.OutboxEvent) error {
+	if event.Notification == nil {
+		return nil
+	}
+
+	// По id события письмо находит группу уведомления, сохраненного на сайте
+	event.Notification.OutboxEventID = event.ID
+	return s.notifyUC.SendNotificationMail(ctx, event.Notification)
+}
+
+// GetNotificationSettings возвращает настройки по всем типам уведомлений, которые может получить пользователь
+func (s NotificationService) GetNotificationSettings(ctx context.Context, userID int, role string) (*dto.NotificationSettings, error) {
+	userRole, ok := entity.AllowedUserRoles[role]
+	if !ok {
+		return nil, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("неверная роль"),
+		)
+	}
+
+	settings, err := s.settingsRepo.GetNotificationSettings(ctx, userID, userRole)
+	if err != nil {
 		return nil, err
 	}
+	return notificationSettingsResponse(settings), nil
+}
+
+// UpdateNotificationSettings сохраняет каналы перечисленных в запросе типов и заменяет тихие часы:
+// если их нет в запросе, тихие часы отключаются
+func (s NotificationService) UpdateNotificationSettings(ctx context.Context, userID int, role string, req *dto.NotificationSettings) (*dto.NotificationSettings, error) {
+	userRole, ok := entity.AllowedUserRoles[role]
+	if !ok {
+		return nil, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("неверная роль"),
+		)
+	}
 
-	if notification.Type == entity.ApplyNotificationType {
-		return s.notificationRepo.GetApplyNotificationPreview(ctx, notification.ID)
+	update := &entity.NotificationSettings{UserID: userID, Role: userRole, QuietHours: req.QuietHours}
+	for _, typeSettings := range req.Types {
+		if spec, ok := entity.LookupNotificationType(typeSettings.Type); !ok || spec.Receiver != userRole {
+			return nil, entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("уведомления типа %q не приходят пользователю с ролью %s", typeSettings.Type, role),
+			)
+		}
+		for channel, enabled := range typeSettings.Channels {
+			if _, ok = entity.AllowedNotificationChannels[string(channel)]; !ok {
+				return nil, entity.NewError(
+					entity.ErrBadRequest,
+					fmt.Errorf("неизвестный канал уведомлений: %s", channel),
+				)
+			}
+			update.Set(typeSettings.Type, channel, enabled)
+		}
+	}
+
+	if update.QuietHours != nil {
+		if err := update.QuietHours.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.settingsRepo.UpdateNotificationSettings(ctx, update); err != nil {
+		return nil, err
+	}
+	return s.GetNotificationSettings(ctx, userID, role)
+}
+
+func notificationSettingsResponse(settings *entity.NotificationSettings) *dto.NotificationSettings {
+	resp := &dto.NotificationSettings{QuietHours: settings.QuietHours}
+	for _, notificationType := range entity.NotificationTypesFor(settings.Role) {
+		channels := make(map[entity.NotificationChannel]bool, len(entity.DefaultNotificationChannels))
+		for channel := range entity.DefaultNotificationChannels {
+			channels[channel] = settings.Enabled(notificationType, channel)
+		}
+		resp.Types = append(resp.Types, &dto.NotificationTypeSettings{Type: notificationType, Channels: channels})
+	}
+	return resp
+}
+
+func notificationMail(to string, preview *entity.NotificationPreview) *entity.Mail {
+	return &entity.Mail{
+		To:      to,
+		Subject: preview.Text,
+		Body:    "Здравствуйте!\n\n" + preview.Text + ".\n\nПодробности в разделе уведомлений на ResuMatch.\n",
 	}
-	return s.notificationRepo.GetDownloadResumeNotificationPreview(ctx, notification.ID)
 }
 
 func (s NotificationService) ReadNotification(ctx context.Context, notificationID, userID int) error {
@@ -62,24 +255,141 @@ func (s NotificationService) ReadNotification(ctx context.Context, notificationI
 	return s.notificationRepo.ReadNotification(ctx, notificationID)
 }
 
+// ReadNotificationGroup отмечает прочитанной всю группу уведомлений groupID
+func (s NotificationService) ReadNotificationGroup(ctx context.Context, groupID, userID int, role string) error {
+	if err := s.checkGroupAccess(ctx, groupID, userID, role); err != nil {
+		return err
+	}
+	return s.notificationRepo.ReadNotificationGroup(ctx, groupID)
+}
+
+// GetNotificationGroup раскрывает группу уведомлений groupID: возвращает ее уведомления по отдельности
+func (s NotificationService) GetNotificationGroup(ctx context.Context, groupID, userID int, role string, limit, offset int) ([]*entity.NotificationPreview, error) {
+	filter := entity.NotificationFilter{Limit: limit, Offset: offset}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGroupAccess(ctx, groupID, userID, role); err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.notificationRepo.GetGroupNotifications(ctx, groupID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, err
+	}
+	for _, notification := range notifications {
+		entity.RenderNotification(notification)
+	}
+	return notifications, nil
+}
+
+// checkGroupAccess проверяет, что groupID - первое уведомление группы и группа адресована пользователю
+func (s NotificationService) checkGroupAccess(ctx context.Context, groupID, userID int, role string) error {
+	head, err := s.notificationRepo.GetNotificationByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if head.GroupID != head.ID {
+		return entity.NewError(
+			entity.ErrNotFound,
+			fmt.Errorf("группа уведомлений с id=%d не найдена", groupID),
+		)
+	}
+	if head.ReceiverID != userID || string(head.ReceiverRole) != role {
+		return entity.NewError(
+			entity.ErrForbidden,
+			fmt.Errorf("нет доступа к группе уведомлений"),
+		)
+	}
+	return nil
+}
+
 func (s NotificationService) ReadAllNotifications(ctx context.Context, userID int, role string) error {
 	return s.notificationRepo.ReadAllNotifications(ctx, userID, role)
 }
 
+// DeleteNotification удаляет одно уведомление. Удалить можно только адресованное самому пользователю
+func (s NotificationService) DeleteNotification(ctx context.Context, notificationID, userID int, role string) error {
+	notification, err := s.notificationRepo.GetNotificationByID(ctx, notificationID)
+	if err != nil {
+		return err
+	}
+
+	if notification.ReceiverID != userID || string(notification.ReceiverRole) != role {
+		return entity.NewError(
+			entity.ErrForbidden,
+			fmt.Errorf("нет доступа к уведомлению"),
+		)
+	}
+	return s.notificationRepo.DeleteNotification(ctx, notificationID)
+}
+
 func (s NotificationService) DeleteAllNotifications(ctx context.Context, userID int, role string) error {
 	return s.notificationRepo.DeleteAllNotifications(ctx, userID, role)
 }
 
-func (s NotificationService) GetNotificationsForUser(ctx context.Context, userID int, role string) ([]*entity.NotificationPreview, error) {
-	switch role {
-	case "applicant":
-		return s.notificationRepo.GetDownloadResumeNotificationsForUser(ctx, userID)
-	case "employer":
-		return s.notificationRepo.GetApplyNotificationsForUser(ctx, userID)
-	default:
+func (s NotificationService) GetNotificationsForUser(ctx context.Context, userID int, role string, filter entity.NotificationFilter) ([]*entity.NotificationPreview, error) {
+	userRole, ok := entity.AllowedUserRoles[role]
+	if !ok {
+		return nil, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("неверная роль"),
+		)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.notificationRepo.GetNotificationsForUser(ctx, userID, userRole, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, notification := range notifications {
+		entity.RenderNotification(notification)
+	}
+	return notifications, nil
+}
+
+// GetNotificationsAfter возвращает не больше limit уведомлений новее afterID в порядке создания
+func (s NotificationService) GetNotificationsAfter(ctx context.Context, userID int, role string, afterID, limit int) ([]*entity.NotificationPreview, error) {
+	return s.GetNotificationsForUser(ctx, userID, role, entity.NotificationFilter{AfterID: afterID, Limit: limit})
+}
+
+// GetLastNotificationID возвращает id последнего уведомления пользователя, с которого новое соединение
+// начинает отсчет пропущенного
+func (s NotificationService) GetLastNotificationID(ctx context.Context, userID int, role string) (int, error) {
+	userRole, ok := entity.AllowedUserRoles[role]
+	if !ok {
+		return 0, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("неверная роль"),
+		)
+	}
+	return s.notificationRepo.GetLastNotificationID(ctx, userID, userRole)
+}
+
+// GetUnreadNotifications возвращает число непрочитанных уведомлений. Типы, которые пользователь может
+// получить, есть в ответе всегда, чтобы клиенту не приходилось отличать ноль от отсутствия ключа
+func (s NotificationService) GetUnreadNotifications(ctx context.Context, userID int, role string) (*dto.UnreadNotificationsResponse, error) {
+	userRole, ok := entity.AllowedUserRoles[role]
+	if !ok {
 		return nil, entity.NewError(
 			entity.ErrBadRequest,
 			fmt.Errorf("неверная роль"),
 		)
 	}
+
+	counts, err := s.notificationRepo.CountUnreadNotifications(ctx, userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.UnreadNotificationsResponse{Types: make(map[entity.NotificationType]int)}
+	for _, notificationType := range entity.NotificationTypesFor(userRole) {
+		resp.Types[notificationType] = counts[notificationType]
+		resp.Total += counts[notificationType]
+	}
+	return resp, nil
 }
@@ -0,0 +1,732 @@
+package service
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/repository/mock"
+	m "ResuMatch/internal/usecase/mock"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationService_CreateNotification(t *testing.T) {
+	t.Parallel()
+
+	// 12:00 UTC - 15:00 по Москве
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	notification := entity.Notification{
+		Type:         entity.ApplyNotificationType,
+		SenderID:     3,
+		SenderRole:   entity.ApplicantRole,
+		ReceiverID:   4,
+		ReceiverRole: entity.EmployerRole,
+		ObjectID:     7,
+		Payload:      entity.NotificationPayload{"vacancy_title": "Go-разработчик"},
+	}
+	stored := func() *entity.NotificationPreview {
+		return &entity.NotificationPreview{
+			ID: 1, Type: entity.ApplyNotificationType, ReceiverID: 4, ApplicantName: "Иван Петров",
+			Payload: entity.NotificationPayload{"vacancy_title": "Go-разработчик"}, GroupID: 1, GroupSize: 1,
+		}
+	}
+	preview := stored()
+	preview.Title = "Go-разработчик"
+	preview.Text = "Иван Петров откликнулся на вакансию «Go-разработчик»"
+	// Без уведомлений на сайте превью собирается по событию и не имеет id
+	unsaved := func() *entity.NotificationPreview {
+		return &entity.NotificationPreview{
+			Type: entity.ApplyNotificationType, SenderID: 3, ReceiverID: 4, ObjectID: 7, ApplicantName: "Иван Петров",
+			Payload: entity.NotificationPayload{"vacancy_title": "Go-разработчик"}, GroupSize: 1,
+		}
+	}
+	unsavedPreview := unsaved()
+	unsavedPreview.Title = "Go-разработчик"
+	unsavedPreview.Text = "Иван Петров откликнулся на вакансию «Go-разработчик»"
+	unsavedPreview.CreatedAt = now
+
+	withChannels := func(channels map[entity.NotificationChannel]bool) *entity.NotificationSettings {
+		settings := &entity.NotificationSettings{UserID: 4, Role: entity.EmployerRole, Email: "employer@mail.ru"}
+		for channel, enabled := range channels {
+			settings.Set(entity.ApplyNotificationType, channel, enabled)
+		}
+		return settings
+	}
+
+	testCases := []struct {
+		name            string
+		settings        *entity.NotificationSettings
+		persisted       bool
+		fromEvent       bool
+		expectedPreview *entity.NotificationPreview
+	}{
+		{
+			name:            "Default settings - stored and pushed",
+			settings:        withChannels(nil),
+			persisted:       true,
+			expectedPreview: preview,
+		},
+		{
+			name:      "Websocket disabled - stored only",
+			settings:  withChannels(map[entity.NotificationChannel]bool{entity.WebsocketChannel: false}),
+			persisted: true,
+		},
+		{
+			name:            "In-app disabled - pushed without being stored",
+			settings:        withChannels(map[entity.NotificationChannel]bool{entity.InAppChannel: false, entity.EmailChannel: true}),
+			fromEvent:       true,
+			expectedPreview: unsavedPreview,
+		},
+		{
+			name: "In-app and websocket disabled - neither stored nor pushed",
+			settings: withChannels(map[entity.NotificationChannel]bool{
+				entity.InAppChannel: false, entity.WebsocketChannel: false, entity.EmailChannel: true,
+			}),
+		},
+		{
+			name:            "Email enabled - pushed, mail is left to its own sink",
+			settings:        withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true}),
+			persisted:       true,
+			expectedPreview: preview,
+		},
+		{
+			name: "Quiet hours - stored without push",
+			settings: func() *entity.NotificationSettings {
+				settings := withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true})
+				settings.QuietHours = &entity.QuietHours{Start: "14:00", End: "09:00", TimeZone: "Europe/Moscow"}
+				return settings
+			}(),
+			persisted: true,
+		},
+		{
+			name: "Quiet hours in another part of the day - pushed",
+			settings: func() *entity.NotificationSettings {
+				settings := withChannels(nil)
+				settings.QuietHours = &entity.QuietHours{Start: "22:00", End: "08:00", TimeZone: "Europe/Moscow"}
+				return settings
+			}(),
+			persisted:       true,
+			expectedPreview: preview,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mock.NewMockNotificationRepository(ctrl)
+			settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+
+			settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 4, entity.EmployerRole).Return(tc.settings, nil)
+			if tc.persisted {
+				notificationRepo.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *entity.Notification) error {
+					n.ID = 1
+					return nil
+				})
+				notificationRepo.EXPECT().GetNotificationPreview(gomock.Any(), 1).Return(stored(), nil)
+			}
+			if tc.fromEvent {
+				notificationRepo.EXPECT().GetEventPreview(gomock.Any(), gomock.Any()).Return(unsaved(), nil)
+			}
+
+			service := &NotificationService{
+				notificationRepo: notificationRepo,
+				settingsRepo:     settingsRepo,
+				now:              func() time.Time { return now },
+			}
+
+			n := notification
+			got, err := service.CreateNotification(context.Background(), &n)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPreview, got)
+		})
+	}
+}
+
+func TestNotificationService_SendNotificationMail(t *testing.T) {
+	t.Parallel()
+
+	// 12:00 UTC - 15:00 по Москве
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	notification := entity.Notification{
+		Type:          entity.ApplyNotificationType,
+		SenderID:      3,
+		SenderRole:    entity.ApplicantRole,
+		ReceiverID:    4,
+		ReceiverRole:  entity.EmployerRole,
+		ObjectID:      7,
+		Payload:       entity.NotificationPayload{"vacancy_title": "Go-разработчик"},
+		OutboxEventID: 9,
+	}
+	mailPreview := func(groupSize int) *entity.NotificationPreview {
+		return &entity.NotificationPreview{
+			Type: entity.ApplyNotificationType, SenderID: 3, ReceiverID: 4, ObjectID: 7, ApplicantName: "Иван Петров",
+			Payload: entity.NotificationPayload{"vacancy_title": "Go-разработчик"}, GroupSize: groupSize,
+		}
+	}
+
+	withChannels := func(channels map[entity.NotificationChannel]bool) *entity.NotificationSettings {
+		settings := &entity.NotificationSettings{UserID: 4, Role: entity.EmployerRole, Email: "employer@mail.ru"}
+		for channel, enabled := range channels {
+			settings.Set(entity.ApplyNotificationType, channel, enabled)
+		}
+		return settings
+	}
+
+	testCases := []struct {
+		name     string
+		settings *entity.NotificationSettings
+		// groupSize - размер группы уведомления на сайте, 0 - превью не запрашивается
+		groupSize   int
+		sendErr     error
+		sent        bool
+		expectedErr error
+	}{
+		{
+			name:     "Success - email disabled by default",
+			settings: withChannels(nil),
+		},
+		{
+			name:      "Success - first notification of the group is mailed",
+			settings:  withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true}),
+			groupSize: 1,
+			sent:      true,
+		},
+		{
+			name:      "Success - mailed with in-app notifications disabled",
+			settings:  withChannels(map[entity.NotificationChannel]bool{entity.InAppChannel: false, entity.EmailChannel: true}),
+			groupSize: 1,
+			sent:      true,
+		},
+		{
+			name:      "Success - rest of the group is not mailed",
+			settings:  withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true}),
+			groupSize: 12,
+		},
+		{
+			name: "Success - email address is not set",
+			settings: func() *entity.NotificationSettings {
+				settings := withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true})
+				settings.Email = ""
+				return settings
+			}(),
+		},
+		{
+			name: "Success - quiet hours",
+			settings: func() *entity.NotificationSettings {
+				settings := withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true})
+				settings.QuietHours = &entity.QuietHours{Start: "14:00", End: "09:00", TimeZone: "Europe/Moscow"}
+				return settings
+			}(),
+		},
+		{
+			name:        "Error - mail is not sent and will be retried",
+			settings:    withChannels(map[entity.NotificationChannel]bool{entity.EmailChannel: true}),
+			groupSize:   1,
+			sent:        true,
+			sendErr:     errors.New("smtp unavailable"),
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mock.NewMockNotificationRepository(ctrl)
+			settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+			mailer := m.NewMockMailer(ctrl)
+
+			settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 4, entity.EmployerRole).Return(tc.settings, nil)
+			if tc.groupSize > 0 {
+				notificationRepo.EXPECT().GetEventPreview(gomock.Any(), gomock.Any()).Return(mailPreview(tc.groupSize), nil)
+			}
+			if tc.sent {
+				mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, mail *entity.Mail) error {
+					require.Equal(t, "employer@mail.ru", mail.To)
+					require.Equal(t, "Иван Петров откликнулся на вакансию «Go-разработчик»", mail.Subject)
+					return tc.sendErr
+				})
+			}
+
+			service := &NotificationService{
+				notificationRepo: notificationRepo,
+				settingsRepo:     settingsRepo,
+				mailer:           mailer,
+				now:              func() time.Time { return now },
+			}
+
+			n := notification
+			err := service.SendNotificationMail(context.Background(), &n)
+			if tc.expectedErr != nil {
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationService_CreateNotification_Registry(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := &NotificationService{
+		notificationRepo: mock.NewMockNotificationRepository(ctrl),
+		settingsRepo:     mock.NewMockNotificationSettingsRepository(ctrl),
+	}
+
+	for _, notification := range []*entity.Notification{
+		{Type: "status_changed", SenderID: 1, ReceiverID: 2},
+		{Type: entity.DownloadResumeType, SenderID: 1, ReceiverID: 2, Payload: entity.NotificationPayload{"title": "Go"}},
+	} {
+		_, err := service.CreateNotification(context.Background(), notification)
+		require.Error(t, err)
+		var serviceErr entity.Error
+		require.ErrorAs(t, err, &serviceErr)
+		require.ErrorIs(t, serviceErr.ClientErr(), entity.ErrBadRequest)
+	}
+}
+
+func TestNotificationService_CreateNotification_Group(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mock.NewMockNotificationRepository(ctrl)
+	settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+
+	settings := &entity.NotificationSettings{UserID: 4, Role: entity.EmployerRole, Email: "employer@mail.ru"}
+	settings.Set(entity.ApplyNotificationType, entity.EmailChannel, true)
+	settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 4, entity.EmployerRole).Return(settings, nil)
+	notificationRepo.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *entity.Notification) error {
+		n.ID, n.GroupID = 12, 1
+		return nil
+	})
+	notificationRepo.EXPECT().GetNotificationPreview(gomock.Any(), 12).Return(&entity.NotificationPreview{
+		ID: 12, Type: entity.ApplyNotificationType, ReceiverID: 4, ApplicantName: "Петр",
+		Payload: entity.NotificationPayload{"vacancy_title": "Go-разработчик"}, GroupID: 1, GroupSize: 12,
+	}, nil)
+
+	service := &NotificationService{
+		notificationRepo: notificationRepo,
+		settingsRepo:     settingsRepo,
+		now:              time.Now,
+	}
+
+	got, err := service.CreateNotification(context.Background(), &entity.Notification{
+		Type:         entity.ApplyNotificationType,
+		SenderID:     5,
+		SenderRole:   entity.ApplicantRole,
+		ReceiverID:   4,
+		ReceiverRole: entity.EmployerRole,
+		ObjectID:     7,
+		Payload:      entity.NotificationPayload{"vacancy_title": "Go-разработчик"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, got.GroupID)
+	require.Equal(t, "12 новых откликов на вакансию «Go-разработчик» за последний час", got.Text)
+}
+
+func TestNotificationService_UpdateNotificationSettings(t *testing.T) {
+	t.Parallel()
+
+	quietHours := &entity.QuietHours{Start: "22:00", End: "08:00", TimeZone: "Europe/Moscow"}
+
+	testCases := []struct {
+		name        string
+		role        string
+		req         *dto.NotificationSettings
+		mockSetup   func(settingsRepo *mock.MockNotificationSettingsRepository)
+		expected    *dto.NotificationSettings
+		expectedErr error
+	}{
+		{
+			name: "Success - changed channels merged with defaults",
+			role: "applicant",
+			req: &dto.NotificationSettings{
+				Types: []*dto.NotificationTypeSettings{
+					{Type: entity.DownloadResumeType, Channels: map[entity.NotificationChannel]bool{entity.EmailChannel: true}},
+				},
+				QuietHours: quietHours,
+			},
+			mockSetup: func(settingsRepo *mock.MockNotificationSettingsRepository) {
+				saved := &entity.NotificationSettings{UserID: 2, Role: entity.ApplicantRole, QuietHours: quietHours}
+				saved.Set(entity.DownloadResumeType, entity.EmailChannel, true)
+
+				settingsRepo.EXPECT().UpdateNotificationSettings(gomock.Any(), saved).Return(nil)
+				settingsRepo.EXPECT().GetNotificationSettings(gomock.Any(), 2, entity.ApplicantRole).Return(saved, nil)
+			},
+			expected: &dto.NotificationSettings{
+				Types: []*dto.NotificationTypeSettings{
+					{Type: entity.DownloadResumeType, Channels: map[entity.NotificationChannel]bool{entity.InAppChannel: true, entity.WebsocketChannel: true, entity.EmailChannel: true}},
+					{Type: entity.ContactRequestType, Channels: map[entity.NotificationChannel]bool{entity.InAppChannel: true, entity.WebsocketChannel: true, entity.EmailChannel: false}},
+					{Type: entity.InvitationType, Channels: map[entity.NotificationChannel]bool{entity.InAppChannel: true, entity.WebsocketChannel: true, entity.EmailChannel: false}},
+				},
+				QuietHours: quietHours,
+			},
+		},
+		{
+			name: "Error - type is not received by the role",
+			role: "applicant",
+			req: &dto.NotificationSettings{
+				Types: []*dto.NotificationTypeSettings{
+					{Type: entity.ApplyNotificationType, Channels: map[entity.NotificationChannel]bool{entity.EmailChannel: true}},
+				},
+			},
+			mockSetup:   func(settingsRepo *mock.MockNotificationSettingsRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name: "Error - unknown channel",
+			role: "employer",
+			req: &dto.NotificationSettings{
+				Types: []*dto.NotificationTypeSettings{
+					{Type: entity.ApplyNotificationType, Channels: map[entity.NotificationChannel]bool{"sms": true}},
+				},
+			},
+			mockSetup:   func(settingsRepo *mock.MockNotificationSettingsRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - unknown time zone",
+			role:        "employer",
+			req:         &dto.NotificationSettings{QuietHours: &entity.QuietHours{Start: "22:00", End: "08:00", TimeZone: "Mars/Olympus"}},
+			mockSetup:   func(settingsRepo *mock.MockNotificationSettingsRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - empty quiet hours interval",
+			role:        "employer",
+			req:         &dto.NotificationSettings{QuietHours: &entity.QuietHours{Start: "22:00", End: "22:00", TimeZone: "UTC"}},
+			mockSetup:   func(settingsRepo *mock.MockNotificationSettingsRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+			tc.mockSetup(settingsRepo)
+
+			service := &NotificationService{settingsRepo: settingsRepo}
+
+			got, err := service.UpdateNotificationSettings(context.Background(), 2, tc.role, tc.req)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestNotificationService_GetNotificationsForUser(t *testing.T) {
+	t.Parallel()
+
+	unread := false
+
+	testCases := []struct {
+		name        string
+		role        string
+		filter      entity.NotificationFilter
+		mockSetup   func(notificationRepo *mock.MockNotificationRepository)
+		expected    []*entity.NotificationPreview
+		expectedErr error
+	}{
+		{
+			name:   "Success - default page size and rendered previews",
+			role:   "applicant",
+			filter: entity.NotificationFilter{Types: []entity.NotificationType{entity.InvitationType}, IsViewed: &unread},
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationsForUser(gomock.Any(), 2, entity.ApplicantRole, entity.NotificationFilter{
+					Types:    []entity.NotificationType{entity.InvitationType},
+					IsViewed: &unread,
+					Limit:    entity.DefaultNotificationPageSize,
+				}).Return([]*entity.NotificationPreview{{
+					ID: 1, Type: entity.InvitationType, EmployerName: "ООО Рога и Копыта",
+					Payload: entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+				}}, nil)
+			},
+			expected: []*entity.NotificationPreview{{
+				ID: 1, Type: entity.InvitationType, EmployerName: "ООО Рога и Копыта", Title: "Backend Developer",
+				Text:    "ООО Рога и Копыта приглашает вас на вакансию «Backend Developer»",
+				Payload: entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+			}},
+		},
+		{
+			name:        "Error - unknown type in filter",
+			role:        "applicant",
+			filter:      entity.NotificationFilter{Types: []entity.NotificationType{"status_changed"}},
+			mockSetup:   func(notificationRepo *mock.MockNotificationRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - page is too large",
+			role:        "employer",
+			filter:      entity.NotificationFilter{Limit: entity.MaxNotificationPageSize + 1},
+			mockSetup:   func(notificationRepo *mock.MockNotificationRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - unknown role",
+			role:        "admin",
+			mockSetup:   func(notificationRepo *mock.MockNotificationRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mock.NewMockNotificationRepository(ctrl)
+			tc.mockSetup(notificationRepo)
+
+			service := &NotificationService{notificationRepo: notificationRepo}
+
+			got, err := service.GetNotificationsForUser(context.Background(), 2, tc.role, tc.filter)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestNotificationService_GetUnreadNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mock.NewMockNotificationRepository(ctrl)
+	notificationRepo.EXPECT().CountUnreadNotifications(gomock.Any(), 2, entity.ApplicantRole).
+		Return(map[entity.NotificationType]int{entity.DownloadResumeType: 3, entity.InvitationType: 1}, nil)
+
+	service := &NotificationService{notificationRepo: notificationRepo}
+
+	got, err := service.GetUnreadNotifications(context.Background(), 2, "applicant")
+	require.NoError(t, err)
+	require.Equal(t, &dto.UnreadNotificationsResponse{
+		Total: 4,
+		Types: map[entity.NotificationType]int{
+			entity.DownloadResumeType: 3,
+			entity.ContactRequestType: 0,
+			entity.InvitationType:     1,
+		},
+	}, got)
+}
+
+func TestNotificationService_DeleteNotification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		userID      int
+		role        string
+		mockSetup   func(notificationRepo *mock.MockNotificationRepository)
+		expectedErr error
+	}{
+		{
+			name:   "Success - own notification deleted",
+			userID: 2,
+			role:   "applicant",
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 5).
+					Return(&entity.Notification{ID: 5, ReceiverID: 2, ReceiverRole: entity.ApplicantRole}, nil)
+				notificationRepo.EXPECT().DeleteNotification(gomock.Any(), 5).Return(nil)
+			},
+		},
+		{
+			name:   "Error - same id but another role",
+			userID: 2,
+			role:   "employer",
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 5).
+					Return(&entity.Notification{ID: 5, ReceiverID: 2, ReceiverRole: entity.ApplicantRole}, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:   "Error - notification not found",
+			userID: 2,
+			role:   "applicant",
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 5).
+					Return(nil, entity.NewError(entity.ErrNotFound, errors.New("уведомление не найдено")))
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mock.NewMockNotificationRepository(ctrl)
+			tc.mockSetup(notificationRepo)
+
+			service := &NotificationService{notificationRepo: notificationRepo}
+
+			err := service.DeleteNotification(context.Background(), 5, tc.userID, tc.role)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationService_GetNotificationGroup(t *testing.T) {
+	t.Parallel()
+
+	head := &entity.Notification{ID: 1, GroupID: 1, ReceiverID: 4, ReceiverRole: entity.EmployerRole}
+
+	testCases := []struct {
+		name           string
+		groupID        int
+		role           string
+		limit          int
+		mockSetup      func(notificationRepo *mock.MockNotificationRepository)
+		expectedResult []*entity.NotificationPreview
+		expectedErr    error
+	}{
+		{
+			name:    "Success - group expanded into single notifications",
+			groupID: 1,
+			role:    "employer",
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 1).Return(head, nil)
+				notificationRepo.EXPECT().GetGroupNotifications(gomock.Any(), 1, entity.DefaultNotificationPageSize, 0).
+					Return([]*entity.NotificationPreview{
+						{ID: 7, Type: entity.ApplyNotificationType, ApplicantName: "Петр", Payload: entity.NotificationPayload{"vacancy_title": "Go"}, GroupID: 1, GroupSize: 1},
+					}, nil)
+			},
+			expectedResult: []*entity.NotificationPreview{
+				{
+					ID: 7, Type: entity.ApplyNotificationType, ApplicantName: "Петр", Payload: entity.NotificationPayload{"vacancy_title": "Go"},
+					GroupID: 1, GroupSize: 1, Title: "Go", Text: "Петр откликнулся на вакансию «Go»",
+				},
+			},
+		},
+		{
+			name:    "Error - id of a group member, not of the group",
+			groupID: 7,
+			role:    "employer",
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 7).
+					Return(&entity.Notification{ID: 7, GroupID: 1, ReceiverID: 4, ReceiverRole: entity.EmployerRole}, nil)
+			},
+			expectedErr: entity.ErrNotFound,
+		},
+		{
+			name:    "Error - group of another user",
+			groupID: 1,
+			role:    "applicant",
+			mockSetup: func(notificationRepo *mock.MockNotificationRepository) {
+				notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 1).Return(head, nil)
+			},
+			expectedErr: entity.ErrForbidden,
+		},
+		{
+			name:        "Error - page too large",
+			groupID:     1,
+			role:        "employer",
+			limit:       entity.MaxNotificationPageSize + 1,
+			mockSetup:   func(notificationRepo *mock.MockNotificationRepository) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mock.NewMockNotificationRepository(ctrl)
+			tc.mockSetup(notificationRepo)
+
+			service := &NotificationService{notificationRepo: notificationRepo}
+
+			result, err := service.GetNotificationGroup(context.Background(), tc.groupID, 4, tc.role, tc.limit, 0)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestNotificationService_ReadNotificationGroup(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mock.NewMockNotificationRepository(ctrl)
+	notificationRepo.EXPECT().GetNotificationByID(gomock.Any(), 1).
+		Return(&entity.Notification{ID: 1, GroupID: 1, ReceiverID: 4, ReceiverRole: entity.EmployerRole}, nil)
+	notificationRepo.EXPECT().ReadNotificationGroup(gomock.Any(), 1).Return(nil)
+
+	service := &NotificationService{notificationRepo: notificationRepo}
+
+	require.NoError(t, service.ReadNotificationGroup(context.Background(), 1, 4, "employer"))
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"ResuMatch/internal/config"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/usecase"
+	l "ResuMatch/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// outboxMaxRetryDelay ограничивает рост паузы между повторами, чтобы починенный получатель
+// не ждал событий часами
+const outboxMaxRetryDelay = time.Hour
+
+type OutboxService struct {
+	OutboxRepo repository.OutboxRepository
+	sinks      []usecase.EventSink
+	cfg        config.OutboxConfig
+	now        func() time.Time
+}
+
+func NewOutboxService(outboxRepository repository.OutboxRepository, cfg config.OutboxConfig, sinks ...usecase.EventSink) usecase.Outbox {
+	return &OutboxService{
+		OutboxRepo: outboxRepository,
+		sinks:      sinks,
+		cfg:        cfg,
+		now:        time.Now,
+	}
+}
+
+// Run доставляет события раз в cfg.Interval, пока не отменен контекст
+func (s *OutboxService) Run(ctx context.Context) {
+	if s.cfg.Interval <= 0 {
+		l.Log.Warn("Интервал доставки событий outbox не задан, события не доставляются")
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Полная пачка - в очереди, скорее всего, есть еще события, и ждать следующего тика незачем
+			for {
+				delivered, err := s.Dispatch(ctx)
+				if err != nil {
+					l.Log.Errorf("Не удалось доставить события outbox: %v", err)
+					break
+				}
+				if delivered < s.cfg.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Dispatch забирает пачку событий и передает каждое всем получателям, которые его еще не обработали.
+// Если хотя бы один получатель вернул ошибку, событие повторяется позже только для оставшихся.
+// Возвращает число обработанных событий, в том числе неудачных
+func (s *OutboxService) Dispatch(ctx context.Context) (int, error) {
+	events, err := s.OutboxRepo.ClaimEvents(ctx, s.cfg.BatchSize, s.cfg.MaxAttempts, s.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		var errs []error
+		for _, sink := range s.sinks {
+			if event.Delivered(sink.Name()) {
+				continue
+			}
+			if err := sink.Deliver(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+				continue
+			}
+			event.DeliveredSinks = append(event.DeliveredSinks, sink.Name())
+		}
+
+		if len(errs) == 0 {
+			if err := s.OutboxRepo.MarkEventDelivered(ctx, event.ID, event.DeliveredSinks); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		deliveryErr := errors.Join(errs...)
+		if event.Attempts >= s.cfg.MaxAttempts {
+			l.Log.Errorf("Событие %d (%s) не доставлено за %d попыток: %v", event.ID, event.Type, event.Attempts, deliveryErr)
+		} else {
+			l.Log.Warnf("Событие %d (%s) не доставлено, попытка %d: %v", event.ID, event.Type, event.Attempts, deliveryErr)
+		}
+		if err := s.OutboxRepo.MarkEventFailed(ctx, event.ID, event.DeliveredSinks, s.now().Add(s.retryDelay(event.Attempts)), deliveryErr.Error()); err != nil {
+			return 0, err
+		}
+	}
+	return len(events), nil
+}
+
+// retryDelay возвращает паузу перед следующей попыткой: cfg.RetryDelay, затем вдвое больше после каждой неудачи
+func (s *OutboxService) retryDelay(attempts int) time.Duration {
+	delay := s.cfg.RetryDelay
+	for i := 1; i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryDelay)
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"ResuMatch/internal/config"
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/repository/mock"
+	"ResuMatch/internal/usecase"
+	m "ResuMatch/internal/usecase/mock"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboxService_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	cfg := config.OutboxConfig{BatchSize: 10, Lease: time.Minute, MaxAttempts: 5, RetryDelay: 5 * time.Second}
+
+	event := func(attempts int, delivered ...string) *entity.OutboxEvent {
+		return &entity.OutboxEvent{
+			ID:             7,
+			Type:           entity.ResponseCreatedEvent,
+			Notification:   &entity.Notification{Type: entity.ApplyNotificationType, SenderID: 1, ReceiverID: 2},
+			DeliveredSinks: delivered,
+			Attempts:       attempts,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		mockSetup         func(outboxRepo *mock.MockOutboxRepository, notificationSink, chatSink *m.MockEventSink)
+		expectedDelivered int
+		expectedErr       error
+	}{
+		{
+			name: "Success - event delivered to every sink",
+			mockSetup: func(outboxRepo *mock.MockOutboxRepository, notificationSink, chatSink *m.MockEventSink) {
+				outboxRepo.EXPECT().ClaimEvents(gomock.Any(), 10, 5, time.Minute).Return([]*entity.OutboxEvent{event(1)}, nil)
+				notificationSink.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(nil)
+				chatSink.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(nil)
+				outboxRepo.EXPECT().MarkEventDelivered(gomock.Any(), 7, []string{"notification", "chat"}).Return(nil)
+			},
+			expectedDelivered: 1,
+		},
+		{
+			name: "Sink failed - retried later, delivered sinks are kept",
+			mockSetup: func(outboxRepo *mock.MockOutboxRepository, notificationSink, chatSink *m.MockEventSink) {
+				outboxRepo.EXPECT().ClaimEvents(gomock.Any(), 10, 5, time.Minute).Return([]*entity.OutboxEvent{event(1)}, nil)
+				notificationSink.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(nil)
+				chatSink.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(errors.New("chat unavailable"))
+				outboxRepo.EXPECT().MarkEventFailed(gomock.Any(), 7, []string{"notification"}, now.Add(5*time.Second), "chat: chat unavailable").Return(nil)
+			},
+			expectedDelivered: 1,
+		},
+		{
+			name: "Retry - only sinks that have not handled the event yet, with a longer delay",
+			mockSetup: func(outboxRepo *mock.MockOutboxRepository, notificationSink, chatSink *m.MockEventSink) {
+				outboxRepo.EXPECT().ClaimEvents(gomock.Any(), 10, 5, time.Minute).Return([]*entity.OutboxEvent{event(3, "notification")}, nil)
+				chatSink.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(errors.New("chat unavailable"))
+				outboxRepo.EXPECT().MarkEventFailed(gomock.Any(), 7, []string{"notification"}, now.Add(20*time.Second), "chat: chat unavailable").Return(nil)
+			},
+			expectedDelivered: 1,
+		},
+		{
+			name: "Success - nothing to deliver",
+			mockSetup: func(outboxRepo *mock.MockOutboxRepository, _, _ *m.MockEventSink) {
+				outboxRepo.EXPECT().ClaimEvents(gomock.Any(), 10, 5, time.Minute).Return(nil, nil)
+			},
+		},
+		{
+			name: "Error - events not claimed",
+			mockSetup: func(outboxRepo *mock.MockOutboxRepository, _, _ *m.MockEventSink) {
+				outboxRepo.EXPECT().ClaimEvents(gomock.Any(), 10, 5, time.Minute).
+					Return(nil, entity.NewError(entity.ErrInternal, errors.New("db error")))
+			},
+			expectedErr: entity.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			outboxRepo := mock.NewMockOutboxRepository(ctrl)
+			notificationSink := m.NewMockEventSink(ctrl)
+			chatSink := m.NewMockEventSink(ctrl)
+			notificationSink.EXPECT().Name().Return("notification").AnyTimes()
+			chatSink.EXPECT().Name().Return("chat").AnyTimes()
+			tc.mockSetup(outboxRepo, notificationSink, chatSink)
+
+			service := &OutboxService{
+				OutboxRepo: outboxRepo,
+				sinks:      []usecase.EventSink{notificationSink, chatSink},
+				cfg:        cfg,
+				now:        func() time.Time { return now },
+			}
+
+			delivered, err := service.Dispatch(context.Background())
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedDelivered, delivered)
+			}
+		})
+	}
+}
+
+func TestOutboxService_RetryDelay(t *testing.T) {
+	t.Parallel()
+
+	service := &OutboxService{cfg: config.OutboxConfig{RetryDelay: 5 * time.Second}}
+
+	require.Equal(t, 5*time.Second, service.retryDelay(1))
+	require.Equal(t, 40*time.Second, service.retryDelay(4))
+	require.Equal(t, outboxMaxRetryDelay, service.retryDelay(30))
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/repository"
+	"ResuMatch/internal/usecase"
+	"context"
+	"time"
+)
+
+// presenceTTL должен пережить хотя бы один пропущенный pong: соединение продлевается на каждом pong,
+// а после падения экземпляра приложения его соединения перестанут учитываться через это время
+const presenceTTL = 2 * time.Minute
+
+type PresenceService struct {
+	PresenceRepo repository.PresenceRepository
+}
+
+func NewPresenceService(presenceRepo repository.PresenceRepository) usecase.Presence {
+	return &PresenceService{
+		PresenceRepo: presenceRepo,
+	}
+}
+
+func (s *PresenceService) Connect(ctx context.Context, userID int, role, connID string) (bool, error) {
+	return s.PresenceRepo.Connect(ctx, userID, role, connID, presenceTTL)
+}
+
+func (s *PresenceService) Disconnect(ctx context.Context, userID int, role, connID string) (bool, error) {
+	return s.PresenceRepo.Disconnect(ctx, userID, role, connID)
+}
+
+func (s *PresenceService) GetPresence(ctx context.Context, userID int, role string) (*dto.PresenceResponse, error) {
+	presence, err := s.PresenceRepo.GetPresence(ctx, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PresenceResponse{
+		UserID:   presence.UserID,
+		Role:     presence.Role,
+		Online:   presence.Online,
+		LastSeen: presence.LastSeen,
+	}, nil
+}
@@ -10,7 +10,9 @@ import (
 	l "ResuMatch/pkg/logger"
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"html/template"
 	"path/filepath"
@@ -26,6 +28,26 @@ type WorkExperience struct {
 	UntilNow           bool
 }
 
+type EducationItem struct {
+	Year        int
+	Level       string
+	Institution string
+	Faculty     string
+	Specialty   string
+}
+
+type CourseItem struct {
+	Kind         string
+	Name         string
+	Organization string
+	Year         int
+}
+
+type LanguageItem struct {
+	Language string
+	Level    string
+}
+
 type ResumeTemplateData struct {
 	FirstName              string
 	LastName               string
@@ -46,6 +68,17 @@ type ResumeTemplateData struct {
 	GraduationYear         string
 	Skills                 []string
 	WorkExperiences        []WorkExperience
+	Anonymous              bool
+	DesiredSalary          string
+	Cities                 []string
+	ReadyToRelocate        bool
+	ReadyForBusinessTrips  bool
+	WorkFormats            []string
+	EmploymentTypes        []string
+	Schedules              []string
+	Educations             []EducationItem
+	Courses                []CourseItem
+	Languages              []LanguageItem
 }
 
 // Add the required dependencies to the ResumeService struct
@@ -55,6 +88,8 @@ type ResumeService struct {
 	specializationRepository repository.SpecializationRepository
 	applicantRepository      repository.ApplicantRepository
 	applicantService         usecase.Applicant
+	staticGateway            usecase.Static
+	outboxRepository         repository.OutboxRepository
 	cfg                      config.ResumeConfig
 	template                 *template.Template
 }
@@ -66,6 +101,8 @@ func NewResumeService(
 	specializationRepo repository.SpecializationRepository,
 	applicantRepo repository.ApplicantRepository,
 	applicantService usecase.Applicant,
+	staticGateway usecase.Static,
+	outboxRepo repository.OutboxRepository,
 	cfg config.ResumeConfig,
 ) usecase.ResumeUsecase {
 	s := &ResumeService{
@@ -74,6 +111,8 @@ func NewResumeService(
 		specializationRepository: specializationRepo,
 		applicantRepository:      applicantRepo,
 		applicantService:         applicantService,
+		staticGateway:            staticGateway,
+		outboxRepository:         outboxRepo,
 		cfg:                      cfg,
 	}
 
@@ -122,6 +161,17 @@ func (s *ResumeService) prepareResumeTemplateData(applicant *dto.ApplicantProfil
 		Profession:             resume.Profession,
 		EducationalInstitution: resume.EducationalInstitution,
 		Skills:                 resume.Skills,
+		Anonymous:              resume.ContactsHidden,
+		Cities:                 resume.Cities,
+		ReadyToRelocate:        resume.ReadyToRelocate,
+		ReadyForBusinessTrips:  resume.ReadyForBusinessTrips,
+		WorkFormats:            translateValues(resume.WorkFormats, entity.WorkFormatRu),
+		EmploymentTypes:        translateValues(resume.EmploymentTypes, entity.EmploymentTypeRu),
+		Schedules:              translateValues(resume.Schedules, entity.ScheduleRu),
+	}
+
+	if resume.DesiredSalary > 0 {
+		templateData.DesiredSalary = fmt.Sprintf("%d %s", resume.DesiredSalary, entity.CurrencySymbols[resume.SalaryCurrency])
 	}
 
 	graduationYear, err := utils.ExtractYearFromDate(resume.GraduationYear)
@@ -158,9 +208,244 @@ func (s *ResumeService) prepareResumeTemplateData(applicant *dto.ApplicantProfil
 		workExperiences = append(workExperiences, workExperience)
 	}
 	templateData.WorkExperiences = workExperiences
+
+	for _, education := range resume.Educations {
+		templateData.Educations = append(templateData.Educations, EducationItem{
+			Year:        education.GraduationYear,
+			Level:       entity.GetEducationTypeRu(education.Level),
+			Institution: education.Institution,
+			Faculty:     education.Faculty,
+			Specialty:   education.Specialty,
+		})
+	}
+	for _, course := range resume.Courses {
+		templateData.Courses = append(templateData.Courses, CourseItem{
+			Kind:         entity.CourseKindRu[course.Kind],
+			Name:         course.Name,
+			Organization: course.Organization,
+			Year:         course.Year,
+		})
+	}
+	for _, language := range resume.Languages {
+		templateData.Languages = append(templateData.Languages, LanguageItem{
+			Language: language.Language,
+			Level:    entity.LanguageLevelRu[language.Level],
+		})
+	}
 	return &templateData, nil
 }
 
+// translateValues заменяет значения перечислений на подписи для шаблона
+func translateValues(values []string, labels map[string]string) []string {
+	translated := make([]string, 0, len(values))
+	for _, value := range values {
+		translated = append(translated, labels[value])
+	}
+	return translated
+}
+
+// resumeCurrency возвращает валюту зарплаты, по умолчанию рубли
+func resumeCurrency(currency entity.Currency) entity.Currency {
+	if currency == "" {
+		return entity.CurrencyRUB
+	}
+	return currency
+}
+
+// fillResumePreferences переносит в ответ пожелания соискателя к работе
+func fillResumePreferences(response *dto.ResumeResponse, resume *entity.Resume, cities []entity.City) {
+	response.DesiredSalary = resume.DesiredSalary
+	response.SalaryCurrency = resume.SalaryCurrency
+	response.ReadyToRelocate = resume.ReadyToRelocate
+	response.ReadyForBusinessTrips = resume.ReadyForBusinessTrips
+	response.WorkFormats = nonNilStrings(resume.WorkFormats)
+	response.EmploymentTypes = nonNilStrings(resume.EmploymentTypes)
+	response.Schedules = nonNilStrings(resume.Schedules)
+	response.Cities = make([]string, 0, len(cities))
+	for _, city := range cities {
+		response.Cities = append(response.Cities, city.Name)
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// replaceCities перезаписывает список городов резюме
+func (s *ResumeService) replaceCities(ctx context.Context, resumeID int, cityNames []string) error {
+	if err := s.resumeRepository.DeleteCities(ctx, resumeID); err != nil {
+		return err
+	}
+	return s.addCities(ctx, resumeID, cityNames)
+}
+
+func (s *ResumeService) addCities(ctx context.Context, resumeID int, cityNames []string) error {
+	if len(cityNames) == 0 {
+		return nil
+	}
+
+	cityIDs, err := s.resumeRepository.FindCityIDsByNames(ctx, cityNames)
+	if err != nil {
+		return err
+	}
+
+	return s.resumeRepository.AddCities(ctx, resumeID, cityIDs)
+}
+
+// fillResumeDetails переносит в резюме образование, курсы и языки из запроса.
+// Старые клиенты присылают только одно образование, его сохраняем как единственную запись
+func fillResumeDetails(resume *entity.Resume, educations []dto.EducationDTO, courses []dto.CourseDTO, languages []dto.LanguageDTO) {
+	for _, education := range educations {
+		resume.Educations = append(resume.Educations, entity.ResumeEducation{
+			ResumeID:       resume.ID,
+			Level:          education.Level,
+			Institution:    education.Institution,
+			Faculty:        education.Faculty,
+			Specialty:      education.Specialty,
+			GraduationYear: education.GraduationYear,
+		})
+	}
+
+	if len(resume.Educations) > 0 {
+		setMainEducation(resume)
+	} else if resume.Education != "" && resume.EducationalInstitution != "" && !resume.GraduationYear.IsZero() {
+		resume.Educations = []entity.ResumeEducation{{
+			ResumeID:       resume.ID,
+			Level:          resume.Education,
+			Institution:    resume.EducationalInstitution,
+			GraduationYear: resume.GraduationYear.Year(),
+		}}
+	}
+
+	for _, course := range courses {
+		resume.Courses = append(resume.Courses, entity.ResumeCourse{
+			ResumeID:     resume.ID,
+			Kind:         course.Kind,
+			Name:         course.Name,
+			Organization: course.Organization,
+			Year:         course.Year,
+			FileID:       course.FileID,
+		})
+	}
+
+	for _, language := range languages {
+		resume.Languages = append(resume.Languages, entity.ResumeLanguage{
+			ResumeID: resume.ID,
+			Language: language.Language,
+			Level:    language.Level,
+		})
+	}
+}
+
+// setMainEducation заполняет основные поля образования резюме самой высокой ступенью,
+// при равном уровне берется более позднее окончание. По ним строятся краткие карточки резюме
+func setMainEducation(resume *entity.Resume) {
+	main := resume.Educations[0]
+	for _, education := range resume.Educations[1:] {
+		if entity.EducationRank[education.Level] > entity.EducationRank[main.Level] ||
+			entity.EducationRank[education.Level] == entity.EducationRank[main.Level] && education.GraduationYear > main.GraduationYear {
+			main = education
+		}
+	}
+
+	resume.Education = main.Level
+	resume.EducationalInstitution = main.Institution
+	resume.GraduationYear = time.Date(main.GraduationYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// addResumeDetails сохраняет образование, курсы и языки резюме
+func (s *ResumeService) addResumeDetails(ctx context.Context, resumeID int, resume *entity.Resume) error {
+	if len(resume.Educations) > 0 {
+		if err := s.resumeRepository.AddEducations(ctx, resumeID, resume.Educations); err != nil {
+			return err
+		}
+	}
+
+	if len(resume.Courses) > 0 {
+		if err := s.resumeRepository.AddCourses(ctx, resumeID, resume.Courses); err != nil {
+			return err
+		}
+	}
+
+	if len(resume.Languages) > 0 {
+		if err := s.resumeRepository.AddLanguages(ctx, resumeID, resume.Languages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceResumeDetails перезаписывает образование, курсы и языки резюме
+func (s *ResumeService) replaceResumeDetails(ctx context.Context, resumeID int, resume *entity.Resume) error {
+	if err := s.resumeRepository.DeleteEducations(ctx, resumeID); err != nil {
+		return err
+	}
+	if err := s.resumeRepository.DeleteCourses(ctx, resumeID); err != nil {
+		return err
+	}
+	if err := s.resumeRepository.DeleteLanguages(ctx, resumeID); err != nil {
+		return err
+	}
+	return s.addResumeDetails(ctx, resumeID, resume)
+}
+
+// loadResumeDetails добавляет в ответ образование, курсы и языки резюме
+func (s *ResumeService) loadResumeDetails(ctx context.Context, response *dto.ResumeResponse, resumeID int) error {
+	educations, err := s.resumeRepository.GetEducationsByResumeID(ctx, resumeID)
+	if err != nil {
+		return err
+	}
+	for _, education := range educations {
+		response.Educations = append(response.Educations, dto.EducationResponse{
+			ID:             education.ID,
+			Level:          education.Level,
+			Institution:    education.Institution,
+			Faculty:        education.Faculty,
+			Specialty:      education.Specialty,
+			GraduationYear: education.GraduationYear,
+		})
+	}
+
+	courses, err := s.resumeRepository.GetCoursesByResumeID(ctx, resumeID)
+	if err != nil {
+		return err
+	}
+	for _, course := range courses {
+		courseResponse := dto.CourseResponse{
+			ID:           course.ID,
+			Kind:         course.Kind,
+			Name:         course.Name,
+			Organization: course.Organization,
+			Year:         course.Year,
+			FileID:       course.FileID,
+		}
+		if course.FileID != 0 {
+			courseResponse.FilePath, err = s.staticGateway.GetStatic(ctx, course.FileID)
+			if err != nil {
+				return err
+			}
+		}
+		response.Courses = append(response.Courses, courseResponse)
+	}
+
+	languages, err := s.resumeRepository.GetLanguagesByResumeID(ctx, resumeID)
+	if err != nil {
+		return err
+	}
+	for _, language := range languages {
+		response.Languages = append(response.Languages, dto.LanguageResponse{
+			Language: language.Language,
+			Level:    language.Level,
+		})
+	}
+
+	return nil
+}
+
 func (s *ResumeService) Create(ctx context.Context, applicantID int, request *dto.CreateResumeRequest) (*dto.ResumeResponse, error) {
 	requestID := utils.GetRequestID(ctx)
 
@@ -200,7 +485,15 @@ func (s *ResumeService) Create(ctx context.Context, applicantID int, request *dt
 		EducationalInstitution: request.EducationalInstitution,
 		GraduationYear:         graduationYear,
 		Profession:             request.Profession,
+		DesiredSalary:          request.DesiredSalary,
+		SalaryCurrency:         resumeCurrency(request.SalaryCurrency),
+		ReadyToRelocate:        request.ReadyToRelocate,
+		ReadyForBusinessTrips:  request.ReadyForBusinessTrips,
+		WorkFormats:            request.WorkFormats,
+		EmploymentTypes:        request.EmploymentTypes,
+		Schedules:              request.Schedules,
 	}
+	fillResumeDetails(resume, request.Educations, request.Courses, request.Languages)
 
 	// Validate resume
 	if err := resume.Validate(); err != nil {
@@ -241,6 +534,14 @@ func (s *ResumeService) Create(ctx context.Context, applicantID int, request *dt
 		}
 	}
 
+	if err := s.addCities(ctx, createdResume.ID, request.Cities); err != nil {
+		return nil, err
+	}
+
+	if err := s.addResumeDetails(ctx, createdResume.ID, resume); err != nil {
+		return nil, err
+	}
+
 	// Add work experiences if provided
 	var workExperiences []entity.WorkExperience
 	for _, we := range request.WorkExperiences {
@@ -308,6 +609,11 @@ func (s *ResumeService) Create(ctx context.Context, applicantID int, request *dt
 		return nil, err
 	}
 
+	cities, err := s.resumeRepository.GetCitiesByResumeID(ctx, createdResume.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build response
 	response := &dto.ResumeResponse{
 		ID:                        createdResume.ID,
@@ -321,6 +627,11 @@ func (s *ResumeService) Create(ctx context.Context, applicantID int, request *dt
 		CreatedAt:                 createdResume.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:                 createdResume.UpdatedAt.Format(time.RFC3339),
 	}
+	fillResumePreferences(response, createdResume, cities)
+
+	if err := s.loadResumeDetails(ctx, response, createdResume.ID); err != nil {
+		return nil, err
+	}
 
 	// Add education info if exists
 	if createdResume.Education != "" {
@@ -370,18 +681,125 @@ func (s *ResumeService) Create(ctx context.Context, applicantID int, request *dt
 	return response, nil
 }
 
-func (s *ResumeService) GetByID(ctx context.Context, id int) (*dto.ResumeResponse, error) {
+// checkResumeAccess проверяет, может ли пользователь просматривать резюме с учетом настроек видимости
+func (s *ResumeService) checkResumeAccess(ctx context.Context, resume *entity.Resume, userID int, role string, accessToken string) error {
+	userRole := entity.AllowedUserRoles[role]
+
+	if userRole == entity.ApplicantRole && resume.ApplicantID == userID {
+		return nil
+	}
+
+	notFound := entity.NewError(
+		entity.ErrNotFound,
+		fmt.Errorf("резюме с id=%d не найдено", resume.ID),
+	)
+
+	if userRole == entity.EmployerRole {
+		blocked, err := s.resumeRepository.IsEmployerBlocked(ctx, resume.ApplicantID, userID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return notFound
+		}
+	}
+
+	switch resume.GetVisibility() {
+	case entity.ResumeVisibilityPublic:
+		return nil
+	case entity.ResumeVisibilityEmployersOnly:
+		if userRole == entity.EmployerRole {
+			return nil
+		}
+	case entity.ResumeVisibilityLinkOnly:
+		if accessToken != "" && resume.AccessToken != "" &&
+			subtle.ConstantTimeCompare([]byte(accessToken), []byte(resume.AccessToken)) == 1 {
+			return nil
+		}
+		// Работодатель, которому соискатель откликнулся этим резюме, видит его без ссылки
+		if userRole == entity.EmployerRole {
+			sent, err := s.resumeRepository.ResumeSentToEmployer(ctx, resume.ID, userID)
+			if err != nil {
+				return err
+			}
+			if sent {
+				return nil
+			}
+		}
+	case entity.ResumeVisibilityHidden:
+		return notFound
+	}
+
+	return entity.NewError(
+		entity.ErrForbidden,
+		fmt.Errorf("нет доступа к резюме с id=%d", resume.ID),
+	)
+}
+
+// contactsHidden определяет, нужно ли скрыть имя, фото и контакты владельца анонимного резюме.
+// Работодатель видит их после отклика соискателя на его вакансию или принятого запроса контактов
+func (s *ResumeService) contactsHidden(ctx context.Context, resume *entity.Resume, userID int, role string) (bool, error) {
+	if !resume.IsAnonymous {
+		return false, nil
+	}
+
+	switch entity.AllowedUserRoles[role] {
+	case entity.ApplicantRole:
+		return resume.ApplicantID != userID, nil
+	case entity.EmployerRole:
+		disclosed, err := s.resumeRepository.ContactsDisclosed(ctx, resume.ApplicantID, userID)
+		if err != nil {
+			return false, err
+		}
+		return !disclosed, nil
+	default:
+		return true, nil
+	}
+}
+
+// maskApplicant оставляет в профиле только данные, по которым нельзя узнать соискателя
+func maskApplicant(applicant *dto.ApplicantProfileResponse) *dto.ApplicantProfileResponse {
+	if applicant == nil {
+		return nil
+	}
+	return &dto.ApplicantProfileResponse{
+		City:      applicant.City,
+		BirthDate: applicant.BirthDate,
+		Sex:       applicant.Sex,
+		Status:    applicant.Status,
+	}
+}
+
+func (s *ResumeService) GetByID(ctx context.Context, id int, userID int, role string, accessToken string) (*dto.ResumeResponse, error) {
+	response, _, err := s.getResume(ctx, id, userID, role, accessToken)
+	return response, err
+}
+
+// getResume собирает ответ с учетом прав пользователя и возвращает вместе с ним исходное резюме,
+// в котором id соискателя не скрыт
+func (s *ResumeService) getResume(ctx context.Context, id int, userID int, role string, accessToken string) (*dto.ResumeResponse, *entity.Resume, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
 		"requestID": requestID,
 		"resumeID":  id,
+		"userID":    userID,
+		"role":      role,
 	}).Info("Получение резюме по ID")
 
 	// Get resume
 	resume, err := s.resumeRepository.GetByID(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if err := s.checkResumeAccess(ctx, resume, userID, role, accessToken); err != nil {
+		return nil, nil, err
+	}
+
+	contactsHidden, err := s.contactsHidden(ctx, resume, userID, role)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Get specialization name
@@ -389,7 +807,7 @@ func (s *ResumeService) GetByID(ctx context.Context, id int) (*dto.ResumeRespons
 	if resume.SpecializationID != 0 {
 		specialization, err := s.specializationRepository.GetByID(ctx, resume.SpecializationID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		specializationName = specialization.Name
 	}
@@ -397,19 +815,24 @@ func (s *ResumeService) GetByID(ctx context.Context, id int) (*dto.ResumeRespons
 	// Get skills
 	skills, err := s.resumeRepository.GetSkillsByResumeID(ctx, resume.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Get additional specializations
 	additionalSpecializations, err := s.resumeRepository.GetSpecializationsByResumeID(ctx, resume.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	cities, err := s.resumeRepository.GetCitiesByResumeID(ctx, resume.ID)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Get work experiences
 	workExperiences, err := s.resumeRepository.GetWorkExperienceByResumeID(ctx, resume.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Build response
@@ -424,6 +847,23 @@ func (s *ResumeService) GetByID(ctx context.Context, id int) (*dto.ResumeRespons
 		WorkExperiences:           make([]dto.WorkExperienceResponse, 0, len(workExperiences)),
 		CreatedAt:                 resume.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:                 resume.UpdatedAt.Format(time.RFC3339),
+		Visibility:                resume.GetVisibility(),
+		IsAnonymous:               resume.IsAnonymous,
+	}
+	fillResumePreferences(response, resume, cities)
+
+	if err := s.loadResumeDetails(ctx, response, resume.ID); err != nil {
+		return nil, nil, err
+	}
+
+	if contactsHidden {
+		response.ApplicantID = 0
+		response.ContactsHidden = true
+	}
+
+	// Ссылку для доступа показываем только владельцу резюме
+	if entity.AllowedUserRoles[role] == entity.ApplicantRole && resume.ApplicantID == userID {
+		response.AccessToken = resume.AccessToken
 	}
 
 	// Add education info if exists
@@ -471,7 +911,7 @@ func (s *ResumeService) GetByID(ctx context.Context, id int) (*dto.ResumeRespons
 		response.WorkExperiences = append(response.WorkExperiences, workExp)
 	}
 
-	return response, nil
+	return response, resume, nil
 }
 
 func (s *ResumeService) Update(ctx context.Context, id int, applicantID int, request *dto.UpdateResumeRequest) (*dto.ResumeResponse, error) {
@@ -528,7 +968,15 @@ func (s *ResumeService) Update(ctx context.Context, id int, applicantID int, req
 		EducationalInstitution: request.EducationalInstitution,
 		GraduationYear:         graduationYear,
 		Profession:             request.Profession,
+		DesiredSalary:          request.DesiredSalary,
+		SalaryCurrency:         resumeCurrency(request.SalaryCurrency),
+		ReadyToRelocate:        request.ReadyToRelocate,
+		ReadyForBusinessTrips:  request.ReadyForBusinessTrips,
+		WorkFormats:            request.WorkFormats,
+		EmploymentTypes:        request.EmploymentTypes,
+		Schedules:              request.Schedules,
 	}
+	fillResumeDetails(resume, request.Educations, request.Courses, request.Languages)
 
 	// Validate resume
 	if err := resume.Validate(); err != nil {
@@ -573,6 +1021,14 @@ func (s *ResumeService) Update(ctx context.Context, id int, applicantID int, req
 		}
 	}
 
+	if err := s.replaceCities(ctx, id, request.Cities); err != nil {
+		return nil, err
+	}
+
+	if err := s.replaceResumeDetails(ctx, id, resume); err != nil {
+		return nil, err
+	}
+
 	// Update work experiences
 	if err := s.resumeRepository.DeleteWorkExperiences(ctx, id); err != nil {
 		return nil, err
@@ -644,6 +1100,11 @@ func (s *ResumeService) Update(ctx context.Context, id int, applicantID int, req
 		return nil, err
 	}
 
+	cities, err := s.resumeRepository.GetCitiesByResumeID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build response
 	response := &dto.ResumeResponse{
 		ID:                        updatedResume.ID,
@@ -657,6 +1118,11 @@ func (s *ResumeService) Update(ctx context.Context, id int, applicantID int, req
 		CreatedAt:                 updatedResume.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:                 updatedResume.UpdatedAt.Format(time.RFC3339),
 	}
+	fillResumePreferences(response, updatedResume, cities)
+
+	if err := s.loadResumeDetails(ctx, response, updatedResume.ID); err != nil {
+		return nil, err
+	}
 
 	// Add education info if exists
 	if updatedResume.Education != "" {
@@ -753,16 +1219,17 @@ func (s *ResumeService) Delete(ctx context.Context, id int, applicantID int) (*d
 	}, nil
 }
 
-// GetAll returns a list of all resumes (for employers)
-func (s *ResumeService) GetAll(ctx context.Context, limit int, offset int) ([]dto.ResumeShortResponse, error) {
+// GetAll returns a list of all resumes visible to the employer
+func (s *ResumeService) GetAll(ctx context.Context, employerID int, limit int, offset int) ([]dto.ResumeShortResponse, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
-		"requestID": requestID,
+		"requestID":  requestID,
+		"employerID": employerID,
 	}).Info("Получение списка всех резюме")
 
 	// Get all resumes with limit
-	resumes, err := s.resumeRepository.GetAll(ctx, limit, offset)
+	resumes, err := s.resumeRepository.GetAll(ctx, employerID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -820,12 +1287,24 @@ func (s *ResumeService) GetAll(ctx context.Context, limit int, offset int) ([]dt
 			continue
 		}
 
+		contactsHidden, err := s.contactsHidden(ctx, &resume, employerID, string(entity.EmployerRole))
+		if err != nil {
+			return nil, err
+		}
+		if contactsHidden {
+			applicantDTO = maskApplicant(applicantDTO)
+		}
+
 		// Create short resume response
 		shortResume := dto.ResumeShortResponse{
 			ID:             resume.ID,
 			Applicant:      applicantDTO,
+			ContactsHidden: contactsHidden,
 			Specialization: specializationName,
 			Profession:     resume.Profession,
+			DesiredSalary:  resume.DesiredSalary,
+			SalaryCurrency: resume.SalaryCurrency,
+			WorkFormats:    resume.WorkFormats,
 			CreatedAt:      resume.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:      resume.UpdatedAt.Format(time.RFC3339),
 		}
@@ -979,7 +1458,7 @@ func (s *ResumeService) GetAllResumesByApplicantID(ctx context.Context, applican
 }
 
 // SearchResumesByProfession ищет резюме по профессии с учетом роли пользователя
-func (s *ResumeService) SearchResumesByProfession(ctx context.Context, userID int, role string, profession string, limit int, offset int) ([]dto.ResumeShortResponse, error) {
+func (s *ResumeService) SearchResumesByProfession(ctx context.Context, userID int, role string, profession string, filter entity.ResumeFilter, limit int, offset int) ([]dto.ResumeShortResponse, error) {
 	requestID := utils.GetRequestID(ctx)
 
 	l.Log.WithFields(logrus.Fields{
@@ -997,15 +1476,42 @@ func (s *ResumeService) SearchResumesByProfession(ctx context.Context, userID in
 		// Для соискателя ищем только его резюме
 		resumes, err = s.resumeRepository.SearchResumesByProfessionForApplicant(ctx, userID, profession, limit, offset)
 	} else {
-		// Для работодателя ищем все резюме
-		resumes, err = s.resumeRepository.SearchResumesByProfession(ctx, profession, limit, offset)
+		// Для работодателя ищем среди резюме, видимых в поиске, с учетом фильтров
+		resumes, err = s.resumeRepository.SearchResumesByProfession(ctx, userID, profession, filter, limit, offset)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	// Формируем ответ, аналогично методам GetAll и GetAllResumesByApplicantID
+	return s.searchResultsToShort(ctx, resumes, userID, role)
+}
+
+// SearchResumesAdvanced выполняет расширенный поиск резюме работодателем
+func (s *ResumeService) SearchResumesAdvanced(ctx context.Context, employerID int, params entity.ResumeSearchParams, limit int, offset int) ([]dto.ResumeShortResponse, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":  utils.GetRequestID(ctx),
+		"employerID": employerID,
+		"params":     params,
+	}).Info("Расширенный поиск резюме")
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	resumes, err := s.resumeRepository.SearchResumesAdvanced(ctx, employerID, params, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.searchResultsToShort(ctx, resumes, employerID, string(entity.EmployerRole))
+}
+
+// searchResultsToShort собирает краткие карточки найденных резюме с учетом скрытия контактов.
+// Резюме, для которых не удалось получить связанные данные, пропускаются
+func (s *ResumeService) searchResultsToShort(ctx context.Context, resumes []entity.Resume, userID int, role string) ([]dto.ResumeShortResponse, error) {
+	requestID := utils.GetRequestID(ctx)
+
 	response := make([]dto.ResumeShortResponse, 0, len(resumes))
 	for _, resume := range resumes {
 		// Получаем имя специализации
@@ -1047,12 +1553,24 @@ func (s *ResumeService) SearchResumesByProfession(ctx context.Context, userID in
 			continue
 		}
 
+		contactsHidden, err := s.contactsHidden(ctx, &resume, userID, role)
+		if err != nil {
+			return nil, err
+		}
+		if contactsHidden {
+			applicantDTO = maskApplicant(applicantDTO)
+		}
+
 		// Создаем краткий ответ о резюме
 		shortResume := dto.ResumeShortResponse{
 			ID:             resume.ID,
 			Applicant:      applicantDTO,
+			ContactsHidden: contactsHidden,
 			Specialization: specializationName,
 			Profession:     resume.Profession,
+			DesiredSalary:  resume.DesiredSalary,
+			SalaryCurrency: resume.SalaryCurrency,
+			WorkFormats:    resume.WorkFormats,
 			CreatedAt:      resume.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:      resume.UpdatedAt.Format(time.RFC3339),
 		}
@@ -1083,47 +1601,56 @@ func (s *ResumeService) SearchResumesByProfession(ctx context.Context, userID in
 	return response, nil
 }
 
-func (s *ResumeService) GetResumePDF(ctx context.Context, resumeID, userID int, role string) ([]byte, entity.Notification, error) {
-	resume, err := s.GetByID(ctx, resumeID)
-	notification := entity.Notification{}
+func (s *ResumeService) GetResumePDF(ctx context.Context, resumeID, userID int, role string, accessToken string) ([]byte, error) {
+	resume, storedResume, err := s.getResume(ctx, resumeID, userID, role, accessToken)
 	if err != nil {
-		return nil, notification, err
+		return nil, err
 	}
 
-	applicant, err := s.applicantService.GetUser(ctx, resume.ApplicantID)
+	applicant, err := s.applicantService.GetUser(ctx, storedResume.ApplicantID)
 	if err != nil {
-		return nil, notification, err
+		return nil, err
+	}
+	if resume.ContactsHidden {
+		applicant = maskApplicant(applicant)
 	}
 
 	templateData, err := s.prepareResumeTemplateData(applicant, resume)
 	if err != nil {
-		return nil, notification, err
+		return nil, err
 	}
 
 	htmlContent, err := s.renderTemplate(templateData)
 	if err != nil {
-		return nil, notification, entity.NewError(entity.ErrInternal, err)
+		return nil, entity.NewError(entity.ErrInternal, err)
 	}
 
 	pdfBytes, err := utils.GeneratePDF(htmlContent, s.cfg)
 	if err != nil {
-		return nil, notification, entity.NewError(entity.ErrInternal, err)
+		return nil, entity.NewError(entity.ErrInternal, err)
 	}
 
+	// Скачивание владельцем не событие: уведомлять его о собственном резюме незачем
 	senderType := entity.AllowedUserRoles[role]
-
 	if senderType != entity.ApplicantRole {
-		notification = entity.Notification{
-			Type:         entity.DownloadResumeType,
-			SenderID:     userID,
-			SenderRole:   senderType,
-			ReceiverID:   resume.ApplicantID,
-			ReceiverRole: entity.ApplicantRole,
-			ObjectID:     resume.ID,
-			ResumeID:     resume.ID,
+		event := &entity.OutboxEvent{
+			Type: entity.ResumeDownloadedEvent,
+			Notification: &entity.Notification{
+				Type:         entity.DownloadResumeType,
+				SenderID:     userID,
+				SenderRole:   senderType,
+				ReceiverID:   storedResume.ApplicantID,
+				ReceiverRole: entity.ApplicantRole,
+				ObjectID:     resume.ID,
+				ResumeID:     resume.ID,
+				Payload:      entity.NotificationPayload{"profession": resume.Profession},
+			},
+		}
+		if err := s.outboxRepository.AddEvent(ctx, event); err != nil {
+			return nil, err
 		}
 	}
-	return pdfBytes, notification, nil
+	return pdfBytes, nil
 }
 
 func (s *ResumeService) renderTemplate(data *ResumeTemplateData) (string, error) {
@@ -1134,3 +1661,205 @@ func (s *ResumeService) renderTemplate(data *ResumeTemplateData) (string, error)
 
 	return buf.String(), nil
 }
+
+// UpdateVisibility изменяет видимость резюме. Для режима "только по ссылке" выдается токен доступа
+func (s *ResumeService) UpdateVisibility(ctx context.Context, resumeID, applicantID int, request *dto.ResumeVisibilityRequest) (*dto.ResumeVisibilityResponse, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   requestID,
+		"resumeID":    resumeID,
+		"applicantID": applicantID,
+		"visibility":  request.Visibility,
+	}).Info("Изменение видимости резюме")
+
+	visibility, ok := entity.AllowedResumeVisibilities[string(request.Visibility)]
+	if !ok {
+		return nil, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("неизвестная видимость резюме: %s", request.Visibility),
+		)
+	}
+
+	resume, err := s.resumeRepository.GetByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resume.ApplicantID != applicantID {
+		return nil, entity.NewError(
+			entity.ErrForbidden,
+			fmt.Errorf("резюме с id=%d не принадлежит соискателю с id=%d", resumeID, applicantID),
+		)
+	}
+
+	// Токен сохраняется при смене режима, чтобы ранее выданная ссылка продолжала работать
+	accessToken := resume.AccessToken
+	if visibility == entity.ResumeVisibilityLinkOnly && (accessToken == "" || request.RegenerateToken) {
+		accessToken = uuid.NewString()
+	}
+
+	if err := s.resumeRepository.UpdateVisibility(ctx, resumeID, visibility, accessToken, request.IsAnonymous); err != nil {
+		return nil, err
+	}
+
+	return &dto.ResumeVisibilityResponse{
+		ID:          resumeID,
+		Visibility:  visibility,
+		AccessToken: accessToken,
+		IsAnonymous: request.IsAnonymous,
+	}, nil
+}
+
+func (s *ResumeService) GetBlockedEmployers(ctx context.Context, applicantID int) (dto.BlockedEmployerResponseList, error) {
+	blocked, err := s.resumeRepository.GetBlockedEmployers(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(dto.BlockedEmployerResponseList, 0, len(blocked))
+	for _, employer := range blocked {
+		response = append(response, dto.BlockedEmployerResponse{
+			EmployerID:  employer.EmployerID,
+			CompanyName: employer.CompanyName,
+			BlockedAt:   employer.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return response, nil
+}
+
+func (s *ResumeService) BlockEmployer(ctx context.Context, applicantID, employerID int) error {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   utils.GetRequestID(ctx),
+		"applicantID": applicantID,
+		"employerID":  employerID,
+	}).Info("Блокировка работодателя соискателем")
+
+	return s.resumeRepository.BlockEmployer(ctx, applicantID, employerID)
+}
+
+func (s *ResumeService) UnblockEmployer(ctx context.Context, applicantID, employerID int) error {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":   utils.GetRequestID(ctx),
+		"applicantID": applicantID,
+		"employerID":  employerID,
+	}).Info("Разблокировка работодателя соискателем")
+
+	return s.resumeRepository.UnblockEmployer(ctx, applicantID, employerID)
+}
+
+// RequestContacts отправляет соискателю запрос работодателя на раскрытие контактов анонимного резюме.
+// Уведомление о запросе создается по событию outbox
+func (s *ResumeService) RequestContacts(ctx context.Context, resumeID, employerID int) (*dto.ContactRequestResponse, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":  utils.GetRequestID(ctx),
+		"resumeID":   resumeID,
+		"employerID": employerID,
+	}).Info("Запрос контактов соискателя")
+
+	resume, err := s.resumeRepository.GetByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	role := string(entity.EmployerRole)
+	if err := s.checkResumeAccess(ctx, resume, employerID, role, ""); err != nil {
+		return nil, err
+	}
+
+	contactsHidden, err := s.contactsHidden(ctx, resume, employerID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !contactsHidden {
+		return nil, entity.NewError(
+			entity.ErrBadRequest,
+			fmt.Errorf("контакты соискателя уже доступны"),
+		)
+	}
+
+	request, err := s.resumeRepository.CreateContactRequest(ctx, &entity.ContactRequest{
+		EmployerID:  employerID,
+		ApplicantID: resume.ApplicantID,
+		ResumeID:    resume.ID,
+	}, &entity.OutboxEvent{
+		Type: entity.ContactRequestedEvent,
+		Notification: &entity.Notification{
+			Type:         entity.ContactRequestType,
+			SenderID:     employerID,
+			SenderRole:   entity.EmployerRole,
+			ReceiverID:   resume.ApplicantID,
+			ReceiverRole: entity.ApplicantRole,
+			ResumeID:     resume.ID,
+			Payload:      entity.NotificationPayload{"profession": resume.Profession},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequestToDTO(request), nil
+}
+
+// AnswerContactRequest принимает или отклоняет запрос контактов. Отвечать может только соискатель, которому он адресован
+func (s *ResumeService) AnswerContactRequest(ctx context.Context, contactRequestID, applicantID int, accept bool) (*dto.ContactRequestResponse, error) {
+	l.Log.WithFields(logrus.Fields{
+		"requestID":        utils.GetRequestID(ctx),
+		"contactRequestID": contactRequestID,
+		"applicantID":      applicantID,
+		"accept":           accept,
+	}).Info("Ответ на запрос контактов")
+
+	request, err := s.resumeRepository.GetContactRequestByID(ctx, contactRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.ApplicantID != applicantID {
+		return nil, entity.NewError(
+			entity.ErrForbidden,
+			fmt.Errorf("запрос контактов с id=%d адресован другому соискателю", contactRequestID),
+		)
+	}
+
+	status := entity.ContactRequestDeclined
+	if accept {
+		status = entity.ContactRequestAccepted
+	}
+
+	updated, err := s.resumeRepository.UpdateContactRequestStatus(ctx, contactRequestID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequestToDTO(updated), nil
+}
+
+// UploadCourseFile загружает скан сертификата в статику, привязка к курсу происходит при сохранении резюме
+func (s *ResumeService) UploadCourseFile(ctx context.Context, data []byte) (*dto.UploadStaticResponse, error) {
+	requestID := utils.GetRequestID(ctx)
+
+	l.Log.WithFields(logrus.Fields{
+		"requestID": requestID,
+	}).Info("Загрузка файла курса")
+
+	return s.staticGateway.UploadStatic(ctx, data)
+}
+
+// contactRequestToDTO не раскрывает id соискателя, пока он не принял запрос: по id работодатель
+// получил бы публичный профиль с именем и контактами в обход анонимного режима
+func contactRequestToDTO(request *entity.ContactRequest) *dto.ContactRequestResponse {
+	response := &dto.ContactRequestResponse{
+		ID:         request.ID,
+		EmployerID: request.EmployerID,
+		ResumeID:   request.ResumeID,
+		Status:     request.Status,
+		CreatedAt:  request.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  request.UpdatedAt.Format(time.RFC3339),
+	}
+	if request.Status == entity.ContactRequestAccepted {
+		response.ApplicantID = request.ApplicantID
+	}
+	return response
+}
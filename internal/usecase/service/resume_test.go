@@ -74,6 +74,10 @@ func TestResumeService_Create(t *testing.T) {
 						Education:              entity.Higher,
 						EducationalInstitution: "МГУ",
 						GraduationYear:         gradYear,
+						SalaryCurrency:         entity.CurrencyRUB,
+						Educations: []entity.ResumeEducation{
+							{Level: entity.Higher, Institution: "МГУ", GraduationYear: 2020},
+						},
 					}).
 					Return(&entity.Resume{
 						ID:                     1,
@@ -104,6 +108,12 @@ func TestResumeService_Create(t *testing.T) {
 					AddSpecializations(gomock.Any(), 1, []int{2}).
 					Return(nil)
 
+				rr.EXPECT().
+					AddEducations(gomock.Any(), 1, []entity.ResumeEducation{
+						{Level: entity.Higher, Institution: "МГУ", GraduationYear: 2020},
+					}).
+					Return(nil)
+
 				rr.EXPECT().
 					AddWorkExperience(gomock.Any(), &entity.WorkExperience{
 						ResumeID:     1,
@@ -142,6 +152,22 @@ func TestResumeService_Create(t *testing.T) {
 					Return([]entity.Specialization{
 						{ID: 2, Name: "DevOps"},
 					}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 1).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeLanguage{}, nil)
 			},
 			expectedResult: &dto.ResumeResponse{
 				ID:                        1,
@@ -154,6 +180,10 @@ func TestResumeService_Create(t *testing.T) {
 				GraduationYear:            gradYearStr,
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{"Go", "SQL"},
 				AdditionalSpecializations: []string{"DevOps"},
 				WorkExperiences: []dto.WorkExperienceResponse{
@@ -180,8 +210,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          2,
@@ -198,6 +229,22 @@ func TestResumeService_Create(t *testing.T) {
 				rr.EXPECT().
 					GetSpecializationsByResumeID(gomock.Any(), 2).
 					Return([]entity.Specialization{}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 2).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeLanguage{}, nil)
 			},
 			expectedResult: &dto.ResumeResponse{
 				ID: 2,
@@ -206,12 +253,287 @@ func TestResumeService_Create(t *testing.T) {
 				Profession:                "Developer",
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
+				Skills:                    []string{},
+				AdditionalSpecializations: []string{},
+				WorkExperiences:           []dto.WorkExperienceResponse{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "Успешное создание резюме с пожеланиями к работе",
+			applicantID: 1,
+			request: &dto.CreateResumeRequest{
+				Profession:            "Developer",
+				DesiredSalary:         3000,
+				SalaryCurrency:        entity.CurrencyUSD,
+				Cities:                []string{"Москва", "Казань"},
+				ReadyToRelocate:       true,
+				ReadyForBusinessTrips: true,
+				WorkFormats:           []string{"remote", "hybrid"},
+				EmploymentTypes:       []string{"full_time"},
+				Schedules:             []string{"5/2"},
+			},
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				resume := &entity.Resume{
+					ApplicantID:           1,
+					Profession:            "Developer",
+					DesiredSalary:         3000,
+					SalaryCurrency:        entity.CurrencyUSD,
+					ReadyToRelocate:       true,
+					ReadyForBusinessTrips: true,
+					WorkFormats:           []string{"remote", "hybrid"},
+					EmploymentTypes:       []string{"full_time"},
+					Schedules:             []string{"5/2"},
+				}
+				rr.EXPECT().
+					Create(gomock.Any(), resume).
+					DoAndReturn(func(_ context.Context, r *entity.Resume) (*entity.Resume, error) {
+						created := *r
+						created.ID = 4
+						created.CreatedAt = now
+						created.UpdatedAt = now
+						return &created, nil
+					})
+
+				rr.EXPECT().
+					FindCityIDsByNames(gomock.Any(), []string{"Москва", "Казань"}).
+					Return([]int{1, 5}, nil)
+
+				rr.EXPECT().
+					AddCities(gomock.Any(), 4, []int{1, 5}).
+					Return(nil)
+
+				rr.EXPECT().
+					GetSkillsByResumeID(gomock.Any(), 4).
+					Return([]entity.Skill{}, nil)
+
+				rr.EXPECT().
+					GetSpecializationsByResumeID(gomock.Any(), 4).
+					Return([]entity.Specialization{}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 4).
+					Return([]entity.City{
+						{ID: 5, Name: "Казань"},
+						{ID: 1, Name: "Москва"},
+					}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 4).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 4).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 4).
+					Return([]entity.ResumeLanguage{}, nil)
+			},
+			expectedResult: &dto.ResumeResponse{
+				ID:                        4,
+				ApplicantID:               1,
+				Profession:                "Developer",
+				CreatedAt:                 now.Format(time.RFC3339),
+				UpdatedAt:                 now.Format(time.RFC3339),
+				DesiredSalary:             3000,
+				SalaryCurrency:            entity.CurrencyUSD,
+				Cities:                    []string{"Казань", "Москва"},
+				ReadyToRelocate:           true,
+				ReadyForBusinessTrips:     true,
+				WorkFormats:               []string{"remote", "hybrid"},
+				EmploymentTypes:           []string{"full_time"},
+				Schedules:                 []string{"5/2"},
+				Skills:                    []string{},
+				AdditionalSpecializations: []string{},
+				WorkExperiences:           []dto.WorkExperienceResponse{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "Успешное создание резюме с несколькими местами учебы, курсами и языками",
+			applicantID: 1,
+			request: &dto.CreateResumeRequest{
+				Profession: "Developer",
+				Educations: []dto.EducationDTO{
+					{Level: entity.Bachelor, Institution: "МГТУ им. Баумана", Specialty: "Информатика", GraduationYear: 2018},
+					{Level: entity.Master, Institution: "МФТИ", Faculty: "ФПМИ", GraduationYear: 2020},
+				},
+				Courses: []dto.CourseDTO{
+					{Kind: entity.CourseKindCertificate, Name: "AWS Solutions Architect", Organization: "Amazon", Year: 2022, FileID: 7},
+				},
+				Languages: []dto.LanguageDTO{
+					{Language: "Английский", Level: "B2"},
+				},
+			},
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				educations := []entity.ResumeEducation{
+					{Level: entity.Bachelor, Institution: "МГТУ им. Баумана", Specialty: "Информатика", GraduationYear: 2018},
+					{Level: entity.Master, Institution: "МФТИ", Faculty: "ФПМИ", GraduationYear: 2020},
+				}
+				courses := []entity.ResumeCourse{
+					{Kind: entity.CourseKindCertificate, Name: "AWS Solutions Architect", Organization: "Amazon", Year: 2022, FileID: 7},
+				}
+				languages := []entity.ResumeLanguage{
+					{Language: "Английский", Level: "B2"},
+				}
+
+				// Основным становится магистратура как самый высокий уровень
+				rr.EXPECT().
+					Create(gomock.Any(), &entity.Resume{
+						ApplicantID:            1,
+						Profession:             "Developer",
+						Education:              entity.Master,
+						EducationalInstitution: "МФТИ",
+						GraduationYear:         time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+						SalaryCurrency:         entity.CurrencyRUB,
+						Educations:             educations,
+						Courses:                courses,
+						Languages:              languages,
+					}).
+					DoAndReturn(func(_ context.Context, r *entity.Resume) (*entity.Resume, error) {
+						created := *r
+						created.ID = 5
+						created.CreatedAt = now
+						created.UpdatedAt = now
+						return &created, nil
+					})
+
+				rr.EXPECT().
+					AddEducations(gomock.Any(), 5, educations).
+					Return(nil)
+
+				rr.EXPECT().
+					AddCourses(gomock.Any(), 5, courses).
+					Return(nil)
+
+				rr.EXPECT().
+					AddLanguages(gomock.Any(), 5, languages).
+					Return(nil)
+
+				rr.EXPECT().
+					GetSkillsByResumeID(gomock.Any(), 5).
+					Return([]entity.Skill{}, nil)
+
+				rr.EXPECT().
+					GetSpecializationsByResumeID(gomock.Any(), 5).
+					Return([]entity.Specialization{}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 5).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 5).
+					Return([]entity.ResumeEducation{
+						{ID: 2, ResumeID: 5, Level: entity.Master, Institution: "МФТИ", Faculty: "ФПМИ", GraduationYear: 2020},
+						{ID: 1, ResumeID: 5, Level: entity.Bachelor, Institution: "МГТУ им. Баумана", Specialty: "Информатика", GraduationYear: 2018},
+					}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 5).
+					Return([]entity.ResumeCourse{
+						{ID: 1, ResumeID: 5, Kind: entity.CourseKindCertificate, Name: "AWS Solutions Architect", Organization: "Amazon", Year: 2022},
+					}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 5).
+					Return([]entity.ResumeLanguage{
+						{ResumeID: 5, Language: "Английский", Level: "B2"},
+					}, nil)
+			},
+			expectedResult: &dto.ResumeResponse{
+				ID:                        5,
+				ApplicantID:               1,
+				Profession:                "Developer",
+				Education:                 entity.Master,
+				EducationalInstitution:    "МФТИ",
+				GraduationYear:            "2020-01-01",
+				CreatedAt:                 now.Format(time.RFC3339),
+				UpdatedAt:                 now.Format(time.RFC3339),
+				SalaryCurrency:            entity.CurrencyRUB,
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{},
 				AdditionalSpecializations: []string{},
 				WorkExperiences:           []dto.WorkExperienceResponse{},
+				Educations: []dto.EducationResponse{
+					{ID: 2, Level: entity.Master, Institution: "МФТИ", Faculty: "ФПМИ", GraduationYear: 2020},
+					{ID: 1, Level: entity.Bachelor, Institution: "МГТУ им. Баумана", Specialty: "Информатика", GraduationYear: 2018},
+				},
+				Courses: []dto.CourseResponse{
+					{ID: 1, Kind: entity.CourseKindCertificate, Name: "AWS Solutions Architect", Organization: "Amazon", Year: 2022},
+				},
+				Languages: []dto.LanguageResponse{
+					{Language: "Английский", Level: "B2"},
+				},
 			},
 			expectedErr: nil,
 		},
+		{
+			name:        "Язык указан несколько раз",
+			applicantID: 1,
+			request: &dto.CreateResumeRequest{
+				Profession: "Developer",
+				Languages: []dto.LanguageDTO{
+					{Language: "Английский", Level: "B2"},
+					{Language: "английский", Level: "C1"},
+				},
+			},
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("язык английский указан несколько раз"),
+			),
+		},
+		{
+			name:        "Неизвестный город в пожеланиях",
+			applicantID: 1,
+			request: &dto.CreateResumeRequest{
+				Profession: "Developer",
+				Cities:     []string{"Атлантида"},
+			},
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(&entity.Resume{ID: 5, ApplicantID: 1, Profession: "Developer"}, nil)
+
+				rr.EXPECT().
+					FindCityIDsByNames(gomock.Any(), []string{"Атлантида"}).
+					Return(nil, entity.NewError(
+						entity.ErrBadRequest,
+						fmt.Errorf("город Атлантида не найден"),
+					))
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("город Атлантида не найден"),
+			),
+		},
+		{
+			name:        "Некорректный формат работы",
+			applicantID: 1,
+			request: &dto.CreateResumeRequest{
+				Profession:  "Developer",
+				WorkFormats: []string{"moon"},
+			},
+			mockSetup: func(*mock.MockResumeRepository, *mock.MockSkillRepository, *mock.MockSpecializationRepository, *mock.MockApplicantRepository) {
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректный формат работы: moon"),
+			),
+		},
 		{
 			name:        "Ошибка парсинга даты окончания учебы",
 			applicantID: 1,
@@ -257,8 +579,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(nil, entity.NewError(
 						entity.ErrInternal,
@@ -287,8 +610,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{ID: 1, ApplicantID: 1, Profession: "Developer"}, nil)
 			},
@@ -316,8 +640,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{ID: 1, ApplicantID: 1, Profession: "Developer"}, nil)
 			},
@@ -344,8 +669,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{ID: 1, ApplicantID: 1, Profession: "Developer"}, nil)
 
@@ -378,8 +704,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						AboutMe:     "Опытный разработчик",
+						ApplicantID:    1,
+						AboutMe:        "Опытный разработчик",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(nil, entity.NewError(
 						entity.ErrBadRequest,
@@ -409,8 +736,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{ID: 1, ApplicantID: 1, Profession: "Developer"}, nil)
 
@@ -452,8 +780,9 @@ func TestResumeService_Create(t *testing.T) {
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
 				rr.EXPECT().
 					Create(gomock.Any(), &entity.Resume{
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          3,
@@ -494,6 +823,22 @@ func TestResumeService_Create(t *testing.T) {
 				rr.EXPECT().
 					GetSpecializationsByResumeID(gomock.Any(), 3).
 					Return([]entity.Specialization{}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 3).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 3).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 3).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 3).
+					Return([]entity.ResumeLanguage{}, nil)
 			},
 			expectedResult: &dto.ResumeResponse{
 				ID:                        3,
@@ -501,6 +846,10 @@ func TestResumeService_Create(t *testing.T) {
 				Profession:                "Developer",
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{},
 				AdditionalSpecializations: []string{},
 				WorkExperiences: []dto.WorkExperienceResponse{
@@ -533,11 +882,11 @@ func TestResumeService_Create(t *testing.T) {
 			mockSkillRepo := mock.NewMockSkillRepository(ctrl)
 			mockSpecRepo := mock.NewMockSpecializationRepository(ctrl)
 			mockApplicantRepo := mock.NewMockApplicantRepository(ctrl)
-			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil)
+			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil, nil)
 
 			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo)
 			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
 			ctx := context.Background()
 
 			result, err := service.Create(ctx, tc.applicantID, tc.request)
@@ -570,6 +919,9 @@ func TestResumeService_GetByID(t *testing.T) {
 	testCases := []struct {
 		name           string
 		resumeID       int
+		userID         int
+		role           string
+		accessToken    string
 		mockSetup      func(*mock.MockResumeRepository, *mock.MockSkillRepository, *mock.MockSpecializationRepository, *mock.MockApplicantRepository)
 		expectedResult *dto.ResumeResponse
 		expectedErr    error
@@ -610,6 +962,22 @@ func TestResumeService_GetByID(t *testing.T) {
 						{ID: 2, Name: "DevOps"},
 					}, nil)
 
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 1).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeLanguage{}, nil)
+
 				rr.EXPECT().
 					GetWorkExperienceByResumeID(gomock.Any(), 1).
 					Return([]entity.WorkExperience{
@@ -649,6 +1017,11 @@ func TestResumeService_GetByID(t *testing.T) {
 				GraduationYear:            gradYearStr,
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Visibility:                entity.ResumeVisibilityPublic,
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{"Go", "SQL"},
 				AdditionalSpecializations: []string{"DevOps"},
 				WorkExperiences: []dto.WorkExperienceResponse{
@@ -699,6 +1072,22 @@ func TestResumeService_GetByID(t *testing.T) {
 					GetSpecializationsByResumeID(gomock.Any(), 2).
 					Return([]entity.Specialization{}, nil)
 
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 2).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeLanguage{}, nil)
+
 				rr.EXPECT().
 					GetWorkExperienceByResumeID(gomock.Any(), 2).
 					Return([]entity.WorkExperience{}, nil)
@@ -709,6 +1098,11 @@ func TestResumeService_GetByID(t *testing.T) {
 				Profession:                "Developer",
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Visibility:                entity.ResumeVisibilityPublic,
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{},
 				AdditionalSpecializations: []string{},
 				WorkExperiences:           []dto.WorkExperienceResponse{},
@@ -840,6 +1234,10 @@ func TestResumeService_GetByID(t *testing.T) {
 					GetSpecializationsByResumeID(gomock.Any(), 1).
 					Return([]entity.Specialization{}, nil)
 
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 1).
+					Return([]entity.City{}, nil)
+
 				rr.EXPECT().
 					GetWorkExperienceByResumeID(gomock.Any(), 1).
 					Return(nil, entity.NewError(
@@ -853,64 +1251,257 @@ func TestResumeService_GetByID(t *testing.T) {
 				fmt.Errorf("ошибка при получении опыта работы"),
 			),
 		},
-	}
-
-	for _, tc := range testCases {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
-			mockSkillRepo := mock.NewMockSkillRepository(ctrl)
-			mockSpecRepo := mock.NewMockSpecializationRepository(ctrl)
-			mockApplicantRepo := mock.NewMockApplicantRepository(ctrl)
-			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil)
-
-			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo)
-
-			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
-			ctx := context.Background()
-
-			result, err := service.GetByID(ctx, tc.resumeID)
-
-			if tc.expectedErr != nil {
-				require.Error(t, err)
-				var serviceErr entity.Error
-				require.ErrorAs(t, err, &serviceErr)
-				require.Equal(t, tc.expectedErr.Error(), err.Error())
-				require.Nil(t, result)
-			} else {
-				require.NoError(t, err)
-				require.Equal(t, tc.expectedResult, result)
-			}
-		})
-	}
-}
-
-func TestResumeService_Update(t *testing.T) {
-	t.Parallel()
-
-	now := time.Now()
-	gradYear := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
-	gradYearStr := gradYear.Format("2006-01-02")
-	startDate := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
-	startDateStr := startDate.Format("2006-01-02")
-	endDate := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
-	endDateStr := endDate.Format("2006-01-02")
+		{
+			name:     "Скрытое резюме недоступно работодателю",
+			resumeID: 3,
+			userID:   5,
+			role:     "employer",
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{ID: 3, ApplicantID: 1, Visibility: entity.ResumeVisibilityHidden}, nil)
 
-	testCases := []struct {
-		name           string
-		resumeID       int
-		applicantID    int
-		request        *dto.UpdateResumeRequest
-		mockSetup      func(*mock.MockResumeRepository, *mock.MockSkillRepository, *mock.MockSpecializationRepository, *mock.MockApplicantRepository)
-		expectedResult *dto.ResumeResponse
-		expectedErr    error
-	}{
+				rr.EXPECT().
+					IsEmployerBlocked(gomock.Any(), 1, 5).
+					Return(false, nil)
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("резюме с id=%d не найдено", 3),
+			),
+		},
+		{
+			name:     "Заблокированный работодатель не видит публичное резюме",
+			resumeID: 3,
+			userID:   5,
+			role:     "employer",
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{ID: 3, ApplicantID: 1, Visibility: entity.ResumeVisibilityPublic}, nil)
+
+				rr.EXPECT().
+					IsEmployerBlocked(gomock.Any(), 1, 5).
+					Return(true, nil)
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("резюме с id=%d не найдено", 3),
+			),
+		},
+		{
+			name:     "Резюме только для работодателей недоступно анонимно",
+			resumeID: 3,
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{ID: 3, ApplicantID: 1, Visibility: entity.ResumeVisibilityEmployersOnly}, nil)
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrForbidden,
+				fmt.Errorf("нет доступа к резюме с id=%d", 3),
+			),
+		},
+		{
+			name:        "Резюме по ссылке с неверным токеном",
+			resumeID:    3,
+			accessToken: "wrong",
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{ID: 3, ApplicantID: 1, Visibility: entity.ResumeVisibilityLinkOnly, AccessToken: "secret"}, nil)
+			},
+			expectedResult: nil,
+			expectedErr: entity.NewError(
+				entity.ErrForbidden,
+				fmt.Errorf("нет доступа к резюме с id=%d", 3),
+			),
+		},
+		{
+			name:        "Резюме по ссылке с верным токеном",
+			resumeID:    3,
+			accessToken: "secret",
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{
+						ID:          3,
+						ApplicantID: 1,
+						Profession:  "Developer",
+						CreatedAt:   now,
+						UpdatedAt:   now,
+						Visibility:  entity.ResumeVisibilityLinkOnly,
+						AccessToken: "secret",
+					}, nil)
+				rr.EXPECT().GetSkillsByResumeID(gomock.Any(), 3).Return([]entity.Skill{}, nil)
+				rr.EXPECT().GetSpecializationsByResumeID(gomock.Any(), 3).Return([]entity.Specialization{}, nil)
+				rr.EXPECT().GetCitiesByResumeID(gomock.Any(), 3).Return([]entity.City{}, nil)
+				rr.EXPECT().GetEducationsByResumeID(gomock.Any(), 3).Return([]entity.ResumeEducation{}, nil)
+				rr.EXPECT().GetCoursesByResumeID(gomock.Any(), 3).Return([]entity.ResumeCourse{}, nil)
+				rr.EXPECT().GetLanguagesByResumeID(gomock.Any(), 3).Return([]entity.ResumeLanguage{}, nil)
+				rr.EXPECT().GetWorkExperienceByResumeID(gomock.Any(), 3).Return([]entity.WorkExperience{}, nil)
+			},
+			expectedResult: &dto.ResumeResponse{
+				ID:                        3,
+				ApplicantID:               1,
+				Profession:                "Developer",
+				CreatedAt:                 now.Format(time.RFC3339),
+				UpdatedAt:                 now.Format(time.RFC3339),
+				Visibility:                entity.ResumeVisibilityLinkOnly,
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
+				Skills:                    []string{},
+				AdditionalSpecializations: []string{},
+				WorkExperiences:           []dto.WorkExperienceResponse{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "Работодатель с откликом видит резюме по ссылке без токена",
+			resumeID: 3,
+			userID:   5,
+			role:     "employer",
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{
+						ID:          3,
+						ApplicantID: 1,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+						Visibility:  entity.ResumeVisibilityLinkOnly,
+						AccessToken: "secret",
+					}, nil)
+				rr.EXPECT().IsEmployerBlocked(gomock.Any(), 1, 5).Return(false, nil)
+				rr.EXPECT().ResumeSentToEmployer(gomock.Any(), 3, 5).Return(true, nil)
+				rr.EXPECT().GetSkillsByResumeID(gomock.Any(), 3).Return([]entity.Skill{}, nil)
+				rr.EXPECT().GetSpecializationsByResumeID(gomock.Any(), 3).Return([]entity.Specialization{}, nil)
+				rr.EXPECT().GetCitiesByResumeID(gomock.Any(), 3).Return([]entity.City{}, nil)
+				rr.EXPECT().GetEducationsByResumeID(gomock.Any(), 3).Return([]entity.ResumeEducation{}, nil)
+				rr.EXPECT().GetCoursesByResumeID(gomock.Any(), 3).Return([]entity.ResumeCourse{}, nil)
+				rr.EXPECT().GetLanguagesByResumeID(gomock.Any(), 3).Return([]entity.ResumeLanguage{}, nil)
+				rr.EXPECT().GetWorkExperienceByResumeID(gomock.Any(), 3).Return([]entity.WorkExperience{}, nil)
+			},
+			expectedResult: &dto.ResumeResponse{
+				ID:                        3,
+				ApplicantID:               1,
+				CreatedAt:                 now.Format(time.RFC3339),
+				UpdatedAt:                 now.Format(time.RFC3339),
+				Visibility:                entity.ResumeVisibilityLinkOnly,
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
+				Skills:                    []string{},
+				AdditionalSpecializations: []string{},
+				WorkExperiences:           []dto.WorkExperienceResponse{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "Владелец видит скрытое резюме и токен доступа",
+			resumeID: 3,
+			userID:   1,
+			role:     "applicant",
+			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 3).
+					Return(&entity.Resume{
+						ID:          3,
+						ApplicantID: 1,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+						Visibility:  entity.ResumeVisibilityHidden,
+						AccessToken: "secret",
+					}, nil)
+				rr.EXPECT().GetSkillsByResumeID(gomock.Any(), 3).Return([]entity.Skill{}, nil)
+				rr.EXPECT().GetSpecializationsByResumeID(gomock.Any(), 3).Return([]entity.Specialization{}, nil)
+				rr.EXPECT().GetCitiesByResumeID(gomock.Any(), 3).Return([]entity.City{}, nil)
+				rr.EXPECT().GetEducationsByResumeID(gomock.Any(), 3).Return([]entity.ResumeEducation{}, nil)
+				rr.EXPECT().GetCoursesByResumeID(gomock.Any(), 3).Return([]entity.ResumeCourse{}, nil)
+				rr.EXPECT().GetLanguagesByResumeID(gomock.Any(), 3).Return([]entity.ResumeLanguage{}, nil)
+				rr.EXPECT().GetWorkExperienceByResumeID(gomock.Any(), 3).Return([]entity.WorkExperience{}, nil)
+			},
+			expectedResult: &dto.ResumeResponse{
+				ID:                        3,
+				ApplicantID:               1,
+				CreatedAt:                 now.Format(time.RFC3339),
+				UpdatedAt:                 now.Format(time.RFC3339),
+				Visibility:                entity.ResumeVisibilityHidden,
+				AccessToken:               "secret",
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
+				Skills:                    []string{},
+				AdditionalSpecializations: []string{},
+				WorkExperiences:           []dto.WorkExperienceResponse{},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			mockSkillRepo := mock.NewMockSkillRepository(ctrl)
+			mockSpecRepo := mock.NewMockSpecializationRepository(ctrl)
+			mockApplicantRepo := mock.NewMockApplicantRepository(ctrl)
+			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil, nil)
+
+			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo)
+
+			var cfg = config.ResumeConfig{}
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
+			ctx := context.Background()
+
+			result, err := service.GetByID(ctx, tc.resumeID, tc.userID, tc.role, tc.accessToken)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestResumeService_Update(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	gradYear := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	gradYearStr := gradYear.Format("2006-01-02")
+	startDate := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	startDateStr := startDate.Format("2006-01-02")
+	endDate := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endDateStr := endDate.Format("2006-01-02")
+
+	testCases := []struct {
+		name           string
+		resumeID       int
+		applicantID    int
+		request        *dto.UpdateResumeRequest
+		mockSetup      func(*mock.MockResumeRepository, *mock.MockSkillRepository, *mock.MockSpecializationRepository, *mock.MockApplicantRepository)
+		expectedResult *dto.ResumeResponse
+		expectedErr    error
+	}{
 		{
 			name:        "Успешное обновление резюме со всеми полями",
 			resumeID:    1,
@@ -967,6 +1558,10 @@ func TestResumeService_Update(t *testing.T) {
 						Education:              entity.Higher,
 						EducationalInstitution: "МГУ",
 						GraduationYear:         gradYear,
+						SalaryCurrency:         entity.CurrencyRUB,
+						Educations: []entity.ResumeEducation{
+							{ResumeID: 1, Level: entity.Higher, Institution: "МГУ", GraduationYear: 2020},
+						},
 					}).
 					Return(&entity.Resume{
 						ID:                     1,
@@ -1005,6 +1600,28 @@ func TestResumeService_Update(t *testing.T) {
 					AddSpecializations(gomock.Any(), 1, []int{2}).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					AddEducations(gomock.Any(), 1, []entity.ResumeEducation{
+						{ResumeID: 1, Level: entity.Higher, Institution: "МГУ", GraduationYear: 2020},
+					}).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -1071,6 +1688,22 @@ func TestResumeService_Update(t *testing.T) {
 					Return([]entity.Specialization{
 						{ID: 2, Name: "DevOps"},
 					}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 1).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 1).
+					Return([]entity.ResumeLanguage{}, nil)
 			},
 			expectedResult: &dto.ResumeResponse{
 				ID:                        1,
@@ -1083,6 +1716,10 @@ func TestResumeService_Update(t *testing.T) {
 				GraduationYear:            gradYearStr,
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{"Go", "SQL"},
 				AdditionalSpecializations: []string{"DevOps"},
 				WorkExperiences: []dto.WorkExperienceResponse{
@@ -1129,9 +1766,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          2,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             2,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          2,
@@ -1149,6 +1787,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 2).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 2).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 2).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 2).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 2).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 2).
 					Return(nil)
@@ -1160,6 +1814,22 @@ func TestResumeService_Update(t *testing.T) {
 				rr.EXPECT().
 					GetSpecializationsByResumeID(gomock.Any(), 2).
 					Return([]entity.Specialization{}, nil)
+
+				rr.EXPECT().
+					GetCitiesByResumeID(gomock.Any(), 2).
+					Return([]entity.City{}, nil)
+
+				rr.EXPECT().
+					GetEducationsByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeEducation{}, nil)
+
+				rr.EXPECT().
+					GetCoursesByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeCourse{}, nil)
+
+				rr.EXPECT().
+					GetLanguagesByResumeID(gomock.Any(), 2).
+					Return([]entity.ResumeLanguage{}, nil)
 			},
 			expectedResult: &dto.ResumeResponse{
 				ID:                        2,
@@ -1167,6 +1837,10 @@ func TestResumeService_Update(t *testing.T) {
 				Profession:                "Developer",
 				CreatedAt:                 now.Format(time.RFC3339),
 				UpdatedAt:                 now.Format(time.RFC3339),
+				Cities:                    []string{},
+				WorkFormats:               []string{},
+				EmploymentTypes:           []string{},
+				Schedules:                 []string{},
 				Skills:                    []string{},
 				AdditionalSpecializations: []string{},
 				WorkExperiences:           []dto.WorkExperienceResponse{},
@@ -1258,9 +1932,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						AboutMe:     "Опытный разработчик",
+						ID:             1,
+						ApplicantID:    1,
+						AboutMe:        "Опытный разработчик",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(nil, entity.NewError(
 						entity.ErrBadRequest,
@@ -1291,9 +1966,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(nil, entity.NewError(
 						entity.ErrInternal,
@@ -1324,9 +2000,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1368,9 +2045,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1416,9 +2094,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1467,9 +2146,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1515,9 +2195,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1567,9 +2248,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1622,9 +2304,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1642,6 +2325,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(entity.NewError(
@@ -1680,9 +2379,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1700,6 +2400,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -1737,9 +2453,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1758,10 +2475,26 @@ func TestResumeService_Update(t *testing.T) {
 					Return(nil)
 
 				rr.EXPECT().
-					DeleteWorkExperiences(gomock.Any(), 1).
+					DeleteCities(gomock.Any(), 1).
 					Return(nil)
-			},
-			expectedResult: nil,
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteWorkExperiences(gomock.Any(), 1).
+					Return(nil)
+			},
+			expectedResult: nil,
 			expectedErr: entity.NewError(
 				entity.ErrBadRequest,
 				fmt.Errorf("неверный формат даты окончания работы: %w", fmt.Errorf("parsing time \"invalid-date\" as \"2006-01-02\": cannot parse \"invalid-date\" as \"2006\"")),
@@ -1793,9 +2526,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1813,6 +2547,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -1861,9 +2611,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -1881,6 +2632,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -1931,6 +2698,7 @@ func TestResumeService_Update(t *testing.T) {
 						ApplicantID:      1,
 						SpecializationID: 1,
 						Profession:       "Developer",
+						SalaryCurrency:   entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:               1,
@@ -1949,6 +2717,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -1984,9 +2768,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -2004,6 +2789,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -2039,9 +2840,10 @@ func TestResumeService_Update(t *testing.T) {
 
 				rr.EXPECT().
 					Update(gomock.Any(), &entity.Resume{
-						ID:          1,
-						ApplicantID: 1,
-						Profession:  "Developer",
+						ID:             1,
+						ApplicantID:    1,
+						Profession:     "Developer",
+						SalaryCurrency: entity.CurrencyRUB,
 					}).
 					Return(&entity.Resume{
 						ID:          1,
@@ -2059,6 +2861,22 @@ func TestResumeService_Update(t *testing.T) {
 					DeleteSpecializations(gomock.Any(), 1).
 					Return(nil)
 
+				rr.EXPECT().
+					DeleteCities(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteEducations(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteCourses(gomock.Any(), 1).
+					Return(nil)
+
+				rr.EXPECT().
+					DeleteLanguages(gomock.Any(), 1).
+					Return(nil)
+
 				rr.EXPECT().
 					DeleteWorkExperiences(gomock.Any(), 1).
 					Return(nil)
@@ -2094,12 +2912,12 @@ func TestResumeService_Update(t *testing.T) {
 			mockSkillRepo := mock.NewMockSkillRepository(ctrl)
 			mockSpecRepo := mock.NewMockSpecializationRepository(ctrl)
 			mockApplicantRepo := mock.NewMockApplicantRepository(ctrl)
-			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil)
+			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil, nil)
 
 			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo)
 
 			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
 			ctx := context.Background()
 
 			result, err := service.Update(ctx, tc.resumeID, tc.applicantID, tc.request)
@@ -2336,12 +3154,12 @@ func TestResumeService_Delete(t *testing.T) {
 			mockSkillRepo := mock.NewMockSkillRepository(ctrl)
 			mockSpecRepo := mock.NewMockSpecializationRepository(ctrl)
 			mockApplicantRepo := mock.NewMockApplicantRepository(ctrl)
-			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil)
+			mockApplicantService := NewApplicantService(mockApplicantRepo, nil, nil, nil)
 
 			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo)
 
 			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
 			ctx := context.Background()
 
 			result, err := service.Delete(ctx, tc.resumeID, tc.applicantID)
@@ -2382,7 +3200,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -2475,7 +3293,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return([]entity.Resume{}, nil)
 			},
 			expectedResult: []dto.ResumeShortResponse{},
@@ -2487,7 +3305,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return(nil, entity.NewError(
 						entity.ErrInternal,
 						fmt.Errorf("ошибка при получении списка резюме"),
@@ -2505,7 +3323,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -2562,7 +3380,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -2624,7 +3442,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -2703,7 +3521,7 @@ func TestResumeService_GetAll(t *testing.T) {
 			offset: 0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					GetAll(gomock.Any(), 10, 0).
+					GetAll(gomock.Any(), 1, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -2761,10 +3579,10 @@ func TestResumeService_GetAll(t *testing.T) {
 			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService)
 
 			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
 			ctx := context.Background()
 
-			result, err := service.GetAll(ctx, tc.limit, tc.offset)
+			result, err := service.GetAll(ctx, 1, tc.limit, tc.offset)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -3311,7 +4129,7 @@ func TestResumeService_GetAllResumesByApplicantID(t *testing.T) {
 			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService)
 
 			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
 			ctx := context.Background()
 
 			result, err := service.GetAllResumesByApplicantID(ctx, tc.applicantID, tc.limit, tc.offset)
@@ -3456,7 +4274,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -3567,7 +4385,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return([]entity.Resume{}, nil)
 			},
 			expectedResult: []dto.ResumeShortResponse{},
@@ -3603,7 +4421,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return(nil, entity.NewError(
 						entity.ErrInternal,
 						fmt.Errorf("ошибка при поиске резюме"),
@@ -3684,7 +4502,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -3809,7 +4627,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -3956,7 +4774,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -4086,7 +4904,7 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			offset:     0,
 			mockSetup: func(rr *mock.MockResumeRepository, sr *mock.MockSkillRepository, spr *mock.MockSpecializationRepository, ar *mock.MockApplicantRepository, as *m.MockApplicant) {
 				rr.EXPECT().
-					SearchResumesByProfession(gomock.Any(), "Developer", 10, 0).
+					SearchResumesByProfession(gomock.Any(), 0, "Developer", entity.ResumeFilter{}, 10, 0).
 					Return([]entity.Resume{
 						{
 							ID:               1,
@@ -4144,10 +4962,10 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 			tc.mockSetup(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService)
 
 			var cfg = config.ResumeConfig{}
-			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, cfg)
+			service := NewResumeService(mockResumeRepo, mockSkillRepo, mockSpecRepo, mockApplicantRepo, mockApplicantService, nil, nil, cfg)
 			ctx := context.Background()
 
-			result, err := service.SearchResumesByProfession(ctx, tc.userID, tc.config.role, tc.profession, tc.limit, tc.offset)
+			result, err := service.SearchResumesByProfession(ctx, tc.userID, tc.config.role, tc.profession, entity.ResumeFilter{}, tc.limit, tc.offset)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -4162,3 +4980,488 @@ func TestResumeService_SearchResumesByProfession(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeService_SearchResumesAdvanced(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	params := entity.ResumeSearchParams{
+		Query:          "микросервисы",
+		SkillsAny:      []string{"Go"},
+		ExperienceFrom: 3,
+	}
+
+	testCases := []struct {
+		name           string
+		params         entity.ResumeSearchParams
+		mockSetup      func(*mock.MockResumeRepository, *mock.MockSpecializationRepository, *m.MockApplicant)
+		expectedResult []dto.ResumeShortResponse
+		expectedErr    error
+	}{
+		{
+			name:   "Успешный поиск, контакты анонимного соискателя скрыты",
+			params: params,
+			mockSetup: func(rr *mock.MockResumeRepository, spr *mock.MockSpecializationRepository, as *m.MockApplicant) {
+				rr.EXPECT().
+					SearchResumesAdvanced(gomock.Any(), 7, params, 10, 0).
+					Return([]entity.Resume{
+						{
+							ID:               1,
+							ApplicantID:      1,
+							SpecializationID: 1,
+							Profession:       "Go Developer",
+							IsAnonymous:      true,
+							CreatedAt:        now,
+							UpdatedAt:        now,
+						},
+					}, nil)
+
+				spr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Specialization{ID: 1, Name: "Backend разработка"}, nil)
+
+				rr.EXPECT().
+					GetWorkExperienceByResumeID(gomock.Any(), 1).
+					Return([]entity.WorkExperience{}, nil)
+
+				as.EXPECT().
+					GetUser(gomock.Any(), 1).
+					Return(&dto.ApplicantProfileResponse{ID: 1, FirstName: "Иван", LastName: "Иванов", City: "Москва"}, nil)
+
+				rr.EXPECT().
+					ContactsDisclosed(gomock.Any(), 1, 7).
+					Return(false, nil)
+			},
+			expectedResult: []dto.ResumeShortResponse{
+				{
+					ID:             1,
+					Applicant:      &dto.ApplicantProfileResponse{City: "Москва"},
+					ContactsHidden: true,
+					Specialization: "Backend разработка",
+					Profession:     "Go Developer",
+					CreatedAt:      now.Format(time.RFC3339),
+					UpdatedAt:      now.Format(time.RFC3339),
+				},
+			},
+		},
+		{
+			name:   "Некорректный диапазон опыта",
+			params: entity.ResumeSearchParams{ExperienceFrom: 5, ExperienceTo: 2},
+			mockSetup: func(*mock.MockResumeRepository, *mock.MockSpecializationRepository, *m.MockApplicant) {
+			},
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("некорректный диапазон опыта работы"),
+			),
+		},
+		{
+			name:   "Ошибка репозитория",
+			params: params,
+			mockSetup: func(rr *mock.MockResumeRepository, spr *mock.MockSpecializationRepository, as *m.MockApplicant) {
+				rr.EXPECT().
+					SearchResumesAdvanced(gomock.Any(), 7, params, 10, 0).
+					Return(nil, entity.NewError(
+						entity.ErrInternal,
+						fmt.Errorf("ошибка при расширенном поиске резюме"),
+					))
+			},
+			expectedErr: entity.NewError(
+				entity.ErrInternal,
+				fmt.Errorf("ошибка при расширенном поиске резюме"),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			mockSpecRepo := mock.NewMockSpecializationRepository(ctrl)
+			mockApplicantService := m.NewMockApplicant(ctrl)
+			tc.mockSetup(mockResumeRepo, mockSpecRepo, mockApplicantService)
+
+			service := NewResumeService(mockResumeRepo, nil, mockSpecRepo, nil, mockApplicantService, nil, nil, config.ResumeConfig{})
+			result, err := service.SearchResumesAdvanced(context.Background(), 7, tc.params, 10, 0)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestResumeService_UpdateVisibility(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		applicantID   int
+		request       *dto.ResumeVisibilityRequest
+		mockSetup     func(*mock.MockResumeRepository)
+		checkResponse func(t *testing.T, response *dto.ResumeVisibilityResponse)
+		expectedErr   error
+	}{
+		{
+			name:        "Режим по ссылке выдает новый токен",
+			applicantID: 1,
+			request:     &dto.ResumeVisibilityRequest{Visibility: entity.ResumeVisibilityLinkOnly},
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 1}, nil)
+				rr.EXPECT().
+					UpdateVisibility(gomock.Any(), 1, entity.ResumeVisibilityLinkOnly, gomock.Not(""), false).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, response *dto.ResumeVisibilityResponse) {
+				require.Equal(t, entity.ResumeVisibilityLinkOnly, response.Visibility)
+				require.NotEmpty(t, response.AccessToken)
+			},
+		},
+		{
+			name:        "Существующий токен сохраняется без запроса на перевыпуск",
+			applicantID: 1,
+			request:     &dto.ResumeVisibilityRequest{Visibility: entity.ResumeVisibilityLinkOnly},
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 1, AccessToken: "old"}, nil)
+				rr.EXPECT().
+					UpdateVisibility(gomock.Any(), 1, entity.ResumeVisibilityLinkOnly, "old", false).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, response *dto.ResumeVisibilityResponse) {
+				require.Equal(t, "old", response.AccessToken)
+			},
+		},
+		{
+			name:        "Перевыпуск токена",
+			applicantID: 1,
+			request:     &dto.ResumeVisibilityRequest{Visibility: entity.ResumeVisibilityLinkOnly, RegenerateToken: true},
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 1, AccessToken: "old"}, nil)
+				rr.EXPECT().
+					UpdateVisibility(gomock.Any(), 1, entity.ResumeVisibilityLinkOnly, gomock.Not("old"), false).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, response *dto.ResumeVisibilityResponse) {
+				require.NotEqual(t, "old", response.AccessToken)
+				require.NotEmpty(t, response.AccessToken)
+			},
+		},
+		{
+			name:        "Чужое резюме",
+			applicantID: 2,
+			request:     &dto.ResumeVisibilityRequest{Visibility: entity.ResumeVisibilityHidden},
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 1}, nil)
+			},
+			expectedErr: entity.NewError(
+				entity.ErrForbidden,
+				fmt.Errorf("резюме с id=%d не принадлежит соискателю с id=%d", 1, 2),
+			),
+		},
+		{
+			name:        "Неизвестная видимость",
+			applicantID: 1,
+			request:     &dto.ResumeVisibilityRequest{Visibility: "everyone"},
+			mockSetup:   func(rr *mock.MockResumeRepository) {},
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("неизвестная видимость резюме: %s", "everyone"),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			tc.mockSetup(mockResumeRepo)
+
+			service := NewResumeService(mockResumeRepo, nil, nil, nil, nil, nil, nil, config.ResumeConfig{})
+
+			result, err := service.UpdateVisibility(context.Background(), 1, tc.applicantID, tc.request)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tc.checkResponse(t, result)
+			}
+		})
+	}
+}
+
+func TestResumeService_RequestContacts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		name        string
+		mockSetup   func(*mock.MockResumeRepository)
+		expectedErr error
+	}{
+		{
+			name: "Успешный запрос контактов анонимного соискателя",
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 3, IsAnonymous: true, Profession: "Go-разработчик"}, nil)
+				rr.EXPECT().IsEmployerBlocked(gomock.Any(), 3, 2).Return(false, nil)
+				rr.EXPECT().ContactsDisclosed(gomock.Any(), 3, 2).Return(false, nil)
+				rr.EXPECT().
+					CreateContactRequest(gomock.Any(), &entity.ContactRequest{EmployerID: 2, ApplicantID: 3, ResumeID: 1}, &entity.OutboxEvent{
+						Type: entity.ContactRequestedEvent,
+						Notification: &entity.Notification{
+							Type:         entity.ContactRequestType,
+							SenderID:     2,
+							SenderRole:   entity.EmployerRole,
+							ReceiverID:   3,
+							ReceiverRole: entity.ApplicantRole,
+							ResumeID:     1,
+							Payload:      entity.NotificationPayload{"profession": "Go-разработчик"},
+						},
+					}).
+					Return(&entity.ContactRequest{
+						ID:          5,
+						EmployerID:  2,
+						ApplicantID: 3,
+						ResumeID:    1,
+						Status:      entity.ContactRequestPending,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					}, nil)
+			},
+		},
+		{
+			name: "Контакты уже доступны",
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 3}, nil)
+				rr.EXPECT().IsEmployerBlocked(gomock.Any(), 3, 2).Return(false, nil)
+			},
+			expectedErr: entity.NewError(
+				entity.ErrBadRequest,
+				fmt.Errorf("контакты соискателя уже доступны"),
+			),
+		},
+		{
+			name: "Работодатель заблокирован",
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().
+					GetByID(gomock.Any(), 1).
+					Return(&entity.Resume{ID: 1, ApplicantID: 3, IsAnonymous: true}, nil)
+				rr.EXPECT().IsEmployerBlocked(gomock.Any(), 3, 2).Return(true, nil)
+			},
+			expectedErr: entity.NewError(
+				entity.ErrNotFound,
+				fmt.Errorf("резюме с id=%d не найдено", 1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			tc.mockSetup(mockResumeRepo)
+
+			service := NewResumeService(mockResumeRepo, nil, nil, nil, nil, nil, nil, config.ResumeConfig{})
+
+			result, err := service.RequestContacts(context.Background(), 1, 2)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 5, result.ID)
+				require.Equal(t, entity.ContactRequestPending, result.Status)
+				require.Zero(t, result.ApplicantID)
+			}
+		})
+	}
+}
+
+func TestResumeService_AnswerContactRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	request := &entity.ContactRequest{
+		ID:          5,
+		EmployerID:  2,
+		ApplicantID: 3,
+		ResumeID:    1,
+		Status:      entity.ContactRequestPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	testCases := []struct {
+		name                string
+		applicantID         int
+		accept              bool
+		mockSetup           func(*mock.MockResumeRepository)
+		expectedStatus      entity.ContactRequestStatus
+		expectedApplicantID int
+		expectedErr         error
+	}{
+		{
+			name:        "Соискатель принимает запрос",
+			applicantID: 3,
+			accept:      true,
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().GetContactRequestByID(gomock.Any(), 5).Return(request, nil)
+				accepted := *request
+				accepted.Status = entity.ContactRequestAccepted
+				rr.EXPECT().
+					UpdateContactRequestStatus(gomock.Any(), 5, entity.ContactRequestAccepted).
+					Return(&accepted, nil)
+			},
+			expectedStatus:      entity.ContactRequestAccepted,
+			expectedApplicantID: 3,
+		},
+		{
+			name:        "Соискатель отклоняет запрос",
+			applicantID: 3,
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().GetContactRequestByID(gomock.Any(), 5).Return(request, nil)
+				declined := *request
+				declined.Status = entity.ContactRequestDeclined
+				rr.EXPECT().
+					UpdateContactRequestStatus(gomock.Any(), 5, entity.ContactRequestDeclined).
+					Return(&declined, nil)
+			},
+			expectedStatus: entity.ContactRequestDeclined,
+		},
+		{
+			name:        "Запрос адресован другому соискателю",
+			applicantID: 4,
+			accept:      true,
+			mockSetup: func(rr *mock.MockResumeRepository) {
+				rr.EXPECT().GetContactRequestByID(gomock.Any(), 5).Return(request, nil)
+			},
+			expectedErr: entity.NewError(
+				entity.ErrForbidden,
+				fmt.Errorf("запрос контактов с id=%d адресован другому соискателю", 5),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockResumeRepo := mock.NewMockResumeRepository(ctrl)
+			tc.mockSetup(mockResumeRepo)
+
+			service := NewResumeService(mockResumeRepo, nil, nil, nil, nil, nil, nil, config.ResumeConfig{})
+
+			result, err := service.AnswerContactRequest(context.Background(), 5, tc.applicantID, tc.accept)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedStatus, result.Status)
+				require.Equal(t, tc.expectedApplicantID, result.ApplicantID)
+			}
+		})
+	}
+}
+
+func TestResumeService_UploadCourseFile(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("certificate")
+
+	testCases := []struct {
+		name        string
+		mockSetup   func(*m.MockStatic)
+		expected    *dto.UploadStaticResponse
+		expectedErr error
+	}{
+		{
+			name: "Успешная загрузка файла сертификата",
+			mockSetup: func(st *m.MockStatic) {
+				st.EXPECT().
+					UploadStatic(gomock.Any(), data).
+					Return(&dto.UploadStaticResponse{ID: 7, Path: "/static/7.png"}, nil)
+			},
+			expected: &dto.UploadStaticResponse{ID: 7, Path: "/static/7.png"},
+		},
+		{
+			name: "Ошибка сервиса статики",
+			mockSetup: func(st *m.MockStatic) {
+				st.EXPECT().
+					UploadStatic(gomock.Any(), data).
+					Return(nil, entity.NewError(entity.ErrBadRequest, fmt.Errorf("неподдерживаемый формат файла")))
+			},
+			expectedErr: entity.NewError(entity.ErrBadRequest, fmt.Errorf("неподдерживаемый формат файла")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStatic := m.NewMockStatic(ctrl)
+			tc.mockSetup(mockStatic)
+
+			service := NewResumeService(nil, nil, nil, nil, nil, mockStatic, nil, config.ResumeConfig{})
+
+			result, err := service.UploadCourseFile(context.Background(), data)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
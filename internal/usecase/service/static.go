@@ -6,6 +6,7 @@ import (
 	"ResuMatch/internal/metrics"
 	"ResuMatch/internal/repository"
 	"ResuMatch/internal/usecase"
+	"archive/zip"
 	"bytes"
 	"context"
 	"fmt"
@@ -26,6 +27,19 @@ var allowedTypes = map[string]string{
 	"image/png":  ".png",
 }
 
+const (
+	pdfContentType  = "application/pdf"
+	docxContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+)
+
+// Во вложениях чата кроме изображений разрешены резюме-портфолио и тестовые задания в PDF и DOCX
+var allowedAttachmentTypes = map[string]string{
+	"image/jpeg":    ".jpg",
+	"image/png":     ".png",
+	pdfContentType:  ".pdf",
+	docxContentType: ".docx",
+}
+
 func NewStaticService(staticRepository repository.StaticRepository) usecase.Static {
 	return &StaticService{
 		staticRepository: staticRepository,
@@ -62,6 +76,61 @@ func (s *StaticService) UploadStatic(ctx context.Context, data []byte) (*dto.Upl
 	}, nil
 }
 
+func (s *StaticService) UploadAttachment(ctx context.Context, data []byte) (*dto.UploadAttachmentResponse, error) {
+	const maxAttachmentSize = 10 << 20
+	if len(data) > maxAttachmentSize {
+		metrics.LayerErrorCounter.WithLabelValues("Static Service", "UploadAttachment").Inc()
+		return nil, entity.NewError(entity.ErrBadRequest, fmt.Errorf("размер вложения превышает 10MB"))
+	}
+
+	contentType, err := s.detectAttachmentType(data)
+	if err != nil {
+		metrics.LayerErrorCounter.WithLabelValues("Static Service", "UploadAttachment").Inc()
+		return nil, err
+	}
+
+	fileName := uuid.New().String() + allowedAttachmentTypes[contentType]
+	id, err := s.staticRepository.UploadPrivateStatic(ctx, fileName, contentType, data)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.UploadAttachmentResponse{
+		ID:          id,
+		ContentType: contentType,
+		Size:        len(data),
+	}, nil
+}
+
+// detectAttachmentType определяет тип по содержимому, а не по имени файла.
+// DOCX - это zip-архив, поэтому дополнительно проверяется наличие основного документа Word
+func (s *StaticService) detectAttachmentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png":
+		if err := s.validateImageContent(data, contentType); err != nil {
+			return "", err
+		}
+		return contentType, nil
+	case pdfContentType:
+		return contentType, nil
+	case "application/zip":
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return "", entity.NewError(entity.ErrBadRequest, fmt.Errorf("невалидный документ: %w", err))
+		}
+		for _, file := range archive.File {
+			if file.Name == "word/document.xml" {
+				return docxContentType, nil
+			}
+		}
+	}
+	return "", entity.NewError(entity.ErrBadRequest, fmt.Errorf("недопустимый формат вложения"))
+}
+
+func (s *StaticService) DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error) {
+	return s.staticRepository.DownloadStatic(ctx, id)
+}
+
 func (s *StaticService) validateImageContent(data []byte, contentType string) error {
 	switch contentType {
 	case "image/jpeg", "image/png":
@@ -1 +1,118 @@
 package service
+
+import (
+	"ResuMatch/internal/entity"
+	"ResuMatch/internal/entity/dto"
+	"ResuMatch/internal/metrics"
+	"ResuMatch/internal/repository/mock"
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	metrics.Init("service_test")
+	os.Exit(m.Run())
+}
+
+func zipArchive(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, name := range names {
+		file, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte("<xml/>"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	return buf.Bytes()
+}
+
+func TestStaticService_UploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	pdf := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF")
+	docx := zipArchive(t, "[Content_Types].xml", "word/document.xml")
+
+	testCases := []struct {
+		name        string
+		data        []byte
+		mockSetup   func(repo *mock.MockStaticRepository, data []byte)
+		expected    *dto.UploadAttachmentResponse
+		expectedErr error
+	}{
+		{
+			name: "Success - pdf",
+			data: pdf,
+			mockSetup: func(repo *mock.MockStaticRepository, data []byte) {
+				repo.EXPECT().
+					UploadPrivateStatic(gomock.Any(), gomock.Any(), pdfContentType, data).
+					Return(7, nil)
+			},
+			expected: &dto.UploadAttachmentResponse{ID: 7, ContentType: pdfContentType, Size: len(pdf)},
+		},
+		{
+			name: "Success - docx",
+			data: docx,
+			mockSetup: func(repo *mock.MockStaticRepository, data []byte) {
+				repo.EXPECT().
+					UploadPrivateStatic(gomock.Any(), gomock.Any(), docxContentType, data).
+					Return(8, nil)
+			},
+			expected: &dto.UploadAttachmentResponse{ID: 8, ContentType: docxContentType, Size: len(docx)},
+		},
+		{
+			name:        "Error - zip archive is not a document",
+			data:        zipArchive(t, "payload.exe"),
+			mockSetup:   func(repo *mock.MockStaticRepository, data []byte) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - plain text",
+			data:        []byte("просто текст"),
+			mockSetup:   func(repo *mock.MockStaticRepository, data []byte) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+		{
+			name:        "Error - too large",
+			data:        append([]byte("%PDF-1.4\n"), make([]byte, 10<<20)...),
+			mockSetup:   func(repo *mock.MockStaticRepository, data []byte) {},
+			expectedErr: entity.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock.NewMockStaticRepository(ctrl)
+			tc.mockSetup(repo, tc.data)
+
+			service := &StaticService{staticRepository: repo}
+
+			got, err := service.UploadAttachment(context.Background(), tc.data)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Nil(t, got)
+				var serviceErr entity.Error
+				require.ErrorAs(t, err, &serviceErr)
+				require.ErrorIs(t, serviceErr.ClientErr(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
@@ -274,7 +274,11 @@ func (vs *VacanciesService) UpdateVacancy(ctx context.Context, id int, employerI
 		return nil, err
 	}
 
-	updatedVacancy, err := vs.vacanciesRepository.Update(ctx, vacancy)
+	event := &entity.OutboxEvent{
+		Type:    entity.VacancyUpdatedEvent,
+		Objects: map[string]int{"vacancy_id": id, "employer_id": employerID},
+	}
+	updatedVacancy, err := vs.vacanciesRepository.Update(ctx, vacancy, event)
 	if err != nil {
 		return nil, err
 	}
@@ -458,32 +462,45 @@ func (s *VacanciesService) GetAll(ctx context.Context, currentUserID int, userRo
 	return response, nil
 }
 
-func (vs *VacanciesService) ApplyToVacancy(ctx context.Context, vacancyID, applicantID, resumeID int) (entity.Notification, error) {
-	notification := entity.Notification{}
+// ApplyToVacancy откликается на вакансию или отзывает отклик, если он уже есть. Отклик и отзыв
+// записываются событием в одной транзакции с изменением и доставляются диспетчером outbox
+func (vs *VacanciesService) ApplyToVacancy(ctx context.Context, vacancyID, applicantID, resumeID int) error {
 	vacancy, err := vs.vacanciesRepository.GetByID(ctx, vacancyID)
 	if err != nil {
-		return notification, fmt.Errorf("vacancy not found: %w", err)
+		return fmt.Errorf("vacancy not found: %w", err)
 	}
 
 	hasResponded, err := vs.vacanciesRepository.ResponseExists(ctx, vacancyID, applicantID)
 	if err != nil {
-		return notification, fmt.Errorf("failed to check existing responses: %w", err)
+		return fmt.Errorf("failed to check existing responses: %w", err)
 	}
 	if hasResponded {
-		return notification, vs.vacanciesRepository.DeleteResponse(ctx, vacancyID, applicantID, resumeID)
-	}
-
-	notification = entity.Notification{
-		Type:         entity.ApplyNotificationType,
-		SenderID:     applicantID,
-		SenderRole:   entity.ApplicantRole,
-		ReceiverID:   vacancy.EmployerID,
-		ReceiverRole: entity.EmployerRole,
-		ObjectID:     vacancy.ID,
-		ResumeID:     resumeID,
-	}
-
-	return notification, vs.vacanciesRepository.CreateResponse(ctx, vacancyID, applicantID, resumeID)
+		return vs.vacanciesRepository.DeleteResponse(ctx, vacancyID, applicantID, resumeID, &entity.OutboxEvent{
+			Type: entity.ResponseWithdrawnEvent,
+			Objects: map[string]int{
+				"vacancy_id":   vacancyID,
+				"applicant_id": applicantID,
+				"resume_id":    resumeID,
+				"employer_id":  vacancy.EmployerID,
+			},
+		})
+	}
+
+	event := &entity.OutboxEvent{
+		Type: entity.ResponseCreatedEvent,
+		Notification: &entity.Notification{
+			Type:         entity.ApplyNotificationType,
+			SenderID:     applicantID,
+			SenderRole:   entity.ApplicantRole,
+			ReceiverID:   vacancy.EmployerID,
+			ReceiverRole: entity.EmployerRole,
+			ObjectID:     vacancy.ID,
+			ResumeID:     resumeID,
+			Payload:      entity.NotificationPayload{"vacancy_title": vacancy.Title},
+		},
+	}
+
+	return vs.vacanciesRepository.CreateResponse(ctx, vacancyID, applicantID, resumeID, event)
 }
 
 func (vs *VacanciesService) GetRespondedResumeOnVacancy(ctx context.Context, vacancyID int, limit, offset int) ([]dto.ResumeApplicantShortResponse, error) {
@@ -597,8 +597,11 @@ func TestVacanciesService_UpdateVacancy(t *testing.T) {
 					Return(42, nil)
 
 				vr.EXPECT().
-					Update(gomock.Any(), gomock.AssignableToTypeOf(&entity.Vacancy{})).
-					DoAndReturn(func(_ context.Context, vacancy *entity.Vacancy) (*entity.Vacancy, error) {
+					Update(gomock.Any(), gomock.AssignableToTypeOf(&entity.Vacancy{}), &entity.OutboxEvent{
+						Type:    entity.VacancyUpdatedEvent,
+						Objects: map[string]int{"vacancy_id": 1, "employer_id": 10},
+					}).
+					DoAndReturn(func(_ context.Context, vacancy *entity.Vacancy, _ *entity.OutboxEvent) (*entity.Vacancy, error) {
 						vacancy.CreatedAt = now
 						vacancy.UpdatedAt = now
 						return vacancy, nil
@@ -1252,7 +1255,6 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 		applicantID   int
 		resumeID      int
 		mockSetup     func(*mock.MockVacancyRepository)
-		expectedNotif entity.Notification
 		expectedErr   error
 		expectedErrAs interface{}
 	}{
@@ -1264,25 +1266,28 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 			mockSetup: func(vr *mock.MockVacancyRepository) {
 				vr.EXPECT().
 					GetByID(gomock.Any(), 1).
-					Return(&entity.Vacancy{ID: 1, EmployerID: 2}, nil)
+					Return(&entity.Vacancy{ID: 1, EmployerID: 2, Title: "Backend Developer"}, nil)
 
 				vr.EXPECT().
 					ResponseExists(gomock.Any(), 1, 1).
 					Return(false, nil)
 
 				vr.EXPECT().
-					CreateResponse(gomock.Any(), 1, 1, 1).
+					CreateResponse(gomock.Any(), 1, 1, 1, &entity.OutboxEvent{
+						Type: entity.ResponseCreatedEvent,
+						Notification: &entity.Notification{
+							Type:         entity.ApplyNotificationType,
+							SenderID:     1,
+							SenderRole:   entity.ApplicantRole,
+							ReceiverID:   2,
+							ReceiverRole: entity.EmployerRole,
+							ObjectID:     1,
+							ResumeID:     1,
+							Payload:      entity.NotificationPayload{"vacancy_title": "Backend Developer"},
+						},
+					}).
 					Return(nil)
 			},
-			expectedNotif: entity.Notification{
-				Type:         entity.ApplyNotificationType,
-				SenderID:     1,
-				SenderRole:   entity.ApplicantRole,
-				ReceiverID:   2,
-				ReceiverRole: entity.EmployerRole,
-				ObjectID:     1,
-				ResumeID:     1,
-			},
 			expectedErr: nil,
 		},
 		{
@@ -1300,19 +1305,12 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 					Return(true, nil)
 
 				vr.EXPECT().
-					DeleteResponse(gomock.Any(), 1, 1, 1).
+					DeleteResponse(gomock.Any(), 1, 1, 1, &entity.OutboxEvent{
+						Type:    entity.ResponseWithdrawnEvent,
+						Objects: map[string]int{"vacancy_id": 1, "applicant_id": 1, "resume_id": 1, "employer_id": 2},
+					}).
 					Return(nil)
 			},
-			expectedNotif: entity.Notification{
-				Type:         "",
-				SenderID:     0,
-				SenderRole:   "",
-				ReceiverID:   0,
-				ReceiverRole: "",
-				ObjectID:     0,
-				ResumeID:     0,
-				IsViewed:     false,
-			},
 			expectedErr: nil,
 		},
 		{
@@ -1328,7 +1326,6 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 						fmt.Errorf("vacancy not found"),
 					))
 			},
-			expectedNotif: entity.Notification{},
 			expectedErr: fmt.Errorf("vacancy not found: %w", entity.NewError(
 				entity.ErrNotFound,
 				fmt.Errorf("vacancy not found"),
@@ -1348,8 +1345,7 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 					ResponseExists(gomock.Any(), 1, 1).
 					Return(false, fmt.Errorf("database error"))
 			},
-			expectedNotif: entity.Notification{},
-			expectedErr:   fmt.Errorf("failed to check existing responses: %w", fmt.Errorf("database error")),
+			expectedErr: fmt.Errorf("failed to check existing responses: %w", fmt.Errorf("database error")),
 		},
 		{
 			name:        "Ошибка при удалении отклика",
@@ -1366,11 +1362,10 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 					Return(true, nil)
 
 				vr.EXPECT().
-					DeleteResponse(gomock.Any(), 1, 1, 1).
+					DeleteResponse(gomock.Any(), 1, 1, 1, gomock.Any()).
 					Return(fmt.Errorf("delete error"))
 			},
-			expectedNotif: entity.Notification{},
-			expectedErr:   fmt.Errorf("delete error"),
+			expectedErr: fmt.Errorf("delete error"),
 		},
 		{
 			name:        "Ошибка при создании отклика",
@@ -1387,11 +1382,10 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 					Return(false, nil)
 
 				vr.EXPECT().
-					CreateResponse(gomock.Any(), 1, 1, 1).
+					CreateResponse(gomock.Any(), 1, 1, 1, gomock.Any()).
 					Return(fmt.Errorf("create error"))
 			},
-			expectedNotif: entity.Notification{},
-			expectedErr:   fmt.Errorf("create error"),
+			expectedErr: fmt.Errorf("create error"),
 		},
 	}
 
@@ -1422,7 +1416,7 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 			)
 			ctx := context.Background()
 
-			notification, err := service.ApplyToVacancy(ctx, tc.vacancyID, tc.applicantID, tc.resumeID)
+			err := service.ApplyToVacancy(ctx, tc.vacancyID, tc.applicantID, tc.resumeID)
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -1433,7 +1427,6 @@ func TestVacanciesService_ApplyToVacancy(t *testing.T) {
 				}
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expectedNotif, notification)
 			}
 		})
 	}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"ResuMatch/internal/entity"
 	"ResuMatch/internal/entity/dto"
 	"context"
 )
@@ -9,4 +10,6 @@ type Static interface {
 	UploadStatic(ctx context.Context, data []byte) (*dto.UploadStaticResponse, error)
 	GetStatic(ctx context.Context, id int) (string, error)
 	DeleteStatic(ctx context.Context, id int) error
+	UploadAttachment(ctx context.Context, data []byte) (*dto.UploadAttachmentResponse, error)
+	DownloadStatic(ctx context.Context, id int) (*entity.StaticContent, error)
 }